@@ -0,0 +1,304 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// annihilator returns a non-zero vector in the kernel of op, or nil if op is
+// injective.
+func annihilator(op *Operator) []*big.Rat {
+	kernel := RatMatrixOf(op.Matrix()).Kernel()
+	if len(kernel) == 0 {
+		return nil
+	}
+	return kernel[0]
+}
+
+// Annihilator sets z equal to a non-zero value w with yw = 0, and returns
+// true. The value w is found in the kernel of the matrix of left
+// multiplication by y. If y is not a zero divisor, then z is left unchanged
+// and Annihilator returns false.
+func (z *Infra) Annihilator(y *Infra) bool {
+	w := annihilator(y.LeftMul())
+	if w == nil {
+		return false
+	}
+	setComponents(z.Components(), w)
+	return true
+}
+
+// Annihilator sets z equal to a non-zero value w with yw = 0, and returns
+// true. The value w is found in the kernel of the matrix of left
+// multiplication by y. If y = a(1±s), then w is a multiple of 1∓s: the null
+// cone of Perplex is the union of the lines through the idempotents (1±s)/2,
+// which annihilate each other. If y is not a zero divisor, then z is left
+// unchanged and Annihilator returns false.
+func (z *Perplex) Annihilator(y *Perplex) bool {
+	w := annihilator(y.LeftMul())
+	if w == nil {
+		return false
+	}
+	setComponents(z.Components(), w)
+	return true
+}
+
+// Annihilator sets z equal to a non-zero value w with yw = 0, and returns
+// true. The value w is found in the kernel of the matrix of left
+// multiplication by y. If y is not a zero divisor, then z is left unchanged
+// and Annihilator returns false.
+func (z *BiComplex) Annihilator(y *BiComplex) bool {
+	w := annihilator(y.LeftMul())
+	if w == nil {
+		return false
+	}
+	setComponents(z.Components(), w)
+	return true
+}
+
+// Annihilator sets z equal to a non-zero value w with yw = 0, and returns
+// true. The value w is found in the kernel of the matrix of left
+// multiplication by y. If y is not a zero divisor, then z is left unchanged
+// and Annihilator returns false.
+func (z *BiPerplex) Annihilator(y *BiPerplex) bool {
+	w := annihilator(y.LeftMul())
+	if w == nil {
+		return false
+	}
+	setComponents(z.Components(), w)
+	return true
+}
+
+// Annihilator sets z equal to a non-zero value w with yw = 0, and returns
+// true. The value w is found in the kernel of the matrix of left
+// multiplication by y. If y is not a zero divisor, then z is left unchanged
+// and Annihilator returns false.
+func (z *Cockle) Annihilator(y *Cockle) bool {
+	w := annihilator(y.LeftMul())
+	if w == nil {
+		return false
+	}
+	setComponents(z.Components(), w)
+	return true
+}
+
+// Annihilator sets z equal to a non-zero value w with yw = 0, and returns
+// true. The value w is found in the kernel of the matrix of left
+// multiplication by y. If y is not a zero divisor, then z is left unchanged
+// and Annihilator returns false.
+func (z *DualComplex) Annihilator(y *DualComplex) bool {
+	w := annihilator(y.LeftMul())
+	if w == nil {
+		return false
+	}
+	setComponents(z.Components(), w)
+	return true
+}
+
+// Annihilator sets z equal to a non-zero value w with yw = 0, and returns
+// true. The value w is found in the kernel of the matrix of left
+// multiplication by y. If y is not a zero divisor, then z is left unchanged
+// and Annihilator returns false.
+func (z *DualPerplex) Annihilator(y *DualPerplex) bool {
+	w := annihilator(y.LeftMul())
+	if w == nil {
+		return false
+	}
+	setComponents(z.Components(), w)
+	return true
+}
+
+// Annihilator sets z equal to a non-zero value w with yw = 0, and returns
+// true. The value w is found in the kernel of the matrix of left
+// multiplication by y. If y is not a zero divisor, then z is left unchanged
+// and Annihilator returns false.
+func (z *Hyper) Annihilator(y *Hyper) bool {
+	w := annihilator(y.LeftMul())
+	if w == nil {
+		return false
+	}
+	setComponents(z.Components(), w)
+	return true
+}
+
+// Annihilator sets z equal to a non-zero value w with yw = 0, and returns
+// true. The value w is found in the kernel of the matrix of left
+// multiplication by y. If y is not a zero divisor, then z is left unchanged
+// and Annihilator returns false.
+func (z *InfraComplex) Annihilator(y *InfraComplex) bool {
+	w := annihilator(y.LeftMul())
+	if w == nil {
+		return false
+	}
+	setComponents(z.Components(), w)
+	return true
+}
+
+// Annihilator sets z equal to a non-zero value w with yw = 0, and returns
+// true. The value w is found in the kernel of the matrix of left
+// multiplication by y. If y is not a zero divisor, then z is left unchanged
+// and Annihilator returns false.
+func (z *InfraPerplex) Annihilator(y *InfraPerplex) bool {
+	w := annihilator(y.LeftMul())
+	if w == nil {
+		return false
+	}
+	setComponents(z.Components(), w)
+	return true
+}
+
+// Annihilator sets z equal to a non-zero value w with yw = 0, and returns
+// true. The value w is found in the kernel of the matrix of left
+// multiplication by y. If y is not a zero divisor, then z is left unchanged
+// and Annihilator returns false.
+func (z *Supra) Annihilator(y *Supra) bool {
+	w := annihilator(y.LeftMul())
+	if w == nil {
+		return false
+	}
+	setComponents(z.Components(), w)
+	return true
+}
+
+// Annihilator sets z equal to a non-zero value w with yw = 0, and returns
+// true. The value w is found in the kernel of the matrix of left
+// multiplication by y. If y is not a zero divisor, then z is left unchanged
+// and Annihilator returns false.
+func (z *BiCockle) Annihilator(y *BiCockle) bool {
+	w := annihilator(y.LeftMul())
+	if w == nil {
+		return false
+	}
+	setComponents(z.Components(), w)
+	return true
+}
+
+// Annihilator sets z equal to a non-zero value w with yw = 0, and returns
+// true. The value w is found in the kernel of the matrix of left
+// multiplication by y. If y is not a zero divisor, then z is left unchanged
+// and Annihilator returns false.
+func (z *BiHamilton) Annihilator(y *BiHamilton) bool {
+	w := annihilator(y.LeftMul())
+	if w == nil {
+		return false
+	}
+	setComponents(z.Components(), w)
+	return true
+}
+
+// Annihilator sets z equal to a non-zero value w with yw = 0, and returns
+// true. The value w is found in the kernel of the matrix of left
+// multiplication by y. If y is not a zero divisor, then z is left unchanged
+// and Annihilator returns false.
+func (z *InfraCockle) Annihilator(y *InfraCockle) bool {
+	w := annihilator(y.LeftMul())
+	if w == nil {
+		return false
+	}
+	setComponents(z.Components(), w)
+	return true
+}
+
+// Annihilator sets z equal to a non-zero value w with yw = 0, and returns
+// true. The value w is found in the kernel of the matrix of left
+// multiplication by y. If y is not a zero divisor, then z is left unchanged
+// and Annihilator returns false.
+func (z *InfraHamilton) Annihilator(y *InfraHamilton) bool {
+	w := annihilator(y.LeftMul())
+	if w == nil {
+		return false
+	}
+	setComponents(z.Components(), w)
+	return true
+}
+
+// Annihilator sets z equal to a non-zero value w with yw = 0, and returns
+// true. The value w is found in the kernel of the matrix of left
+// multiplication by y. If y is not a zero divisor, then z is left unchanged
+// and Annihilator returns false.
+func (z *SupraComplex) Annihilator(y *SupraComplex) bool {
+	w := annihilator(y.LeftMul())
+	if w == nil {
+		return false
+	}
+	setComponents(z.Components(), w)
+	return true
+}
+
+// Annihilator sets z equal to a non-zero value w with yw = 0, and returns
+// true. The value w is found in the kernel of the matrix of left
+// multiplication by y. If y is not a zero divisor, then z is left unchanged
+// and Annihilator returns false.
+func (z *SupraPerplex) Annihilator(y *SupraPerplex) bool {
+	w := annihilator(y.LeftMul())
+	if w == nil {
+		return false
+	}
+	setComponents(z.Components(), w)
+	return true
+}
+
+// Annihilator sets z equal to a non-zero value w with yw = 0, and returns
+// true. The value w is found in the kernel of the matrix of left
+// multiplication by y. If y is not a zero divisor, then z is left unchanged
+// and Annihilator returns false.
+func (z *TriComplex) Annihilator(y *TriComplex) bool {
+	w := annihilator(y.LeftMul())
+	if w == nil {
+		return false
+	}
+	setComponents(z.Components(), w)
+	return true
+}
+
+// Annihilator sets z equal to a non-zero value w with yw = 0, and returns
+// true. The value w is found in the kernel of the matrix of left
+// multiplication by y. If y is not a zero divisor, then z is left unchanged
+// and Annihilator returns false.
+func (z *TriNilplex) Annihilator(y *TriNilplex) bool {
+	w := annihilator(y.LeftMul())
+	if w == nil {
+		return false
+	}
+	setComponents(z.Components(), w)
+	return true
+}
+
+// Annihilator sets z equal to a non-zero value w with yw = 0, and returns
+// true. The value w is found in the kernel of the matrix of left
+// multiplication by y. If y is not a zero divisor, then z is left unchanged
+// and Annihilator returns false.
+func (z *TriPerplex) Annihilator(y *TriPerplex) bool {
+	w := annihilator(y.LeftMul())
+	if w == nil {
+		return false
+	}
+	setComponents(z.Components(), w)
+	return true
+}
+
+// Annihilator sets z equal to a non-zero value w with yw = 0, and returns
+// true. The value w is found in the kernel of the matrix of left
+// multiplication by y. If y is not a zero divisor, then z is left unchanged
+// and Annihilator returns false.
+func (z *Ultra) Annihilator(y *Ultra) bool {
+	w := annihilator(y.LeftMul())
+	if w == nil {
+		return false
+	}
+	setComponents(z.Components(), w)
+	return true
+}
+
+// Annihilator sets z equal to a non-zero value w with yw = 0, and returns
+// true. The value w is found in the kernel of the matrix of left
+// multiplication by y. If y is not a zero divisor, then z is left unchanged
+// and Annihilator returns false.
+func (z *Zorn) Annihilator(y *Zorn) bool {
+	w := annihilator(y.LeftMul())
+	if w == nil {
+		return false
+	}
+	setComponents(z.Components(), w)
+	return true
+}
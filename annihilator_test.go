@@ -0,0 +1,66 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestPerplexAnnihilator(t *testing.T) {
+	one, zero := big.NewRat(1, 1), big.NewRat(0, 1)
+	y := NewPerplex(big.NewRat(3, 1), big.NewRat(3, 1))
+	z := new(Perplex)
+	if !z.Annihilator(y) {
+		t.Fatalf("Annihilator(%v) = false", y)
+	}
+	if p := new(Perplex).Mul(y, z); !p.Equals(new(Perplex)) || z.Equals(new(Perplex)) {
+		t.Errorf("Annihilator(%v) = %v", y, z)
+	}
+	if z.Real().Cmp(new(big.Rat).Neg(&z.r)) != 0 {
+		t.Errorf("Annihilator(%v) = %v, want a multiple of 1-s", y, z)
+	}
+	if x := NewPerplex(one, zero); z.Annihilator(x) {
+		t.Errorf("Annihilator(%v) = true", x)
+	}
+}
+
+func TestAnnihilator(t *testing.T) {
+	one, zero := big.NewRat(1, 1), big.NewRat(0, 1)
+	check := func(name string, ok bool, y, z, p interface{ Components() []*big.Rat }) {
+		if !ok {
+			t.Errorf("%s: Annihilator(%v) = false", name, y)
+			return
+		}
+		for _, x := range p.Components() {
+			if x.Sign() != 0 {
+				t.Errorf("%s: Annihilator(%v) = %v, product %v", name, y, z, p)
+				return
+			}
+		}
+	}
+	a := NewInfra(zero, one)
+	b := new(Infra)
+	check("Infra", b.Annihilator(a), a, b, new(Infra).Mul(a, b))
+	c := NewCockle(one, zero, one, zero)
+	d := new(Cockle)
+	check("Cockle", d.Annihilator(c), c, d, new(Cockle).Mul(c, d))
+	e := NewBiComplex(one, zero, zero, one)
+	f := new(BiComplex)
+	check("BiComplex", f.Annihilator(e), e, f, new(BiComplex).Mul(e, f))
+	g := NewZorn(one, zero, zero, zero, one, zero, zero, zero)
+	h := new(Zorn)
+	check("Zorn", h.Annihilator(g), g, h, new(Zorn).Mul(g, h))
+}
+
+func TestZornAnnihilator(t *testing.T) {
+	f := func(x *Zorn) bool {
+		// t.Logf("x = %v", x)
+		return new(Zorn).Annihilator(x) == x.IsZeroDivisor()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
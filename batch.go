@@ -0,0 +1,328 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "runtime"
+
+// ComplexInvSlice sets each z[i] equal to the inverse of y[i], and returns z.
+// If the lengths of z and y differ, or if some y[i] is zero, then
+// ComplexInvSlice panics.
+func ComplexInvSlice(z, y []*Complex) []*Complex {
+	if len(z) != len(y) {
+		panic("length mismatch")
+	}
+	for i := range y {
+		q := y[i].Quad()
+		if q.Sign() == 0 {
+			putRat(q)
+			panic(denominatorError("inverse of zero"))
+		}
+		z[i].Scal(z[i].Conj(y[i]), q.Inv(q))
+		putRat(q)
+	}
+	return z
+}
+
+// PerplexInvSlice sets each z[i] equal to the inverse of y[i], and returns z.
+// If the lengths of z and y differ, or if some y[i] is a zero divisor, then
+// PerplexInvSlice panics.
+func PerplexInvSlice(z, y []*Perplex) []*Perplex {
+	if len(z) != len(y) {
+		panic("length mismatch")
+	}
+	for i := range y {
+		q := y[i].Quad()
+		if q.Sign() == 0 {
+			putRat(q)
+			panic(divisorError("inverse of zero divisor"))
+		}
+		z[i].Scal(z[i].Conj(y[i]), q.Inv(q))
+		putRat(q)
+	}
+	return z
+}
+
+// InfraInvSlice sets each z[i] equal to the inverse of y[i], and returns z. If
+// the lengths of z and y differ, or if some y[i] is a zero divisor, then
+// InfraInvSlice panics.
+func InfraInvSlice(z, y []*Infra) []*Infra {
+	if len(z) != len(y) {
+		panic("length mismatch")
+	}
+	for i := range y {
+		q := y[i].Quad()
+		if q.Sign() == 0 {
+			putRat(q)
+			panic(divisorError("inverse of zero divisor"))
+		}
+		z[i].Scal(z[i].Conj(y[i]), q.Inv(q))
+		putRat(q)
+	}
+	return z
+}
+
+// HamiltonInvSlice sets each z[i] equal to the inverse of y[i], and returns z.
+// If the lengths of z and y differ, or if some y[i] is zero, then
+// HamiltonInvSlice panics.
+func HamiltonInvSlice(z, y []*Hamilton) []*Hamilton {
+	if len(z) != len(y) {
+		panic("length mismatch")
+	}
+	for i := range y {
+		q := y[i].Quad()
+		if q.Sign() == 0 {
+			putRat(q)
+			panic(denominatorError("inverse of zero"))
+		}
+		z[i].Scal(z[i].Conj(y[i]), q.Inv(q))
+		putRat(q)
+	}
+	return z
+}
+
+// CockleInvSlice sets each z[i] equal to the inverse of y[i], and returns z. If
+// the lengths of z and y differ, or if some y[i] is a zero divisor, then
+// CockleInvSlice panics.
+func CockleInvSlice(z, y []*Cockle) []*Cockle {
+	if len(z) != len(y) {
+		panic("length mismatch")
+	}
+	for i := range y {
+		q := y[i].Quad()
+		if q.Sign() == 0 {
+			putRat(q)
+			panic(divisorError("inverse of zero divisor"))
+		}
+		z[i].Scal(z[i].Conj(y[i]), q.Inv(q))
+		putRat(q)
+	}
+	return z
+}
+
+// InfraComplexInvSlice sets each z[i] equal to the inverse of y[i], and returns
+// z. If the lengths of z and y differ, or if some y[i] is a zero divisor, then
+// InfraComplexInvSlice panics.
+func InfraComplexInvSlice(z, y []*InfraComplex) []*InfraComplex {
+	if len(z) != len(y) {
+		panic("length mismatch")
+	}
+	for i := range y {
+		q := y[i].Quad()
+		if q.Sign() == 0 {
+			putRat(q)
+			panic(divisorError("inverse of zero divisor"))
+		}
+		z[i].Scal(z[i].Conj(y[i]), q.Inv(q))
+		putRat(q)
+	}
+	return z
+}
+
+// InfraPerplexInvSlice sets each z[i] equal to the inverse of y[i], and returns
+// z. If the lengths of z and y differ, or if some y[i] is a zero divisor, then
+// InfraPerplexInvSlice panics.
+func InfraPerplexInvSlice(z, y []*InfraPerplex) []*InfraPerplex {
+	if len(z) != len(y) {
+		panic("length mismatch")
+	}
+	for i := range y {
+		q := y[i].Quad()
+		if q.Sign() == 0 {
+			putRat(q)
+			panic(divisorError("inverse of zero divisor"))
+		}
+		z[i].Scal(z[i].Conj(y[i]), q.Inv(q))
+		putRat(q)
+	}
+	return z
+}
+
+// SupraInvSlice sets each z[i] equal to the inverse of y[i], and returns z. If
+// the lengths of z and y differ, or if some y[i] is a zero divisor, then
+// SupraInvSlice panics.
+func SupraInvSlice(z, y []*Supra) []*Supra {
+	if len(z) != len(y) {
+		panic("length mismatch")
+	}
+	for i := range y {
+		q := y[i].Quad()
+		if q.Sign() == 0 {
+			putRat(q)
+			panic(divisorError("inverse of zero divisor"))
+		}
+		z[i].Scal(z[i].Conj(y[i]), q.Inv(q))
+		putRat(q)
+	}
+	return z
+}
+
+// CayleyInvSlice sets each z[i] equal to the inverse of y[i], and returns z. If
+// the lengths of z and y differ, or if some y[i] is zero, then CayleyInvSlice
+// panics.
+func CayleyInvSlice(z, y []*Cayley) []*Cayley {
+	if len(z) != len(y) {
+		panic("length mismatch")
+	}
+	for i := range y {
+		q := y[i].Quad()
+		if q.Sign() == 0 {
+			putRat(q)
+			panic(denominatorError("inverse of zero"))
+		}
+		z[i].Scal(z[i].Conj(y[i]), q.Inv(q))
+		putRat(q)
+	}
+	return z
+}
+
+// ZornInvSlice sets each z[i] equal to the inverse of y[i], and returns z. If
+// the lengths of z and y differ, or if some y[i] is a zero divisor, then
+// ZornInvSlice panics.
+func ZornInvSlice(z, y []*Zorn) []*Zorn {
+	if len(z) != len(y) {
+		panic("length mismatch")
+	}
+	for i := range y {
+		q := y[i].Quad()
+		if q.Sign() == 0 {
+			putRat(q)
+			panic(divisorError("inverse of zero divisor"))
+		}
+		z[i].Scal(z[i].Conj(y[i]), q.Inv(q))
+		putRat(q)
+	}
+	return z
+}
+
+// InfraHamiltonInvSlice sets each z[i] equal to the inverse of y[i], and
+// returns z. If the lengths of z and y differ, or if some y[i] is a zero
+// divisor, then InfraHamiltonInvSlice panics.
+func InfraHamiltonInvSlice(z, y []*InfraHamilton) []*InfraHamilton {
+	if len(z) != len(y) {
+		panic("length mismatch")
+	}
+	for i := range y {
+		q := y[i].Quad()
+		if q.Sign() == 0 {
+			putRat(q)
+			panic(divisorError("inverse of zero divisor"))
+		}
+		z[i].Scal(z[i].Conj(y[i]), q.Inv(q))
+		putRat(q)
+	}
+	return z
+}
+
+// InfraCockleInvSlice sets each z[i] equal to the inverse of y[i], and returns
+// z. If the lengths of z and y differ, or if some y[i] is a zero divisor, then
+// InfraCockleInvSlice panics.
+func InfraCockleInvSlice(z, y []*InfraCockle) []*InfraCockle {
+	if len(z) != len(y) {
+		panic("length mismatch")
+	}
+	for i := range y {
+		q := y[i].Quad()
+		if q.Sign() == 0 {
+			putRat(q)
+			panic(divisorError("inverse of zero divisor"))
+		}
+		z[i].Scal(z[i].Conj(y[i]), q.Inv(q))
+		putRat(q)
+	}
+	return z
+}
+
+// SupraComplexInvSlice sets each z[i] equal to the inverse of y[i], and returns
+// z. If the lengths of z and y differ, or if some y[i] is a zero divisor, then
+// SupraComplexInvSlice panics.
+func SupraComplexInvSlice(z, y []*SupraComplex) []*SupraComplex {
+	if len(z) != len(y) {
+		panic("length mismatch")
+	}
+	for i := range y {
+		q := y[i].Quad()
+		if q.Sign() == 0 {
+			putRat(q)
+			panic(divisorError("inverse of zero divisor"))
+		}
+		z[i].Scal(z[i].Conj(y[i]), q.Inv(q))
+		putRat(q)
+	}
+	return z
+}
+
+// SupraPerplexInvSlice sets each z[i] equal to the inverse of y[i], and returns
+// z. If the lengths of z and y differ, or if some y[i] is a zero divisor, then
+// SupraPerplexInvSlice panics.
+func SupraPerplexInvSlice(z, y []*SupraPerplex) []*SupraPerplex {
+	if len(z) != len(y) {
+		panic("length mismatch")
+	}
+	for i := range y {
+		q := y[i].Quad()
+		if q.Sign() == 0 {
+			putRat(q)
+			panic(divisorError("inverse of zero divisor"))
+		}
+		z[i].Scal(z[i].Conj(y[i]), q.Inv(q))
+		putRat(q)
+	}
+	return z
+}
+
+// UltraInvSlice sets each z[i] equal to the inverse of y[i], and returns z. If
+// the lengths of z and y differ, or if some y[i] is a zero divisor, then
+// UltraInvSlice panics.
+func UltraInvSlice(z, y []*Ultra) []*Ultra {
+	if len(z) != len(y) {
+		panic("length mismatch")
+	}
+	for i := range y {
+		q := y[i].Quad()
+		if q.Sign() == 0 {
+			putRat(q)
+			panic(divisorError("inverse of zero divisor"))
+		}
+		z[i].Scal(z[i].Conj(y[i]), q.Inv(q))
+		putRat(q)
+	}
+	return z
+}
+
+// MulBatch sets each dst[i] equal to xs[i] times ys[i], and returns dst. The
+// products are computed by up to workers goroutines, each handling a run of
+// consecutive indices; if workers is not positive, then GOMAXPROCS(0) is used.
+// A nil dst[i] is allocated. Each worker multiplies into dst[i] directly, or,
+// when dst[i] aliases xs[i] or ys[i], into a scratch value of its own that is
+// reused for every aliased product, so the copies that Mul makes for aliased
+// arguments are avoided. An element of dst must not appear at another index
+// of dst, xs, or ys. If the lengths of dst, xs, and ys differ, then MulBatch
+// panics.
+func MulBatch[S any, T Elem[S]](dst, xs, ys []T, workers int) []T {
+	if len(dst) != len(xs) || len(dst) != len(ys) {
+		panic("length mismatch")
+	}
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	m := max(1, min(workers, len(dst)))
+	parallel(len(dst), m, func(_, lo, hi int) {
+		var scratch T
+		for i := lo; i < hi; i++ {
+			switch {
+			case dst[i] == nil:
+				dst[i] = T(new(S))
+				dst[i].Mul(xs[i], ys[i])
+			case dst[i] == xs[i] || dst[i] == ys[i]:
+				if scratch == nil {
+					scratch = T(new(S))
+				}
+				dst[i].Set(scratch.Mul(xs[i], ys[i]))
+			default:
+				dst[i].Mul(xs[i], ys[i])
+			}
+		}
+	})
+	return dst
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+	"testing/quick"
+)
+
+func TestHamiltonInvSlice(t *testing.T) {
+	f := func(x, y, z *Hamilton) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		v := []*Hamilton{x, y, z, new(Hamilton).Conj(x)}
+		w := HamiltonInvSlice([]*Hamilton{new(Hamilton), new(Hamilton), new(Hamilton), new(Hamilton)}, v)
+		for i := range v {
+			if !w[i].Equals(new(Hamilton).Inv(v[i])) {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCockleInvSliceAliased(t *testing.T) {
+	f := func(x, y *Cockle) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := []*Cockle{new(Cockle).Inv(x), new(Cockle).Inv(y)}
+		v := []*Cockle{new(Cockle).Set(x), new(Cockle).Set(y)}
+		CockleInvSlice(v, v)
+		return v[0].Equals(l[0]) && v[1].Equals(l[1])
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// sphere returns a center y and n points on a common sphere around it, all
+// at quadrance 25 from y, so that their translates by y share a quadrance.
+func sphere(n int) (*Hamilton, []*Hamilton) {
+	y := NewHamilton(big.NewRat(7, 3), big.NewRat(-2, 5), big.NewRat(11, 13), big.NewRat(1, 17))
+	units := [][4]int64{{3, 4, 0, 0}, {0, 3, 4, 0}, {0, 0, 3, 4}, {4, 0, 0, 3}, {5, 0, 0, 0}, {0, 0, 0, 5}}
+	xs := make([]*Hamilton, n)
+	for i := range xs {
+		u := units[i%len(units)]
+		x := NewHamilton(big.NewRat(u[0], 1), big.NewRat(u[1], 1), big.NewRat(u[2], 1), big.NewRat(u[3], 1))
+		xs[i] = x.Add(y, x)
+	}
+	return y, xs
+}
+
+// möbiusPoints returns the coefficients of a Möbius transform whose
+// denominator x - y vanishes at the center y of sphere.
+func möbiusPoints(n int) (a, b, c, d *Hamilton, xs []*Hamilton) {
+	y, xs := sphere(n)
+	a = NewHamilton(big.NewRat(1, 2), big.NewRat(3, 1), big.NewRat(-1, 7), big.NewRat(2, 1))
+	b = NewHamilton(big.NewRat(-5, 3), big.NewRat(0, 1), big.NewRat(4, 9), big.NewRat(1, 1))
+	c = NewHamilton(big.NewRat(1, 1), big.NewRat(0, 1), big.NewRat(0, 1), big.NewRat(0, 1))
+	return a, b, c, new(Hamilton).Neg(y), xs
+}
+
+func TestHamiltonMöbiusRInvSlice(t *testing.T) {
+	a, b, c, d, xs := möbiusPoints(12)
+	num, den := make([]*Hamilton, len(xs)), make([]*Hamilton, len(xs))
+	for i, x := range xs {
+		num[i] = new(Hamilton).Add(new(Hamilton).Mul(a, x), b)
+		den[i] = new(Hamilton).Add(new(Hamilton).Mul(c, x), d)
+	}
+	HamiltonInvSlice(den, den)
+	for i, x := range xs {
+		if got, want := num[i].Mul(num[i], den[i]), new(Hamilton).MöbiusR(x, a, b, c, d); !got.Equals(want) {
+			t.Errorf("MöbiusR(%v) = %v, want %v", x, got, want)
+		}
+	}
+}
+
+// translates returns the translates w - x of the points x of sphere(n) by
+// its center w, which all share a quadrance.
+func translates(n int) []*Hamilton {
+	w, xs := sphere(n)
+	for _, x := range xs {
+		x.Sub(w, x)
+	}
+	return xs
+}
+
+func BenchmarkHamiltonInv(b *testing.B) {
+	y := translates(64)
+	z := make([]*Hamilton, len(y))
+	for i := range z {
+		z[i] = new(Hamilton)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for i := range y {
+			z[i].Inv(y[i])
+		}
+	}
+}
+
+func BenchmarkHamiltonInvSlice(b *testing.B) {
+	y := translates(64)
+	z := make([]*Hamilton, len(y))
+	for i := range z {
+		z[i] = new(Hamilton)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		HamiltonInvSlice(z, y)
+	}
+}
+
+// benchmarkMöbiusR evaluates the Möbius transform of möbiusPoints(64) at
+// every point, inverting the denominators with inv.
+func benchmarkMöbiusR(b *testing.B, inv func(den []*Hamilton)) {
+	p, q, r, s, xs := möbiusPoints(64)
+	num, den := make([]*Hamilton, len(xs)), make([]*Hamilton, len(xs))
+	for i := range xs {
+		num[i], den[i] = new(Hamilton), new(Hamilton)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for i, x := range xs {
+			num[i].Add(num[i].Mul(p, x), q)
+			den[i].Add(den[i].Mul(r, x), s)
+		}
+		inv(den)
+		for i := range xs {
+			num[i].Mul(num[i], den[i])
+		}
+	}
+}
+
+func BenchmarkHamiltonMöbiusRInv(b *testing.B) {
+	benchmarkMöbiusR(b, func(den []*Hamilton) {
+		for _, d := range den {
+			d.Inv(d)
+		}
+	})
+}
+
+func BenchmarkHamiltonMöbiusRInvSlice(b *testing.B) {
+	benchmarkMöbiusR(b, func(den []*Hamilton) {
+		HamiltonInvSlice(den, den)
+	})
+}
+
+func TestMulBatch(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	n := 500
+	xs, ys := make([]*Cayley, n), make([]*Cayley, n)
+	want := make([]*Cayley, n)
+	for i := range xs {
+		xs[i] = new(Cayley).Random(r, nil)
+		ys[i] = new(Cayley).Random(r, nil)
+		want[i] = new(Cayley).Mul(xs[i], ys[i])
+	}
+	for _, workers := range []int{0, 1, 3} {
+		dst := MulBatch(make([]*Cayley, n), xs, ys, workers)
+		for i := range dst {
+			if !dst[i].Equals(want[i]) {
+				t.Fatalf("workers = %d: dst[%d] = %v, want %v", workers, i, dst[i], want[i])
+			}
+		}
+	}
+	// aliased products overwrite their left factors
+	MulBatch(xs, xs, ys, 4)
+	for i := range xs {
+		if !xs[i].Equals(want[i]) {
+			t.Fatalf("aliased: xs[%d] = %v, want %v", i, xs[i], want[i])
+		}
+	}
+}
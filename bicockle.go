@@ -5,6 +5,7 @@ package rational
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"reflect"
@@ -18,25 +19,40 @@ type BiCockle struct {
 	l, r Cockle
 }
 
-// Real returns the (rational) real part of z.
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
 func (z *BiCockle) Real() *big.Rat {
 	return (&z.l).Real()
 }
 
-// Rats returns the eight rational components of z.
+// Rats returns the eight rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
 func (z *BiCockle) Rats() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
 	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
 	return &z.l.l.l, &z.l.l.r, &z.l.r.l, &z.l.r.r,
 		&z.r.l.l, &z.r.l.r, &z.r.r.l, &z.r.r.r
 }
 
+// RatsCopy returns copies of the eight rational components of z.
+func (z *BiCockle) RatsCopy() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
+	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
+	return new(BiCockle).Set(z).Rats()
+}
+
+// Components returns the eight rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *BiCockle) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
 // String returns the string representation of a BiCockle value.
 func (z *BiCockle) String() string {
 	v := make([]*big.Rat, 8)
 	v[0], v[1], v[2], v[3] = z.l.Rats()
 	v[4], v[5], v[6], v[7] = z.r.Rats()
 	a := make([]string, 17)
-	a[0] = leftBracket
+	br := brackets()
+	a[0] = br[0]
 	a[1] = fmt.Sprintf("%v", v[0].RatString())
 	i := 1
 	for j := 2; j < 16; j = j + 2 {
@@ -48,7 +64,7 @@ func (z *BiCockle) String() string {
 		a[j+1] = symbBiCockle[i]
 		i++
 	}
-	a[16] = rightBracket
+	a[16] = br[1]
 	return strings.Join(a, "")
 }
 
@@ -60,6 +76,16 @@ func (z *BiCockle) Equals(y *BiCockle) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *BiCockle) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Cockle))
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *BiCockle) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *BiCockle) Set(y *BiCockle) *BiCockle {
 	z.l.Set(&y.l)
@@ -95,13 +121,27 @@ func (z *BiCockle) Neg(y *BiCockle) *BiCockle {
 	return z
 }
 
-// Conj sets z equal to the conjugate of y, and returns z.
+// Conj sets z equal to the conjugate of y, and returns z. This operation
+// changes the sign of all the components with i, t, or u, and reverses
+// products. It fixes the center, the subalgebra of values a+bH, a copy of
+// Complex.
 func (z *BiCockle) Conj(y *BiCockle) *BiCockle {
 	z.l.Conj(&y.l)
 	z.r.Conj(&y.r)
 	return z
 }
 
+// Star sets z equal to the star conjugate of y, and returns z. This operation
+// changes the sign of all the components with H, and preserves products. It
+// fixes the subalgebra of values with no H, a copy of Cockle. Its composition
+// with Conj reverses products, and fixes the values a+(bi+ct+du)H, which are
+// not a subalgebra.
+func (z *BiCockle) Star(y *BiCockle) *BiCockle {
+	z.l.Set(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
 // Add sets z equal to x+y, and returns z.
 func (z *BiCockle) Add(x, y *BiCockle) *BiCockle {
 	z.l.Add(&x.l, &y.l)
@@ -128,12 +168,26 @@ func (z *BiCockle) Sub(x, y *BiCockle) *BiCockle {
 // 		Mul(t, H) = Mul(H, t)
 // 		Mul(u, H) = Mul(H, u)
 // This binary operation is noncommutative but associative.
-func (z *BiCockle) Mul(x, y *BiCockle) *BiCockle {
-	a := new(Cockle).Set(&x.l)
-	b := new(Cockle).Set(&x.r)
-	c := new(Cockle).Set(&y.l)
-	d := new(Cockle).Set(&y.r)
-	temp := new(Cockle)
+func (z *BiCockle) Mul(x, y *BiCockle) (product *BiCockle) {
+	if h := currentHook(); h != nil {
+		x, y := new(BiCockle).Set(x), new(BiCockle).Set(y)
+		defer func() { traceMul(h, "BiCockle.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	a, b, c, d := &x.l, &x.r, &y.l, &y.r
+	if z == x || z == y {
+		// z.l is written before z.r is computed
+		a, b = cockleArena.get().Set(a), cockleArena.get().Set(b)
+		c, d = cockleArena.get().Set(c), cockleArena.get().Set(d)
+		defer cockleArena.put(a, b, c, d)
+	}
+	temp := cockleArena.get()
+	defer cockleArena.put(temp)
 	z.l.Sub(
 		z.l.Mul(a, c),
 		temp.Mul(b, d),
@@ -188,9 +242,13 @@ func (z *BiCockle) IsZeroDivisor() bool {
 
 // Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
 // then Inv panics.
-func (z *BiCockle) Inv(y *BiCockle) *BiCockle {
+func (z *BiCockle) Inv(y *BiCockle) (inverse *BiCockle) {
+	if h := currentHook(); h != nil {
+		y := new(BiCockle).Set(y)
+		defer func() { traceInv(h, "BiCockle.Inv", inverse != nil, z, y) }()
+	}
 	if y.IsZeroDivisor() {
-		panic("inverse of zero divisor")
+		panic(divisorError("inverse of zero divisor"))
 	}
 	p := new(BiCockle).Conj(y)
 	q := y.quad()
@@ -209,7 +267,7 @@ func (z *BiCockle) Inv(y *BiCockle) *BiCockle {
 // Then it returns z. If y is zero, then QuoL panics.
 func (z *BiCockle) QuoL(x, y *BiCockle) *BiCockle {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(z.Inv(y), x)
 }
@@ -219,7 +277,7 @@ func (z *BiCockle) QuoL(x, y *BiCockle) *BiCockle {
 // Then it returns z. If y is zero, then QuoR panics.
 func (z *BiCockle) QuoR(x, y *BiCockle) *BiCockle {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(x, z.Inv(y))
 }
@@ -282,21 +340,115 @@ func (z *BiCockle) MöbiusR(y, a, b, c, d *BiCockle) *BiCockle {
 	return z.Mul(z, temp)
 }
 
+// LeftMul returns the linear operator that sends y to Mul(z, y), acting on
+// the rational components of y.
+func (z *BiCockle) LeftMul() *Operator {
+	a := new(BiCockle).Set(z)
+	return &Operator{8, func(v []*big.Rat) []*big.Rat {
+		y := NewBiCockle(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+		return rats(y.Mul(a, y).Rats())
+	}}
+}
+
+// RightMul returns the linear operator that sends y to Mul(y, z), acting on
+// the rational components of y.
+func (z *BiCockle) RightMul() *Operator {
+	a := new(BiCockle).Set(z)
+	return &Operator{8, func(v []*big.Rat) []*big.Rat {
+		y := NewBiCockle(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+		return rats(y.Mul(y, a).Rats())
+	}}
+}
+
+// WriteTo writes a canonical encoding of z to w, and returns the number of
+// bytes written. Equal values produce equal encodings, so any hash.Hash can be
+// used as w to hash z without building an intermediate byte slice.
+func (z *BiCockle) WriteTo(w io.Writer) (int64, error) {
+	return writeRats(w, rats(z.Rats()))
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// the rational parameter t, and returns z. The curve is evaluated exactly with
+// the De Casteljau algorithm. If p is empty, then Bezier panics.
+func (z *BiCockle) Bezier(t *big.Rat, p ...*BiCockle) *BiCockle {
+	if len(p) == 0 {
+		panic("no control points")
+	}
+	s := new(big.Rat).Sub(big.NewRat(1, 1), t)
+	b := make([]*BiCockle, len(p))
+	for i := range p {
+		b[i] = new(BiCockle).Set(p[i])
+	}
+	temp := new(BiCockle)
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			b[i].Add(b[i].Scal(b[i], s), temp.Scal(b[i+1], t))
+		}
+	}
+	return z.Set(b[0])
+}
+
+// Round sets z equal to y with each component rounded to a multiple of 1/den
+// in the direction given by mode, and returns z. Directed modes round every
+// component the same way, so interval bounds stay rigorous. If den is not
+// positive, then Round panics.
+func (z *BiCockle) Round(y *BiCockle, den *big.Int, mode big.RoundingMode) *BiCockle {
+	z.Set(y)
+	for _, c := range rats(z.Rats()) {
+		roundRat(c, c, den, mode)
+	}
+	return z
+}
+
+// Act returns the left action of z on the pair (a, b) of Cockle values, which
+// is identified with the BiCockle value a + bH and multiplied on the left by
+// z. Since BiCockle is associative, Act is a representation of BiCockle on
+// pairs of Cockle values, with Mul(x, y) acting as y followed by x.
+func (z *BiCockle) Act(a, b *Cockle) (*Cockle, *Cockle) {
+	v := new(BiCockle)
+	v.l.Set(a)
+	v.r.Set(b)
+	v.Mul(z, v)
+	return &v.l, &v.r
+}
+
+// SolveCrossRatioL sets z equal to the point y such that the left
+// cross-ratio of v, w, x, and y is c, and returns z. With
+// A = Inv(w - x) * (v - x) and B = Inv(A) * c, the point is
+// 		(v*B - w) * Inv(B - 1)
+// If the point is not uniquely determined, then SolveCrossRatioL panics.
+func (z *BiCockle) SolveCrossRatioL(v, w, x, c *BiCockle) *BiCockle {
+	one := new(BiCockle)
+	one.Real().SetInt64(1)
+	b := new(BiCockle).Sub(v, x)
+	b.Mul(new(BiCockle).Inv(new(BiCockle).Sub(w, x)), b)
+	b.Mul(b.Inv(b), c)
+	temp := new(BiCockle).Sub(b, one)
+	temp.Inv(temp)
+	z.Mul(v, b)
+	z.Sub(z, w)
+	return z.Mul(z, temp)
+}
+
+// SolveCrossRatioR sets z equal to the point y such that the right
+// cross-ratio of v, w, x, and y is c, and returns z. With
+// A = (v - x) * Inv(w - x) and B = Inv(A) * c, the point is
+// 		Inv(B - 1) * (B*v - w)
+// If the point is not uniquely determined, then SolveCrossRatioR panics.
+func (z *BiCockle) SolveCrossRatioR(v, w, x, c *BiCockle) *BiCockle {
+	one := new(BiCockle)
+	one.Real().SetInt64(1)
+	b := new(BiCockle).Sub(v, x)
+	b.Mul(b, new(BiCockle).Inv(new(BiCockle).Sub(w, x)))
+	b.Mul(b.Inv(b), c)
+	temp := new(BiCockle).Sub(b, one)
+	temp.Inv(temp)
+	z.Mul(b, v)
+	z.Sub(z, w)
+	return z.Mul(temp, z)
+}
+
 // Generate returns a random BiCockle value for quick.Check testing.
 func (z *BiCockle) Generate(rand *rand.Rand, size int) reflect.Value {
-	randomBiCockle := &BiCockle{
-		*NewCockle(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-		*NewCockle(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-	}
-	return reflect.ValueOf(randomBiCockle)
+	return reflect.ValueOf(new(BiCockle).Random(rand, generateOptions))
 }
@@ -347,3 +347,40 @@ func TestBiCockleComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Scalar operands
+
+func TestBiCockleMulReal(t *testing.T) {
+	f := func(x, y *BiCockle) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(big.Rat).Set(y.Real())
+		s := new(BiCockle)
+		s.Real().Set(a)
+		if !s.IsReal() || x.IsReal() {
+			return false
+		}
+		l, r := new(BiCockle), new(BiCockle)
+		l.Mul(s, x)
+		r.Mul(x, s)
+		// an independent product: s x = (s + x)x - x x, with no real factor
+		xx := new(BiCockle).Mul(x, x)
+		sx := new(BiCockle).Add(s, x)
+		return l.Equals(new(BiCockle).Sub(new(BiCockle).Mul(sx, x), xx)) &&
+			r.Equals(new(BiCockle).Sub(new(BiCockle).Mul(x, sx), xx))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBiCockleIsPure(t *testing.T) {
+	f := func(x *BiCockle) bool {
+		// t.Logf("x = %v", x)
+		p := new(BiCockle).Set(x)
+		p.Real().SetInt64(0)
+		return p.IsPure() && !x.IsPure()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -5,6 +5,7 @@ package rational
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"reflect"
@@ -18,23 +19,37 @@ type BiComplex struct {
 	l, r Complex
 }
 
-// Real returns the (rational) real part of z.
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
 func (z *BiComplex) Real() *big.Rat {
 	return (&z.l).Real()
 }
 
-// Rats returns the four rational components of z.
+// Rats returns the four rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
 func (z *BiComplex) Rats() (*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
 	return &z.l.l, &z.l.r, &z.r.l, &z.r.r
 }
 
+// RatsCopy returns copies of the four rational components of z.
+func (z *BiComplex) RatsCopy() (*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
+	return new(BiComplex).Set(z).Rats()
+}
+
+// Components returns the four rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *BiComplex) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
 // String returns the string representation of a BiComplex value.
 func (z *BiComplex) String() string {
 	v := make([]*big.Rat, 4)
 	v[0], v[1] = z.l.Rats()
 	v[2], v[3] = z.r.Rats()
 	a := make([]string, 9)
-	a[0] = leftBracket
+	br := brackets()
+	a[0] = br[0]
 	a[1] = fmt.Sprintf("%v", v[0].RatString())
 	i := 1
 	for j := 2; j < 8; j = j + 2 {
@@ -46,7 +61,7 @@ func (z *BiComplex) String() string {
 		a[j+1] = symbBiComplex[i]
 		i++
 	}
-	a[8] = rightBracket
+	a[8] = br[1]
 	return strings.Join(a, "")
 }
 
@@ -58,6 +73,16 @@ func (z *BiComplex) Equals(y *BiComplex) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *BiComplex) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Complex))
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *BiComplex) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *BiComplex) Set(y *BiComplex) *BiComplex {
 	z.l.Set(&y.l)
@@ -90,14 +115,18 @@ func (z *BiComplex) Neg(y *BiComplex) *BiComplex {
 }
 
 // Conj sets z equal to the conjugate of y, and returns z. This operation
-// changes the sign of all the components with J.
+// changes the sign of all the components with J, and fixes the subalgebra of
+// values a+bi, a copy of Complex.
 func (z *BiComplex) Conj(y *BiComplex) *BiComplex {
 	z.l.Set(&y.l)
 	z.r.Neg(&y.r)
 	return z
 }
 
-// Star sets z equal to the star conjugate of y, and returns z.
+// Star sets z equal to the star conjugate of y, and returns z. This operation
+// changes the sign of all the components with i, and fixes the subalgebra of
+// values a+bJ, another copy of Complex. Its composition with Conj changes the
+// signs of i and J, and fixes the values a+biJ, a copy of Perplex.
 func (z *BiComplex) Star(y *BiComplex) *BiComplex {
 	z.l.Conj(&y.l)
 	z.r.Conj(&y.r)
@@ -124,12 +153,26 @@ func (z *BiComplex) Sub(x, y *BiComplex) *BiComplex {
 // 		Mul(i, i) = Mul(J, J) = -1
 // 		Mul(i, J) = Mul(J, i)
 // This binary operation is commutative and associative.
-func (z *BiComplex) Mul(x, y *BiComplex) *BiComplex {
-	a := new(Complex).Set(&x.l)
-	b := new(Complex).Set(&x.r)
-	c := new(Complex).Set(&y.l)
-	d := new(Complex).Set(&y.r)
-	temp := new(Complex)
+func (z *BiComplex) Mul(x, y *BiComplex) (product *BiComplex) {
+	if h := currentHook(); h != nil {
+		x, y := new(BiComplex).Set(x), new(BiComplex).Set(y)
+		defer func() { traceMul(h, "BiComplex.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	a, b, c, d := &x.l, &x.r, &y.l, &y.r
+	if z == x || z == y {
+		// z.l is written before z.r is computed
+		a, b = complexArena.get().Set(a), complexArena.get().Set(b)
+		c, d = complexArena.get().Set(c), complexArena.get().Set(d)
+		defer complexArena.put(a, b, c, d)
+	}
+	temp := complexArena.get()
+	defer complexArena.put(temp)
 	z.l.Sub(
 		z.l.Mul(a, c),
 		temp.Mul(b, d),
@@ -145,9 +188,10 @@ func (z *BiComplex) Mul(x, y *BiComplex) *BiComplex {
 // 		a² - b² + c² - d² + 2(ab + cd)i
 // Note that this is a complex number.
 func (z *BiComplex) Quad() *Complex {
-	quad := new(Complex)
+	quad, temp := complexArena.get(), complexArena.get()
+	defer complexArena.put(temp)
 	quad.Mul(&z.l, &z.l)
-	return quad.Add(quad, new(Complex).Mul(&z.r, &z.r))
+	return quad.Add(quad, temp.Mul(&z.r, &z.r))
 }
 
 // Norm returns the norm of z. If z = a+bi+cJ+diJ, then the norm is
@@ -171,11 +215,16 @@ func (z *BiComplex) IsZeroDivisor() bool {
 
 // Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
 // then Inv panics.
-func (z *BiComplex) Inv(y *BiComplex) *BiComplex {
+func (z *BiComplex) Inv(y *BiComplex) (inverse *BiComplex) {
+	if h := currentHook(); h != nil {
+		y := new(BiComplex).Set(y)
+		defer func() { traceInv(h, "BiComplex.Inv", inverse != nil, z, y) }()
+	}
 	if y.IsZeroDivisor() {
-		panic("inverse of zero divisor")
+		panic(divisorError("inverse of zero divisor"))
 	}
 	quad := y.Quad()
+	defer complexArena.put(quad)
 	quad.Inv(quad)
 	z.Conj(y)
 	z.l.Mul(&z.l, quad)
@@ -187,7 +236,7 @@ func (z *BiComplex) Inv(y *BiComplex) *BiComplex {
 // Quo panics.
 func (z *BiComplex) Quo(x, y *BiComplex) *BiComplex {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(z.Inv(y), x)
 }
@@ -221,17 +270,105 @@ func (z *BiComplex) Möbius(y, a, b, c, d *BiComplex) *BiComplex {
 	return z.Mul(z, temp)
 }
 
+// LeftMul returns the linear operator that sends y to Mul(z, y), acting on
+// the rational components of y.
+func (z *BiComplex) LeftMul() *Operator {
+	a := new(BiComplex).Set(z)
+	return &Operator{4, func(v []*big.Rat) []*big.Rat {
+		y := NewBiComplex(v[0], v[1], v[2], v[3])
+		return rats(y.Mul(a, y).Rats())
+	}}
+}
+
+// RightMul returns the linear operator that sends y to Mul(y, z), acting on
+// the rational components of y.
+func (z *BiComplex) RightMul() *Operator {
+	a := new(BiComplex).Set(z)
+	return &Operator{4, func(v []*big.Rat) []*big.Rat {
+		y := NewBiComplex(v[0], v[1], v[2], v[3])
+		return rats(y.Mul(y, a).Rats())
+	}}
+}
+
+// WriteTo writes a canonical encoding of z to w, and returns the number of
+// bytes written. Equal values produce equal encodings, so any hash.Hash can be
+// used as w to hash z without building an intermediate byte slice.
+func (z *BiComplex) WriteTo(w io.Writer) (int64, error) {
+	return writeRats(w, rats(z.Rats()))
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// the rational parameter t, and returns z. The curve is evaluated exactly with
+// the De Casteljau algorithm. If p is empty, then Bezier panics.
+func (z *BiComplex) Bezier(t *big.Rat, p ...*BiComplex) *BiComplex {
+	if len(p) == 0 {
+		panic("no control points")
+	}
+	s := new(big.Rat).Sub(big.NewRat(1, 1), t)
+	b := make([]*BiComplex, len(p))
+	for i := range p {
+		b[i] = new(BiComplex).Set(p[i])
+	}
+	temp := new(BiComplex)
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			b[i].Add(b[i].Scal(b[i], s), temp.Scal(b[i+1], t))
+		}
+	}
+	return z.Set(b[0])
+}
+
+// Less returns true if z precedes y in the lexicographic order on the
+// rational components. This is a total order, compatible with Equals, so
+// BiComplex values can be sorted deterministically.
+func (z *BiComplex) Less(y *BiComplex) bool {
+	return lexLess(rats(z.Rats()), rats(y.Rats()))
+}
+
+// Round sets z equal to y with each component rounded to a multiple of 1/den
+// in the direction given by mode, and returns z. Directed modes round every
+// component the same way, so interval bounds stay rigorous. If den is not
+// positive, then Round panics.
+func (z *BiComplex) Round(y *BiComplex, den *big.Int, mode big.RoundingMode) *BiComplex {
+	z.Set(y)
+	for _, c := range rats(z.Rats()) {
+		roundRat(c, c, den, mode)
+	}
+	return z
+}
+
+// Act returns the left action of z on the pair (a, b) of Complex values, which
+// is identified with the BiComplex value a + bJ and multiplied on the left by
+// z. Since BiComplex is commutative and associative, Act is a representation
+// of BiComplex that is linear over Complex: multiplying both a and b by a
+// Complex value commutes with it.
+func (z *BiComplex) Act(a, b *Complex) (*Complex, *Complex) {
+	v := new(BiComplex)
+	v.l.Set(a)
+	v.r.Set(b)
+	v.Mul(z, v)
+	return &v.l, &v.r
+}
+
+// SolveCrossRatio sets z equal to the point y such that the cross-ratio of v,
+// w, x, and y is c, and returns z. With A = Inv(w - x) * (v - x) and
+// B = Inv(A) * c, the point is
+// 		(v*B - w) * Inv(B - 1)
+// If the point is not uniquely determined, then SolveCrossRatio panics.
+func (z *BiComplex) SolveCrossRatio(v, w, x, c *BiComplex) *BiComplex {
+	one := new(BiComplex)
+	one.Real().SetInt64(1)
+	b := new(BiComplex).Sub(v, x)
+	b.Mul(new(BiComplex).Inv(new(BiComplex).Sub(w, x)), b)
+	b.Mul(b.Inv(b), c)
+	temp := new(BiComplex).Sub(b, one)
+	temp.Inv(temp)
+	z.Mul(v, b)
+	z.Sub(z, w)
+	return z.Mul(z, temp)
+}
+
 // Generate returns a random BiComplex value for quick.Check testing.
 func (z *BiComplex) Generate(rand *rand.Rand, size int) reflect.Value {
-	randomBiComplex := &BiComplex{
-		*NewComplex(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-		*NewComplex(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-	}
-	return reflect.ValueOf(randomBiComplex)
+	return reflect.ValueOf(new(BiComplex).Random(rand, generateOptions))
 }
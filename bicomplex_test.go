@@ -341,3 +341,98 @@ func TestBiComplexComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Scalar operands
+
+func TestBiComplexMulReal(t *testing.T) {
+	f := func(x, y *BiComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(big.Rat).Set(y.Real())
+		s := new(BiComplex)
+		s.Real().Set(a)
+		if !s.IsReal() || x.IsReal() {
+			return false
+		}
+		l, r := new(BiComplex), new(BiComplex)
+		l.Mul(s, x)
+		r.Mul(x, s)
+		// an independent product: s x = (s + x)x - x x, with no real factor
+		xx := new(BiComplex).Mul(x, x)
+		sx := new(BiComplex).Add(s, x)
+		return l.Equals(new(BiComplex).Sub(new(BiComplex).Mul(sx, x), xx)) &&
+			r.Equals(new(BiComplex).Sub(new(BiComplex).Mul(x, sx), xx))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBiComplexIsPure(t *testing.T) {
+	f := func(x *BiComplex) bool {
+		// t.Logf("x = %v", x)
+		p := new(BiComplex).Set(x)
+		p.Real().SetInt64(0)
+		return p.IsPure() && !x.IsPure()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Ordering
+
+func TestBiComplexLessTrichotomy(t *testing.T) {
+	f := func(x, y *BiComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		n := 0
+		for _, b := range []bool{x.Less(y), y.Less(x), x.Equals(y)} {
+			if b {
+				n++
+			}
+		}
+		return n == 1 && !x.Less(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBiComplexLessTransitive(t *testing.T) {
+	f := func(x, y, z *BiComplex) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		if x.Less(y) && y.Less(z) {
+			return x.Less(z)
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Module structure
+
+func TestBiComplexActAssociative(t *testing.T) {
+	f := func(x, y, w *BiComplex) bool {
+		// t.Logf("x = %v, y = %v, w = %v", x, y, w)
+		a, b := new(BiComplex).Mul(x, y).Act(&w.l, &w.r)
+		c, d := x.Act(y.Act(&w.l, &w.r))
+		return a.Equals(c) && b.Equals(d)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Cross-ratio
+
+func TestBiComplexSolveCrossRatio(t *testing.T) {
+	f := func(v, w, x, y *BiComplex) bool {
+		// t.Logf("v = %v, w = %v, x = %v, y = %v", v, w, x, y)
+		c := new(BiComplex).CrossRatio(v, w, x, y)
+		return new(BiComplex).SolveCrossRatio(v, w, x, c).Equals(y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -5,6 +5,7 @@ package rational
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"reflect"
@@ -18,18 +19,32 @@ type BiHamilton struct {
 	l, r Hamilton
 }
 
-// Real returns the (rational) real part of z.
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
 func (z *BiHamilton) Real() *big.Rat {
 	return (&z.l).Real()
 }
 
-// Rats returns the eight rational components of z.
+// Rats returns the eight rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
 func (z *BiHamilton) Rats() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
 	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
 	return &z.l.l.l, &z.l.l.r, &z.l.r.l, &z.l.r.r,
 		&z.r.l.l, &z.r.l.r, &z.r.r.l, &z.r.r.r
 }
 
+// RatsCopy returns copies of the eight rational components of z.
+func (z *BiHamilton) RatsCopy() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
+	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
+	return new(BiHamilton).Set(z).Rats()
+}
+
+// Components returns the eight rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *BiHamilton) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
 // String returns the string representation of a BiHamilton value.
 //
 // If z corresponds to a + bi + cj + dk + eH + fiH + gjH + hkH, then the string
@@ -39,7 +54,8 @@ func (z *BiHamilton) String() string {
 	v[0], v[1], v[2], v[3] = z.l.Rats()
 	v[4], v[5], v[6], v[7] = z.r.Rats()
 	a := make([]string, 17)
-	a[0] = leftBracket
+	br := brackets()
+	a[0] = br[0]
 	a[1] = fmt.Sprintf("%v", v[0].RatString())
 	i := 1
 	for j := 2; j < 16; j = j + 2 {
@@ -51,7 +67,7 @@ func (z *BiHamilton) String() string {
 		a[j+1] = symbBiHamilton[i]
 		i++
 	}
-	a[16] = rightBracket
+	a[16] = br[1]
 	return strings.Join(a, "")
 }
 
@@ -63,6 +79,16 @@ func (z *BiHamilton) Equals(y *BiHamilton) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *BiHamilton) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Hamilton))
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *BiHamilton) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *BiHamilton) Set(y *BiHamilton) *BiHamilton {
 	z.l.Set(&y.l)
@@ -98,13 +124,27 @@ func (z *BiHamilton) Neg(y *BiHamilton) *BiHamilton {
 	return z
 }
 
-// Conj sets z equal to the conjugate of y, and returns z.
+// Conj sets z equal to the conjugate of y, and returns z. This operation
+// changes the sign of all the components with i, j, or k, and reverses
+// products. It fixes the center, the subalgebra of values a+bH, a copy of
+// Complex.
 func (z *BiHamilton) Conj(y *BiHamilton) *BiHamilton {
 	z.l.Conj(&y.l)
 	z.r.Conj(&y.r)
 	return z
 }
 
+// Star sets z equal to the star conjugate of y, and returns z. This operation
+// changes the sign of all the components with H, and preserves products. It
+// fixes the subalgebra of values with no H, a copy of Hamilton. Its
+// composition with Conj reverses products, and fixes the values a+(bi+cj+dk)H,
+// which are not a subalgebra.
+func (z *BiHamilton) Star(y *BiHamilton) *BiHamilton {
+	z.l.Set(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
 // Add sets z equal to x+y, and returns z.
 func (z *BiHamilton) Add(x, y *BiHamilton) *BiHamilton {
 	z.l.Add(&x.l, &y.l)
@@ -130,12 +170,26 @@ func (z *BiHamilton) Sub(x, y *BiHamilton) *BiHamilton {
 // 		Mul(j, H) = Mul(H, j)
 // 		Mul(k, H) = Mul(H, k)
 // This binary operation is noncommutative but associative.
-func (z *BiHamilton) Mul(x, y *BiHamilton) *BiHamilton {
-	a := new(Hamilton).Set(&x.l)
-	b := new(Hamilton).Set(&x.r)
-	c := new(Hamilton).Set(&y.l)
-	d := new(Hamilton).Set(&y.r)
-	temp := new(Hamilton)
+func (z *BiHamilton) Mul(x, y *BiHamilton) (product *BiHamilton) {
+	if h := currentHook(); h != nil {
+		x, y := new(BiHamilton).Set(x), new(BiHamilton).Set(y)
+		defer func() { traceMul(h, "BiHamilton.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	a, b, c, d := &x.l, &x.r, &y.l, &y.r
+	if z == x || z == y {
+		// z.l is written before z.r is computed
+		a, b = hamiltonArena.get().Set(a), hamiltonArena.get().Set(b)
+		c, d = hamiltonArena.get().Set(c), hamiltonArena.get().Set(d)
+		defer hamiltonArena.put(a, b, c, d)
+	}
+	temp := hamiltonArena.get()
+	defer hamiltonArena.put(temp)
 	z.l.Sub(
 		z.l.Mul(a, c),
 		temp.Mul(b, d),
@@ -190,9 +244,13 @@ func (z *BiHamilton) IsZeroDivisor() bool {
 
 // Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
 // then Inv panics.
-func (z *BiHamilton) Inv(y *BiHamilton) *BiHamilton {
+func (z *BiHamilton) Inv(y *BiHamilton) (inverse *BiHamilton) {
+	if h := currentHook(); h != nil {
+		y := new(BiHamilton).Set(y)
+		defer func() { traceInv(h, "BiHamilton.Inv", inverse != nil, z, y) }()
+	}
 	if y.IsZeroDivisor() {
-		panic("inverse of zero divisor")
+		panic(divisorError("inverse of zero divisor"))
 	}
 	p := new(BiHamilton).Conj(y)
 	q := y.quad()
@@ -211,7 +269,7 @@ func (z *BiHamilton) Inv(y *BiHamilton) *BiHamilton {
 // Then it returns z. If y is zero, then QuoL panics.
 func (z *BiHamilton) QuoL(x, y *BiHamilton) *BiHamilton {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(z.Inv(y), x)
 }
@@ -221,7 +279,7 @@ func (z *BiHamilton) QuoL(x, y *BiHamilton) *BiHamilton {
 // Then it returns z. If y is zero, then QuoR panics.
 func (z *BiHamilton) QuoR(x, y *BiHamilton) *BiHamilton {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(x, z.Inv(y))
 }
@@ -284,21 +342,116 @@ func (z *BiHamilton) MöbiusR(y, a, b, c, d *BiHamilton) *BiHamilton {
 	return z.Mul(z, temp)
 }
 
+// LeftMul returns the linear operator that sends y to Mul(z, y), acting on
+// the rational components of y.
+func (z *BiHamilton) LeftMul() *Operator {
+	a := new(BiHamilton).Set(z)
+	return &Operator{8, func(v []*big.Rat) []*big.Rat {
+		y := NewBiHamilton(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+		return rats(y.Mul(a, y).Rats())
+	}}
+}
+
+// RightMul returns the linear operator that sends y to Mul(y, z), acting on
+// the rational components of y.
+func (z *BiHamilton) RightMul() *Operator {
+	a := new(BiHamilton).Set(z)
+	return &Operator{8, func(v []*big.Rat) []*big.Rat {
+		y := NewBiHamilton(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+		return rats(y.Mul(y, a).Rats())
+	}}
+}
+
+// WriteTo writes a canonical encoding of z to w, and returns the number of
+// bytes written. Equal values produce equal encodings, so any hash.Hash can be
+// used as w to hash z without building an intermediate byte slice.
+func (z *BiHamilton) WriteTo(w io.Writer) (int64, error) {
+	return writeRats(w, rats(z.Rats()))
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// the rational parameter t, and returns z. The curve is evaluated exactly with
+// the De Casteljau algorithm. If p is empty, then Bezier panics.
+func (z *BiHamilton) Bezier(t *big.Rat, p ...*BiHamilton) *BiHamilton {
+	if len(p) == 0 {
+		panic("no control points")
+	}
+	s := new(big.Rat).Sub(big.NewRat(1, 1), t)
+	b := make([]*BiHamilton, len(p))
+	for i := range p {
+		b[i] = new(BiHamilton).Set(p[i])
+	}
+	temp := new(BiHamilton)
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			b[i].Add(b[i].Scal(b[i], s), temp.Scal(b[i+1], t))
+		}
+	}
+	return z.Set(b[0])
+}
+
+// Round sets z equal to y with each component rounded to a multiple of 1/den
+// in the direction given by mode, and returns z. Directed modes round every
+// component the same way, so interval bounds stay rigorous. If den is not
+// positive, then Round panics.
+func (z *BiHamilton) Round(y *BiHamilton, den *big.Int, mode big.RoundingMode) *BiHamilton {
+	z.Set(y)
+	for _, c := range rats(z.Rats()) {
+		roundRat(c, c, den, mode)
+	}
+	return z
+}
+
+// Act returns the left action of z on the pair (a, b) of Hamilton values,
+// which is identified with the BiHamilton value a + bH and multiplied on the
+// left by z. BiHamilton is associative, so acting by Mul(x, y) is acting by y
+// and then by x, making Act a representation of BiHamilton on pairs of
+// Hamilton values.
+func (z *BiHamilton) Act(a, b *Hamilton) (*Hamilton, *Hamilton) {
+	v := new(BiHamilton)
+	v.l.Set(a)
+	v.r.Set(b)
+	v.Mul(z, v)
+	return &v.l, &v.r
+}
+
+// SolveCrossRatioL sets z equal to the point y such that the left
+// cross-ratio of v, w, x, and y is c, and returns z. With
+// A = Inv(w - x) * (v - x) and B = Inv(A) * c, the point is
+// 		(v*B - w) * Inv(B - 1)
+// If the point is not uniquely determined, then SolveCrossRatioL panics.
+func (z *BiHamilton) SolveCrossRatioL(v, w, x, c *BiHamilton) *BiHamilton {
+	one := new(BiHamilton)
+	one.Real().SetInt64(1)
+	b := new(BiHamilton).Sub(v, x)
+	b.Mul(new(BiHamilton).Inv(new(BiHamilton).Sub(w, x)), b)
+	b.Mul(b.Inv(b), c)
+	temp := new(BiHamilton).Sub(b, one)
+	temp.Inv(temp)
+	z.Mul(v, b)
+	z.Sub(z, w)
+	return z.Mul(z, temp)
+}
+
+// SolveCrossRatioR sets z equal to the point y such that the right
+// cross-ratio of v, w, x, and y is c, and returns z. With
+// A = (v - x) * Inv(w - x) and B = Inv(A) * c, the point is
+// 		Inv(B - 1) * (B*v - w)
+// If the point is not uniquely determined, then SolveCrossRatioR panics.
+func (z *BiHamilton) SolveCrossRatioR(v, w, x, c *BiHamilton) *BiHamilton {
+	one := new(BiHamilton)
+	one.Real().SetInt64(1)
+	b := new(BiHamilton).Sub(v, x)
+	b.Mul(b, new(BiHamilton).Inv(new(BiHamilton).Sub(w, x)))
+	b.Mul(b.Inv(b), c)
+	temp := new(BiHamilton).Sub(b, one)
+	temp.Inv(temp)
+	z.Mul(b, v)
+	z.Sub(z, w)
+	return z.Mul(temp, z)
+}
+
 // Generate returns a random BiHamilton value for quick.Check testing.
 func (z *BiHamilton) Generate(rand *rand.Rand, size int) reflect.Value {
-	randomBiHamilton := &BiHamilton{
-		*NewHamilton(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-		*NewHamilton(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-	}
-	return reflect.ValueOf(randomBiHamilton)
+	return reflect.ValueOf(new(BiHamilton).Random(rand, generateOptions))
 }
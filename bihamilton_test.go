@@ -347,3 +347,40 @@ func TestBiHamiltonComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Scalar operands
+
+func TestBiHamiltonMulReal(t *testing.T) {
+	f := func(x, y *BiHamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(big.Rat).Set(y.Real())
+		s := new(BiHamilton)
+		s.Real().Set(a)
+		if !s.IsReal() || x.IsReal() {
+			return false
+		}
+		l, r := new(BiHamilton), new(BiHamilton)
+		l.Mul(s, x)
+		r.Mul(x, s)
+		// an independent product: s x = (s + x)x - x x, with no real factor
+		xx := new(BiHamilton).Mul(x, x)
+		sx := new(BiHamilton).Add(s, x)
+		return l.Equals(new(BiHamilton).Sub(new(BiHamilton).Mul(sx, x), xx)) &&
+			r.Equals(new(BiHamilton).Sub(new(BiHamilton).Mul(x, sx), xx))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBiHamiltonIsPure(t *testing.T) {
+	f := func(x *BiHamilton) bool {
+		// t.Logf("x = %v", x)
+		p := new(BiHamilton).Set(x)
+		p.Real().SetInt64(0)
+		return p.IsPure() && !x.IsPure()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
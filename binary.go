@@ -0,0 +1,273 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// The binary form of a value is its latest canonical form: the version byte
+// CanonicalBinary followed by the encoding written by WriteTo. Decoding also
+// accepts every earlier version. Since encoding/gob uses these methods, values
+// can be sent over RPC without losing exactness.
+
+// unmarshalBinary sets the rationals in v from any canonical form in data.
+func unmarshalBinary(data []byte, v []*big.Rat) error {
+	w, err := decodeCanonicalDim(data, len(v))
+	if err != nil {
+		return err
+	}
+	for i := range v {
+		v[i].Set(w[i])
+	}
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (z *Complex) MarshalBinary() ([]byte, error) {
+	return encodeCanonical(z.Components()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (z *Complex) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, z.Components())
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (z *Infra) MarshalBinary() ([]byte, error) {
+	return encodeCanonical(z.Components()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (z *Infra) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, z.Components())
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (z *Perplex) MarshalBinary() ([]byte, error) {
+	return encodeCanonical(z.Components()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (z *Perplex) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, z.Components())
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (z *BiComplex) MarshalBinary() ([]byte, error) {
+	return encodeCanonical(z.Components()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (z *BiComplex) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, z.Components())
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (z *BiPerplex) MarshalBinary() ([]byte, error) {
+	return encodeCanonical(z.Components()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (z *BiPerplex) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, z.Components())
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (z *Cockle) MarshalBinary() ([]byte, error) {
+	return encodeCanonical(z.Components()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (z *Cockle) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, z.Components())
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (z *DualComplex) MarshalBinary() ([]byte, error) {
+	return encodeCanonical(z.Components()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (z *DualComplex) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, z.Components())
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (z *DualPerplex) MarshalBinary() ([]byte, error) {
+	return encodeCanonical(z.Components()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (z *DualPerplex) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, z.Components())
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (z *Hamilton) MarshalBinary() ([]byte, error) {
+	return encodeCanonical(z.Components()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (z *Hamilton) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, z.Components())
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (z *Hyper) MarshalBinary() ([]byte, error) {
+	return encodeCanonical(z.Components()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (z *Hyper) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, z.Components())
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (z *InfraComplex) MarshalBinary() ([]byte, error) {
+	return encodeCanonical(z.Components()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (z *InfraComplex) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, z.Components())
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (z *InfraPerplex) MarshalBinary() ([]byte, error) {
+	return encodeCanonical(z.Components()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (z *InfraPerplex) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, z.Components())
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (z *Supra) MarshalBinary() ([]byte, error) {
+	return encodeCanonical(z.Components()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (z *Supra) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, z.Components())
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (z *BiCockle) MarshalBinary() ([]byte, error) {
+	return encodeCanonical(z.Components()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (z *BiCockle) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, z.Components())
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (z *BiHamilton) MarshalBinary() ([]byte, error) {
+	return encodeCanonical(z.Components()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (z *BiHamilton) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, z.Components())
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (z *Cayley) MarshalBinary() ([]byte, error) {
+	return encodeCanonical(z.Components()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (z *Cayley) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, z.Components())
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (z *InfraCockle) MarshalBinary() ([]byte, error) {
+	return encodeCanonical(z.Components()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (z *InfraCockle) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, z.Components())
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (z *InfraHamilton) MarshalBinary() ([]byte, error) {
+	return encodeCanonical(z.Components()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (z *InfraHamilton) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, z.Components())
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (z *SupraComplex) MarshalBinary() ([]byte, error) {
+	return encodeCanonical(z.Components()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (z *SupraComplex) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, z.Components())
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (z *SupraPerplex) MarshalBinary() ([]byte, error) {
+	return encodeCanonical(z.Components()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (z *SupraPerplex) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, z.Components())
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (z *TriComplex) MarshalBinary() ([]byte, error) {
+	return encodeCanonical(z.Components()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (z *TriComplex) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, z.Components())
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (z *TriNilplex) MarshalBinary() ([]byte, error) {
+	return encodeCanonical(z.Components()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (z *TriNilplex) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, z.Components())
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (z *TriPerplex) MarshalBinary() ([]byte, error) {
+	return encodeCanonical(z.Components()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (z *TriPerplex) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, z.Components())
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (z *Ultra) MarshalBinary() ([]byte, error) {
+	return encodeCanonical(z.Components()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (z *Ultra) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, z.Components())
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (z *Zorn) MarshalBinary() ([]byte, error) {
+	return encodeCanonical(z.Components()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (z *Zorn) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, z.Components())
+}
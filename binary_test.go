@@ -0,0 +1,210 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"bytes"
+	"encoding/gob"
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	tests := []interface{}{
+		func(x *Complex) bool {
+			// t.Logf("x = %v", x)
+			data, err := x.MarshalBinary()
+			y := new(Complex)
+			return err == nil && y.UnmarshalBinary(data) == nil && y.Equals(x)
+		},
+		func(x *Infra) bool {
+			// t.Logf("x = %v", x)
+			data, err := x.MarshalBinary()
+			y := new(Infra)
+			return err == nil && y.UnmarshalBinary(data) == nil && y.Equals(x)
+		},
+		func(x *Perplex) bool {
+			// t.Logf("x = %v", x)
+			data, err := x.MarshalBinary()
+			y := new(Perplex)
+			return err == nil && y.UnmarshalBinary(data) == nil && y.Equals(x)
+		},
+		func(x *BiComplex) bool {
+			// t.Logf("x = %v", x)
+			data, err := x.MarshalBinary()
+			y := new(BiComplex)
+			return err == nil && y.UnmarshalBinary(data) == nil && y.Equals(x)
+		},
+		func(x *BiPerplex) bool {
+			// t.Logf("x = %v", x)
+			data, err := x.MarshalBinary()
+			y := new(BiPerplex)
+			return err == nil && y.UnmarshalBinary(data) == nil && y.Equals(x)
+		},
+		func(x *Cockle) bool {
+			// t.Logf("x = %v", x)
+			data, err := x.MarshalBinary()
+			y := new(Cockle)
+			return err == nil && y.UnmarshalBinary(data) == nil && y.Equals(x)
+		},
+		func(x *DualComplex) bool {
+			// t.Logf("x = %v", x)
+			data, err := x.MarshalBinary()
+			y := new(DualComplex)
+			return err == nil && y.UnmarshalBinary(data) == nil && y.Equals(x)
+		},
+		func(x *DualPerplex) bool {
+			// t.Logf("x = %v", x)
+			data, err := x.MarshalBinary()
+			y := new(DualPerplex)
+			return err == nil && y.UnmarshalBinary(data) == nil && y.Equals(x)
+		},
+		func(x *Hamilton) bool {
+			// t.Logf("x = %v", x)
+			data, err := x.MarshalBinary()
+			y := new(Hamilton)
+			return err == nil && y.UnmarshalBinary(data) == nil && y.Equals(x)
+		},
+		func(x *Hyper) bool {
+			// t.Logf("x = %v", x)
+			data, err := x.MarshalBinary()
+			y := new(Hyper)
+			return err == nil && y.UnmarshalBinary(data) == nil && y.Equals(x)
+		},
+		func(x *InfraComplex) bool {
+			// t.Logf("x = %v", x)
+			data, err := x.MarshalBinary()
+			y := new(InfraComplex)
+			return err == nil && y.UnmarshalBinary(data) == nil && y.Equals(x)
+		},
+		func(x *InfraPerplex) bool {
+			// t.Logf("x = %v", x)
+			data, err := x.MarshalBinary()
+			y := new(InfraPerplex)
+			return err == nil && y.UnmarshalBinary(data) == nil && y.Equals(x)
+		},
+		func(x *Supra) bool {
+			// t.Logf("x = %v", x)
+			data, err := x.MarshalBinary()
+			y := new(Supra)
+			return err == nil && y.UnmarshalBinary(data) == nil && y.Equals(x)
+		},
+		func(x *BiCockle) bool {
+			// t.Logf("x = %v", x)
+			data, err := x.MarshalBinary()
+			y := new(BiCockle)
+			return err == nil && y.UnmarshalBinary(data) == nil && y.Equals(x)
+		},
+		func(x *BiHamilton) bool {
+			// t.Logf("x = %v", x)
+			data, err := x.MarshalBinary()
+			y := new(BiHamilton)
+			return err == nil && y.UnmarshalBinary(data) == nil && y.Equals(x)
+		},
+		func(x *Cayley) bool {
+			// t.Logf("x = %v", x)
+			data, err := x.MarshalBinary()
+			y := new(Cayley)
+			return err == nil && y.UnmarshalBinary(data) == nil && y.Equals(x)
+		},
+		func(x *InfraCockle) bool {
+			// t.Logf("x = %v", x)
+			data, err := x.MarshalBinary()
+			y := new(InfraCockle)
+			return err == nil && y.UnmarshalBinary(data) == nil && y.Equals(x)
+		},
+		func(x *InfraHamilton) bool {
+			// t.Logf("x = %v", x)
+			data, err := x.MarshalBinary()
+			y := new(InfraHamilton)
+			return err == nil && y.UnmarshalBinary(data) == nil && y.Equals(x)
+		},
+		func(x *SupraComplex) bool {
+			// t.Logf("x = %v", x)
+			data, err := x.MarshalBinary()
+			y := new(SupraComplex)
+			return err == nil && y.UnmarshalBinary(data) == nil && y.Equals(x)
+		},
+		func(x *SupraPerplex) bool {
+			// t.Logf("x = %v", x)
+			data, err := x.MarshalBinary()
+			y := new(SupraPerplex)
+			return err == nil && y.UnmarshalBinary(data) == nil && y.Equals(x)
+		},
+		func(x *TriComplex) bool {
+			// t.Logf("x = %v", x)
+			data, err := x.MarshalBinary()
+			y := new(TriComplex)
+			return err == nil && y.UnmarshalBinary(data) == nil && y.Equals(x)
+		},
+		func(x *TriNilplex) bool {
+			// t.Logf("x = %v", x)
+			data, err := x.MarshalBinary()
+			y := new(TriNilplex)
+			return err == nil && y.UnmarshalBinary(data) == nil && y.Equals(x)
+		},
+		func(x *TriPerplex) bool {
+			// t.Logf("x = %v", x)
+			data, err := x.MarshalBinary()
+			y := new(TriPerplex)
+			return err == nil && y.UnmarshalBinary(data) == nil && y.Equals(x)
+		},
+		func(x *Ultra) bool {
+			// t.Logf("x = %v", x)
+			data, err := x.MarshalBinary()
+			y := new(Ultra)
+			return err == nil && y.UnmarshalBinary(data) == nil && y.Equals(x)
+		},
+		func(x *Zorn) bool {
+			// t.Logf("x = %v", x)
+			data, err := x.MarshalBinary()
+			y := new(Zorn)
+			return err == nil && y.UnmarshalBinary(data) == nil && y.Equals(x)
+		},
+	}
+	for _, f := range tests {
+		if err := quick.Check(f, nil); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestGob(t *testing.T) {
+	type message struct {
+		Z *Cayley
+		V []*Hamilton
+	}
+	f := func(z *Cayley, x, y *Hamilton) bool {
+		// t.Logf("z = %v, x = %v, y = %v", z, x, y)
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(message{z, []*Hamilton{x, y}}); err != nil {
+			t.Log(err)
+			return false
+		}
+		var m message
+		if err := gob.NewDecoder(&buf).Decode(&m); err != nil {
+			t.Log(err)
+			return false
+		}
+		return m.Z.Equals(z) && len(m.V) == 2 && m.V[0].Equals(x) && m.V[1].Equals(y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUnmarshalBinaryText(t *testing.T) {
+	// earlier canonical versions are still accepted
+	z := new(Complex)
+	if err := z.UnmarshalBinary([]byte("3/4 -2")); err != nil {
+		t.Fatal(err)
+	}
+	if want := NewComplex(big.NewRat(3, 4), big.NewRat(-2, 1)); !z.Equals(want) {
+		t.Errorf("UnmarshalBinary = %v, want %v", z, want)
+	}
+	if err := z.UnmarshalBinary([]byte("3/4 -2 1")); err == nil {
+		t.Error("UnmarshalBinary accepted three components for Complex")
+	}
+}
@@ -5,6 +5,7 @@ package rational
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"reflect"
@@ -18,23 +19,37 @@ type BiPerplex struct {
 	l, r Perplex
 }
 
-// Real returns the (rational) real part of z.
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
 func (z *BiPerplex) Real() *big.Rat {
 	return (&z.l).Real()
 }
 
-// Rats returns the four rational components of z.
+// Rats returns the four rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
 func (z *BiPerplex) Rats() (*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
 	return &z.l.l, &z.l.r, &z.r.l, &z.r.r
 }
 
+// RatsCopy returns copies of the four rational components of z.
+func (z *BiPerplex) RatsCopy() (*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
+	return new(BiPerplex).Set(z).Rats()
+}
+
+// Components returns the four rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *BiPerplex) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
 // String returns the string representation of a BiPerplex value.
 func (z *BiPerplex) String() string {
 	v := make([]*big.Rat, 4)
 	v[0], v[1] = z.l.Rats()
 	v[2], v[3] = z.r.Rats()
 	a := make([]string, 9)
-	a[0] = leftBracket
+	br := brackets()
+	a[0] = br[0]
 	a[1] = fmt.Sprintf("%v", v[0].RatString())
 	i := 1
 	for j := 2; j < 8; j = j + 2 {
@@ -46,7 +61,7 @@ func (z *BiPerplex) String() string {
 		a[j+1] = symbBiPerplex[i]
 		i++
 	}
-	a[8] = rightBracket
+	a[8] = br[1]
 	return strings.Join(a, "")
 }
 
@@ -58,6 +73,16 @@ func (z *BiPerplex) Equals(y *BiPerplex) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *BiPerplex) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Perplex))
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *BiPerplex) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *BiPerplex) Set(y *BiPerplex) *BiPerplex {
 	z.l.Set(&y.l)
@@ -89,14 +114,19 @@ func (z *BiPerplex) Neg(y *BiPerplex) *BiPerplex {
 	return z
 }
 
-// Conj sets z equal to the conjugate of y, and returns z.
+// Conj sets z equal to the conjugate of y, and returns z. This operation
+// changes the sign of all the components with T, and fixes the subalgebra of
+// values a+bs, a copy of Perplex.
 func (z *BiPerplex) Conj(y *BiPerplex) *BiPerplex {
 	z.l.Set(&y.l)
 	z.r.Neg(&y.r)
 	return z
 }
 
-// Star sets z equal to the star conjugate of y, and returns z.
+// Star sets z equal to the star conjugate of y, and returns z. This operation
+// changes the sign of all the components with s, and fixes the subalgebra of
+// values a+bT, another copy of Perplex. Its composition with Conj changes the
+// signs of s and T, and fixes the values a+bsT, a third copy of Perplex.
 func (z *BiPerplex) Star(y *BiPerplex) *BiPerplex {
 	z.l.Conj(&y.l)
 	z.r.Conj(&y.r)
@@ -123,12 +153,26 @@ func (z *BiPerplex) Sub(x, y *BiPerplex) *BiPerplex {
 // 		Mul(s, s) = Mul(T, T) = +1
 // 		Mul(s, T) = Mul(T, s)
 // This binary operation is commutative and associative.
-func (z *BiPerplex) Mul(x, y *BiPerplex) *BiPerplex {
-	a := new(Perplex).Set(&x.l)
-	b := new(Perplex).Set(&x.r)
-	c := new(Perplex).Set(&y.l)
-	d := new(Perplex).Set(&y.r)
-	temp := new(Perplex)
+func (z *BiPerplex) Mul(x, y *BiPerplex) (product *BiPerplex) {
+	if h := currentHook(); h != nil {
+		x, y := new(BiPerplex).Set(x), new(BiPerplex).Set(y)
+		defer func() { traceMul(h, "BiPerplex.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	a, b, c, d := &x.l, &x.r, &y.l, &y.r
+	if z == x || z == y {
+		// z.l is written before z.r is computed
+		a, b = perplexArena.get().Set(a), perplexArena.get().Set(b)
+		c, d = perplexArena.get().Set(c), perplexArena.get().Set(d)
+		defer perplexArena.put(a, b, c, d)
+	}
+	temp := perplexArena.get()
+	defer perplexArena.put(temp)
 	z.l.Add(
 		z.l.Mul(a, c),
 		temp.Mul(b, d),
@@ -144,9 +188,10 @@ func (z *BiPerplex) Mul(x, y *BiPerplex) *BiPerplex {
 // 		a² + b² - c² - d² + 2(ab - cd)s
 // Note that this is a perplex number.
 func (z *BiPerplex) Quad() *Perplex {
-	quad := new(Perplex)
+	quad, temp := perplexArena.get(), perplexArena.get()
+	defer perplexArena.put(temp)
 	quad.Mul(&z.l, &z.l)
-	return quad.Sub(quad, new(Perplex).Mul(&z.r, &z.r))
+	return quad.Sub(quad, temp.Mul(&z.r, &z.r))
 }
 
 // Norm returns the norm of z. If z = a+bs+cR+dsT, then the norm is
@@ -169,11 +214,16 @@ func (z *BiPerplex) IsZeroDivisor() bool {
 
 // Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
 // then Inv panics.
-func (z *BiPerplex) Inv(y *BiPerplex) *BiPerplex {
+func (z *BiPerplex) Inv(y *BiPerplex) (inverse *BiPerplex) {
+	if h := currentHook(); h != nil {
+		y := new(BiPerplex).Set(y)
+		defer func() { traceInv(h, "BiPerplex.Inv", inverse != nil, z, y) }()
+	}
 	if y.IsZeroDivisor() {
-		panic("inverse of zero divisor")
+		panic(divisorError("inverse of zero divisor"))
 	}
 	quad := y.Quad()
+	defer perplexArena.put(quad)
 	quad.Inv(quad)
 	z.Conj(y)
 	z.l.Mul(&z.l, quad)
@@ -185,7 +235,7 @@ func (z *BiPerplex) Inv(y *BiPerplex) *BiPerplex {
 // Quo panics.
 func (z *BiPerplex) Quo(x, y *BiPerplex) *BiPerplex {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(z.Inv(y), x)
 }
@@ -219,17 +269,105 @@ func (z *BiPerplex) Möbius(y, a, b, c, d *BiPerplex) *BiPerplex {
 	return z.Mul(z, temp)
 }
 
+// LeftMul returns the linear operator that sends y to Mul(z, y), acting on
+// the rational components of y.
+func (z *BiPerplex) LeftMul() *Operator {
+	a := new(BiPerplex).Set(z)
+	return &Operator{4, func(v []*big.Rat) []*big.Rat {
+		y := NewBiPerplex(v[0], v[1], v[2], v[3])
+		return rats(y.Mul(a, y).Rats())
+	}}
+}
+
+// RightMul returns the linear operator that sends y to Mul(y, z), acting on
+// the rational components of y.
+func (z *BiPerplex) RightMul() *Operator {
+	a := new(BiPerplex).Set(z)
+	return &Operator{4, func(v []*big.Rat) []*big.Rat {
+		y := NewBiPerplex(v[0], v[1], v[2], v[3])
+		return rats(y.Mul(y, a).Rats())
+	}}
+}
+
+// WriteTo writes a canonical encoding of z to w, and returns the number of
+// bytes written. Equal values produce equal encodings, so any hash.Hash can be
+// used as w to hash z without building an intermediate byte slice.
+func (z *BiPerplex) WriteTo(w io.Writer) (int64, error) {
+	return writeRats(w, rats(z.Rats()))
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// the rational parameter t, and returns z. The curve is evaluated exactly with
+// the De Casteljau algorithm. If p is empty, then Bezier panics.
+func (z *BiPerplex) Bezier(t *big.Rat, p ...*BiPerplex) *BiPerplex {
+	if len(p) == 0 {
+		panic("no control points")
+	}
+	s := new(big.Rat).Sub(big.NewRat(1, 1), t)
+	b := make([]*BiPerplex, len(p))
+	for i := range p {
+		b[i] = new(BiPerplex).Set(p[i])
+	}
+	temp := new(BiPerplex)
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			b[i].Add(b[i].Scal(b[i], s), temp.Scal(b[i+1], t))
+		}
+	}
+	return z.Set(b[0])
+}
+
+// Less returns true if z precedes y in the lexicographic order on the
+// rational components. This is a total order, compatible with Equals, so
+// BiPerplex values can be sorted deterministically.
+func (z *BiPerplex) Less(y *BiPerplex) bool {
+	return lexLess(rats(z.Rats()), rats(y.Rats()))
+}
+
+// Round sets z equal to y with each component rounded to a multiple of 1/den
+// in the direction given by mode, and returns z. Directed modes round every
+// component the same way, so interval bounds stay rigorous. If den is not
+// positive, then Round panics.
+func (z *BiPerplex) Round(y *BiPerplex, den *big.Int, mode big.RoundingMode) *BiPerplex {
+	z.Set(y)
+	for _, c := range rats(z.Rats()) {
+		roundRat(c, c, den, mode)
+	}
+	return z
+}
+
+// Act returns the left action of z on the pair (a, b) of Perplex values, which
+// is identified with the BiPerplex value a + bT and multiplied on the left by
+// z. BiPerplex is commutative and associative, so acting by a product is
+// acting by its factors in turn, and Act commutes with multiplying both a and
+// b by a common Perplex value.
+func (z *BiPerplex) Act(a, b *Perplex) (*Perplex, *Perplex) {
+	v := new(BiPerplex)
+	v.l.Set(a)
+	v.r.Set(b)
+	v.Mul(z, v)
+	return &v.l, &v.r
+}
+
+// SolveCrossRatio sets z equal to the point y such that the cross-ratio of v,
+// w, x, and y is c, and returns z. With A = Inv(w - x) * (v - x) and
+// B = Inv(A) * c, the point is
+// 		(v*B - w) * Inv(B - 1)
+// If the point is not uniquely determined, then SolveCrossRatio panics.
+func (z *BiPerplex) SolveCrossRatio(v, w, x, c *BiPerplex) *BiPerplex {
+	one := new(BiPerplex)
+	one.Real().SetInt64(1)
+	b := new(BiPerplex).Sub(v, x)
+	b.Mul(new(BiPerplex).Inv(new(BiPerplex).Sub(w, x)), b)
+	b.Mul(b.Inv(b), c)
+	temp := new(BiPerplex).Sub(b, one)
+	temp.Inv(temp)
+	z.Mul(v, b)
+	z.Sub(z, w)
+	return z.Mul(z, temp)
+}
+
 // Generate returns a random BiPerplex value for quick.Check testing.
 func (z *BiPerplex) Generate(rand *rand.Rand, size int) reflect.Value {
-	randomBiPerplex := &BiPerplex{
-		*NewPerplex(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-		*NewPerplex(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-	}
-	return reflect.ValueOf(randomBiPerplex)
+	return reflect.ValueOf(new(BiPerplex).Random(rand, generateOptions))
 }
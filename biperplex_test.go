@@ -329,3 +329,71 @@ func TestBiPerplexComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Scalar operands
+
+func TestBiPerplexMulReal(t *testing.T) {
+	f := func(x, y *BiPerplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(big.Rat).Set(y.Real())
+		s := new(BiPerplex)
+		s.Real().Set(a)
+		if !s.IsReal() || x.IsReal() {
+			return false
+		}
+		l, r := new(BiPerplex), new(BiPerplex)
+		l.Mul(s, x)
+		r.Mul(x, s)
+		// an independent product: s x = (s + x)x - x x, with no real factor
+		xx := new(BiPerplex).Mul(x, x)
+		sx := new(BiPerplex).Add(s, x)
+		return l.Equals(new(BiPerplex).Sub(new(BiPerplex).Mul(sx, x), xx)) &&
+			r.Equals(new(BiPerplex).Sub(new(BiPerplex).Mul(x, sx), xx))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBiPerplexIsPure(t *testing.T) {
+	f := func(x *BiPerplex) bool {
+		// t.Logf("x = %v", x)
+		p := new(BiPerplex).Set(x)
+		p.Real().SetInt64(0)
+		return p.IsPure() && !x.IsPure()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Ordering
+
+func TestBiPerplexLessTrichotomy(t *testing.T) {
+	f := func(x, y *BiPerplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		n := 0
+		for _, b := range []bool{x.Less(y), y.Less(x), x.Equals(y)} {
+			if b {
+				n++
+			}
+		}
+		return n == 1 && !x.Less(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBiPerplexLessTransitive(t *testing.T) {
+	f := func(x, y, z *BiPerplex) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		if x.Less(y) && y.Less(z) {
+			return x.Less(z)
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// biCoeffs returns the four Complex coefficients of a BiHamilton or BiCockle
+// value with components v, along 1 and the three units of its half. Each
+// coefficient lies in the span of 1 and H.
+func biCoeffs(v []*big.Rat) [4]*Complex {
+	var c [4]*Complex
+	for k := range c {
+		c[k] = NewComplex(v[k], v[4+k])
+	}
+	return c
+}
+
+// mulH sets z equal to yH, and returns z.
+func mulH(z, y *Complex) *Complex {
+	return z.Mul(y, NewComplex(big.NewRat(0, 1), big.NewRat(1, 1)))
+}
+
+// Matrix returns the 2×2 Complex matrix of z, indexed by row and column, with
+// H serving as the imaginary unit. If z = a+bi+cj+dk with coefficients a, b,
+// c, and d in the span of 1 and H, then the matrix is
+// 		[ a+bH   c+dH ]
+// 		[ -c+dH  a-bH ]
+// This is an isomorphism onto the 2×2 Complex matrices, so that
+// Matrix(xy) = Matrix(x)Matrix(y).
+func (z *BiHamilton) Matrix() [2][2]*Complex {
+	c := biCoeffs(z.Components())
+	bh, dh := mulH(new(Complex), c[1]), mulH(new(Complex), c[3])
+	return [2][2]*Complex{
+		{new(Complex).Add(c[0], bh), new(Complex).Add(c[2], dh)},
+		{new(Complex).Sub(dh, c[2]), new(Complex).Sub(c[0], bh)},
+	}
+}
+
+// Det returns the determinant of Matrix(z), which is the Complex quadrance
+// z Conj(z). If z = a+bi+cj+dk with coefficients in the span of 1 and H, then
+// the determinant is
+// 		a² + b² + c² + d²
+// It is multiplicative, and z is invertible exactly when it is not zero.
+func (z *BiHamilton) Det() *Complex {
+	return z.quad()
+}
+
+// LorentzParts returns the decomposition
+// 		z = a + e + bH
+// with a in the span of 1 and H, and e and b pure. Conjugation by a unit of
+// Det one, x ↦ uxInv(u), fixes a and acts on e + bH as a proper Lorentz
+// transformation, in the way that it acts on the electric and magnetic
+// fields. The invariants of this action are given by LorentzInvariants.
+func (z *BiHamilton) LorentzParts() (a *Complex, e, b *HamiltonPure) {
+	a = NewComplex(z.l.Real(), z.r.Real())
+	_, e = z.l.Split()
+	_, b = z.r.Split()
+	return a, e, b
+}
+
+// LorentzInvariants returns the two invariants of the pure parts e and b of
+// LorentzParts,
+// 		p = Dot(e, e) - Dot(b, b)
+// 		q = 2Dot(e, b)
+// which are the real and H parts of Det(z) - a². They are unchanged by
+// conjugation with invertible values.
+func (z *BiHamilton) LorentzInvariants() (p, q *big.Rat) {
+	_, e, b := z.LorentzParts()
+	p = new(big.Rat).Sub(e.Dot(e), b.Dot(b))
+	q = e.Dot(b)
+	q.Add(q, q)
+	return p, q
+}
+
+// Matrix returns the 2×2 Complex matrix of z, indexed by row and column, with
+// H serving as the imaginary unit. If z = a+bi+ct+du with coefficients a, b,
+// c, and d in the span of 1 and H, then the matrix is
+// 		[ a+c  -b+d ]
+// 		[ b+d   a-c ]
+// the real matrix of Cockle with Complex entries. This is an isomorphism onto
+// the 2×2 Complex matrices, so that Matrix(xy) = Matrix(x)Matrix(y).
+func (z *BiCockle) Matrix() [2][2]*Complex {
+	c := biCoeffs(z.Components())
+	return [2][2]*Complex{
+		{new(Complex).Add(c[0], c[2]), new(Complex).Sub(c[3], c[1])},
+		{new(Complex).Add(c[1], c[3]), new(Complex).Sub(c[0], c[2])},
+	}
+}
+
+// Det returns the determinant of Matrix(z), which is the Complex quadrance
+// z Conj(z). If z = a+bi+ct+du with coefficients in the span of 1 and H, then
+// the determinant is
+// 		a² + b² - c² - d²
+// It is multiplicative, and z is invertible exactly when it is not zero.
+func (z *BiCockle) Det() *Complex {
+	return z.quad()
+}
+
+// LorentzParts returns the decomposition
+// 		z = a + e + bH
+// with a in the span of 1 and H, and e and b pure. Conjugation by a unit of
+// Det one, x ↦ uxInv(u), fixes a and preserves the invariants of e + bH given
+// by LorentzInvariants.
+func (z *BiCockle) LorentzParts() (a *Complex, e, b *CocklePure) {
+	a = NewComplex(z.l.Real(), z.r.Real())
+	_, e = z.l.Split()
+	_, b = z.r.Split()
+	return a, e, b
+}
+
+// LorentzInvariants returns the two invariants of the pure parts e and b of
+// LorentzParts,
+// 		p = Dot(e, e) - Dot(b, b)
+// 		q = 2Dot(e, b)
+// which are the real and H parts of Det(z) - a². They are unchanged by
+// conjugation with invertible values.
+func (z *BiCockle) LorentzInvariants() (p, q *big.Rat) {
+	_, e, b := z.LorentzParts()
+	p = new(big.Rat).Sub(e.Dot(e), b.Dot(b))
+	q = e.Dot(b)
+	q.Add(q, q)
+	return p, q
+}
+
+// setBiCoeffs sets the components v of a BiHamilton or BiCockle value to the
+// Complex coefficients c, the inverse of biCoeffs.
+func setBiCoeffs(v []*big.Rat, c [4]*Complex) {
+	for k := range c {
+		v[k].Set(&c[k].l)
+		v[4+k].Set(&c[k].r)
+	}
+}
+
+// halfSum returns (x + y)/2, and halfDiff returns (x - y)/2.
+func halfSum(x, y *Complex) *Complex {
+	s := new(Complex).Add(x, y)
+	return s.Scal(s, big.NewRat(1, 2))
+}
+
+func halfDiff(x, y *Complex) *Complex {
+	s := new(Complex).Sub(x, y)
+	return s.Scal(s, big.NewRat(1, 2))
+}
+
+// mobius returns the image of w under the Möbius transformation of m,
+// 		(m₀₀w + m₀₁)/(m₁₀w + m₁₁)
+// and whether the denominator is not zero.
+func mobius(m [2][2]*Complex, w *Complex) (*Complex, bool) {
+	den := new(Complex).Mul(m[1][0], w)
+	den.Add(den, m[1][1])
+	if den.Equals(new(Complex)) {
+		return nil, false
+	}
+	num := new(Complex).Mul(m[0][0], w)
+	num.Add(num, m[0][1])
+	return new(Complex).Quo(num, den), true
+}
+
+// SetMatrix sets z equal to the value whose Matrix is m, and returns z. It is
+// the inverse of Matrix, so every 2×2 Complex matrix is the Matrix of exactly
+// one BiHamilton value.
+func (z *BiHamilton) SetMatrix(m [2][2]*Complex) *BiHamilton {
+	c := [4]*Complex{
+		halfSum(m[0][0], m[1][1]),
+		halfDiff(m[0][0], m[1][1]),
+		halfDiff(m[0][1], m[1][0]),
+		halfSum(m[0][1], m[1][0]),
+	}
+	// bH and dH were found, so multiply by -H
+	for _, k := range []int{1, 3} {
+		mulH(c[k], c[k])
+		c[k].Neg(c[k])
+	}
+	setBiCoeffs(z.Components(), c)
+	return z
+}
+
+// Compose sets z equal to the composition of the Möbius transformations of x
+// and y, first y and then x, and returns z. This is the product xy, since
+// Matrix is an isomorphism.
+func (z *BiHamilton) Compose(x, y *BiHamilton) *BiHamilton {
+	return z.Mul(x, y)
+}
+
+// Mobius returns the image of w under the Möbius transformation of z. If Matrix
+// returns [a b; c d], then the image is
+// 		(aw + b)/(cw + d)
+// with H serving as the imaginary unit of w. If cw + d is zero, so that w is
+// sent to infinity, then Mobius returns a *DivisionError. The transformation is
+// only invertible if Det(z) is not zero, and scaling z by a non-zero Complex
+// value does not change it.
+func (z *BiHamilton) Mobius(w *Complex) (*Complex, error) {
+	m := z.Matrix()
+	v, ok := mobius(m, w)
+	if !ok {
+		den := new(Complex).Mul(m[1][0], w)
+		return nil, &DivisionError{"BiHamilton", "Mobius", den.Add(den, m[1][1])}
+	}
+	return v, nil
+}
+
+// SetMatrix sets z equal to the value whose Matrix is m, and returns z. It is
+// the inverse of Matrix, so every 2×2 Complex matrix is the Matrix of exactly
+// one BiCockle value. The entries are Complex and not Perplex: the 2×2
+// Perplex matrices split into two copies of Cockle, while BiCockle, like
+// BiHamilton, is a single algebra of Complex matrices.
+func (z *BiCockle) SetMatrix(m [2][2]*Complex) *BiCockle {
+	c := [4]*Complex{
+		halfSum(m[0][0], m[1][1]),
+		halfDiff(m[1][0], m[0][1]),
+		halfDiff(m[0][0], m[1][1]),
+		halfSum(m[0][1], m[1][0]),
+	}
+	setBiCoeffs(z.Components(), c)
+	return z
+}
+
+// Compose sets z equal to the composition of the Möbius transformations of x
+// and y, first y and then x, and returns z. This is the product xy, since
+// Matrix is an isomorphism.
+func (z *BiCockle) Compose(x, y *BiCockle) *BiCockle {
+	return z.Mul(x, y)
+}
+
+// Mobius returns the image of w under the Möbius transformation of z. If Matrix
+// returns [a b; c d], then the image is
+// 		(aw + b)/(cw + d)
+// with H serving as the imaginary unit of w. If cw + d is zero, so that w is
+// sent to infinity, then Mobius returns a *DivisionError. The values of z with
+// real Matrix entries, which are the Cockle values, preserve the real line.
+func (z *BiCockle) Mobius(w *Complex) (*Complex, error) {
+	m := z.Matrix()
+	v, ok := mobius(m, w)
+	if !ok {
+		den := new(Complex).Mul(m[1][0], w)
+		return nil, &DivisionError{"BiCockle", "Mobius", den.Add(den, m[1][1])}
+	}
+	return v, nil
+}
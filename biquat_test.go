@@ -0,0 +1,196 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// mulMatrix returns the product of the 2×2 Complex matrices m and n.
+func mulMatrix(m, n [2][2]*Complex) [2][2]*Complex {
+	var p [2][2]*Complex
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			p[i][j] = new(Complex).Add(
+				new(Complex).Mul(m[i][0], n[0][j]),
+				new(Complex).Mul(m[i][1], n[1][j]),
+			)
+		}
+	}
+	return p
+}
+
+func equalMatrix(m, n [2][2]*Complex) bool {
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			if !m[i][j].Equals(n[i][j]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// matrixDet returns the determinant of the 2×2 Complex matrix m.
+func matrixDet(m [2][2]*Complex) *Complex {
+	return new(Complex).Sub(
+		new(Complex).Mul(m[0][0], m[1][1]),
+		new(Complex).Mul(m[0][1], m[1][0]),
+	)
+}
+
+func TestBiHamiltonMatrix(t *testing.T) {
+	f := func(x, y *BiHamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		m := x.Matrix()
+		if !matrixDet(m).Equals(x.Det()) {
+			return false
+		}
+		if !new(Complex).Add(m[0][0], m[1][1]).Equals(x.BiTrace()) {
+			return false
+		}
+		if !new(Complex).Mul(x.Det(), y.Det()).Equals(new(BiHamilton).Mul(x, y).Det()) {
+			return false
+		}
+		return equalMatrix(new(BiHamilton).Mul(x, y).Matrix(), mulMatrix(m, y.Matrix()))
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBiCockleMatrix(t *testing.T) {
+	f := func(x, y *BiCockle) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		m := x.Matrix()
+		if !matrixDet(m).Equals(x.Det()) {
+			return false
+		}
+		if !new(Complex).Add(m[0][0], m[1][1]).Equals(x.BiTrace()) {
+			return false
+		}
+		if !new(Complex).Mul(x.Det(), y.Det()).Equals(new(BiCockle).Mul(x, y).Det()) {
+			return false
+		}
+		return equalMatrix(new(BiCockle).Mul(x, y).Matrix(), mulMatrix(m, y.Matrix()))
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBiHamiltonLorentz(t *testing.T) {
+	f := func(x, u *BiHamilton) bool {
+		// t.Logf("x = %v, u = %v", x, u)
+		a, e, b := x.LorentzParts()
+		h := new(BiHamilton)
+		h.r.Real().SetInt64(1)
+		y := new(BiHamilton).Embed(new(Hamilton).SetSplit(a.Real(), e))
+		w := new(BiHamilton).Embed(new(Hamilton).SetSplit(&a.r, b))
+		if !x.Equals(y.Add(y, w.Mul(w, h))) {
+			return false
+		}
+		p, q := x.LorentzInvariants()
+		d := new(Complex).Sub(x.Det(), new(Complex).Mul(a, a))
+		if d.l.Cmp(p) != 0 || d.r.Cmp(q) != 0 {
+			return false
+		}
+		if u.IsZeroDivisor() {
+			return true
+		}
+		v := new(BiHamilton).Mul(new(BiHamilton).Mul(u, x), new(BiHamilton).Inv(u))
+		pv, qv := v.LorentzInvariants()
+		return p.Cmp(pv) == 0 && q.Cmp(qv) == 0
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBiCockleLorentz(t *testing.T) {
+	f := func(x, u *BiCockle) bool {
+		// t.Logf("x = %v, u = %v", x, u)
+		a, e, b := x.LorentzParts()
+		h := new(BiCockle)
+		h.r.Real().SetInt64(1)
+		y := new(BiCockle).Embed(new(Cockle).SetSplit(a.Real(), e))
+		w := new(BiCockle).Embed(new(Cockle).SetSplit(&a.r, b))
+		if !x.Equals(y.Add(y, w.Mul(w, h))) {
+			return false
+		}
+		p, q := x.LorentzInvariants()
+		d := new(Complex).Sub(x.Det(), new(Complex).Mul(a, a))
+		if d.l.Cmp(p) != 0 || d.r.Cmp(q) != 0 {
+			return false
+		}
+		if u.IsZeroDivisor() {
+			return true
+		}
+		v := new(BiCockle).Mul(new(BiCockle).Mul(u, x), new(BiCockle).Inv(u))
+		pv, qv := v.LorentzInvariants()
+		return p.Cmp(pv) == 0 && q.Cmp(qv) == 0
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBiHamiltonSetMatrix(t *testing.T) {
+	f := func(x, y *BiHamilton, w *Complex) bool {
+		// t.Logf("x = %v, y = %v, w = %v", x, y, w)
+		if !new(BiHamilton).SetMatrix(x.Matrix()).Equals(x) {
+			return false
+		}
+		v, err := y.Mobius(w)
+		if err != nil {
+			return true
+		}
+		got, err := new(BiHamilton).Compose(x, y).Mobius(w)
+		want, werr := x.Mobius(v)
+		if err != nil || werr != nil {
+			return (err != nil) == (werr != nil)
+		}
+		return got.Equals(want)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBiCockleSetMatrix(t *testing.T) {
+	f := func(x, y *BiCockle, w *Complex) bool {
+		// t.Logf("x = %v, y = %v, w = %v", x, y, w)
+		if !new(BiCockle).SetMatrix(x.Matrix()).Equals(x) {
+			return false
+		}
+		v, err := y.Mobius(w)
+		if err != nil {
+			return true
+		}
+		got, err := new(BiCockle).Compose(x, y).Mobius(w)
+		want, werr := x.Mobius(v)
+		if err != nil || werr != nil {
+			return (err != nil) == (werr != nil)
+		}
+		return got.Equals(want)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+	// the translation w ↦ w + 1 sends nothing to infinity but infinity
+	var m [2][2]*Complex
+	one, zero := NewComplex(big.NewRat(1, 1), big.NewRat(0, 1)), new(Complex)
+	m[0] = [2]*Complex{one, one}
+	m[1] = [2]*Complex{zero, one}
+	x := new(BiCockle).SetMatrix(m)
+	if w, err := x.Mobius(one); err != nil || !w.Equals(NewComplex(big.NewRat(2, 1), big.NewRat(0, 1))) {
+		t.Errorf("Mobius(1) = %v, %v", w, err)
+	}
+	m[1] = [2]*Complex{one, new(Complex).Neg(one)}
+	if _, err := new(BiCockle).SetMatrix(m).Mobius(one); err == nil {
+		t.Error("Mobius(1) did not fail for w/(w-1)")
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// NewPerplexBoost returns a pointer to the Perplex value
+// 		(1 + ws)/(1 - ws) = (1 + w² + 2ws)/(1 - w²)
+// which is the Cayley transform of ws, an exact value of quadrance one. As a
+// boost of the plane t + xs, with Quad the squared interval t² - x², it has
+// rapidity φ with
+// 		tanh(φ/2) = w
+// If |w| < 1, it equals Boost(k) with Doppler factor k = (1 + w)/(1 - w). If w
+// is 1 or -1, then NewPerplexBoost panics.
+func NewPerplexBoost(w *big.Rat) *Perplex {
+	return cayley(new(Perplex), NewPerplex(new(big.Rat), w))
+}
+
+// BoostMatrix returns the 2×2 rational matrix of
+// 		v ↦ zv
+// in the basis (1, s). If z = a+bs, this is
+// 		[[a, b], [b, a]]
+// which is a Lorentz boost of the plane t + xs when Quad(z) = 1 and a > 0.
+func (z *Perplex) BoostMatrix() [2][2]*big.Rat {
+	return [2][2]*big.Rat{
+		{new(big.Rat).Set(&z.l), new(big.Rat).Set(&z.r)},
+		{new(big.Rat).Set(&z.r), new(big.Rat).Set(&z.l)},
+	}
+}
+
+// NewCockleBoost returns a pointer to the Cockle value
+// 		(1 + w)/(1 - w) = (1 + w)²/(1 + Quad(w))
+// with w = ai+bt+cu. This is the Cayley transform of w, an exact value of
+// quadrance one. Through Rotate, it acts on the pure values bi+ct+du, whose
+// quadrance b² - c² - d² is a Lorentz interval with time along i. If w = bt+cu,
+// then it is a boost that fixes the spatial direction bt+cu, with rapidity φ
+// where
+// 		tanh(φ/4)² = b² + c²
+// If w = ai, then it is a rotation of the tu-plane by θ with tan(θ/4) = a. If
+// Quad(w) = -1, then NewCockleBoost panics.
+func NewCockleBoost(a, b, c *big.Rat) *Cockle {
+	return cayley(new(Cockle), NewCockle(new(big.Rat), a, b, c))
+}
+
+// Rotate sets z equal to the sandwich product
+// 		q v Inv(q)
+// and returns z. If v is pure, then so is z, and they have the same
+// quadrance, so that z is v under a Lorentz transformation of the pure
+// values. The real part of v is left unchanged. Scaling q does not change the
+// transformation. If q is a zero divisor, then Rotate panics.
+func (z *Cockle) Rotate(q, v *Cockle) *Cockle {
+	if q.IsZeroDivisor() {
+		panic(divisorError("rotation by zero divisor"))
+	}
+	w := new(Cockle).Mul(q, v)
+	return z.Mul(w, new(Cockle).Inv(q))
+}
+
+// RotationMatrix returns the 3×3 rational matrix of the Lorentz
+// transformation
+// 		v ↦ z v Inv(z)
+// on the pure values, in the basis (i, t, u). If z is a zero divisor, then
+// RotationMatrix panics.
+func (z *Cockle) RotationMatrix() [3][3]*big.Rat {
+	var m [3][3]*big.Rat
+	for j := 0; j < 3; j++ {
+		e := new(Cockle)
+		e.Components()[j+1].SetInt64(1)
+		for i, c := range new(Cockle).Rotate(z, e).Components()[1:] {
+			m[i][j] = c
+		}
+	}
+	return m
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestNewPerplexBoost(t *testing.T) {
+	f := func(a, b int64) bool {
+		// t.Logf("a = %v, b = %v", a, b)
+		if b == 0 {
+			return true
+		}
+		w := big.NewRat(a, b)
+		if w.Cmp(big.NewRat(1, 1)) == 0 || w.Cmp(big.NewRat(-1, 1)) == 0 {
+			return true
+		}
+		z := NewPerplexBoost(w)
+		if z.Quad().Cmp(big.NewRat(1, 1)) != 0 {
+			return false
+		}
+		if new(big.Rat).Abs(w).Cmp(big.NewRat(1, 1)) >= 0 {
+			return true
+		}
+		k := new(big.Rat).Quo(new(big.Rat).Add(big.NewRat(1, 1), w), new(big.Rat).Sub(big.NewRat(1, 1), w))
+		return z.Equals(new(Perplex).Boost(k))
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPerplexBoostMatrix(t *testing.T) {
+	f := func(x, y *Perplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		m := x.BoostMatrix()
+		v := y.Components()
+		w := new(Perplex).Mul(x, y).Components()
+		for i := 0; i < 2; i++ {
+			c := new(big.Rat).Add(new(big.Rat).Mul(m[i][0], v[0]), new(big.Rat).Mul(m[i][1], v[1]))
+			if c.Cmp(w[i]) != 0 {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCockleRotate(t *testing.T) {
+	f := func(q, v *Cockle) bool {
+		// t.Logf("q = %v, v = %v", q, v)
+		if q.IsZeroDivisor() {
+			return true
+		}
+		v.Real().SetInt64(0)
+		w := new(Cockle).Rotate(q, v)
+		m := q.RotationMatrix()
+		x := v.Components()[1:]
+		for i := 0; i < 3; i++ {
+			c := new(big.Rat)
+			for j := 0; j < 3; j++ {
+				c.Add(c, new(big.Rat).Mul(m[i][j], x[j]))
+			}
+			if c.Cmp(w.Components()[i+1]) != 0 {
+				return false
+			}
+		}
+		return w.IsPure() && w.Quad().Cmp(v.Quad()) == 0
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNewCockleBoost(t *testing.T) {
+	zero, third := new(big.Rat), big.NewRat(1, 3)
+	q := NewCockleBoost(zero, third, zero)
+	if n := q.Quad(); n.Cmp(big.NewRat(1, 1)) != 0 {
+		t.Errorf("Quad(%v) = %v, want 1", q, n)
+	}
+	// the boost fixes t and mixes i with u
+	m := q.RotationMatrix()
+	if m[1][1].Cmp(big.NewRat(1, 1)) != 0 || m[0][1].Sign() != 0 || m[2][1].Sign() != 0 {
+		t.Errorf("NewCockleBoost(0, 1/3, 0) moves t: %v", m)
+	}
+	// cosh(φ) with tanh(φ/4) = 1/3 is 1 + 2sinh²(φ/2) = 17/8
+	if m[0][0].Cmp(big.NewRat(17, 8)) != 0 {
+		t.Errorf("cosh(φ) = %v, want 17/8", m[0][0])
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+)
+
+// Versions of the canonical form of persisted values.
+const (
+	// CanonicalText is the space-separated list of the RatString of each
+	// component, such as "1/2 -3 0 7/4".
+	CanonicalText = 1
+	// CanonicalBinary is a version byte followed by the encoding written by
+	// WriteTo.
+	CanonicalBinary = 2
+	// CanonicalLatest is the version written by the encoders of this package.
+	CanonicalLatest = CanonicalBinary
+)
+
+// readRat reads one rational encoded by writeRats from r. It returns io.EOF
+// only if r is exhausted before the first byte. The length prefixes are not
+// trusted: the buffers grow only as bytes arrive, so a truncated input with a
+// huge prefix fails without a huge allocation.
+func readRat(r io.Reader) (*big.Rat, error) {
+	var head [5]byte
+	get := func() (*big.Int, error) {
+		if _, err := io.ReadFull(r, head[1:]); err != nil {
+			return nil, unexpected(err)
+		}
+		var buf bytes.Buffer
+		size := int64(binary.BigEndian.Uint32(head[1:]))
+		if _, err := io.CopyN(&buf, r, size); err != nil {
+			return nil, unexpected(err)
+		}
+		return new(big.Int).SetBytes(buf.Bytes()), nil
+	}
+	if _, err := io.ReadFull(r, head[:1]); err != nil {
+		return nil, err
+	}
+	if head[0] > 2 {
+		return nil, errors.New("rational: invalid sign byte")
+	}
+	num, err := get()
+	if err != nil {
+		return nil, err
+	}
+	den, err := get()
+	if err != nil {
+		return nil, err
+	}
+	if den.Sign() == 0 {
+		return nil, errors.New("rational: zero denominator")
+	}
+	if (head[0] == 1) != (num.Sign() == 0) {
+		return nil, errors.New("rational: sign byte disagrees with numerator")
+	}
+	if head[0] == 0 {
+		num.Neg(num)
+	}
+	return new(big.Rat).SetFrac(num, den), nil
+}
+
+// readRats reads rationals encoded by writeRats from r until EOF.
+func readRats(r io.Reader) ([]*big.Rat, error) {
+	var v []*big.Rat
+	for {
+		x, err := readRat(r)
+		if err == io.EOF {
+			return v, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		v = append(v, x)
+	}
+}
+
+// unexpected converts io.EOF into io.ErrUnexpectedEOF.
+func unexpected(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// encodeCanonical returns the latest canonical form of the rationals in v.
+func encodeCanonical(v []*big.Rat) []byte {
+	var b bytes.Buffer
+	b.WriteByte(CanonicalLatest)
+	writeRats(&b, v)
+	return b.Bytes()
+}
+
+// decodeCanonical returns the rationals stored in data, together with the
+// version of the canonical form that was detected. A binary form starts with
+// its version byte, which is never the first byte of a text form.
+func decodeCanonical(data []byte) ([]*big.Rat, int, error) {
+	if len(data) > 0 && data[0] == CanonicalBinary {
+		v, err := readRats(bytes.NewReader(data[1:]))
+		return v, CanonicalBinary, err
+	}
+	fields := strings.Fields(string(data))
+	v := make([]*big.Rat, len(fields))
+	for i, s := range fields {
+		x, ok := new(big.Rat).SetString(s)
+		if !ok {
+			return nil, CanonicalText, fmt.Errorf("rational: invalid component %q", s)
+		}
+		v[i] = x
+	}
+	return v, CanonicalText, nil
+}
+
+// decodeCanonicalDim is like decodeCanonical, but it also checks that data
+// holds exactly dim components.
+func decodeCanonicalDim(data []byte, dim int) ([]*big.Rat, error) {
+	v, _, err := decodeCanonical(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(v) != dim {
+		return nil, fmt.Errorf("rational: got %d components, want %d", len(v), dim)
+	}
+	return v, nil
+}
+
+// CanonicalVersion returns the version of the canonical form stored in data.
+func CanonicalVersion(data []byte) int {
+	if len(data) > 0 && data[0] == CanonicalBinary {
+		return CanonicalBinary
+	}
+	return CanonicalText
+}
+
+// MigrateCanonical converts a canonical form of any version into the latest
+// version. Since every version is exact, no value changes in the process.
+func MigrateCanonical(data []byte) ([]byte, error) {
+	v, _, err := decodeCanonical(data)
+	if err != nil {
+		return nil, err
+	}
+	return encodeCanonical(v), nil
+}
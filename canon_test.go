@@ -0,0 +1,80 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"bytes"
+	"math/big"
+	"runtime"
+	"testing"
+	"testing/quick"
+)
+
+func TestCanonicalRoundTrip(t *testing.T) {
+	f := func(x *Cayley) bool {
+		// t.Logf("x = %v", x)
+		data := encodeCanonical(rats(x.Rats()))
+		if CanonicalVersion(data) != CanonicalLatest {
+			return false
+		}
+		v, err := decodeCanonicalDim(data, 8)
+		if err != nil {
+			return false
+		}
+		return NewCayley(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7]).Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMigrateCanonicalText(t *testing.T) {
+	old := []byte("1/2 -3 0 14/8")
+	if v := CanonicalVersion(old); v != CanonicalText {
+		t.Fatalf("CanonicalVersion = %d, want %d", v, CanonicalText)
+	}
+	data, err := MigrateCanonical(old)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := NewHamilton(big.NewRat(1, 2), big.NewRat(-3, 1), new(big.Rat), big.NewRat(7, 4))
+	if want := encodeCanonical(rats(x.Rats())); !bytes.Equal(data, want) {
+		t.Errorf("MigrateCanonical(%q) = %x, want %x", old, data, want)
+	}
+}
+
+func TestDecodeCanonicalErrors(t *testing.T) {
+	for _, data := range [][]byte{
+		[]byte("1/2 x"),
+		[]byte("1/0"),
+		{CanonicalBinary, 1, 0, 0},
+		{CanonicalBinary, 7, 0, 0, 0, 0, 0, 0, 0, 0},
+		// a zero sign byte with a non-zero numerator
+		{CanonicalBinary, 1, 0, 0, 0, 1, 5, 0, 0, 0, 1, 1},
+		// a positive sign byte with a zero numerator
+		{CanonicalBinary, 2, 0, 0, 0, 0, 0, 0, 0, 1, 1},
+	} {
+		if _, _, err := decodeCanonical(data); err == nil {
+			t.Errorf("decodeCanonical(%q) succeeded", data)
+		}
+	}
+	if _, err := decodeCanonicalDim([]byte("1 2 3"), 4); err == nil {
+		t.Error("decodeCanonicalDim accepted 3 components for dimension 4")
+	}
+}
+
+func TestDecodeCanonicalOversizedPrefix(t *testing.T) {
+	// a length prefix of 2 GiB with no bytes after it
+	data := []byte{CanonicalBinary, 2, 0x7f, 0xff, 0xff, 0xff}
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	before := m.TotalAlloc
+	if err := new(Complex).UnmarshalBinary(data); err == nil {
+		t.Errorf("UnmarshalBinary(%x) succeeded", data)
+	}
+	runtime.ReadMemStats(&m)
+	if n := m.TotalAlloc - before; n > 1<<20 {
+		t.Errorf("UnmarshalBinary(%x) allocated %d bytes", data, n)
+	}
+}
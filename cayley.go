@@ -5,6 +5,7 @@ package rational
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"reflect"
@@ -18,18 +19,32 @@ type Cayley struct {
 	l, r Hamilton
 }
 
-// Real returns the (rational) real part of z.
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
 func (z *Cayley) Real() *big.Rat {
 	return (&z.l).Real()
 }
 
-// Rats returns the eight rational components of z.
+// Rats returns the eight rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
 func (z *Cayley) Rats() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
 	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
 	return &z.l.l.l, &z.l.l.r, &z.l.r.l, &z.l.r.r,
 		&z.r.l.l, &z.r.l.r, &z.r.r.l, &z.r.r.r
 }
 
+// RatsCopy returns copies of the eight rational components of z.
+func (z *Cayley) RatsCopy() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
+	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
+	return new(Cayley).Set(z).Rats()
+}
+
+// Components returns the eight rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *Cayley) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
 // String returns the string representation of a Cayley value.
 //
 // If z corresponds to a + bi + cj + dk + em + fn + gp + hq, then the
@@ -39,7 +54,8 @@ func (z *Cayley) String() string {
 	v[0], v[1], v[2], v[3] = z.l.Rats()
 	v[4], v[5], v[6], v[7] = z.r.Rats()
 	a := make([]string, 17)
-	a[0] = leftBracket
+	br := brackets()
+	a[0] = br[0]
 	a[1] = fmt.Sprintf("%v", v[0].RatString())
 	i := 1
 	for j := 2; j < 16; j = j + 2 {
@@ -51,7 +67,7 @@ func (z *Cayley) String() string {
 		a[j+1] = symbCayley[i]
 		i++
 	}
-	a[16] = rightBracket
+	a[16] = br[1]
 	return strings.Join(a, "")
 }
 
@@ -63,6 +79,16 @@ func (z *Cayley) Equals(y *Cayley) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *Cayley) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Hamilton))
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *Cayley) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *Cayley) Set(y *Cayley) *Cayley {
 	z.l.Set(&y.l)
@@ -146,12 +172,26 @@ func (z *Cayley) Sub(x, y *Cayley) *Cayley {
 // 		Mul(n, q) = -Mul(q, n) = +j
 // 		Mul(p, q) = -Mul(q, p) = -i
 // This binary operation is noncommutative and nonassociative.
-func (z *Cayley) Mul(x, y *Cayley) *Cayley {
-	a := new(Hamilton).Set(&x.l)
-	b := new(Hamilton).Set(&x.r)
-	c := new(Hamilton).Set(&y.l)
-	d := new(Hamilton).Set(&y.r)
-	temp := new(Hamilton)
+func (z *Cayley) Mul(x, y *Cayley) (product *Cayley) {
+	if h := currentHook(); h != nil {
+		x, y := new(Cayley).Set(x), new(Cayley).Set(y)
+		defer func() { traceMul(h, "Cayley.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	a, b, c, d := &x.l, &x.r, &y.l, &y.r
+	if z == x || z == y {
+		// z.l is written before z.r is computed
+		a, b = hamiltonArena.get().Set(a), hamiltonArena.get().Set(b)
+		c, d = hamiltonArena.get().Set(c), hamiltonArena.get().Set(d)
+		defer hamiltonArena.put(a, b, c, d)
+	}
+	temp := hamiltonArena.get()
+	defer hamiltonArena.put(temp)
 	z.l.Sub(
 		z.l.Mul(a, c),
 		temp.Mul(temp.Conj(d), b),
@@ -189,19 +229,23 @@ func (z *Cayley) Associator(w, x, y *Cayley) *Cayley {
 //		a² + b² + c² + d² + e² + f² + g² + h²
 // This is always non-negative.
 func (z *Cayley) Quad() *big.Rat {
-	return new(big.Rat).Add(
-		z.l.Quad(),
-		z.r.Quad(),
-	)
+	q, r := z.l.Quad(), z.r.Quad()
+	defer putRat(r)
+	return q.Add(q, r)
 }
 
 // Inv sets z equal to the inverse of y, and returns z. If y is zero, then Inv
 // panics.
-func (z *Cayley) Inv(y *Cayley) *Cayley {
+func (z *Cayley) Inv(y *Cayley) (inverse *Cayley) {
+	if h := currentHook(); h != nil {
+		y := new(Cayley).Set(y)
+		defer func() { traceInv(h, "Cayley.Inv", inverse != nil, z, y) }()
+	}
 	if zero := new(Cayley); y.Equals(zero) {
-		panic("inverse of zero")
+		panic(denominatorError("inverse of zero"))
 	}
 	a := y.Quad()
+	defer putRat(a)
 	a.Inv(a)
 	return z.Scal(z.Conj(y), a)
 }
@@ -211,7 +255,7 @@ func (z *Cayley) Inv(y *Cayley) *Cayley {
 // Then it returns z. If y is zero, then QuoL panics.
 func (z *Cayley) QuoL(x, y *Cayley) *Cayley {
 	if zero := new(Cayley); y.Equals(zero) {
-		panic("denominator is zero")
+		panic(denominatorError("denominator is zero"))
 	}
 	return z.Mul(z.Inv(y), x)
 }
@@ -221,7 +265,7 @@ func (z *Cayley) QuoL(x, y *Cayley) *Cayley {
 // Then it returns z. If y is zero, then QuoR panics.
 func (z *Cayley) QuoR(x, y *Cayley) *Cayley {
 	if zero := new(Cayley); y.Equals(zero) {
-		panic("denominator is zero")
+		panic(denominatorError("denominator is zero"))
 	}
 	return z.Mul(x, z.Inv(y))
 }
@@ -250,21 +294,153 @@ func (z *Cayley) Klein(a, b, c, d, e, f, g, h *big.Int) *Cayley {
 	return z
 }
 
+// LeftMul returns the linear operator that sends y to Mul(z, y), acting on
+// the rational components of y.
+func (z *Cayley) LeftMul() *Operator {
+	a := new(Cayley).Set(z)
+	return &Operator{8, func(v []*big.Rat) []*big.Rat {
+		y := NewCayley(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+		return rats(y.Mul(a, y).Rats())
+	}}
+}
+
+// RightMul returns the linear operator that sends y to Mul(y, z), acting on
+// the rational components of y.
+func (z *Cayley) RightMul() *Operator {
+	a := new(Cayley).Set(z)
+	return &Operator{8, func(v []*big.Rat) []*big.Rat {
+		y := NewCayley(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+		return rats(y.Mul(y, a).Rats())
+	}}
+}
+
+// MinPoly returns the minimal polynomial of z. If z is real, then the
+// minimal polynomial is
+// 		t - Real(z)
+// Otherwise, it is
+// 		t² - 2Real(z)t + Quad(z)
+func (z *Cayley) MinPoly() Laurent {
+	re := new(big.Rat).Set(z.Real())
+	pure := new(Cayley).Set(z)
+	pure.Real().SetInt64(0)
+	if pure.Equals(new(Cayley)) {
+		return Laurent{0: re.Neg(re), 1: big.NewRat(1, 1)}
+	}
+	return Laurent{
+		0: z.Quad(),
+		1: re.Mul(re, big.NewRat(-2, 1)),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// EvaluateMinPoly returns true if z satisfies its minimal polynomial.
+func (z *Cayley) EvaluateMinPoly() bool {
+	return satisfies(z, z.MinPoly())
+}
+
+// PowViaMinPoly sets z equal to y raised to the power n, and returns z. High
+// powers are reduced with the minimal polynomial of y, so only O(log n)
+// rational multiplications are needed.
+func (z *Cayley) PowViaMinPoly(y *Cayley, n uint64) *Cayley {
+	p, q := quadPow(y.MinPoly(), n)
+	z.Scal(y, p)
+	z.Real().Add(z.Real(), q)
+	return z
+}
+
+// WriteTo writes a canonical encoding of z to w, and returns the number of
+// bytes written. Equal values produce equal encodings, so any hash.Hash can be
+// used as w to hash z without building an intermediate byte slice.
+func (z *Cayley) WriteTo(w io.Writer) (int64, error) {
+	return writeRats(w, rats(z.Rats()))
+}
+
+// IsRootOfUnity returns the order n of z and true if z is a root of unity
+// with n less than or equal to maxOrder. Otherwise it returns 0 and false. The
+// order is read from the real part and quadrance of z, since
+// 		z² - 2Re(z)z + Quad(z) = 0
+// so no powers of z are computed. Roots of unity are integral, but z need not
+// be: any other value is simply not a root of unity.
+func (z *Cayley) IsRootOfUnity(maxOrder uint64) (uint64, bool) {
+	n := rootOfUnityOrder(z.Real(), z.Quad())
+	if n == 0 || n > maxOrder {
+		return 0, false
+	}
+	return n, true
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// the rational parameter t, and returns z. The curve is evaluated exactly with
+// the De Casteljau algorithm. If p is empty, then Bezier panics.
+func (z *Cayley) Bezier(t *big.Rat, p ...*Cayley) *Cayley {
+	if len(p) == 0 {
+		panic("no control points")
+	}
+	s := new(big.Rat).Sub(big.NewRat(1, 1), t)
+	b := make([]*Cayley, len(p))
+	for i := range p {
+		b[i] = new(Cayley).Set(p[i])
+	}
+	temp := new(Cayley)
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			b[i].Add(b[i].Scal(b[i], s), temp.Scal(b[i+1], t))
+		}
+	}
+	return z.Set(b[0])
+}
+
+// Triple returns the rational trilinear form of z, x, and y:
+// 		Real(Mul(Mul(z, x), Conj(y)))
+// Restricted to pure values, this is the associative 3-form, and it is
+// alternating.
+func (z *Cayley) Triple(x, y *Cayley) *big.Rat {
+	p := new(Cayley).Mul(z, x)
+	p.Mul(p, new(Cayley).Conj(y))
+	return new(big.Rat).Set(p.Real())
+}
+
+// AssociatorQuad returns the quadrance of the associator of z, x, and y.
+func (z *Cayley) AssociatorQuad(x, y *Cayley) *big.Rat {
+	return new(Cayley).Associator(z, x, y).Quad()
+}
+
+// Quadruple returns the rational 4-form of z, w, x, and y:
+// 		Real(Mul(z, Conj(Associator(w, x, y)))) / 2
+// Restricted to pure values, this is the coassociative 4-form, and it is
+// alternating.
+func (z *Cayley) Quadruple(w, x, y *Cayley) *big.Rat {
+	a := new(Cayley).Associator(w, x, y)
+	p := new(Cayley).Mul(z, a.Conj(a))
+	return new(big.Rat).Quo(p.Real(), big.NewRat(2, 1))
+}
+
+// Round sets z equal to y with each component rounded to a multiple of 1/den
+// in the direction given by mode, and returns z. Directed modes round every
+// component the same way, so interval bounds stay rigorous. If den is not
+// positive, then Round panics.
+func (z *Cayley) Round(y *Cayley, den *big.Int, mode big.RoundingMode) *Cayley {
+	z.Set(y)
+	for _, c := range rats(z.Rats()) {
+		roundRat(c, c, den, mode)
+	}
+	return z
+}
+
+// Act returns the left action of z on the pair (a, b) of Hamilton values,
+// which is identified with the Cayley value a + bm and multiplied on the left
+// by z. Cayley is not associative, so acting by Mul(x, y) is not in general
+// acting by y and then by x, and Act is not a representation of Cayley. It is
+// only alternative: acting by x twice is acting by Mul(x, x).
+func (z *Cayley) Act(a, b *Hamilton) (*Hamilton, *Hamilton) {
+	v := new(Cayley)
+	v.l.Set(a)
+	v.r.Set(b)
+	v.Mul(z, v)
+	return &v.l, &v.r
+}
+
 // Generate returns a random Cayley value for quick.Check testing.
 func (z *Cayley) Generate(rand *rand.Rand, size int) reflect.Value {
-	randomCayley := &Cayley{
-		*NewHamilton(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-		*NewHamilton(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-	}
-	return reflect.ValueOf(randomCayley)
+	return reflect.ValueOf(new(Cayley).Random(rand, generateOptions))
 }
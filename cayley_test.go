@@ -378,3 +378,141 @@ func TestCayleyComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Scalar operands
+
+func TestCayleyMulReal(t *testing.T) {
+	f := func(x, y *Cayley) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(big.Rat).Set(y.Real())
+		s := new(Cayley)
+		s.Real().Set(a)
+		if !s.IsReal() || x.IsReal() {
+			return false
+		}
+		l, r := new(Cayley), new(Cayley)
+		l.Mul(s, x)
+		r.Mul(x, s)
+		// an independent product: s x = (s + x)x - x x, with no real factor
+		xx := new(Cayley).Mul(x, x)
+		sx := new(Cayley).Add(s, x)
+		return l.Equals(new(Cayley).Sub(new(Cayley).Mul(sx, x), xx)) &&
+			r.Equals(new(Cayley).Sub(new(Cayley).Mul(x, sx), xx))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyIsPure(t *testing.T) {
+	f := func(x *Cayley) bool {
+		// t.Logf("x = %v", x)
+		p := new(Cayley).Set(x)
+		p.Real().SetInt64(0)
+		return p.IsPure() && !x.IsPure()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Roots of unity
+
+func TestCayleyIsRootOfUnity(t *testing.T) {
+	h, mh, zero := big.NewRat(1, 2), big.NewRat(-1, 2), new(big.Rat)
+	z := NewCayley(mh, zero, h, zero, h, zero, h, zero)
+	if order, ok := z.IsRootOfUnity(12); !ok || order != 3 {
+		t.Errorf("IsRootOfUnity(%v) = %d, %v, want 3, true", z, order, ok)
+	}
+	z = NewCayley(big.NewRat(2, 1), zero, zero, zero, zero, zero, zero, zero)
+	if _, ok := z.IsRootOfUnity(12); ok {
+		t.Errorf("IsRootOfUnity(%v) = true, want false", z)
+	}
+}
+
+// Trilinear forms
+
+func TestCayleyTripleAlternating(t *testing.T) {
+	f := func(x, y, z *Cayley) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		x.Real().SetInt64(0)
+		y.Real().SetInt64(0)
+		z.Real().SetInt64(0)
+		a := x.Triple(y, z)
+		b := new(big.Rat).Neg(y.Triple(x, z))
+		c := new(big.Rat).Neg(x.Triple(z, y))
+		return a.Cmp(b) == 0 && a.Cmp(c) == 0 && x.Triple(x, y).Sign() == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyQuadrupleAlternating(t *testing.T) {
+	f := func(w, x, y, z *Cayley) bool {
+		// t.Logf("w = %v, x = %v, y = %v, z = %v", w, x, y, z)
+		w.Real().SetInt64(0)
+		x.Real().SetInt64(0)
+		y.Real().SetInt64(0)
+		z.Real().SetInt64(0)
+		a := w.Quadruple(x, y, z)
+		b := new(big.Rat).Neg(x.Quadruple(w, y, z))
+		c := new(big.Rat).Neg(w.Quadruple(x, z, y))
+		return a.Cmp(b) == 0 && a.Cmp(c) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyAssociatorQuad(t *testing.T) {
+	f := func(x, y *Cayley) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return x.AssociatorQuad(x, y).Sign() == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Copies
+
+func TestCayleyRatsCopy(t *testing.T) {
+	f := func(x *Cayley) bool {
+		// t.Logf("x = %v", x)
+		y := new(Cayley).Set(x)
+		v := rats(x.RatsCopy())
+		for _, c := range v {
+			c.Add(c, big.NewRat(1, 1))
+		}
+		return x.Equals(y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Module structure
+
+func TestCayleyActNonAssociative(t *testing.T) {
+	zero, one := new(big.Rat), big.NewRat(1, 1)
+	x := NewCayley(zero, one, zero, zero, zero, zero, zero, zero)
+	y := NewCayley(zero, zero, one, zero, zero, zero, zero, zero)
+	// the pair (0, 1) is the unit m
+	a, b := new(Hamilton), NewHamilton(one, zero, zero, zero)
+	c, d := new(Cayley).Mul(x, y).Act(a, b)
+	e, f := x.Act(y.Act(a, b))
+	if c.Equals(e) && d.Equals(f) {
+		t.Errorf("Mul(%v, %v) acts on (%v, %v) as %v then %v", x, y, a, b, y, x)
+	}
+	// Cayley is alternative, so acting by x twice is acting by Mul(x, x)
+	g := func(x, w *Cayley) bool {
+		// t.Logf("x = %v, w = %v", x, w)
+		a, b := new(Cayley).Mul(x, x).Act(&w.l, &w.r)
+		c, d := x.Act(x.Act(&w.l, &w.r))
+		return a.Equals(c) && b.Equals(d)
+	}
+	if err := quick.Check(g, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,517 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// quadraticCharPoly returns the polynomial
+// 		t² - tr t + n
+// over the commutative type S.
+func quadraticCharPoly[S any, T Elem[S]](tr, n T) *Poly[S, T] {
+	one := T(new(S))
+	one.Real().SetInt64(1)
+	return NewPoly(n, T(new(S)).Neg(tr), one)
+}
+
+// normPoly returns the product of p and its coefficientwise conjugate. If
+// Conj is an automorphism of S, then the coefficients of the product are
+// fixed by Conj.
+func normPoly[S any, T Elem[S]](p *Poly[S, T]) *Poly[S, T] {
+	q := &Poly[S, T]{make([]S, len(p.c))}
+	for i := range p.c {
+		T(&q.c[i]).Conj(&p.c[i])
+	}
+	return q.Mul(p, q)
+}
+
+// laurentOf returns the real parts of the coefficients of p as a Laurent
+// polynomial.
+func laurentOf[S any, T Elem[S]](p *Poly[S, T]) Laurent {
+	l := make(Laurent)
+	for i := range p.c {
+		l[int64(i)] = new(big.Rat).Set(T(&p.c[i]).Real())
+	}
+	return l
+}
+
+// lowerPoly returns the polynomial whose coefficients are the l halves of
+// those of p.
+func lowerPoly[S any, T Elem[S], R any, U Elem[R]](p *Poly[S, T], l func(T) U) *Poly[R, U] {
+	c := make([]U, len(p.c))
+	for i := range p.c {
+		c[i] = l(&p.c[i])
+	}
+	return NewPoly(c...)
+}
+
+// satisfies returns true if z is a zero of p. If p has terms of negative
+// degree, then satisfies panics.
+func satisfies[S any, T Elem[S]](z T, p Laurent) bool {
+	neg, nonneg := p.Degrees()
+	if len(neg) > 0 {
+		panic("negative degree")
+	}
+	sum, pow, temp := T(new(S)), T(new(S)), T(new(S))
+	pow.Real().SetInt64(1)
+	for d := int64(0); len(nonneg) > 0 && d <= nonneg[len(nonneg)-1]; d++ {
+		if c, ok := p[d]; ok {
+			sum.Add(sum, temp.Scal(pow, c))
+		}
+		pow.Mul(pow, z)
+	}
+	return sum.Equals(T(new(S)))
+}
+
+// CharPoly returns the reduced characteristic polynomial of z,
+// 		t² - Trace(z)t + Quad(z)
+// whose coefficients are the trace z + Conj(z) and the norm z Conj(z). Unlike
+// MinPoly, it has degree two even when z is real.
+func (z *Complex) CharPoly() Laurent {
+	tr := z.Trace()
+	return Laurent{
+		0: z.Quad(),
+		1: tr.Neg(tr),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// CharPoly returns the reduced characteristic polynomial of z,
+// 		t² - Trace(z)t + Quad(z)
+// whose coefficients are the trace z + Conj(z) and the norm z Conj(z). Unlike
+// MinPoly, it has degree two even when z is real.
+func (z *Infra) CharPoly() Laurent {
+	tr := z.Trace()
+	return Laurent{
+		0: z.Quad(),
+		1: tr.Neg(tr),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// CharPoly returns the reduced characteristic polynomial of z,
+// 		t² - Trace(z)t + Quad(z)
+// whose coefficients are the trace z + Conj(z) and the norm z Conj(z). Unlike
+// MinPoly, it has degree two even when z is real.
+func (z *Perplex) CharPoly() Laurent {
+	tr := z.Trace()
+	return Laurent{
+		0: z.Quad(),
+		1: tr.Neg(tr),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// CharPoly returns the reduced characteristic polynomial of z. Over Complex,
+// z is a zero of
+// 		t² - BiTrace(z)t + z Conj(z)
+// and CharPoly returns the product of this quadratic with its Complex
+// conjugate, a quartic with rational coefficients whose constant term is
+// Norm(z).
+func (z *BiComplex) CharPoly() Laurent {
+	n := new(BiComplex).Mul(z, new(BiComplex).Conj(z))
+	return laurentOf(normPoly(quadraticCharPoly(z.BiTrace(), &n.l)))
+}
+
+// CharPoly returns the reduced characteristic polynomial of z. Over Perplex,
+// z is a zero of
+// 		t² - BiTrace(z)t + z Conj(z)
+// and CharPoly returns the product of this quadratic with its Perplex
+// conjugate, a quartic with rational coefficients whose constant term is
+// Norm(z).
+func (z *BiPerplex) CharPoly() Laurent {
+	n := new(BiPerplex).Mul(z, new(BiPerplex).Conj(z))
+	return laurentOf(normPoly(quadraticCharPoly(z.BiTrace(), &n.l)))
+}
+
+// CharPoly returns the reduced characteristic polynomial of z,
+// 		t² - Trace(z)t + Quad(z)
+// whose coefficients are the trace z + Conj(z) and the norm z Conj(z). Unlike
+// MinPoly, it has degree two even when z is real.
+func (z *Cockle) CharPoly() Laurent {
+	tr := z.Trace()
+	return Laurent{
+		0: z.Quad(),
+		1: tr.Neg(tr),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// CharPoly returns the reduced characteristic polynomial of z. Over Complex,
+// z is a zero of
+// 		t² - BiTrace(z)t + z Conj(z)
+// and CharPoly returns the product of this quadratic with its Complex
+// conjugate, a quartic with rational coefficients whose constant term is
+// Norm(z).
+func (z *DualComplex) CharPoly() Laurent {
+	n := new(DualComplex).Mul(z, new(DualComplex).Conj(z))
+	return laurentOf(normPoly(quadraticCharPoly(z.BiTrace(), &n.l)))
+}
+
+// CharPoly returns the reduced characteristic polynomial of z. Over Perplex,
+// z is a zero of
+// 		t² - BiTrace(z)t + z Conj(z)
+// and CharPoly returns the product of this quadratic with its Perplex
+// conjugate, a quartic with rational coefficients whose constant term is
+// Norm(z).
+func (z *DualPerplex) CharPoly() Laurent {
+	n := new(DualPerplex).Mul(z, new(DualPerplex).Conj(z))
+	return laurentOf(normPoly(quadraticCharPoly(z.BiTrace(), &n.l)))
+}
+
+// CharPoly returns the reduced characteristic polynomial of z,
+// 		t² - Trace(z)t + Quad(z)
+// whose coefficients are the trace z + Conj(z) and the norm z Conj(z). Unlike
+// MinPoly, it has degree two even when z is real.
+func (z *Hamilton) CharPoly() Laurent {
+	tr := z.Trace()
+	return Laurent{
+		0: z.Quad(),
+		1: tr.Neg(tr),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// CharPoly returns the reduced characteristic polynomial of z. Over Infra,
+// z is a zero of
+// 		t² - BiTrace(z)t + z Conj(z)
+// and CharPoly returns the product of this quadratic with its Infra
+// conjugate, a quartic with rational coefficients whose constant term is
+// Norm(z).
+func (z *Hyper) CharPoly() Laurent {
+	n := new(Hyper).Mul(z, new(Hyper).Conj(z))
+	return laurentOf(normPoly(quadraticCharPoly(z.BiTrace(), &n.l)))
+}
+
+// CharPoly returns the reduced characteristic polynomial of z,
+// 		t² - Trace(z)t + Quad(z)
+// whose coefficients are the trace z + Conj(z) and the norm z Conj(z). Unlike
+// MinPoly, it has degree two even when z is real.
+func (z *InfraComplex) CharPoly() Laurent {
+	tr := z.Trace()
+	return Laurent{
+		0: z.Quad(),
+		1: tr.Neg(tr),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// CharPoly returns the reduced characteristic polynomial of z,
+// 		t² - Trace(z)t + Quad(z)
+// whose coefficients are the trace z + Conj(z) and the norm z Conj(z). Unlike
+// MinPoly, it has degree two even when z is real.
+func (z *InfraPerplex) CharPoly() Laurent {
+	tr := z.Trace()
+	return Laurent{
+		0: z.Quad(),
+		1: tr.Neg(tr),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// CharPoly returns the reduced characteristic polynomial of z,
+// 		t² - Trace(z)t + Quad(z)
+// whose coefficients are the trace z + Conj(z) and the norm z Conj(z). Unlike
+// MinPoly, it has degree two even when z is real.
+func (z *Supra) CharPoly() Laurent {
+	tr := z.Trace()
+	return Laurent{
+		0: z.Quad(),
+		1: tr.Neg(tr),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// CharPoly returns the reduced characteristic polynomial of z. Over the
+// Complex numbers spanned by 1 and H, z is a zero of
+// 		t² - BiTrace(z)t + z Conj(z)
+// and CharPoly returns the product of this quadratic with its Complex
+// conjugate, a quartic with rational coefficients whose constant term is
+// Norm(z).
+func (z *BiCockle) CharPoly() Laurent {
+	return laurentOf(normPoly(quadraticCharPoly(z.BiTrace(), z.quad())))
+}
+
+// CharPoly returns the reduced characteristic polynomial of z. Over the
+// Complex numbers spanned by 1 and H, z is a zero of
+// 		t² - BiTrace(z)t + z Conj(z)
+// and CharPoly returns the product of this quadratic with its Complex
+// conjugate, a quartic with rational coefficients whose constant term is
+// Norm(z).
+func (z *BiHamilton) CharPoly() Laurent {
+	return laurentOf(normPoly(quadraticCharPoly(z.BiTrace(), z.quad())))
+}
+
+// CharPoly returns the reduced characteristic polynomial of z,
+// 		t² - Trace(z)t + Quad(z)
+// whose coefficients are the trace z + Conj(z) and the norm z Conj(z). Unlike
+// MinPoly, it has degree two even when z is real.
+func (z *Cayley) CharPoly() Laurent {
+	tr := z.Trace()
+	return Laurent{
+		0: z.Quad(),
+		1: tr.Neg(tr),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// CharPoly returns the reduced characteristic polynomial of z,
+// 		t² - Trace(z)t + Quad(z)
+// whose coefficients are the trace z + Conj(z) and the norm z Conj(z). Unlike
+// MinPoly, it has degree two even when z is real.
+func (z *InfraCockle) CharPoly() Laurent {
+	tr := z.Trace()
+	return Laurent{
+		0: z.Quad(),
+		1: tr.Neg(tr),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// CharPoly returns the reduced characteristic polynomial of z,
+// 		t² - Trace(z)t + Quad(z)
+// whose coefficients are the trace z + Conj(z) and the norm z Conj(z). Unlike
+// MinPoly, it has degree two even when z is real.
+func (z *InfraHamilton) CharPoly() Laurent {
+	tr := z.Trace()
+	return Laurent{
+		0: z.Quad(),
+		1: tr.Neg(tr),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// CharPoly returns the reduced characteristic polynomial of z,
+// 		t² - Trace(z)t + Quad(z)
+// whose coefficients are the trace z + Conj(z) and the norm z Conj(z). Unlike
+// MinPoly, it has degree two even when z is real.
+func (z *SupraComplex) CharPoly() Laurent {
+	tr := z.Trace()
+	return Laurent{
+		0: z.Quad(),
+		1: tr.Neg(tr),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// CharPoly returns the reduced characteristic polynomial of z,
+// 		t² - Trace(z)t + Quad(z)
+// whose coefficients are the trace z + Conj(z) and the norm z Conj(z). Unlike
+// MinPoly, it has degree two even when z is real.
+func (z *SupraPerplex) CharPoly() Laurent {
+	tr := z.Trace()
+	return Laurent{
+		0: z.Quad(),
+		1: tr.Neg(tr),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// CharPoly returns the reduced characteristic polynomial of z. Over BiComplex,
+// z is a zero of
+// 		t² - BiTrace(z)t + z Conj(z)
+// and multiplying this quadratic by its conjugates over BiComplex and Complex
+// gives an octic with rational coefficients whose constant term is Norm(z).
+func (z *TriComplex) CharPoly() Laurent {
+	n := new(TriComplex).Mul(z, new(TriComplex).Conj(z))
+	p := normPoly(quadraticCharPoly(z.BiTrace(), &n.l))
+	return laurentOf(normPoly(lowerPoly(p, func(x *BiComplex) *Complex { return &x.l })))
+}
+
+// CharPoly returns the reduced characteristic polynomial of z. Over Hyper,
+// z is a zero of
+// 		t² - BiTrace(z)t + z Conj(z)
+// and multiplying this quadratic by its conjugates over Hyper and Infra
+// gives an octic with rational coefficients whose constant term is Norm(z).
+func (z *TriNilplex) CharPoly() Laurent {
+	n := new(TriNilplex).Mul(z, new(TriNilplex).Conj(z))
+	p := normPoly(quadraticCharPoly(z.BiTrace(), &n.l))
+	return laurentOf(normPoly(lowerPoly(p, func(x *Hyper) *Infra { return &x.l })))
+}
+
+// CharPoly returns the reduced characteristic polynomial of z. Over BiPerplex,
+// z is a zero of
+// 		t² - BiTrace(z)t + z Conj(z)
+// and multiplying this quadratic by its conjugates over BiPerplex and Perplex
+// gives an octic with rational coefficients whose constant term is Norm(z).
+func (z *TriPerplex) CharPoly() Laurent {
+	n := new(TriPerplex).Mul(z, new(TriPerplex).Conj(z))
+	p := normPoly(quadraticCharPoly(z.BiTrace(), &n.l))
+	return laurentOf(normPoly(lowerPoly(p, func(x *BiPerplex) *Perplex { return &x.l })))
+}
+
+// CharPoly returns the reduced characteristic polynomial of z,
+// 		t² - Trace(z)t + Quad(z)
+// whose coefficients are the trace z + Conj(z) and the norm z Conj(z). Unlike
+// MinPoly, it has degree two even when z is real.
+func (z *Ultra) CharPoly() Laurent {
+	tr := z.Trace()
+	return Laurent{
+		0: z.Quad(),
+		1: tr.Neg(tr),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// CharPoly returns the reduced characteristic polynomial of z,
+// 		t² - Trace(z)t + Quad(z)
+// whose coefficients are the trace z + Conj(z) and the norm z Conj(z). Unlike
+// MinPoly, it has degree two even when z is real.
+func (z *Zorn) CharPoly() Laurent {
+	tr := z.Trace()
+	return Laurent{
+		0: z.Quad(),
+		1: tr.Neg(tr),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// Satisfies returns true if z is a zero of the polynomial p, such as the
+// CharPoly of z. If p has terms of negative degree, then Satisfies panics.
+func (z *Complex) Satisfies(p Laurent) bool {
+	return satisfies(z, p)
+}
+
+// Satisfies returns true if z is a zero of the polynomial p, such as the
+// CharPoly of z. If p has terms of negative degree, then Satisfies panics.
+func (z *Infra) Satisfies(p Laurent) bool {
+	return satisfies(z, p)
+}
+
+// Satisfies returns true if z is a zero of the polynomial p, such as the
+// CharPoly of z. If p has terms of negative degree, then Satisfies panics.
+func (z *Perplex) Satisfies(p Laurent) bool {
+	return satisfies(z, p)
+}
+
+// Satisfies returns true if z is a zero of the polynomial p, such as the
+// CharPoly of z. If p has terms of negative degree, then Satisfies panics.
+func (z *BiComplex) Satisfies(p Laurent) bool {
+	return satisfies(z, p)
+}
+
+// Satisfies returns true if z is a zero of the polynomial p, such as the
+// CharPoly of z. If p has terms of negative degree, then Satisfies panics.
+func (z *BiPerplex) Satisfies(p Laurent) bool {
+	return satisfies(z, p)
+}
+
+// Satisfies returns true if z is a zero of the polynomial p, such as the
+// CharPoly of z. If p has terms of negative degree, then Satisfies panics.
+func (z *Cockle) Satisfies(p Laurent) bool {
+	return satisfies(z, p)
+}
+
+// Satisfies returns true if z is a zero of the polynomial p, such as the
+// CharPoly of z. If p has terms of negative degree, then Satisfies panics.
+func (z *DualComplex) Satisfies(p Laurent) bool {
+	return satisfies(z, p)
+}
+
+// Satisfies returns true if z is a zero of the polynomial p, such as the
+// CharPoly of z. If p has terms of negative degree, then Satisfies panics.
+func (z *DualPerplex) Satisfies(p Laurent) bool {
+	return satisfies(z, p)
+}
+
+// Satisfies returns true if z is a zero of the polynomial p, such as the
+// CharPoly of z. If p has terms of negative degree, then Satisfies panics.
+func (z *Hamilton) Satisfies(p Laurent) bool {
+	return satisfies(z, p)
+}
+
+// Satisfies returns true if z is a zero of the polynomial p, such as the
+// CharPoly of z. If p has terms of negative degree, then Satisfies panics.
+func (z *Hyper) Satisfies(p Laurent) bool {
+	return satisfies(z, p)
+}
+
+// Satisfies returns true if z is a zero of the polynomial p, such as the
+// CharPoly of z. If p has terms of negative degree, then Satisfies panics.
+func (z *InfraComplex) Satisfies(p Laurent) bool {
+	return satisfies(z, p)
+}
+
+// Satisfies returns true if z is a zero of the polynomial p, such as the
+// CharPoly of z. If p has terms of negative degree, then Satisfies panics.
+func (z *InfraPerplex) Satisfies(p Laurent) bool {
+	return satisfies(z, p)
+}
+
+// Satisfies returns true if z is a zero of the polynomial p, such as the
+// CharPoly of z. If p has terms of negative degree, then Satisfies panics.
+func (z *Supra) Satisfies(p Laurent) bool {
+	return satisfies(z, p)
+}
+
+// Satisfies returns true if z is a zero of the polynomial p, such as the
+// CharPoly of z. If p has terms of negative degree, then Satisfies panics.
+func (z *BiCockle) Satisfies(p Laurent) bool {
+	return satisfies(z, p)
+}
+
+// Satisfies returns true if z is a zero of the polynomial p, such as the
+// CharPoly of z. If p has terms of negative degree, then Satisfies panics.
+func (z *BiHamilton) Satisfies(p Laurent) bool {
+	return satisfies(z, p)
+}
+
+// Satisfies returns true if z is a zero of the polynomial p, such as the
+// CharPoly of z. If p has terms of negative degree, then Satisfies panics.
+func (z *Cayley) Satisfies(p Laurent) bool {
+	return satisfies(z, p)
+}
+
+// Satisfies returns true if z is a zero of the polynomial p, such as the
+// CharPoly of z. If p has terms of negative degree, then Satisfies panics.
+func (z *InfraCockle) Satisfies(p Laurent) bool {
+	return satisfies(z, p)
+}
+
+// Satisfies returns true if z is a zero of the polynomial p, such as the
+// CharPoly of z. If p has terms of negative degree, then Satisfies panics.
+func (z *InfraHamilton) Satisfies(p Laurent) bool {
+	return satisfies(z, p)
+}
+
+// Satisfies returns true if z is a zero of the polynomial p, such as the
+// CharPoly of z. If p has terms of negative degree, then Satisfies panics.
+func (z *SupraComplex) Satisfies(p Laurent) bool {
+	return satisfies(z, p)
+}
+
+// Satisfies returns true if z is a zero of the polynomial p, such as the
+// CharPoly of z. If p has terms of negative degree, then Satisfies panics.
+func (z *SupraPerplex) Satisfies(p Laurent) bool {
+	return satisfies(z, p)
+}
+
+// Satisfies returns true if z is a zero of the polynomial p, such as the
+// CharPoly of z. If p has terms of negative degree, then Satisfies panics.
+func (z *TriComplex) Satisfies(p Laurent) bool {
+	return satisfies(z, p)
+}
+
+// Satisfies returns true if z is a zero of the polynomial p, such as the
+// CharPoly of z. If p has terms of negative degree, then Satisfies panics.
+func (z *TriNilplex) Satisfies(p Laurent) bool {
+	return satisfies(z, p)
+}
+
+// Satisfies returns true if z is a zero of the polynomial p, such as the
+// CharPoly of z. If p has terms of negative degree, then Satisfies panics.
+func (z *TriPerplex) Satisfies(p Laurent) bool {
+	return satisfies(z, p)
+}
+
+// Satisfies returns true if z is a zero of the polynomial p, such as the
+// CharPoly of z. If p has terms of negative degree, then Satisfies panics.
+func (z *Ultra) Satisfies(p Laurent) bool {
+	return satisfies(z, p)
+}
+
+// Satisfies returns true if z is a zero of the polynomial p, such as the
+// CharPoly of z. If p has terms of negative degree, then Satisfies panics.
+func (z *Zorn) Satisfies(p Laurent) bool {
+	return satisfies(z, p)
+}
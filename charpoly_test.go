@@ -0,0 +1,174 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestCharPoly(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i, f := range []func() bool{
+		func() bool {
+			x := new(Complex).Generate(r, 0).Interface().(*Complex)
+			p := x.CharPoly()
+			return x.Satisfies(p) && p[0].Cmp(x.Quad()) == 0
+		},
+		func() bool {
+			x := new(Infra).Generate(r, 0).Interface().(*Infra)
+			p := x.CharPoly()
+			return x.Satisfies(p) && p[0].Cmp(x.Quad()) == 0
+		},
+		func() bool {
+			x := new(Perplex).Generate(r, 0).Interface().(*Perplex)
+			p := x.CharPoly()
+			return x.Satisfies(p) && p[0].Cmp(x.Quad()) == 0
+		},
+		func() bool {
+			x := new(BiComplex).Generate(r, 0).Interface().(*BiComplex)
+			p := x.CharPoly()
+			return x.Satisfies(p) && p[0].Cmp(x.Norm()) == 0
+		},
+		func() bool {
+			x := new(BiPerplex).Generate(r, 0).Interface().(*BiPerplex)
+			p := x.CharPoly()
+			return x.Satisfies(p) && p[0].Cmp(x.Norm()) == 0
+		},
+		func() bool {
+			x := new(Cockle).Generate(r, 0).Interface().(*Cockle)
+			p := x.CharPoly()
+			return x.Satisfies(p) && p[0].Cmp(x.Quad()) == 0
+		},
+		func() bool {
+			x := new(DualComplex).Generate(r, 0).Interface().(*DualComplex)
+			p := x.CharPoly()
+			return x.Satisfies(p) && p[0].Cmp(x.Norm()) == 0
+		},
+		func() bool {
+			x := new(DualPerplex).Generate(r, 0).Interface().(*DualPerplex)
+			p := x.CharPoly()
+			return x.Satisfies(p) && p[0].Cmp(x.Norm()) == 0
+		},
+		func() bool {
+			x := new(Hamilton).Generate(r, 0).Interface().(*Hamilton)
+			p := x.CharPoly()
+			return x.Satisfies(p) && p[0].Cmp(x.Quad()) == 0
+		},
+		func() bool {
+			x := new(Hyper).Generate(r, 0).Interface().(*Hyper)
+			p := x.CharPoly()
+			return x.Satisfies(p) && p[0].Cmp(x.Norm()) == 0
+		},
+		func() bool {
+			x := new(InfraComplex).Generate(r, 0).Interface().(*InfraComplex)
+			p := x.CharPoly()
+			return x.Satisfies(p) && p[0].Cmp(x.Quad()) == 0
+		},
+		func() bool {
+			x := new(InfraPerplex).Generate(r, 0).Interface().(*InfraPerplex)
+			p := x.CharPoly()
+			return x.Satisfies(p) && p[0].Cmp(x.Quad()) == 0
+		},
+		func() bool {
+			x := new(Supra).Generate(r, 0).Interface().(*Supra)
+			p := x.CharPoly()
+			return x.Satisfies(p) && p[0].Cmp(x.Quad()) == 0
+		},
+		func() bool {
+			x := new(BiCockle).Generate(r, 0).Interface().(*BiCockle)
+			p := x.CharPoly()
+			return x.Satisfies(p) && p[0].Cmp(x.Norm()) == 0
+		},
+		func() bool {
+			x := new(BiHamilton).Generate(r, 0).Interface().(*BiHamilton)
+			p := x.CharPoly()
+			return x.Satisfies(p) && p[0].Cmp(x.Norm()) == 0
+		},
+		func() bool {
+			x := new(Cayley).Generate(r, 0).Interface().(*Cayley)
+			p := x.CharPoly()
+			return x.Satisfies(p) && p[0].Cmp(x.Quad()) == 0
+		},
+		func() bool {
+			x := new(InfraCockle).Generate(r, 0).Interface().(*InfraCockle)
+			p := x.CharPoly()
+			return x.Satisfies(p) && p[0].Cmp(x.Quad()) == 0
+		},
+		func() bool {
+			x := new(InfraHamilton).Generate(r, 0).Interface().(*InfraHamilton)
+			p := x.CharPoly()
+			return x.Satisfies(p) && p[0].Cmp(x.Quad()) == 0
+		},
+		func() bool {
+			x := new(SupraComplex).Generate(r, 0).Interface().(*SupraComplex)
+			p := x.CharPoly()
+			return x.Satisfies(p) && p[0].Cmp(x.Quad()) == 0
+		},
+		func() bool {
+			x := new(SupraPerplex).Generate(r, 0).Interface().(*SupraPerplex)
+			p := x.CharPoly()
+			return x.Satisfies(p) && p[0].Cmp(x.Quad()) == 0
+		},
+		func() bool {
+			x := new(TriComplex).Generate(r, 0).Interface().(*TriComplex)
+			p := x.CharPoly()
+			return x.Satisfies(p) && p[0].Cmp(x.Norm()) == 0
+		},
+		func() bool {
+			x := new(TriNilplex).Generate(r, 0).Interface().(*TriNilplex)
+			p := x.CharPoly()
+			return x.Satisfies(p) && p[0].Cmp(x.Norm()) == 0
+		},
+		func() bool {
+			x := new(TriPerplex).Generate(r, 0).Interface().(*TriPerplex)
+			p := x.CharPoly()
+			return x.Satisfies(p) && p[0].Cmp(x.Norm()) == 0
+		},
+		func() bool {
+			x := new(Ultra).Generate(r, 0).Interface().(*Ultra)
+			p := x.CharPoly()
+			return x.Satisfies(p) && p[0].Cmp(x.Quad()) == 0
+		},
+		func() bool {
+			x := new(Zorn).Generate(r, 0).Interface().(*Zorn)
+			p := x.CharPoly()
+			return x.Satisfies(p) && p[0].Cmp(x.Quad()) == 0
+		},
+	} {
+		if !f() {
+			t.Errorf("case %d: value is not a zero of its CharPoly", i)
+		}
+	}
+}
+
+func TestCharPolyDegree(t *testing.T) {
+	one := big.NewRat(1, 1)
+	for _, test := range []struct {
+		p    Laurent
+		want int
+	}{
+		{new(Complex).CharPoly(), 2},
+		{new(Cayley).CharPoly(), 2},
+		{new(BiComplex).CharPoly(), 4},
+		{new(BiHamilton).CharPoly(), 4},
+		{new(TriPerplex).CharPoly(), 8},
+	} {
+		if len(test.p) != test.want+1 || test.p[int64(test.want)].Cmp(one) != 0 {
+			t.Errorf("CharPoly = %v, want monic of degree %d", test.p, test.want)
+		}
+	}
+}
+
+func TestSatisfies(t *testing.T) {
+	i := NewComplex(big.NewRat(0, 1), big.NewRat(1, 1))
+	// t² + 1
+	if !i.Satisfies(Laurent{0: big.NewRat(1, 1), 2: big.NewRat(1, 1)}) {
+		t.Error("i does not satisfy t² + 1")
+	}
+	if i.Satisfies(Laurent{0: big.NewRat(-1, 1), 2: big.NewRat(1, 1)}) {
+		t.Error("i satisfies t² - 1")
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"slices"
+)
+
+// characters returns the algebra homomorphisms from impl to the Gaussian
+// rationals, each given by its values on the basis units. Every basis unit
+// must square to -1, 0, or 1 times 1, so that it is sent to ±i, 0, or ±1;
+// otherwise characters returns false.
+func characters(impl *Impl) ([][]*Complex, bool) {
+	n := impl.Dim
+	prod := products(impl)
+	one, i := big.NewRat(1, 1), big.NewRat(1, 1)
+	cands := make([][]*Complex, n)
+	cands[0] = []*Complex{NewComplex(one, new(big.Rat))}
+	for k := 1; k < n; k++ {
+		sq := prod[k][k]
+		for _, c := range sq[1:] {
+			if c.Sign() != 0 {
+				return nil, false
+			}
+		}
+		switch {
+		case sq[0].Cmp(big.NewRat(-1, 1)) == 0:
+			cands[k] = []*Complex{NewComplex(new(big.Rat), i), NewComplex(new(big.Rat), new(big.Rat).Neg(i))}
+		case sq[0].Sign() == 0:
+			cands[k] = []*Complex{new(Complex)}
+		case sq[0].Cmp(one) == 0:
+			cands[k] = []*Complex{NewComplex(one, new(big.Rat)), NewComplex(new(big.Rat).Neg(one), new(big.Rat))}
+		default:
+			return nil, false
+		}
+	}
+	// holds checks χ(e_a)χ(e_b) = χ(e_a e_b) for a, b ≤ k whose product lies in
+	// the span of the units up to k
+	holds := func(chi []*Complex, k int) bool {
+		for a := 0; a <= k; a++ {
+			for b := 0; b <= k; b++ {
+				want, ok := new(Complex), true
+				for l, c := range prod[a][b] {
+					if c.Sign() == 0 {
+						continue
+					}
+					if l > k {
+						ok = false
+						break
+					}
+					want.Add(want, new(Complex).Scal(chi[l], c))
+				}
+				if ok && !new(Complex).Mul(chi[a], chi[b]).Equals(want) {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	var chars [][]*Complex
+	chi := make([]*Complex, n)
+	var search func(k int)
+	search = func(k int) {
+		if k == n {
+			chars = append(chars, slices.Clone(chi))
+			return
+		}
+		for _, c := range cands[k] {
+			chi[k] = c
+			if holds(chi, k) {
+				search(k + 1)
+			}
+		}
+	}
+	search(0)
+	return chars, len(chars) > 0
+}
+
+// charRoots returns the eigenvalues, with multiplicity, of left
+// multiplication by the value with components v of the commutative type with
+// the given name.
+func charRoots(name string, v []*big.Rat) ([]*Complex, error) {
+	var impl *Impl
+	for _, a := range algebras {
+		if i := a.impl(); i.Name == name {
+			impl = i
+		}
+	}
+	fail := &EigenError{Op: name + ".CharRoots", Msg: "eigenvalues are not Gaussian rationals"}
+	chars, ok := characters(impl)
+	if !ok || impl.Dim%len(chars) != 0 {
+		return nil, fail
+	}
+	// the generalized eigenspaces have equal dimensions, which the traces of
+	// the basis units confirm
+	m := impl.Dim / len(chars)
+	for k := 0; k < impl.Dim; k++ {
+		sum := new(Complex)
+		for _, chi := range chars {
+			sum.Add(sum, chi[k])
+		}
+		tr := new(big.Rat).SetInt64(int64(m))
+		if !sum.Scal(sum, tr).Equals(NewComplex(leftMat(impl, unit(impl.Dim, k)).Trace(), new(big.Rat))) {
+			return nil, fail
+		}
+	}
+	var roots []*Complex
+	for _, chi := range chars {
+		lambda := new(Complex)
+		for k, c := range v {
+			lambda.Add(lambda, new(Complex).Scal(chi[k], c))
+		}
+		for range m {
+			roots = append(roots, new(Complex).Set(lambda))
+		}
+	}
+	slices.SortFunc(roots, (*Complex).Cmp)
+	return roots, nil
+}
+
+// CharRoots returns the eigenvalues of left multiplication by z, with
+// multiplicity and in increasing order. Since Complex is commutative, they are
+// the values of z under its homomorphisms to the Gaussian rationals, each
+// repeated over a generalized eigenspace. If the eigenvalues are not Gaussian
+// rationals, then CharRoots returns an *EigenError.
+func (z *Complex) CharRoots() ([]*Complex, error) {
+	return charRoots("Complex", z.Components())
+}
+
+// CharRoots returns the eigenvalues of left multiplication by z, with
+// multiplicity and in increasing order. Since Infra is commutative, they are
+// the values of z under its homomorphisms to the Gaussian rationals, each
+// repeated over a generalized eigenspace. If the eigenvalues are not Gaussian
+// rationals, then CharRoots returns an *EigenError.
+func (z *Infra) CharRoots() ([]*Complex, error) {
+	return charRoots("Infra", z.Components())
+}
+
+// CharRoots returns the eigenvalues of left multiplication by z, with
+// multiplicity and in increasing order. Since Perplex is commutative, they are
+// the values of z under its homomorphisms to the Gaussian rationals, each
+// repeated over a generalized eigenspace. If the eigenvalues are not Gaussian
+// rationals, then CharRoots returns an *EigenError.
+func (z *Perplex) CharRoots() ([]*Complex, error) {
+	return charRoots("Perplex", z.Components())
+}
+
+// CharRoots returns the eigenvalues of left multiplication by z, with
+// multiplicity and in increasing order. Since BiComplex is commutative, they
+// are the values of z under its homomorphisms to the Gaussian rationals, each
+// repeated over a generalized eigenspace. If the eigenvalues are not Gaussian
+// rationals, then CharRoots returns an *EigenError.
+func (z *BiComplex) CharRoots() ([]*Complex, error) {
+	return charRoots("BiComplex", z.Components())
+}
+
+// CharRoots returns the eigenvalues of left multiplication by z, with
+// multiplicity and in increasing order. Since BiPerplex is commutative, they
+// are the values of z under its homomorphisms to the Gaussian rationals, each
+// repeated over a generalized eigenspace. If the eigenvalues are not Gaussian
+// rationals, then CharRoots returns an *EigenError.
+func (z *BiPerplex) CharRoots() ([]*Complex, error) {
+	return charRoots("BiPerplex", z.Components())
+}
+
+// CharRoots returns the eigenvalues of left multiplication by z, with
+// multiplicity and in increasing order. Since DualComplex is commutative, they
+// are the values of z under its homomorphisms to the Gaussian rationals, each
+// repeated over a generalized eigenspace. If the eigenvalues are not Gaussian
+// rationals, then CharRoots returns an *EigenError.
+func (z *DualComplex) CharRoots() ([]*Complex, error) {
+	return charRoots("DualComplex", z.Components())
+}
+
+// CharRoots returns the eigenvalues of left multiplication by z, with
+// multiplicity and in increasing order. Since DualPerplex is commutative, they
+// are the values of z under its homomorphisms to the Gaussian rationals, each
+// repeated over a generalized eigenspace. If the eigenvalues are not Gaussian
+// rationals, then CharRoots returns an *EigenError.
+func (z *DualPerplex) CharRoots() ([]*Complex, error) {
+	return charRoots("DualPerplex", z.Components())
+}
+
+// CharRoots returns the eigenvalues of left multiplication by z, with
+// multiplicity and in increasing order. Since Hyper is commutative, they are
+// the values of z under its homomorphisms to the Gaussian rationals, each
+// repeated over a generalized eigenspace. If the eigenvalues are not Gaussian
+// rationals, then CharRoots returns an *EigenError.
+func (z *Hyper) CharRoots() ([]*Complex, error) {
+	return charRoots("Hyper", z.Components())
+}
+
+// CharRoots returns the eigenvalues of left multiplication by z, with
+// multiplicity and in increasing order. Since TriComplex is commutative, they
+// are the values of z under its homomorphisms to the Gaussian rationals, each
+// repeated over a generalized eigenspace. If the eigenvalues are not Gaussian
+// rationals, then CharRoots returns an *EigenError.
+func (z *TriComplex) CharRoots() ([]*Complex, error) {
+	return charRoots("TriComplex", z.Components())
+}
+
+// CharRoots returns the eigenvalues of left multiplication by z, with
+// multiplicity and in increasing order. Since TriNilplex is commutative, they
+// are the values of z under its homomorphisms to the Gaussian rationals, each
+// repeated over a generalized eigenspace. If the eigenvalues are not Gaussian
+// rationals, then CharRoots returns an *EigenError.
+func (z *TriNilplex) CharRoots() ([]*Complex, error) {
+	return charRoots("TriNilplex", z.Components())
+}
+
+// CharRoots returns the eigenvalues of left multiplication by z, with
+// multiplicity and in increasing order. Since TriPerplex is commutative, they
+// are the values of z under its homomorphisms to the Gaussian rationals, each
+// repeated over a generalized eigenspace. If the eigenvalues are not Gaussian
+// rationals, then CharRoots returns an *EigenError.
+func (z *TriPerplex) CharRoots() ([]*Complex, error) {
+	return charRoots("TriPerplex", z.Components())
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// rootsMatch returns true if roots have the sum and product of the
+// eigenvalues of left multiplication by v in the type with the given name,
+// and if each real root makes it singular.
+func rootsMatch(name string, v []*big.Rat, roots []*Complex) bool {
+	var impl *Impl
+	for _, a := range algebras {
+		if i := a.impl(); i.Name == name {
+			impl = i
+		}
+	}
+	l := leftMat(impl, v)
+	if len(roots) != impl.Dim {
+		return false
+	}
+	sum, prod := new(Complex), NewComplex(big.NewRat(1, 1), new(big.Rat))
+	for _, r := range roots {
+		sum.Add(sum, r)
+		prod.Mul(prod, r)
+		if r.r.Sign() == 0 {
+			s := new(RatMatrix).Sub(l, new(RatMatrix).Scal(IdentityRatMatrix(impl.Dim), &r.l))
+			if s.Det().Sign() != 0 {
+				return false
+			}
+		}
+	}
+	return sum.Equals(NewComplex(l.Trace(), new(big.Rat))) && prod.Equals(NewComplex(l.Det(), new(big.Rat)))
+}
+
+func TestCharRoots(t *testing.T) {
+	f := func(a *Complex, b *Perplex, c *BiComplex, d *DualComplex, e *Hyper, g *TriComplex, h *TriPerplex, k *TriNilplex) bool {
+		// t.Logf("a = %v, b = %v, c = %v, d = %v, e = %v, g = %v, h = %v, k = %v", a, b, c, d, e, g, h, k)
+		for _, x := range []interface {
+			Components() []*big.Rat
+			String() string
+			CharRoots() ([]*Complex, error)
+		}{a, b, c, d, e, g, h, k} {
+			roots, err := x.CharRoots()
+			if err != nil || !rootsMatch(typeName(x), x.Components(), roots) {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 10}); err != nil {
+		t.Error(err)
+	}
+	// a+bi has the eigenvalues a-bi and a+bi, and a+bs has a-b and a+b
+	x := NewComplex(big.NewRat(1, 1), big.NewRat(2, 1))
+	if roots, _ := x.CharRoots(); len(roots) != 2 || !roots[0].Equals(new(Complex).Conj(x)) || !roots[1].Equals(x) {
+		t.Errorf("CharRoots(%v) = %v", x, roots)
+	}
+	y := NewDualPerplex(big.NewRat(1, 1), big.NewRat(2, 1), big.NewRat(3, 1), big.NewRat(4, 1))
+	if roots, _ := y.CharRoots(); len(roots) != 4 || roots[0].l.Cmp(big.NewRat(-1, 1)) != 0 ||
+		!roots[0].Equals(roots[1]) || roots[3].l.Cmp(big.NewRat(3, 1)) != 0 {
+		t.Errorf("CharRoots(%v) = %v", y, roots)
+	}
+	// Hamilton is not commutative, and has no homomorphisms to the Gaussian
+	// rationals
+	if _, err := charRoots("Hamilton", new(Hamilton).Components()); err == nil {
+		t.Error("charRoots(Hamilton) did not fail")
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "fmt"
+
+// A Classification describes the quadratic form and the algebraic properties
+// of a type, as machine-readable metadata.
+type Classification struct {
+	Name string `json:"name"`
+	Dim  int    `json:"dim"`
+	// Elliptic, Parabolic, and Hyperbolic count the basis units other than 1
+	// that square to -1, 0, and 1.
+	Elliptic   int `json:"elliptic"`
+	Parabolic  int `json:"parabolic"`
+	Hyperbolic int `json:"hyperbolic"`
+
+	Commutative bool `json:"commutative"`
+	Associative bool `json:"associative"`
+	Alternative bool `json:"alternative"`
+	// ZeroDivisors is true if there are non-zero values x and y with xy = 0.
+	ZeroDivisors bool `json:"zeroDivisors"`
+}
+
+// String returns a one-line summary of c, such as
+// "Hamilton: 3 elliptic, 0 parabolic, 0 hyperbolic; associative".
+func (c *Classification) String() string {
+	s := fmt.Sprintf("%s: %d elliptic, %d parabolic, %d hyperbolic", c.Name,
+		c.Elliptic, c.Parabolic, c.Hyperbolic)
+	sep := "; "
+	for _, f := range []struct {
+		ok   bool
+		name string
+	}{
+		{c.Commutative, "commutative"},
+		{c.Associative, "associative"},
+		{c.Alternative && !c.Associative, "alternative"},
+		{c.ZeroDivisors, "zero divisors"},
+	} {
+		if f.ok {
+			s += sep + f.name
+			sep = ", "
+		}
+	}
+	return s
+}
+
+// classify returns the classification of the type with the given name. A
+// basis unit e that squares to 0 is a zero divisor, and so is 1-e if e
+// squares to 1; the types whose units all square to -1 are the division
+// algebras Complex, Hamilton, and Cayley.
+func classify(name string) *Classification {
+	for _, a := range algebras {
+		impl := a.impl()
+		if impl.Name != name {
+			continue
+		}
+		r := CheckStructureConstants(impl, a.basis)
+		c := &Classification{
+			Name:        name,
+			Dim:         impl.Dim,
+			Commutative: r.Commutative,
+			Associative: r.Associative,
+			Alternative: r.Alternative,
+		}
+		for i := 1; i < impl.Dim; i++ {
+			e := unit(impl.Dim, i)
+			switch impl.Ops["Mul"](e, e)[0].Sign() {
+			case -1:
+				c.Elliptic++
+			case 0:
+				c.Parabolic++
+			case 1:
+				c.Hyperbolic++
+			}
+		}
+		c.ZeroDivisors = c.Parabolic+c.Hyperbolic > 0
+		return c
+	}
+	panic("unknown type " + name)
+}
+
+// Classify returns the signature of the quadratic form of Complex and its
+// algebraic properties. The result does not depend on z.
+func (z *Complex) Classify() *Classification {
+	return classify("Complex")
+}
+
+// Classify returns the signature of the quadratic form of Infra and its
+// algebraic properties. The result does not depend on z.
+func (z *Infra) Classify() *Classification {
+	return classify("Infra")
+}
+
+// Classify returns the signature of the quadratic form of Perplex and its
+// algebraic properties. The result does not depend on z.
+func (z *Perplex) Classify() *Classification {
+	return classify("Perplex")
+}
+
+// Classify returns the signature of the quadratic form of BiComplex and its
+// algebraic properties. The result does not depend on z.
+func (z *BiComplex) Classify() *Classification {
+	return classify("BiComplex")
+}
+
+// Classify returns the signature of the quadratic form of BiPerplex and its
+// algebraic properties. The result does not depend on z.
+func (z *BiPerplex) Classify() *Classification {
+	return classify("BiPerplex")
+}
+
+// Classify returns the signature of the quadratic form of Cockle and its
+// algebraic properties. The result does not depend on z.
+func (z *Cockle) Classify() *Classification {
+	return classify("Cockle")
+}
+
+// Classify returns the signature of the quadratic form of DualComplex and its
+// algebraic properties. The result does not depend on z.
+func (z *DualComplex) Classify() *Classification {
+	return classify("DualComplex")
+}
+
+// Classify returns the signature of the quadratic form of DualPerplex and its
+// algebraic properties. The result does not depend on z.
+func (z *DualPerplex) Classify() *Classification {
+	return classify("DualPerplex")
+}
+
+// Classify returns the signature of the quadratic form of Hamilton and its
+// algebraic properties. The result does not depend on z.
+func (z *Hamilton) Classify() *Classification {
+	return classify("Hamilton")
+}
+
+// Classify returns the signature of the quadratic form of Hyper and its
+// algebraic properties. The result does not depend on z.
+func (z *Hyper) Classify() *Classification {
+	return classify("Hyper")
+}
+
+// Classify returns the signature of the quadratic form of InfraComplex and its
+// algebraic properties. The result does not depend on z.
+func (z *InfraComplex) Classify() *Classification {
+	return classify("InfraComplex")
+}
+
+// Classify returns the signature of the quadratic form of InfraPerplex and its
+// algebraic properties. The result does not depend on z.
+func (z *InfraPerplex) Classify() *Classification {
+	return classify("InfraPerplex")
+}
+
+// Classify returns the signature of the quadratic form of Supra and its
+// algebraic properties. The result does not depend on z.
+func (z *Supra) Classify() *Classification {
+	return classify("Supra")
+}
+
+// Classify returns the signature of the quadratic form of BiCockle and its
+// algebraic properties. The result does not depend on z.
+func (z *BiCockle) Classify() *Classification {
+	return classify("BiCockle")
+}
+
+// Classify returns the signature of the quadratic form of BiHamilton and its
+// algebraic properties. The result does not depend on z.
+func (z *BiHamilton) Classify() *Classification {
+	return classify("BiHamilton")
+}
+
+// Classify returns the signature of the quadratic form of Cayley and its
+// algebraic properties. The result does not depend on z.
+func (z *Cayley) Classify() *Classification {
+	return classify("Cayley")
+}
+
+// Classify returns the signature of the quadratic form of DualHamilton and its
+// algebraic properties. The result does not depend on z.
+func (z *DualHamilton) Classify() *Classification {
+	return classify("DualHamilton")
+}
+
+// Classify returns the signature of the quadratic form of InfraCockle and its
+// algebraic properties. The result does not depend on z.
+func (z *InfraCockle) Classify() *Classification {
+	return classify("InfraCockle")
+}
+
+// Classify returns the signature of the quadratic form of InfraHamilton and its
+// algebraic properties. The result does not depend on z.
+func (z *InfraHamilton) Classify() *Classification {
+	return classify("InfraHamilton")
+}
+
+// Classify returns the signature of the quadratic form of SupraComplex and its
+// algebraic properties. The result does not depend on z.
+func (z *SupraComplex) Classify() *Classification {
+	return classify("SupraComplex")
+}
+
+// Classify returns the signature of the quadratic form of SupraPerplex and its
+// algebraic properties. The result does not depend on z.
+func (z *SupraPerplex) Classify() *Classification {
+	return classify("SupraPerplex")
+}
+
+// Classify returns the signature of the quadratic form of TriComplex and its
+// algebraic properties. The result does not depend on z.
+func (z *TriComplex) Classify() *Classification {
+	return classify("TriComplex")
+}
+
+// Classify returns the signature of the quadratic form of TriNilplex and its
+// algebraic properties. The result does not depend on z.
+func (z *TriNilplex) Classify() *Classification {
+	return classify("TriNilplex")
+}
+
+// Classify returns the signature of the quadratic form of TriPerplex and its
+// algebraic properties. The result does not depend on z.
+func (z *TriPerplex) Classify() *Classification {
+	return classify("TriPerplex")
+}
+
+// Classify returns the signature of the quadratic form of Ultra and its
+// algebraic properties. The result does not depend on z.
+func (z *Ultra) Classify() *Classification {
+	return classify("Ultra")
+}
+
+// Classify returns the signature of the quadratic form of Zorn and its
+// algebraic properties. The result does not depend on z.
+func (z *Zorn) Classify() *Classification {
+	return classify("Zorn")
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "testing"
+
+func TestClassify(t *testing.T) {
+	for _, test := range []struct {
+		c    *Classification
+		want string
+	}{
+		{new(Complex).Classify(), "Complex: 1 elliptic, 0 parabolic, 0 hyperbolic; commutative, associative"},
+		{new(Infra).Classify(), "Infra: 0 elliptic, 1 parabolic, 0 hyperbolic; commutative, associative, zero divisors"},
+		{new(Hamilton).Classify(), "Hamilton: 3 elliptic, 0 parabolic, 0 hyperbolic; associative"},
+		{new(Cockle).Classify(), "Cockle: 1 elliptic, 0 parabolic, 2 hyperbolic; associative, zero divisors"},
+		{new(Cayley).Classify(), "Cayley: 7 elliptic, 0 parabolic, 0 hyperbolic; alternative"},
+		{new(Zorn).Classify(), "Zorn: 3 elliptic, 0 parabolic, 4 hyperbolic; alternative, zero divisors"},
+	} {
+		if got := test.c.String(); got != test.want {
+			t.Errorf("Classify() = %s, want %s", got, test.want)
+		}
+	}
+	for _, s := range Schemas() {
+		c := classify(s.Name)
+		if c.Elliptic+c.Parabolic+c.Hyperbolic != c.Dim-1 {
+			t.Errorf("%v does not count every unit", c)
+		}
+		if c.Commutative != s.Commutative || c.Associative != s.Associative {
+			t.Errorf("%v disagrees with its schema", c)
+		}
+	}
+}
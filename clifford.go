@@ -0,0 +1,392 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+)
+
+// A CliffordError reports a signature with no construct of this package.
+type CliffordError struct {
+	P, Q, R int
+}
+
+func (e *CliffordError) Error() string {
+	return fmt.Sprintf("rational: Cl(%d,%d,%d) is not a construct of this package", e.P, e.Q, e.R)
+}
+
+// cliffords lists the signatures of the Clifford algebras that are
+// constructs of this package, with the basis symbols of the generators.
+var cliffords = []struct {
+	p, q, r int
+	name    string
+	gens    []string
+}{
+	{0, 0, 0, "big.Rat", nil},
+	{0, 1, 0, "Complex", []string{"i"}},
+	{1, 0, 0, "Perplex", []string{"s"}},
+	{0, 0, 1, "Infra", []string{"α"}},
+	{0, 2, 0, "Hamilton", []string{"i", "j"}},
+	{1, 1, 0, "Cockle", []string{"t", "i"}},
+	{2, 0, 0, "Cockle", []string{"t", "u"}},
+	{0, 1, 1, "InfraComplex", []string{"i", "β"}},
+	{1, 0, 1, "InfraPerplex", []string{"s", "τ"}},
+	{0, 0, 2, "Supra", []string{"α", "β"}},
+	{3, 0, 0, "BiHamilton", []string{"iH", "jH", "kH"}},
+	{1, 2, 0, "BiHamilton", []string{"kH", "i", "j"}},
+	{0, 2, 1, "DualHamilton", []string{"i", "j", "kΓ"}},
+}
+
+// ratImpl returns the trivial implementation of the rationals, Cl(0,0,0).
+func ratImpl() *Impl {
+	return &Impl{"big.Rat", 1, map[string]Op{
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return []*big.Rat{new(big.Rat).Mul(x[0], y[0])}
+		},
+	}}
+}
+
+// A Clifford is the Clifford algebra Cl(p, q, r), generated by p units that
+// square to 1, q units that square to -1, and r units that square to 0, all
+// anticommuting. Its values are Multivectors, whose geometric product is the
+// product of an isomorphic construct of this package, such as Hamilton for
+// Cl(0,2). Each Multivector is written in the blades, the ordered products of
+// the generators, indexed by the bit masks of their generators: blade 5 is
+// e₁e₃.
+type Clifford struct {
+	P, Q, R int
+	impl    *Impl
+	// the blade with mask A is sign[A] times the basis unit index[A]
+	index []int
+	sign  []int
+}
+
+// NewClifford returns the Clifford algebra Cl(p, q, r), if it is isomorphic
+// to a construct of this package. These are
+// 		Cl(0,0,0)	big.Rat
+// 		Cl(0,1,0)	Complex
+// 		Cl(1,0,0)	Perplex
+// 		Cl(0,0,1)	Infra
+// 		Cl(0,2,0)	Hamilton
+// 		Cl(1,1,0)	Cockle
+// 		Cl(2,0,0)	Cockle
+// 		Cl(0,1,1)	InfraComplex
+// 		Cl(1,0,1)	InfraPerplex
+// 		Cl(0,0,2)	Supra
+// 		Cl(3,0,0)	BiHamilton
+// 		Cl(1,2,0)	BiHamilton
+// 		Cl(0,2,1)	DualHamilton
+// Cl(2,1,0) and Cl(0,3,0) split into two copies of Cockle and Hamilton, and
+// the other degenerate algebras of three generators have no associative
+// construct here; InfraHamilton, for one, is not associative. For them, and
+// for higher dimensions, NewClifford returns a *CliffordError.
+func NewClifford(p, q, r int) (*Clifford, error) {
+	for _, c := range cliffords {
+		if c.p != p || c.q != q || c.r != r {
+			continue
+		}
+		impl, basis := ratImpl(), []string{"1"}
+		for _, a := range algebras {
+			if i := a.impl(); i.Name == c.name {
+				impl, basis = i, a.basis
+			}
+		}
+		return newClifford(p, q, r, impl, basis, c.gens), nil
+	}
+	return nil, &CliffordError{p, q, r}
+}
+
+// newClifford computes the blades of the generators gens in impl. If a blade
+// is not a basis unit up to sign, then newClifford panics.
+func newClifford(p, q, r int, impl *Impl, basis, gens []string) *Clifford {
+	n := len(gens)
+	c := &Clifford{p, q, r, impl, make([]int, 1<<n), make([]int, 1<<n)}
+	blades := make([][]*big.Rat, 1<<n)
+	blades[0] = unit(impl.Dim, 0)
+	for k, g := range gens {
+		i := 0
+		for i < len(basis) && basis[i] != g {
+			i++
+		}
+		e := unit(impl.Dim, i)
+		// the blades below 1<<k do not contain e, so append it on the right
+		for a := 0; a < 1<<k; a++ {
+			blades[a|1<<k] = impl.Ops["Mul"](blades[a], e)
+		}
+	}
+	for a, v := range blades {
+		c.index[a] = -1
+		for i, x := range v {
+			switch {
+			case x.Sign() == 0:
+				continue
+			case c.index[a] >= 0 || x.Num().CmpAbs(big.NewInt(1)) != 0 || !x.IsInt():
+				panic("blade is not a basis unit")
+			}
+			c.index[a], c.sign[a] = i, x.Sign()
+		}
+		if c.index[a] < 0 {
+			panic("blade is zero")
+		}
+	}
+	return c
+}
+
+// Name returns the name of the construct of this package that implements c,
+// such as "Hamilton".
+func (c *Clifford) Name() string {
+	return c.impl.Name
+}
+
+// Gens returns the number of generators of c, which is p + q + r.
+func (c *Clifford) Gens() int {
+	return c.P + c.Q + c.R
+}
+
+// Dim returns the dimension of c, the number of its blades, which is 2 to the
+// power Gens.
+func (c *Clifford) Dim() int {
+	return len(c.index)
+}
+
+// Blade returns a new Multivector equal to the blade with the given mask. The
+// generators are ordered with the p positive ones first and the r null ones
+// last, so that Blade(1<<k) is the generator k. If mask is out of range, then
+// Blade panics.
+func (c *Clifford) Blade(mask int) *Multivector {
+	z := c.zero()
+	z.v[mask].SetInt64(1)
+	return z
+}
+
+// zero returns a new zero Multivector of c.
+func (c *Clifford) zero() *Multivector {
+	z := &Multivector{c, make([]*big.Rat, c.Dim())}
+	for a := range z.v {
+		z.v[a] = new(big.Rat)
+	}
+	return z
+}
+
+// bladeName returns the name of the blade with the given mask, such as "e13",
+// or "1" for the scalar blade.
+func bladeName(mask int) string {
+	if mask == 0 {
+		return "1"
+	}
+	s := "e"
+	for k := 0; mask>>k != 0; k++ {
+		if mask&(1<<k) != 0 {
+			s += fmt.Sprint(k + 1)
+		}
+	}
+	return s
+}
+
+// A Multivector is a value of a Clifford algebra, written as a rational
+// combination of its blades. The zero value is the zero of no algebra, and
+// takes the algebra of the first operand it is set from.
+type Multivector struct {
+	alg *Clifford
+	v   []*big.Rat
+}
+
+// Algebra returns the Clifford algebra of z.
+func (z *Multivector) Algebra() *Clifford {
+	return z.alg
+}
+
+// Coeffs returns the coefficients of the blades of z, indexed by mask. The
+// results alias z.
+func (z *Multivector) Coeffs() []*big.Rat {
+	return z.v
+}
+
+// String returns the string representation of a Multivector value, with the
+// blades ordered by grade, as in "(1+2e1-e23)".
+func (z *Multivector) String() string {
+	var v []*big.Rat
+	var names []string
+	for k := 0; k <= z.alg.Gens(); k++ {
+		for a, x := range z.v {
+			if bits.OnesCount(uint(a)) == k {
+				v = append(v, x)
+				names = append(names, bladeName(a))
+			}
+		}
+	}
+	br := brackets()
+	return br[0] + formatBasis(v, names) + br[1]
+}
+
+// Equals returns true if z and y are equal values of the same algebra.
+func (z *Multivector) Equals(y *Multivector) bool {
+	return z.alg == y.alg && equalRats(z.v, y.v)
+}
+
+// use sets the algebra of z to c, and returns z. If z already has another
+// algebra, then use panics.
+func (z *Multivector) use(c *Clifford) *Multivector {
+	switch {
+	case z.alg == nil:
+		*z = *c.zero()
+	case z.alg != c:
+		panic("different Clifford algebras")
+	}
+	return z
+}
+
+// Set sets z equal to y, and returns z.
+func (z *Multivector) Set(y *Multivector) *Multivector {
+	z.use(y.alg)
+	for a := range z.v {
+		z.v[a].Set(y.v[a])
+	}
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *Multivector) Add(x, y *Multivector) *Multivector {
+	z.use(x.alg).use(y.alg)
+	for a := range z.v {
+		z.v[a].Add(x.v[a], y.v[a])
+	}
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *Multivector) Sub(x, y *Multivector) *Multivector {
+	z.use(x.alg).use(y.alg)
+	for a := range z.v {
+		z.v[a].Sub(x.v[a], y.v[a])
+	}
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *Multivector) Scal(y *Multivector, a *big.Rat) *Multivector {
+	z.use(y.alg)
+	for i := range z.v {
+		z.v[i].Mul(y.v[i], a)
+	}
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *Multivector) Neg(y *Multivector) *Multivector {
+	z.use(y.alg)
+	for a := range z.v {
+		z.v[a].Neg(y.v[a])
+	}
+	return z
+}
+
+// Components returns the components of z in the construct of its algebra,
+// in the order of its Rats method.
+func (z *Multivector) Components() []*big.Rat {
+	c := z.alg
+	w := make([]*big.Rat, c.impl.Dim)
+	for i := range w {
+		w[i] = new(big.Rat)
+	}
+	temp := new(big.Rat)
+	for a, x := range z.v {
+		temp.SetInt64(int64(c.sign[a]))
+		w[c.index[a]].Add(w[c.index[a]], temp.Mul(temp, x))
+	}
+	return w
+}
+
+// SetComponents sets z equal to the value of c with the given components in
+// the construct of c, and returns z. It is the inverse of Components.
+func (z *Multivector) SetComponents(c *Clifford, w []*big.Rat) *Multivector {
+	z.use(c)
+	for a := range z.v {
+		z.v[a].SetInt64(int64(c.sign[a]))
+		z.v[a].Mul(z.v[a], w[c.index[a]])
+	}
+	return z
+}
+
+// Mul sets z equal to the geometric product of x and y, and returns z. It is
+// computed as the product of the construct of the algebra.
+func (z *Multivector) Mul(x, y *Multivector) *Multivector {
+	c := z.use(x.alg).use(y.alg).alg
+	return z.SetComponents(c, c.impl.Ops["Mul"](x.Components(), y.Components()))
+}
+
+// Grade sets z equal to the part of y of grade k, the sum of its terms along
+// blades of k generators, and returns z.
+func (z *Multivector) Grade(y *Multivector, k int) *Multivector {
+	z.use(y.alg)
+	for a := range z.v {
+		if bits.OnesCount(uint(a)) == k {
+			z.v[a].Set(y.v[a])
+		} else {
+			z.v[a].SetInt64(0)
+		}
+	}
+	return z
+}
+
+// flip sets z equal to y with the blades of grade k negated when neg(k) is
+// true, and returns z.
+func (z *Multivector) flip(y *Multivector, neg func(k int) bool) *Multivector {
+	z.use(y.alg)
+	for a := range z.v {
+		if neg(bits.OnesCount(uint(a))) {
+			z.v[a].Neg(y.v[a])
+		} else {
+			z.v[a].Set(y.v[a])
+		}
+	}
+	return z
+}
+
+// Reverse sets z equal to the reversion of y, which reverses the order of the
+// generators in every blade, and returns z. A blade of grade k is multiplied
+// by
+// 		(-1)^(k(k-1)/2)
+// Reversion is an anti-automorphism:
+// 		Reverse(xy) = Reverse(y)Reverse(x)
+func (z *Multivector) Reverse(y *Multivector) *Multivector {
+	return z.flip(y, func(k int) bool { return k%4 == 2 || k%4 == 3 })
+}
+
+// GradeInvolution sets z equal to the grade involution of y, which negates
+// every generator, and returns z. A blade of grade k is multiplied by (-1)^k.
+// The grade involution is an automorphism, which fixes Even(y) and negates
+// Odd(y).
+func (z *Multivector) GradeInvolution(y *Multivector) *Multivector {
+	return z.flip(y, func(k int) bool { return k%2 == 1 })
+}
+
+// Even sets z equal to the even part of y, the sum of its terms of even
+// grade, and returns z. The even parts form a subalgebra, the algebra of
+// spinors: Cl(0,2,0) is Hamilton, and its even part is Complex.
+func (z *Multivector) Even(y *Multivector) *Multivector {
+	return z.parity(y, 0)
+}
+
+// Odd sets z equal to the odd part of y, the sum of its terms of odd grade,
+// and returns z. The product of two odd parts is even.
+func (z *Multivector) Odd(y *Multivector) *Multivector {
+	return z.parity(y, 1)
+}
+
+// parity sets z equal to the terms of y whose grade has the parity p, and
+// returns z.
+func (z *Multivector) parity(y *Multivector, p int) *Multivector {
+	z.use(y.alg)
+	for a := range z.v {
+		if bits.OnesCount(uint(a))%2 == p {
+			z.v[a].Set(y.v[a])
+		} else {
+			z.v[a].SetInt64(0)
+		}
+	}
+	return z
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// randMultivector returns a Multivector of c with small random coefficients.
+func randMultivector(r *rand.Rand, c *Clifford) *Multivector {
+	z := c.Blade(0)
+	for _, x := range z.Coeffs() {
+		x.SetFrac64(r.Int63n(19)-9, r.Int63n(4)+1)
+	}
+	return z
+}
+
+func TestClifford(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, s := range cliffords {
+		c, err := NewClifford(s.p, s.q, s.r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c.Name() != s.name {
+			t.Errorf("Cl(%d,%d,%d) is %s, want %s", s.p, s.q, s.r, c.Name(), s.name)
+		}
+		// the blades are a basis of the construct
+		seen := make(map[int]bool)
+		for _, i := range c.index {
+			seen[i] = true
+		}
+		if len(seen) != c.Dim() || c.Dim() != c.impl.Dim {
+			t.Errorf("Cl(%d,%d,%d): blades are not a basis of %s", s.p, s.q, s.r, c.Name())
+		}
+		for k := 0; k < c.Gens(); k++ {
+			e := c.Blade(1 << k)
+			want := c.Blade(0)
+			switch {
+			case k < c.P:
+			case k < c.P+c.Q:
+				want.Neg(want)
+			default:
+				want.Scal(want, new(big.Rat))
+			}
+			if got := new(Multivector).Mul(e, e); !got.Equals(want) {
+				t.Errorf("Cl(%d,%d,%d): e%d² = %v, want %v", s.p, s.q, s.r, k+1, got, want)
+			}
+			for l := 0; l < k; l++ {
+				f := c.Blade(1 << l)
+				ef, fe := new(Multivector).Mul(e, f), new(Multivector).Mul(f, e)
+				if !ef.Add(ef, fe).Equals(c.Blade(0).Scal(c.Blade(0), new(big.Rat))) {
+					t.Errorf("Cl(%d,%d,%d): e%d and e%d do not anticommute", s.p, s.q, s.r, k+1, l+1)
+				}
+			}
+		}
+		for i := 0; i < 4; i++ {
+			x, y := randMultivector(r, c), randMultivector(r, c)
+			xy := new(Multivector).Mul(x, y)
+			ry := new(Multivector).Reverse(y)
+			if !new(Multivector).Reverse(xy).Equals(ry.Mul(ry, new(Multivector).Reverse(x))) {
+				t.Errorf("Cl(%d,%d,%d): Reverse is not an anti-automorphism", s.p, s.q, s.r)
+			}
+			ix := new(Multivector).GradeInvolution(x)
+			if !new(Multivector).GradeInvolution(xy).Equals(ix.Mul(ix, new(Multivector).GradeInvolution(y))) {
+				t.Errorf("Cl(%d,%d,%d): GradeInvolution is not an automorphism", s.p, s.q, s.r)
+			}
+			ex, ox := new(Multivector).Even(x), new(Multivector).Odd(x)
+			if !new(Multivector).Sub(ex, ox).Equals(new(Multivector).GradeInvolution(x)) {
+				t.Errorf("Cl(%d,%d,%d): GradeInvolution(%v) is not Even - Odd", s.p, s.q, s.r, x)
+			}
+			ey := new(Multivector).Even(y)
+			if p := new(Multivector).Mul(ex, ey); !new(Multivector).Even(p).Equals(p) {
+				t.Errorf("Cl(%d,%d,%d): the even part is not a subalgebra", s.p, s.q, s.r)
+			}
+			sum := c.Blade(0).Scal(c.Blade(0), new(big.Rat))
+			for k := 0; k <= c.Gens(); k++ {
+				sum.Add(sum, new(Multivector).Grade(x, k))
+			}
+			if !sum.Equals(x) {
+				t.Errorf("Cl(%d,%d,%d): the grades of %v add to %v", s.p, s.q, s.r, x, sum)
+			}
+			if !new(Multivector).SetComponents(c, x.Components()).Equals(x) {
+				t.Errorf("Cl(%d,%d,%d): SetComponents is not the inverse of Components", s.p, s.q, s.r)
+			}
+		}
+	}
+	for _, s := range [][3]int{{2, 1, 0}, {0, 3, 0}, {0, 0, 3}, {4, 0, 0}} {
+		if _, err := NewClifford(s[0], s[1], s[2]); err == nil {
+			t.Errorf("Cl(%d,%d,%d) did not fail", s[0], s[1], s[2])
+		}
+	}
+	c, _ := NewClifford(0, 2, 0)
+	x := c.Blade(3)
+	x.Sub(x, c.Blade(1))
+	if got, want := x.String(), "⦗-e1+e12⦘"; got != want {
+		t.Errorf("String() = %s, want %s", got, want)
+	}
+}
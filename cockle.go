@@ -5,6 +5,7 @@ package rational
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"reflect"
@@ -18,16 +19,29 @@ type Cockle struct {
 	l, r Complex
 }
 
-// Real returns the (rational) real part of z.
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
 func (z *Cockle) Real() *big.Rat {
 	return (&z.l).Real()
 }
 
-// Rats returns the four rational components of z.
+// Rats returns the four rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
 func (z *Cockle) Rats() (*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
 	return &z.l.l, &z.l.r, &z.r.l, &z.r.r
 }
 
+// RatsCopy returns copies of the four rational components of z.
+func (z *Cockle) RatsCopy() (*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
+	return new(Cockle).Set(z).Rats()
+}
+
+// Components returns the four rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *Cockle) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
 // String returns the string representation of a Cockle value.
 // If z corresponds to a + bi + ct + du, then the string is "(a+bi+ct+du)",
 // similar to complex128 values.
@@ -36,7 +50,8 @@ func (z *Cockle) String() string {
 	v[0], v[1] = z.l.Rats()
 	v[2], v[3] = z.r.Rats()
 	a := make([]string, 9)
-	a[0] = leftBracket
+	br := brackets()
+	a[0] = br[0]
 	a[1] = fmt.Sprintf("%v", v[0].RatString())
 	i := 1
 	for j := 2; j < 8; j = j + 2 {
@@ -48,7 +63,7 @@ func (z *Cockle) String() string {
 		a[j+1] = symbCockle[i]
 		i++
 	}
-	a[8] = rightBracket
+	a[8] = br[1]
 	return strings.Join(a, "")
 }
 
@@ -60,6 +75,16 @@ func (z *Cockle) Equals(y *Cockle) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *Cockle) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Complex))
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *Cockle) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *Cockle) Set(y *Cockle) *Cockle {
 	z.l.Set(&y.l)
@@ -121,12 +146,26 @@ func (z *Cockle) Sub(x, y *Cockle) *Cockle {
 // 		Mul(u, t) = -Mul(t, u) = i
 // 		Mul(u, i) = -Mul(i, u) = t
 // This binary operation is noncommutative but associative.
-func (z *Cockle) Mul(x, y *Cockle) *Cockle {
-	a := new(Complex).Set(&x.l)
-	b := new(Complex).Set(&x.r)
-	c := new(Complex).Set(&y.l)
-	d := new(Complex).Set(&y.r)
-	temp := new(Complex)
+func (z *Cockle) Mul(x, y *Cockle) (product *Cockle) {
+	if h := currentHook(); h != nil {
+		x, y := new(Cockle).Set(x), new(Cockle).Set(y)
+		defer func() { traceMul(h, "Cockle.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	a, b, c, d := &x.l, &x.r, &y.l, &y.r
+	if z == x || z == y {
+		// z.l is written before z.r is computed
+		a, b = complexArena.get().Set(a), complexArena.get().Set(b)
+		c, d = complexArena.get().Set(c), complexArena.get().Set(d)
+		defer complexArena.put(a, b, c, d)
+	}
+	temp := complexArena.get()
+	defer complexArena.put(temp)
 	z.l.Add(
 		z.l.Mul(a, c),
 		temp.Mul(temp.Conj(d), b),
@@ -152,10 +191,9 @@ func (z *Cockle) Commutator(x, y *Cockle) *Cockle {
 // 		a² + b² - c² - d²
 // This can be positive, negative, or zero.
 func (z *Cockle) Quad() *big.Rat {
-	return new(big.Rat).Sub(
-		z.l.Quad(),
-		z.r.Quad(),
-	)
+	q, r := z.l.Quad(), z.r.Quad()
+	defer putRat(r)
+	return q.Sub(q, r)
 }
 
 // IsZeroDivisor returns true if z is a zero divisor.
@@ -165,11 +203,16 @@ func (z *Cockle) IsZeroDivisor() bool {
 
 // Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
 // then Inv panics.
-func (z *Cockle) Inv(y *Cockle) *Cockle {
+func (z *Cockle) Inv(y *Cockle) (inverse *Cockle) {
+	if h := currentHook(); h != nil {
+		y := new(Cockle).Set(y)
+		defer func() { traceInv(h, "Cockle.Inv", inverse != nil, z, y) }()
+	}
 	if y.IsZeroDivisor() {
-		panic("inverse of zero divisor")
+		panic(divisorError("inverse of zero divisor"))
 	}
 	a := y.Quad()
+	defer putRat(a)
 	a.Inv(a)
 	return z.Scal(z.Conj(y), a)
 }
@@ -179,7 +222,7 @@ func (z *Cockle) Inv(y *Cockle) *Cockle {
 // Then it returns z. If y is a zero divisor, then QuoL panics.
 func (z *Cockle) QuoL(x, y *Cockle) *Cockle {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(z.Inv(y), x)
 }
@@ -189,7 +232,7 @@ func (z *Cockle) QuoL(x, y *Cockle) *Cockle {
 // Then it returns z. If y is a zero divisor, then QuoR panics.
 func (z *Cockle) QuoR(x, y *Cockle) *Cockle {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(x, z.Inv(y))
 }
@@ -274,17 +317,150 @@ func (z *Cockle) Dot(y *Cockle) *big.Rat {
 	return new(big.Rat).Sub(z.l.Dot(&y.l), z.r.Dot(&y.r))
 }
 
+// LeftMul returns the linear operator that sends y to Mul(z, y), acting on
+// the rational components of y.
+func (z *Cockle) LeftMul() *Operator {
+	a := new(Cockle).Set(z)
+	return &Operator{4, func(v []*big.Rat) []*big.Rat {
+		y := NewCockle(v[0], v[1], v[2], v[3])
+		return rats(y.Mul(a, y).Rats())
+	}}
+}
+
+// RightMul returns the linear operator that sends y to Mul(y, z), acting on
+// the rational components of y.
+func (z *Cockle) RightMul() *Operator {
+	a := new(Cockle).Set(z)
+	return &Operator{4, func(v []*big.Rat) []*big.Rat {
+		y := NewCockle(v[0], v[1], v[2], v[3])
+		return rats(y.Mul(y, a).Rats())
+	}}
+}
+
+// MinPoly returns the minimal polynomial of z. If z is real, then the
+// minimal polynomial is
+// 		t - Real(z)
+// Otherwise, it is
+// 		t² - 2Real(z)t + Quad(z)
+func (z *Cockle) MinPoly() Laurent {
+	re := new(big.Rat).Set(z.Real())
+	pure := new(Cockle).Set(z)
+	pure.Real().SetInt64(0)
+	if pure.Equals(new(Cockle)) {
+		return Laurent{0: re.Neg(re), 1: big.NewRat(1, 1)}
+	}
+	return Laurent{
+		0: z.Quad(),
+		1: re.Mul(re, big.NewRat(-2, 1)),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// EvaluateMinPoly returns true if z satisfies its minimal polynomial.
+func (z *Cockle) EvaluateMinPoly() bool {
+	return satisfies(z, z.MinPoly())
+}
+
+// PowViaMinPoly sets z equal to y raised to the power n, and returns z. High
+// powers are reduced with the minimal polynomial of y, so only O(log n)
+// rational multiplications are needed.
+func (z *Cockle) PowViaMinPoly(y *Cockle, n uint64) *Cockle {
+	p, q := quadPow(y.MinPoly(), n)
+	z.Scal(y, p)
+	z.Real().Add(z.Real(), q)
+	return z
+}
+
+// WriteTo writes a canonical encoding of z to w, and returns the number of
+// bytes written. Equal values produce equal encodings, so any hash.Hash can be
+// used as w to hash z without building an intermediate byte slice.
+func (z *Cockle) WriteTo(w io.Writer) (int64, error) {
+	return writeRats(w, rats(z.Rats()))
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// the rational parameter t, and returns z. The curve is evaluated exactly with
+// the De Casteljau algorithm. If p is empty, then Bezier panics.
+func (z *Cockle) Bezier(t *big.Rat, p ...*Cockle) *Cockle {
+	if len(p) == 0 {
+		panic("no control points")
+	}
+	s := new(big.Rat).Sub(big.NewRat(1, 1), t)
+	b := make([]*Cockle, len(p))
+	for i := range p {
+		b[i] = new(Cockle).Set(p[i])
+	}
+	temp := new(Cockle)
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			b[i].Add(b[i].Scal(b[i], s), temp.Scal(b[i+1], t))
+		}
+	}
+	return z.Set(b[0])
+}
+
+// Round sets z equal to y with each component rounded to a multiple of 1/den
+// in the direction given by mode, and returns z. Directed modes round every
+// component the same way, so interval bounds stay rigorous. If den is not
+// positive, then Round panics.
+func (z *Cockle) Round(y *Cockle, den *big.Int, mode big.RoundingMode) *Cockle {
+	z.Set(y)
+	for _, c := range rats(z.Rats()) {
+		roundRat(c, c, den, mode)
+	}
+	return z
+}
+
+// Act returns the left action of z on the pair (a, b) of Complex values, which
+// is identified with the Cockle value a + bt and multiplied on the left by z.
+// Cockle is associative, so acting by Mul(x, y) is acting by y and then by x,
+// and Act is a representation of Cockle on pairs of Complex values. Unlike
+// that of Hamilton, a zero divisor z sends some non-zero pairs to zero.
+func (z *Cockle) Act(a, b *Complex) (*Complex, *Complex) {
+	v := new(Cockle)
+	v.l.Set(a)
+	v.r.Set(b)
+	v.Mul(z, v)
+	return &v.l, &v.r
+}
+
+// SolveCrossRatioL sets z equal to the point y such that the left
+// cross-ratio of v, w, x, and y is c, and returns z. With
+// A = Inv(w - x) * (v - x) and B = Inv(A) * c, the point is
+// 		(v*B - w) * Inv(B - 1)
+// If the point is not uniquely determined, then SolveCrossRatioL panics.
+func (z *Cockle) SolveCrossRatioL(v, w, x, c *Cockle) *Cockle {
+	one := new(Cockle)
+	one.Real().SetInt64(1)
+	b := new(Cockle).Sub(v, x)
+	b.Mul(new(Cockle).Inv(new(Cockle).Sub(w, x)), b)
+	b.Mul(b.Inv(b), c)
+	temp := new(Cockle).Sub(b, one)
+	temp.Inv(temp)
+	z.Mul(v, b)
+	z.Sub(z, w)
+	return z.Mul(z, temp)
+}
+
+// SolveCrossRatioR sets z equal to the point y such that the right
+// cross-ratio of v, w, x, and y is c, and returns z. With
+// A = (v - x) * Inv(w - x) and B = Inv(A) * c, the point is
+// 		Inv(B - 1) * (B*v - w)
+// If the point is not uniquely determined, then SolveCrossRatioR panics.
+func (z *Cockle) SolveCrossRatioR(v, w, x, c *Cockle) *Cockle {
+	one := new(Cockle)
+	one.Real().SetInt64(1)
+	b := new(Cockle).Sub(v, x)
+	b.Mul(b, new(Cockle).Inv(new(Cockle).Sub(w, x)))
+	b.Mul(b.Inv(b), c)
+	temp := new(Cockle).Sub(b, one)
+	temp.Inv(temp)
+	z.Mul(b, v)
+	z.Sub(z, w)
+	return z.Mul(temp, z)
+}
+
 // Generate returns a random Cockle value for quick.Check testing.
 func (z *Cockle) Generate(rand *rand.Rand, size int) reflect.Value {
-	randomCockle := &Cockle{
-		*NewComplex(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-		*NewComplex(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-	}
-	return reflect.ValueOf(randomCockle)
+	return reflect.ValueOf(new(Cockle).Random(rand, generateOptions))
 }
@@ -331,3 +331,78 @@ func TestCockleComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Scalar operands
+
+func TestCockleMulReal(t *testing.T) {
+	f := func(x, y *Cockle) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(big.Rat).Set(y.Real())
+		s := new(Cockle)
+		s.Real().Set(a)
+		if !s.IsReal() || x.IsReal() {
+			return false
+		}
+		l, r := new(Cockle), new(Cockle)
+		l.Mul(s, x)
+		r.Mul(x, s)
+		// an independent product: s x = (s + x)x - x x, with no real factor
+		xx := new(Cockle).Mul(x, x)
+		sx := new(Cockle).Add(s, x)
+		return l.Equals(new(Cockle).Sub(new(Cockle).Mul(sx, x), xx)) &&
+			r.Equals(new(Cockle).Sub(new(Cockle).Mul(x, sx), xx))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCockleIsPure(t *testing.T) {
+	f := func(x *Cockle) bool {
+		// t.Logf("x = %v", x)
+		p := new(Cockle).Set(x)
+		p.Real().SetInt64(0)
+		return p.IsPure() && !x.IsPure()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Module structure
+
+func TestCockleActAssociative(t *testing.T) {
+	f := func(x, y, w *Cockle) bool {
+		// t.Logf("x = %v, y = %v, w = %v", x, y, w)
+		a, b := new(Cockle).Mul(x, y).Act(&w.l, &w.r)
+		c, d := x.Act(y.Act(&w.l, &w.r))
+		return a.Equals(c) && b.Equals(d)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Cross-ratio
+
+func TestCockleSolveCrossRatioL(t *testing.T) {
+	f := func(v, w, x, y *Cockle) bool {
+		// t.Logf("v = %v, w = %v, x = %v, y = %v", v, w, x, y)
+		c := new(Cockle).CrossRatioL(v, w, x, y)
+		return new(Cockle).SolveCrossRatioL(v, w, x, c).Equals(y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCockleSolveCrossRatioR(t *testing.T) {
+	f := func(v, w, x, y *Cockle) bool {
+		// t.Logf("v = %v, w = %v, x = %v, y = %v", v, w, x, y)
+		c := new(Cockle).CrossRatioR(v, w, x, y)
+		return new(Cockle).SolveCrossRatioR(v, w, x, c).Equals(y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
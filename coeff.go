@@ -0,0 +1,492 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// Dim returns the number of rational components of a Complex value, 2.
+func (z *Complex) Dim() int {
+	return 2
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *Complex) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *Complex) SetCoeff(i int, a *big.Rat) *Complex {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of a Infra value, 2.
+func (z *Infra) Dim() int {
+	return 2
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *Infra) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *Infra) SetCoeff(i int, a *big.Rat) *Infra {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of a Perplex value, 2.
+func (z *Perplex) Dim() int {
+	return 2
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *Perplex) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *Perplex) SetCoeff(i int, a *big.Rat) *Perplex {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of a BiComplex value, 4.
+func (z *BiComplex) Dim() int {
+	return 4
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *BiComplex) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *BiComplex) SetCoeff(i int, a *big.Rat) *BiComplex {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of a BiPerplex value, 4.
+func (z *BiPerplex) Dim() int {
+	return 4
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *BiPerplex) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *BiPerplex) SetCoeff(i int, a *big.Rat) *BiPerplex {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of a Cockle value, 4.
+func (z *Cockle) Dim() int {
+	return 4
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *Cockle) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *Cockle) SetCoeff(i int, a *big.Rat) *Cockle {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of a DualComplex value, 4.
+func (z *DualComplex) Dim() int {
+	return 4
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *DualComplex) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *DualComplex) SetCoeff(i int, a *big.Rat) *DualComplex {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of a DualPerplex value, 4.
+func (z *DualPerplex) Dim() int {
+	return 4
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *DualPerplex) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *DualPerplex) SetCoeff(i int, a *big.Rat) *DualPerplex {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of a Hamilton value, 4.
+func (z *Hamilton) Dim() int {
+	return 4
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *Hamilton) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *Hamilton) SetCoeff(i int, a *big.Rat) *Hamilton {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of a Hyper value, 4.
+func (z *Hyper) Dim() int {
+	return 4
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *Hyper) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *Hyper) SetCoeff(i int, a *big.Rat) *Hyper {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of a InfraComplex value, 4.
+func (z *InfraComplex) Dim() int {
+	return 4
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *InfraComplex) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *InfraComplex) SetCoeff(i int, a *big.Rat) *InfraComplex {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of a InfraPerplex value, 4.
+func (z *InfraPerplex) Dim() int {
+	return 4
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *InfraPerplex) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *InfraPerplex) SetCoeff(i int, a *big.Rat) *InfraPerplex {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of a Supra value, 4.
+func (z *Supra) Dim() int {
+	return 4
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *Supra) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *Supra) SetCoeff(i int, a *big.Rat) *Supra {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of a BiCockle value, 8.
+func (z *BiCockle) Dim() int {
+	return 8
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *BiCockle) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *BiCockle) SetCoeff(i int, a *big.Rat) *BiCockle {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of a BiHamilton value, 8.
+func (z *BiHamilton) Dim() int {
+	return 8
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *BiHamilton) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *BiHamilton) SetCoeff(i int, a *big.Rat) *BiHamilton {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of a Cayley value, 8.
+func (z *Cayley) Dim() int {
+	return 8
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *Cayley) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *Cayley) SetCoeff(i int, a *big.Rat) *Cayley {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of a InfraCockle value, 8.
+func (z *InfraCockle) Dim() int {
+	return 8
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *InfraCockle) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *InfraCockle) SetCoeff(i int, a *big.Rat) *InfraCockle {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of a InfraHamilton value, 8.
+func (z *InfraHamilton) Dim() int {
+	return 8
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *InfraHamilton) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *InfraHamilton) SetCoeff(i int, a *big.Rat) *InfraHamilton {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of a SupraComplex value, 8.
+func (z *SupraComplex) Dim() int {
+	return 8
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *SupraComplex) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *SupraComplex) SetCoeff(i int, a *big.Rat) *SupraComplex {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of a SupraPerplex value, 8.
+func (z *SupraPerplex) Dim() int {
+	return 8
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *SupraPerplex) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *SupraPerplex) SetCoeff(i int, a *big.Rat) *SupraPerplex {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of a TriComplex value, 8.
+func (z *TriComplex) Dim() int {
+	return 8
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *TriComplex) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *TriComplex) SetCoeff(i int, a *big.Rat) *TriComplex {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of a TriNilplex value, 8.
+func (z *TriNilplex) Dim() int {
+	return 8
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *TriNilplex) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *TriNilplex) SetCoeff(i int, a *big.Rat) *TriNilplex {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of a TriPerplex value, 8.
+func (z *TriPerplex) Dim() int {
+	return 8
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *TriPerplex) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *TriPerplex) SetCoeff(i int, a *big.Rat) *TriPerplex {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of a Ultra value, 8.
+func (z *Ultra) Dim() int {
+	return 8
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *Ultra) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *Ultra) SetCoeff(i int, a *big.Rat) *Ultra {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of a Zorn value, 8.
+func (z *Zorn) Dim() int {
+	return 8
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *Zorn) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *Zorn) SetCoeff(i int, a *big.Rat) *Zorn {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of z.
+func (z *Double[S, T]) Dim() int {
+	return len(z.Components())
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *Double[S, T]) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *Double[S, T]) SetCoeff(i int, a *big.Rat) *Double[S, T] {
+	z.Components()[i].Set(a)
+	return z
+}
+
+// Dim returns the number of rational components of a DualHamilton value, 8.
+func (z *DualHamilton) Dim() int {
+	return 8
+}
+
+// Coeff returns a copy of the i-th rational component of z, in the order of
+// Components. If i is out of range, then Coeff panics.
+func (z *DualHamilton) Coeff(i int) *big.Rat {
+	return new(big.Rat).Set(z.Components()[i])
+}
+
+// SetCoeff sets the i-th rational component of z equal to a, and returns z.
+// If i is out of range, then SetCoeff panics.
+func (z *DualHamilton) SetCoeff(i int, a *big.Rat) *DualHamilton {
+	z.Components()[i].Set(a)
+	return z
+}
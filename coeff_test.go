@@ -0,0 +1,80 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+)
+
+// An indexed value can be addressed by component.
+type indexed interface {
+	Dim() int
+	Coeff(i int) *big.Rat
+	Components() []*big.Rat
+}
+
+func TestCoeff(t *testing.T) {
+	for _, z := range []indexed{
+		new(Complex),
+		new(Infra),
+		new(Perplex),
+		new(BiComplex),
+		new(BiPerplex),
+		new(Cockle),
+		new(DualComplex),
+		new(DualPerplex),
+		new(Hamilton),
+		new(Hyper),
+		new(InfraComplex),
+		new(InfraPerplex),
+		new(Supra),
+		new(BiCockle),
+		new(BiHamilton),
+		new(Cayley),
+		new(DualHamilton),
+		new(InfraCockle),
+		new(InfraHamilton),
+		new(SupraComplex),
+		new(SupraPerplex),
+		new(TriComplex),
+		new(TriNilplex),
+		new(TriPerplex),
+		new(Ultra),
+		new(Zorn),
+		new(Sedenion),
+	} {
+		if len(z.Components()) != z.Dim() {
+			t.Errorf("%T: Dim = %d, but %d components", z, z.Dim(), len(z.Components()))
+		}
+		for i := 0; i < z.Dim(); i++ {
+			z.Components()[i].SetInt64(int64(i + 1))
+		}
+		for i := 0; i < z.Dim(); i++ {
+			c := z.Coeff(i)
+			if c.Cmp(big.NewRat(int64(i+1), 1)) != 0 {
+				t.Errorf("%T: Coeff(%d) = %v, want %d", z, i, c, i+1)
+			}
+			// Coeff returns a copy
+			c.SetInt64(0)
+		}
+		if z.Coeff(0).Sign() == 0 {
+			t.Errorf("%T: Coeff aliases z", z)
+		}
+	}
+}
+
+func TestSetCoeff(t *testing.T) {
+	z := new(Hamilton).SetCoeff(2, big.NewRat(3, 4))
+	want := NewHamilton(big.NewRat(0, 1), big.NewRat(0, 1), big.NewRat(3, 4), big.NewRat(0, 1))
+	if !z.Equals(want) {
+		t.Errorf("SetCoeff(2, 3/4) = %v, want %v", z, want)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("SetCoeff(8, 1) on a Hamilton value did not panic")
+		}
+	}()
+	z.SetCoeff(8, big.NewRat(1, 1))
+}
@@ -5,6 +5,7 @@ package rational
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"reflect"
@@ -18,23 +19,37 @@ type Complex struct {
 	l, r big.Rat
 }
 
-// Real returns the (rational) real part of z.
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
 func (z *Complex) Real() *big.Rat {
 	return &z.l
 }
 
-// Rats returns the two rational components of z.
+// Rats returns the two rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
 func (z *Complex) Rats() (*big.Rat, *big.Rat) {
 	return &z.l, &z.r
 }
 
+// RatsCopy returns copies of the two rational components of z.
+func (z *Complex) RatsCopy() (*big.Rat, *big.Rat) {
+	return new(Complex).Set(z).Rats()
+}
+
+// Components returns the two rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *Complex) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
 // String returns the string version of a Complex value.
 //
 // If z corresponds to a + bi, then the string is "(a+bi)", similar to
 // complex128 values.
 func (z *Complex) String() string {
 	a := make([]string, 5)
-	a[0] = leftBracket
+	br := brackets()
+	a[0] = br[0]
 	a[1] = fmt.Sprintf("%v", z.l.RatString())
 	if z.r.Sign() < 0 {
 		a[2] = fmt.Sprintf("%v", z.r.RatString())
@@ -42,7 +57,7 @@ func (z *Complex) String() string {
 		a[2] = fmt.Sprintf("+%v", z.r.RatString())
 	}
 	a[3] = symbComplex[1]
-	a[4] = rightBracket
+	a[4] = br[1]
 	return strings.Join(a, "")
 }
 
@@ -54,6 +69,16 @@ func (z *Complex) Equals(y *Complex) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *Complex) IsReal() bool {
+	return z.r.Sign() == 0
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *Complex) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *Complex) Set(y *Complex) *Complex {
 	z.l.Set(&y.l)
@@ -109,20 +134,24 @@ func (z *Complex) Sub(x, y *Complex) *Complex {
 // The multiplication rule is:
 // 		Mul(i, i) = -1
 // This binary operation is commutative and associative.
-func (z *Complex) Mul(x, y *Complex) *Complex {
-	a := new(big.Rat).Set(&x.l)
-	b := new(big.Rat).Set(&x.r)
-	c := new(big.Rat).Set(&y.l)
-	d := new(big.Rat).Set(&y.r)
-	temp := new(big.Rat)
-	z.l.Sub(
-		z.l.Mul(a, c),
-		temp.Mul(d, b),
-	)
-	z.r.Add(
-		z.r.Mul(d, a),
-		temp.Mul(b, c),
-	)
+func (z *Complex) Mul(x, y *Complex) (product *Complex) {
+	if h := currentHook(); h != nil {
+		x, y := new(Complex).Set(x), new(Complex).Set(y)
+		defer func() { traceMul(h, "Complex.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	// Each component is normalized once, and z.l is only written after z.r
+	// is computed, so z may alias x or y.
+	l := getRat()
+	defer putRat(l)
+	dot2(l, &x.l, &y.l, &y.r, &x.r, -1)
+	dot2(&z.r, &y.r, &x.l, &x.r, &y.l, 1)
+	z.l.Set(l)
 	return z
 }
 
@@ -130,20 +159,21 @@ func (z *Complex) Mul(x, y *Complex) *Complex {
 // 		a² + b²
 // This is always non-negative.
 func (z *Complex) Quad() *big.Rat {
-	quad := new(big.Rat)
-	return quad.Add(
-		quad.Mul(&z.l, &z.l),
-		new(big.Rat).Mul(&z.r, &z.r),
-	)
+	return dot2(getRat(), &z.l, &z.l, &z.r, &z.r, 1)
 }
 
 // Inv sets z equal to the inverse of y, and returns z. If y is zero, then Inv
 // panics.
-func (z *Complex) Inv(y *Complex) *Complex {
+func (z *Complex) Inv(y *Complex) (inverse *Complex) {
+	if h := currentHook(); h != nil {
+		y := new(Complex).Set(y)
+		defer func() { traceInv(h, "Complex.Inv", inverse != nil, z, y) }()
+	}
 	if zero := new(Complex); y.Equals(zero) {
-		panic("inverse of zero")
+		panic(denominatorError("inverse of zero"))
 	}
 	a := y.Quad()
+	defer putRat(a)
 	a.Inv(a)
 	return z.Scal(z.Conj(y), a)
 }
@@ -152,7 +182,7 @@ func (z *Complex) Inv(y *Complex) *Complex {
 // then Quo panics.
 func (z *Complex) Quo(x, y *Complex) *Complex {
 	if zero := new(Complex); y.Equals(zero) {
-		panic("denominator is zero")
+		panic(denominatorError("denominator is zero"))
 	}
 	return z.Mul(x, z.Inv(y))
 }
@@ -248,14 +278,232 @@ func (z *Complex) Dot(y *Complex) *big.Rat {
 	dot := new(big.Rat)
 	temp := new(big.Rat)
 	dot.Mul(&z.l, &y.l)
-	return dot.Add(dot, temp.Mul(&z.r, &z.r))
+	return dot.Add(dot, temp.Mul(&z.r, &y.r))
+}
+
+// LeftMul returns the linear operator that sends y to Mul(z, y), acting on
+// the rational components of y.
+func (z *Complex) LeftMul() *Operator {
+	a := new(Complex).Set(z)
+	return &Operator{2, func(v []*big.Rat) []*big.Rat {
+		y := NewComplex(v[0], v[1])
+		return rats(y.Mul(a, y).Rats())
+	}}
+}
+
+// RightMul returns the linear operator that sends y to Mul(y, z), acting on
+// the rational components of y.
+func (z *Complex) RightMul() *Operator {
+	a := new(Complex).Set(z)
+	return &Operator{2, func(v []*big.Rat) []*big.Rat {
+		y := NewComplex(v[0], v[1])
+		return rats(y.Mul(y, a).Rats())
+	}}
+}
+
+// MinPoly returns the minimal polynomial of z. If z is real, then the
+// minimal polynomial is
+// 		t - Real(z)
+// Otherwise, it is
+// 		t² - 2Real(z)t + Quad(z)
+func (z *Complex) MinPoly() Laurent {
+	re := new(big.Rat).Set(z.Real())
+	pure := new(Complex).Set(z)
+	pure.Real().SetInt64(0)
+	if pure.Equals(new(Complex)) {
+		return Laurent{0: re.Neg(re), 1: big.NewRat(1, 1)}
+	}
+	return Laurent{
+		0: z.Quad(),
+		1: re.Mul(re, big.NewRat(-2, 1)),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// EvaluateMinPoly returns true if z satisfies its minimal polynomial.
+func (z *Complex) EvaluateMinPoly() bool {
+	return satisfies(z, z.MinPoly())
+}
+
+// PowViaMinPoly sets z equal to y raised to the power n, and returns z. High
+// powers are reduced with the minimal polynomial of y, so only O(log n)
+// rational multiplications are needed.
+func (z *Complex) PowViaMinPoly(y *Complex, n uint64) *Complex {
+	p, q := quadPow(y.MinPoly(), n)
+	z.Scal(y, p)
+	z.Real().Add(z.Real(), q)
+	return z
+}
+
+// WriteTo writes a canonical encoding of z to w, and returns the number of
+// bytes written. Equal values produce equal encodings, so any hash.Hash can be
+// used as w to hash z without building an intermediate byte slice.
+func (z *Complex) WriteTo(w io.Writer) (int64, error) {
+	return writeRats(w, rats(z.Rats()))
+}
+
+// IsRootOfUnity returns the order n of z and true if z is a root of unity
+// with n less than or equal to maxOrder. Otherwise it returns 0 and false. The
+// order is read from the real part and quadrance of z, since
+// 		z² - 2Re(z)z + Quad(z) = 0
+// so no powers of z are computed. Roots of unity are integral, but z need not
+// be: any other value is simply not a root of unity.
+func (z *Complex) IsRootOfUnity(maxOrder uint64) (uint64, bool) {
+	n := rootOfUnityOrder(z.Real(), z.Quad())
+	if n == 0 || n > maxOrder {
+		return 0, false
+	}
+	return n, true
+}
+
+// rootOfUnityOrder returns the order of a root of unity with real part re
+// and quadrance quad in a type whose quadrance is positive definite, or zero
+// if there is none. A root of unity has unit quadrance. A real one is 1 or -1,
+// and any other one has the minimal polynomial x² - tx + 1 with t = 2re
+// rational, so it is a primitive root of unity of order 3, 4, or 6 as t is -1,
+// 0, or 1.
+func rootOfUnityOrder(re, quad *big.Rat) uint64 {
+	t := new(big.Rat).Add(re, re)
+	if quad.Cmp(big.NewRat(1, 1)) != 0 || !t.IsInt() {
+		return 0
+	}
+	// |t| ≤ 2, since the quadrance is at least re²
+	switch t.Num().Int64() {
+	case 2:
+		return 1
+	case -2:
+		return 2
+	case -1:
+		return 3
+	case 0:
+		return 4
+	case 1:
+		return 6
+	}
+	return 0
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// the rational parameter t, and returns z. The curve is evaluated exactly with
+// the De Casteljau algorithm. If p is empty, then Bezier panics.
+func (z *Complex) Bezier(t *big.Rat, p ...*Complex) *Complex {
+	if len(p) == 0 {
+		panic("no control points")
+	}
+	s := new(big.Rat).Sub(big.NewRat(1, 1), t)
+	b := make([]*Complex, len(p))
+	for i := range p {
+		b[i] = new(Complex).Set(p[i])
+	}
+	temp := new(Complex)
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			b[i].Add(b[i].Scal(b[i], s), temp.Scal(b[i+1], t))
+		}
+	}
+	return z.Set(b[0])
+}
+
+// Barycentric sets z equal to the barycentric combination of the points p
+// with rational weights w:
+// 		(w[0]*p[0] + w[1]*p[1] + ...) / (w[0] + w[1] + ...)
+// Then it returns z. If the lengths of w and p differ, or if the weights sum
+// to zero, then Barycentric panics.
+func (z *Complex) Barycentric(w []*big.Rat, p []*Complex) *Complex {
+	if len(w) != len(p) {
+		panic("length mismatch")
+	}
+	sum := new(big.Rat)
+	acc, temp := new(Complex), new(Complex)
+	for i := range p {
+		sum.Add(sum, w[i])
+		acc.Add(acc, temp.Scal(p[i], w[i]))
+	}
+	if sum.Sign() == 0 {
+		panic("weights sum to zero")
+	}
+	return z.Scal(acc, sum.Inv(sum))
+}
+
+// Dehomogenize sets z equal to the affine representative x/y of the point
+// [x : y] of the projective line, and returns true. If y is a zero divisor,
+// then [x : y] has no affine representative, z is left unchanged, and
+// Dehomogenize returns false.
+func (z *Complex) Dehomogenize(x, y *Complex) bool {
+	if y.Equals(new(Complex)) {
+		return false
+	}
+	z.Quo(x, y)
+	return true
+}
+
+// Collinear returns true if z, x, and y lie on a common line of the plane.
+func (z *Complex) Collinear(x, y *Complex) bool {
+	a := new(Complex).Sub(x, z)
+	b := new(Complex).Sub(y, z)
+	det := new(big.Rat).Mul(&a.l, &b.r)
+	return det.Cmp(new(big.Rat).Mul(&a.r, &b.l)) == 0
+}
+
+// Concyclic returns true if the cross-ratio of z, w, x, and y is real, that
+// is, if the four points lie on a common generalized circle. The cross-ratio
+// is real exactly when
+// 		(z - x)(w - y) Conj((w - x)(z - y))
+// is real, which needs no inverses. If two of the points coincide, then there
+// are at most three distinct points, which always lie on a common generalized
+// circle, and Concyclic returns true.
+func (z *Complex) Concyclic(w, x, y *Complex) bool {
+	a := new(Complex).Mul(new(Complex).Sub(z, x), new(Complex).Sub(w, y))
+	b := new(Complex).Mul(new(Complex).Sub(w, x), new(Complex).Sub(z, y))
+	return a.Mul(a, b.Conj(b)).IsReal()
+}
+
+// Round sets z equal to y with each component rounded to a multiple of 1/den
+// in the direction given by mode, and returns z. Directed modes round every
+// component the same way, so interval bounds stay rigorous. If den is not
+// positive, then Round panics.
+func (z *Complex) Round(y *Complex, den *big.Int, mode big.RoundingMode) *Complex {
+	z.Set(y)
+	for _, c := range rats(z.Rats()) {
+		roundRat(c, c, den, mode)
+	}
+	return z
+}
+
+// Act returns the left action of z on the pair (a, b) of rationals, which is
+// identified with the Complex value a + bi and multiplied on the left by z. If
+// z = x+yi, then
+// 		(a, b) ↦ (xa - yb, ya + xb)
+// which is a rotation of the plane scaled by √Quad(z). Since Complex is
+// associative, acting by a product is acting by its factors in turn, so Act is
+// a representation of Complex on pairs of rationals.
+func (z *Complex) Act(a, b *big.Rat) (*big.Rat, *big.Rat) {
+	v := new(Complex)
+	v.l.Set(a)
+	v.r.Set(b)
+	v.Mul(z, v)
+	return &v.l, &v.r
+}
+
+// SolveCrossRatio sets z equal to the point y such that the cross-ratio of v,
+// w, x, and y is c, and returns z. With A = Inv(w - x) * (v - x) and
+// B = Inv(A) * c, the point is
+// 		(v*B - w) * Inv(B - 1)
+// If the point is not uniquely determined, then SolveCrossRatio panics.
+func (z *Complex) SolveCrossRatio(v, w, x, c *Complex) *Complex {
+	one := new(Complex)
+	one.Real().SetInt64(1)
+	b := new(Complex).Sub(v, x)
+	b.Mul(new(Complex).Inv(new(Complex).Sub(w, x)), b)
+	b.Mul(b.Inv(b), c)
+	temp := new(Complex).Sub(b, one)
+	temp.Inv(temp)
+	z.Mul(v, b)
+	z.Sub(z, w)
+	return z.Mul(z, temp)
 }
 
 // Generate returns a random Complex value for quick.Check testing.
 func (z *Complex) Generate(rand *rand.Rand, size int) reflect.Value {
-	randomComplex := &Complex{
-		*big.NewRat(rand.Int63(), rand.Int63()),
-		*big.NewRat(rand.Int63(), rand.Int63()),
-	}
-	return reflect.ValueOf(randomComplex)
+	return reflect.ValueOf(new(Complex).Random(rand, generateOptions))
 }
@@ -4,6 +4,7 @@
 package rational
 
 import (
+	"math"
 	"math/big"
 	"testing"
 	"testing/quick"
@@ -339,3 +340,227 @@ func TestComplexComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Dot product
+
+func TestComplexDot(t *testing.T) {
+	x := NewComplex(big.NewRat(1, 1), big.NewRat(2, 1))
+	y := NewComplex(big.NewRat(3, 1), big.NewRat(5, 1))
+	// 1·3 + 2·5
+	if d := x.Dot(y); d.Cmp(big.NewRat(13, 1)) != 0 {
+		t.Errorf("Dot(%v, %v) = %v, want 13", x, y, d)
+	}
+	f := func(x, y *Complex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return x.Dot(y).Cmp(y.Dot(x)) == 0 && x.Dot(x).Cmp(x.Quad()) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Scalar operands
+
+func TestComplexMulReal(t *testing.T) {
+	f := func(x, y *Complex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(big.Rat).Set(y.Real())
+		s := new(Complex)
+		s.Real().Set(a)
+		if !s.IsReal() || x.IsReal() {
+			return false
+		}
+		l, r := new(Complex), new(Complex)
+		l.Mul(s, x)
+		r.Mul(x, s)
+		// an independent product: s x = (s + x)x - x x, with no real factor
+		xx := new(Complex).Mul(x, x)
+		sx := new(Complex).Add(s, x)
+		return l.Equals(new(Complex).Sub(new(Complex).Mul(sx, x), xx)) &&
+			r.Equals(new(Complex).Sub(new(Complex).Mul(x, sx), xx))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexIsPure(t *testing.T) {
+	f := func(x *Complex) bool {
+		// t.Logf("x = %v", x)
+		p := new(Complex).Set(x)
+		p.Real().SetInt64(0)
+		return p.IsPure() && !x.IsPure()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Roots of unity
+
+func TestComplexIsRootOfUnity(t *testing.T) {
+	zero, one, half := new(big.Rat), big.NewRat(1, 1), big.NewRat(1, 2)
+	tests := []struct {
+		z     *Complex
+		order uint64
+		ok    bool
+	}{
+		{NewComplex(one, zero), 1, true},
+		{NewComplex(new(big.Rat).Neg(one), zero), 2, true},
+		{NewComplex(zero, one), 4, true},
+		{NewComplex(big.NewRat(3, 5), big.NewRat(4, 5)), 0, false},
+		{NewComplex(half, half), 0, false},
+	}
+	for _, test := range tests {
+		order, ok := test.z.IsRootOfUnity(12)
+		if order != test.order || ok != test.ok {
+			t.Errorf("IsRootOfUnity(%v) = %d, %v, want %d, %v",
+				test.z, order, ok, test.order, test.ok)
+		}
+	}
+	// no powers are computed, so a large bound returns at once
+	z := NewComplex(big.NewRat(3, 5), big.NewRat(4, 5))
+	if _, ok := z.IsRootOfUnity(math.MaxUint64); ok {
+		t.Errorf("IsRootOfUnity(%v) = true, want false", z)
+	}
+	z = NewComplex(zero, one)
+	if _, ok := z.IsRootOfUnity(3); ok {
+		t.Errorf("IsRootOfUnity(%v) found order greater than 3", z)
+	}
+}
+
+// Curves
+
+func TestComplexBezierEndpoints(t *testing.T) {
+	zero, one := new(big.Rat), big.NewRat(1, 1)
+	f := func(x, y, z *Complex) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		l := new(Complex).Bezier(zero, x, y, z)
+		r := new(Complex).Bezier(one, x, y, z)
+		return l.Equals(x) && r.Equals(z)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexBezierQuadratic(t *testing.T) {
+	a := big.NewRat(2, 7)
+	b := new(big.Rat).Sub(big.NewRat(1, 1), a)
+	f := func(x, y, z *Complex) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		l := new(Complex).Bezier(a, x, y, z)
+		r, temp := new(Complex), new(Complex)
+		r.Scal(x, new(big.Rat).Mul(b, b))
+		r.Add(r, temp.Scal(y, new(big.Rat).Mul(big.NewRat(2, 1), new(big.Rat).Mul(a, b))))
+		r.Add(r, temp.Scal(z, new(big.Rat).Mul(a, a)))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Copies
+
+func TestComplexRatsCopy(t *testing.T) {
+	f := func(x *Complex) bool {
+		// t.Logf("x = %v", x)
+		y := new(Complex).Set(x)
+		v := rats(x.RatsCopy())
+		for _, c := range v {
+			c.Add(c, big.NewRat(1, 1))
+		}
+		return x.Equals(y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Planar geometry
+
+func TestComplexBarycentricCollinear(t *testing.T) {
+	f := func(x, y *Complex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		w := []*big.Rat{big.NewRat(2, 3), big.NewRat(5, 7)}
+		m := new(Complex).Barycentric(w, []*Complex{x, y})
+		return m.Collinear(x, y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexBarycentricMidpoint(t *testing.T) {
+	x := NewComplex(big.NewRat(1, 1), big.NewRat(2, 1))
+	y := NewComplex(big.NewRat(3, 1), big.NewRat(-4, 1))
+	half := big.NewRat(1, 2)
+	m := new(Complex).Barycentric([]*big.Rat{half, half}, []*Complex{x, y})
+	if want := NewComplex(big.NewRat(2, 1), big.NewRat(-1, 1)); !m.Equals(want) {
+		t.Errorf("Barycentric = %v, want %v", m, want)
+	}
+}
+
+func TestComplexConcyclic(t *testing.T) {
+	zero := new(big.Rat)
+	one := big.NewRat(1, 1)
+	v := NewComplex(big.NewRat(3, 5), big.NewRat(4, 5))
+	w := NewComplex(one, zero)
+	x := NewComplex(zero, one)
+	y := NewComplex(new(big.Rat).Neg(one), zero)
+	if !v.Concyclic(w, x, y) {
+		t.Errorf("%v, %v, %v, %v are not concyclic", v, w, x, y)
+	}
+	y = NewComplex(big.NewRat(1, 2), zero)
+	if v.Concyclic(w, x, y) {
+		t.Errorf("%v, %v, %v, %v are concyclic", v, w, x, y)
+	}
+	// coincident points leave at most three distinct points
+	for _, p := range [][4]*Complex{{v, v, x, y}, {v, w, v, y}, {v, w, x, v}, {v, w, w, y}, {v, w, x, w}, {v, w, x, x}, {v, v, v, v}} {
+		if !p[0].Concyclic(p[1], p[2], p[3]) {
+			t.Errorf("%v are not concyclic", p)
+		}
+	}
+}
+
+func TestComplexDehomogenize(t *testing.T) {
+	f := func(x, y *Complex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		z := new(Complex)
+		if z.Dehomogenize(x, new(Complex)) {
+			return false
+		}
+		return z.Dehomogenize(x, y) && new(Complex).Mul(z, y).Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Module structure
+
+func TestComplexActAssociative(t *testing.T) {
+	f := func(x, y, w *Complex) bool {
+		// t.Logf("x = %v, y = %v, w = %v", x, y, w)
+		a, b := new(Complex).Mul(x, y).Act(&w.l, &w.r)
+		c, d := x.Act(y.Act(&w.l, &w.r))
+		return a.Cmp(c) == 0 && b.Cmp(d) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Cross-ratio
+
+func TestComplexSolveCrossRatio(t *testing.T) {
+	f := func(v, w, x, y *Complex) bool {
+		// t.Logf("v = %v, w = %v, x = %v, y = %v", v, w, x, y)
+		c := new(Complex).CrossRatio(v, w, x, y)
+		return new(Complex).SolveCrossRatio(v, w, x, c).Equals(y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// complexity returns the largest bit length of the numerators and
+// denominators of v.
+func complexity(v []*big.Rat) int {
+	bits := 0
+	for _, x := range v {
+		if n := x.Num().BitLen(); n > bits {
+			bits = n
+		}
+		if n := x.Denom().BitLen(); n > bits {
+			bits = n
+		}
+	}
+	return bits
+}
+
+// Complexity returns the largest bit length of the numerators and
+// denominators of the components of z. It measures the cost of arithmetic
+// with z, which grows along chains of operations.
+func (z *Complex) Complexity() int {
+	return complexity(z.Components())
+}
+
+// Complexity returns the largest bit length of the numerators and
+// denominators of the components of z. It measures the cost of arithmetic
+// with z, which grows along chains of operations.
+func (z *Infra) Complexity() int {
+	return complexity(z.Components())
+}
+
+// Complexity returns the largest bit length of the numerators and
+// denominators of the components of z. It measures the cost of arithmetic
+// with z, which grows along chains of operations.
+func (z *Perplex) Complexity() int {
+	return complexity(z.Components())
+}
+
+// Complexity returns the largest bit length of the numerators and
+// denominators of the components of z. It measures the cost of arithmetic
+// with z, which grows along chains of operations.
+func (z *BiComplex) Complexity() int {
+	return complexity(z.Components())
+}
+
+// Complexity returns the largest bit length of the numerators and
+// denominators of the components of z. It measures the cost of arithmetic
+// with z, which grows along chains of operations.
+func (z *BiPerplex) Complexity() int {
+	return complexity(z.Components())
+}
+
+// Complexity returns the largest bit length of the numerators and
+// denominators of the components of z. It measures the cost of arithmetic
+// with z, which grows along chains of operations.
+func (z *Cockle) Complexity() int {
+	return complexity(z.Components())
+}
+
+// Complexity returns the largest bit length of the numerators and
+// denominators of the components of z. It measures the cost of arithmetic
+// with z, which grows along chains of operations.
+func (z *DualComplex) Complexity() int {
+	return complexity(z.Components())
+}
+
+// Complexity returns the largest bit length of the numerators and
+// denominators of the components of z. It measures the cost of arithmetic
+// with z, which grows along chains of operations.
+func (z *DualPerplex) Complexity() int {
+	return complexity(z.Components())
+}
+
+// Complexity returns the largest bit length of the numerators and
+// denominators of the components of z. It measures the cost of arithmetic
+// with z, which grows along chains of operations.
+func (z *Hamilton) Complexity() int {
+	return complexity(z.Components())
+}
+
+// Complexity returns the largest bit length of the numerators and
+// denominators of the components of z. It measures the cost of arithmetic
+// with z, which grows along chains of operations.
+func (z *Hyper) Complexity() int {
+	return complexity(z.Components())
+}
+
+// Complexity returns the largest bit length of the numerators and
+// denominators of the components of z. It measures the cost of arithmetic
+// with z, which grows along chains of operations.
+func (z *InfraComplex) Complexity() int {
+	return complexity(z.Components())
+}
+
+// Complexity returns the largest bit length of the numerators and
+// denominators of the components of z. It measures the cost of arithmetic
+// with z, which grows along chains of operations.
+func (z *InfraPerplex) Complexity() int {
+	return complexity(z.Components())
+}
+
+// Complexity returns the largest bit length of the numerators and
+// denominators of the components of z. It measures the cost of arithmetic
+// with z, which grows along chains of operations.
+func (z *Supra) Complexity() int {
+	return complexity(z.Components())
+}
+
+// Complexity returns the largest bit length of the numerators and
+// denominators of the components of z. It measures the cost of arithmetic
+// with z, which grows along chains of operations.
+func (z *BiCockle) Complexity() int {
+	return complexity(z.Components())
+}
+
+// Complexity returns the largest bit length of the numerators and
+// denominators of the components of z. It measures the cost of arithmetic
+// with z, which grows along chains of operations.
+func (z *BiHamilton) Complexity() int {
+	return complexity(z.Components())
+}
+
+// Complexity returns the largest bit length of the numerators and
+// denominators of the components of z. It measures the cost of arithmetic
+// with z, which grows along chains of operations.
+func (z *Cayley) Complexity() int {
+	return complexity(z.Components())
+}
+
+// Complexity returns the largest bit length of the numerators and
+// denominators of the components of z. It measures the cost of arithmetic
+// with z, which grows along chains of operations.
+func (z *InfraCockle) Complexity() int {
+	return complexity(z.Components())
+}
+
+// Complexity returns the largest bit length of the numerators and
+// denominators of the components of z. It measures the cost of arithmetic
+// with z, which grows along chains of operations.
+func (z *InfraHamilton) Complexity() int {
+	return complexity(z.Components())
+}
+
+// Complexity returns the largest bit length of the numerators and
+// denominators of the components of z. It measures the cost of arithmetic
+// with z, which grows along chains of operations.
+func (z *SupraComplex) Complexity() int {
+	return complexity(z.Components())
+}
+
+// Complexity returns the largest bit length of the numerators and
+// denominators of the components of z. It measures the cost of arithmetic
+// with z, which grows along chains of operations.
+func (z *SupraPerplex) Complexity() int {
+	return complexity(z.Components())
+}
+
+// Complexity returns the largest bit length of the numerators and
+// denominators of the components of z. It measures the cost of arithmetic
+// with z, which grows along chains of operations.
+func (z *TriComplex) Complexity() int {
+	return complexity(z.Components())
+}
+
+// Complexity returns the largest bit length of the numerators and
+// denominators of the components of z. It measures the cost of arithmetic
+// with z, which grows along chains of operations.
+func (z *TriNilplex) Complexity() int {
+	return complexity(z.Components())
+}
+
+// Complexity returns the largest bit length of the numerators and
+// denominators of the components of z. It measures the cost of arithmetic
+// with z, which grows along chains of operations.
+func (z *TriPerplex) Complexity() int {
+	return complexity(z.Components())
+}
+
+// Complexity returns the largest bit length of the numerators and
+// denominators of the components of z. It measures the cost of arithmetic
+// with z, which grows along chains of operations.
+func (z *Ultra) Complexity() int {
+	return complexity(z.Components())
+}
+
+// Complexity returns the largest bit length of the numerators and
+// denominators of the components of z. It measures the cost of arithmetic
+// with z, which grows along chains of operations.
+func (z *Zorn) Complexity() int {
+	return complexity(z.Components())
+}
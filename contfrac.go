@@ -0,0 +1,171 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"iter"
+	"math/big"
+)
+
+// cfDigits returns the continued-fraction digits of x, each chosen from the
+// current remainder by digit. The expansion stops when the remainder is zero,
+// or when it is a zero divisor, which is then returned as rem.
+func cfDigits[T Number[T]](x T, newT func() T, digit func(T) T, invertible func(T) bool) (digits []T, rem T) {
+	x = newT().Set(x)
+	for {
+		d := digit(x)
+		digits = append(digits, d)
+		r := newT().Sub(x, d)
+		if r.Equals(newT()) {
+			return digits, rem
+		}
+		if !invertible(r) {
+			return digits, r
+		}
+		x.Inv(r)
+	}
+}
+
+// convergents returns an iterator over the convergents of digits, given by
+// the recurrences
+// 		p(k) = a(k)p(k-1) + p(k-2)
+// 		q(k) = a(k)q(k-1) + q(k-2)
+// as p(k)Inv(q(k)). Convergents whose q(k) is not invertible are skipped.
+func convergents[T Number[T]](digits []T, newT func() T, invertible func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		p0, q0 := newT(), newT()
+		p1, q1 := newT(), newT()
+		q0.Real().SetInt64(1)
+		p1.Real().SetInt64(1)
+		for _, a := range digits {
+			p := newT().Add(newT().Mul(a, p1), p0)
+			q := newT().Add(newT().Mul(a, q1), q0)
+			p0, q0, p1, q1 = p1, q1, p, q
+			if !invertible(q) {
+				continue
+			}
+			if !yield(newT().Mul(p, newT().Inv(q))) {
+				return
+			}
+		}
+	}
+}
+
+func newComplex() *Complex { return new(Complex) }
+
+func complexInvertible(x *Complex) bool { return !x.Equals(new(Complex)) }
+
+// complexDigit returns x rounded to the nearest Gaussian integer.
+func complexDigit(x *Complex) *Complex {
+	return NewComplex(
+		new(big.Rat).SetInt(roundInt(&x.l, big.ToNearestEven)),
+		new(big.Rat).SetInt(roundInt(&x.r, big.ToNearestEven)),
+	)
+}
+
+// ContinuedFraction returns the digits of the Gaussian continued fraction of
+// z,
+// 		z = a₀ + 1/(a₁ + 1/(a₂ + ...))
+// with each digit a Gaussian integer. Each digit is the remainder rounded to
+// the nearest Gaussian integer, as in the quotient of DivMod, so that the
+// next remainder has Quad at most 1/2. Since the components of z are
+// rational, the expansion is finite and exact: it runs the Euclidean
+// algorithm on the numerator and denominator of z.
+func (z *Complex) ContinuedFraction() []*Complex {
+	digits, _ := cfDigits(z, newComplex, complexDigit, complexInvertible)
+	return digits
+}
+
+// Convergents returns an iterator over the convergents of the continued
+// fraction of z, the values obtained by truncating ContinuedFraction. The
+// last convergent is equal to z.
+func (z *Complex) Convergents() iter.Seq[*Complex] {
+	return convergents(z.ContinuedFraction(), newComplex, complexInvertible)
+}
+
+func newPerplex() *Perplex { return new(Perplex) }
+
+func perplexInvertible(x *Perplex) bool { return !x.IsZeroDivisor() }
+
+// nearInts returns the two integers nearest to w other than w itself: its
+// floor and ceiling, or w-1 and w+1 if w is an integer.
+func nearInts(w *big.Rat) [2]*big.Int {
+	lo := roundInt(w, big.ToNegativeInf)
+	if w.IsInt() {
+		lo.Sub(lo, big.NewInt(1))
+		return [2]*big.Int{lo, new(big.Int).Add(lo, big.NewInt(2))}
+	}
+	return [2]*big.Int{lo, new(big.Int).Add(lo, big.NewInt(1))}
+}
+
+// perplexDigit returns the Perplex integer d nearest to x such that x - d is
+// invertible, working along the null coordinates u = a+b and v = a-b. A
+// Perplex integer has null coordinates of the same parity. If u and v are
+// both integers, then d is x if it is a Perplex integer, and otherwise the
+// remainder is a zero divisor.
+func perplexDigit(x *Perplex) *Perplex {
+	u := new(big.Rat).Add(&x.l, &x.r)
+	v := new(big.Rat).Sub(&x.l, &x.r)
+	du, dv := new(big.Int), new(big.Int)
+	switch {
+	case u.IsInt() && v.IsInt():
+		du.Set(u.Num())
+		dv.Set(v.Num())
+		if du.Bit(0) != dv.Bit(0) {
+			dv.Sub(dv, big.NewInt(1))
+		}
+	default:
+		// an integer coordinate keeps a remainder of ±1, so that the other
+		// coordinate can be reduced
+		best := new(big.Rat)
+		for _, cu := range nearInts(u) {
+			for _, cv := range nearInts(v) {
+				if cu.Bit(0) != cv.Bit(0) {
+					continue
+				}
+				ru := new(big.Rat).Sub(u, new(big.Rat).SetInt(cu))
+				rv := new(big.Rat).Sub(v, new(big.Rat).SetInt(cv))
+				r := ru.Abs(ru)
+				if rv.Abs(rv).Cmp(r) > 0 {
+					r = rv
+				}
+				if best.Sign() == 0 || r.Cmp(best) < 0 {
+					best = r
+					du.Set(cu)
+					dv.Set(cv)
+				}
+			}
+		}
+	}
+	a := new(big.Rat).SetFrac(new(big.Int).Add(du, dv), big.NewInt(2))
+	b := new(big.Rat).SetFrac(new(big.Int).Sub(du, dv), big.NewInt(2))
+	return NewPerplex(a, b)
+}
+
+// ContinuedFraction returns the digits of the continued fraction of z,
+// 		z = a₀ + 1/(a₁ + 1/(a₂ + ...))
+// with each digit a Perplex value with integer components. The digits are
+// chosen along the null coordinates a+b and a-b of the remainder, which must
+// be integers of the same parity, so that the remainder stays invertible and
+// the denominators of its null coordinates decrease. Hence the expansion is
+// finite, but not every value has one: it ends at null coordinates that are
+// integers of opposite parity, such as those of 1/2 + s/2, which are not the
+// ratio of two Perplex integers generating every Perplex integer. Then
+// ContinuedFraction returns the digits found so far and a *DivisionError.
+func (z *Perplex) ContinuedFraction() ([]*Perplex, error) {
+	digits, rem := cfDigits(z, newPerplex, perplexDigit, perplexInvertible)
+	if rem != nil {
+		return digits, &DivisionError{"Perplex", "ContinuedFraction", rem}
+	}
+	return digits, nil
+}
+
+// Convergents returns an iterator over the convergents of the continued
+// fraction of z, the values obtained by truncating ContinuedFraction.
+// Convergents whose denominators are zero divisors are skipped. If the
+// expansion is complete, then the last convergent is equal to z.
+func (z *Perplex) Convergents() iter.Seq[*Perplex] {
+	digits, _ := z.ContinuedFraction()
+	return convergents(digits, newPerplex, perplexInvertible)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// evalContinuedFraction returns a₀ + 1/(a₁ + 1/(a₂ + ...)).
+func evalContinuedFraction[T Number[T]](digits []T, newT func() T) T {
+	x := newT().Set(digits[len(digits)-1])
+	for k := len(digits) - 2; k >= 0; k-- {
+		x.Add(digits[k], newT().Inv(x))
+	}
+	return x
+}
+
+func TestComplexContinuedFraction(t *testing.T) {
+	f := func(x *Complex) bool {
+		// t.Logf("x = %v", x)
+		digits := x.ContinuedFraction()
+		for _, d := range digits {
+			if !d.l.IsInt() || !d.r.IsInt() {
+				return false
+			}
+		}
+		if !evalContinuedFraction(digits, newComplex).Equals(x) {
+			return false
+		}
+		var last *Complex
+		n := 0
+		for c := range x.Convergents() {
+			last = c
+			n++
+		}
+		return n == len(digits) && last.Equals(x)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+	// 7/3 = 2 + 1/3
+	x := NewComplex(big.NewRat(7, 3), big.NewRat(0, 1))
+	if digits := x.ContinuedFraction(); len(digits) != 2 || digits[0].l.Cmp(big.NewRat(2, 1)) != 0 ||
+		digits[1].l.Cmp(big.NewRat(3, 1)) != 0 {
+		t.Errorf("ContinuedFraction(%v) = %v", x, digits)
+	}
+}
+
+func TestPerplexContinuedFraction(t *testing.T) {
+	f := func(x *Perplex) bool {
+		// t.Logf("x = %v", x)
+		digits, err := x.ContinuedFraction()
+		for _, d := range digits {
+			if !d.l.IsInt() || !d.r.IsInt() {
+				return false
+			}
+		}
+		if err != nil {
+			return true
+		}
+		if !evalContinuedFraction(digits, newPerplex).Equals(x) {
+			return false
+		}
+		var last *Perplex
+		for c := range x.Convergents() {
+			last = c
+		}
+		return last.Equals(x)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+	// (3+s)/2 has null coordinates 2 and 1, of opposite parity
+	x := NewPerplex(big.NewRat(3, 2), big.NewRat(1, 2))
+	if _, err := x.ContinuedFraction(); err == nil {
+		t.Errorf("ContinuedFraction(%v) did not fail", x)
+	}
+}
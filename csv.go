@@ -0,0 +1,61 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+)
+
+// WriteSlice writes the values v to w as CSV records, one per value, with one
+// component per column in the order of Components. Each cell is exact, as
+// written by RatString, such as "-3/4" or "2". For TSV, set w.Comma to '\t'
+// first. WriteSlice flushes w, and returns the first error.
+func WriteSlice[T Number[T]](w *csv.Writer, v []T) error {
+	var rec []string
+	for _, x := range v {
+		rec = rec[:0]
+		for _, c := range x.Components() {
+			rec = append(rec, c.RatString())
+		}
+		if err := w.Write(rec); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// ReadSlice reads CSV records from r until the end of its input, and returns
+// the values they hold, one per record, as written by WriteSlice. Each record
+// must have one cell per component, holding a rational in a form accepted by
+// big.Rat.SetString, such as "-3/4", "2", or "0.5". Otherwise ReadSlice
+// returns the values read so far with a *ParseError, or with the error of r.
+// For TSV, set r.Comma to '\t' first.
+func ReadSlice[S any, T Elem[S]](r *csv.Reader) ([]T, error) {
+	var v []T
+	for {
+		rec, err := r.Read()
+		if err == io.EOF {
+			return v, nil
+		}
+		if err != nil {
+			return v, err
+		}
+		z := T(new(S))
+		c := z.Components()
+		if len(rec) != len(c) {
+			line, _ := r.FieldPos(0)
+			return v, &ParseError{typeName(z), fmt.Sprint(rec), fmt.Sprintf("line %d: %d cells, want %d", line, len(rec), len(c))}
+		}
+		for i, s := range rec {
+			if _, ok := c[i].SetString(s); !ok {
+				line, col := r.FieldPos(i)
+				return v, &ParseError{typeName(z), s, fmt.Sprintf("line %d, column %d: invalid rational", line, col)}
+			}
+		}
+		v = append(v, z)
+	}
+}
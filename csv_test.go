@@ -0,0 +1,62 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestSliceRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	v := make([]*Cayley, 1000)
+	for i := range v {
+		v[i] = new(Cayley).Random(r, &RandomOptions{MaxNum: 1 << 62, MaxDen: 1 << 62})
+	}
+	for _, comma := range []rune{',', '\t'} {
+		var b bytes.Buffer
+		w := csv.NewWriter(&b)
+		w.Comma = comma
+		if err := WriteSlice(w, v); err != nil {
+			t.Fatal(err)
+		}
+		rd := csv.NewReader(&b)
+		rd.Comma = comma
+		u, err := ReadSlice[Cayley](rd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(u) != len(v) {
+			t.Fatalf("read %d values, want %d", len(u), len(v))
+		}
+		for i := range v {
+			if !u[i].Equals(v[i]) {
+				t.Errorf("value %d = %v, want %v", i, u[i], v[i])
+			}
+		}
+	}
+}
+
+func TestReadSliceError(t *testing.T) {
+	tests := []string{
+		"1,2\n3,x\n",
+		"1,2\n3,4,5\n",
+		"1,2\n\"3\n",
+	}
+	for _, in := range tests {
+		v, err := ReadSlice[Complex](csv.NewReader(strings.NewReader(in)))
+		if err == nil || len(v) != 1 {
+			t.Errorf("ReadSlice(%q) = %v, %v", in, v, err)
+		}
+	}
+	var e *ParseError
+	_, err := ReadSlice[Complex](csv.NewReader(strings.NewReader("1/2,0.25\n1,-\n")))
+	if !errors.As(err, &e) || e.Type != "Complex" || e.Input != "-" {
+		t.Errorf("ReadSlice error = %v", err)
+	}
+}
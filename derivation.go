@@ -0,0 +1,108 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// products returns the products of the basis units of impl, indexed by the
+// units.
+func products(impl *Impl) [][][]*big.Rat {
+	n := impl.Dim
+	prod := make([][][]*big.Rat, n)
+	for i := range prod {
+		prod[i] = make([][]*big.Rat, n)
+		for j := range prod[i] {
+			prod[i][j] = impl.Ops["Mul"](unit(n, i), unit(n, j))
+		}
+	}
+	return prod
+}
+
+// Derivations returns a basis of the derivations of the algebra impl, whose
+// "Mul" operation is required. A derivation is a linear map D with
+// 		D(xy) = D(x)y + xD(y)
+// and it is returned as the matrix whose columns are the images of the basis
+// units, as in Apply. The conditions on the products of basis units form a
+// linear system in the n² entries of D, which is solved exactly, so the
+// length of the result is the dimension of the derivation algebra: 3 for
+// Hamilton and Cockle, and 14 for Cayley and Zorn, whose derivations form
+// the exceptional Lie algebra g₂. The derivations are closed under the
+// commutator
+// 		[D, E] = DE - ED
+// and they generate the automorphisms of impl that are connected to the
+// identity.
+func Derivations(impl *Impl) []*RatMatrix {
+	n := impl.Dim
+	prod := products(impl)
+	// the unknown entry D(p, q) is column p*n + q
+	sys := NewRatMatrix(n*n*n, n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			for p := 0; p < n; p++ {
+				row := (i*n+j)*n + p
+				// D(eᵢeⱼ) has component p equal to Σₖ c(k) D(p, k)
+				for k, c := range prod[i][j] {
+					sys.At(row, p*n+k).Add(sys.At(row, p*n+k), c)
+				}
+				// D(eᵢ)eⱼ + eᵢD(eⱼ) has component p equal to
+				// Σ_q D(q, i)(e_q eⱼ)(p) + D(q, j)(eᵢ e_q)(p)
+				for q := 0; q < n; q++ {
+					sys.At(row, q*n+i).Sub(sys.At(row, q*n+i), prod[q][j][p])
+					sys.At(row, q*n+j).Sub(sys.At(row, q*n+j), prod[i][q][p])
+				}
+			}
+		}
+	}
+	var basis []*RatMatrix
+	for _, v := range sys.Kernel() {
+		d := NewRatMatrix(n, n)
+		for k, x := range v {
+			d.At(k/n, k%n).Set(x)
+		}
+		basis = append(basis, d)
+	}
+	return basis
+}
+
+// IsDerivation returns true if the matrix d is a derivation of the algebra
+// impl, so that d(xy) = d(x)y + xd(y) for all x and y.
+func IsDerivation(impl *Impl, d *RatMatrix) bool {
+	n := impl.Dim
+	mul := impl.Ops["Mul"]
+	prod := products(impl)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			x, y := d.Apply(unit(n, i)), d.Apply(unit(n, j))
+			s := mul(x, unit(n, j))
+			for k, c := range mul(unit(n, i), y) {
+				s[k].Add(s[k], c)
+			}
+			if !equalRats(d.Apply(prod[i][j]), s) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// IsAutomorphism returns true if the matrix m is an automorphism of the
+// algebra impl: an invertible linear map with m(xy) = m(x)m(y) for all x and
+// y. The exponential of a nilpotent derivation, such as the commutator with
+// the null Cockle value i+t, is an automorphism.
+func IsAutomorphism(impl *Impl, m *RatMatrix) bool {
+	n := impl.Dim
+	if m.Det().Sign() == 0 {
+		return false
+	}
+	mul := impl.Ops["Mul"]
+	prod := products(impl)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if !equalRats(m.Apply(prod[i][j]), mul(m.Apply(unit(n, i)), m.Apply(unit(n, j)))) {
+				return false
+			}
+		}
+	}
+	return true
+}
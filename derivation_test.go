@@ -0,0 +1,71 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestDerivations(t *testing.T) {
+	for _, test := range []struct {
+		impl *Impl
+		dim  int
+	}{
+		{ComplexImpl(), 0},
+		{InfraImpl(), 1},
+		{HamiltonImpl(), 3},
+		{CockleImpl(), 3},
+		{CayleyImpl(), 14},
+		{ZornImpl(), 14},
+	} {
+		basis := Derivations(test.impl)
+		if len(basis) != test.dim {
+			t.Errorf("%s has %d derivations, want %d", test.impl.Name, len(basis), test.dim)
+		}
+		for i, d := range basis {
+			if !IsDerivation(test.impl, d) {
+				t.Errorf("%v is not a derivation of %s", d, test.impl.Name)
+			}
+			for _, e := range basis[:i] {
+				c := new(RatMatrix).Sub(new(RatMatrix).Mul(d, e), new(RatMatrix).Mul(e, d))
+				if !IsDerivation(test.impl, c) {
+					t.Errorf("the commutator of %v and %v is not a derivation of %s", d, e, test.impl.Name)
+				}
+			}
+		}
+	}
+}
+
+func TestDerivationExp(t *testing.T) {
+	impl := CockleImpl()
+	n := impl.Dim
+	// the commutator with the null value i+t is a nilpotent derivation
+	x := []*big.Rat{big.NewRat(0, 1), big.NewRat(1, 1), big.NewRat(1, 1), big.NewRat(0, 1)}
+	d := NewRatMatrix(n, n)
+	for j := 0; j < n; j++ {
+		xy, yx := impl.Ops["Mul"](x, unit(n, j)), impl.Ops["Mul"](unit(n, j), x)
+		for i := 0; i < n; i++ {
+			d.At(i, j).Sub(xy[i], yx[i])
+		}
+	}
+	if !IsDerivation(impl, d) {
+		t.Fatalf("%v is not a derivation of Cockle", d)
+	}
+	exp, term := IdentityRatMatrix(n), IdentityRatMatrix(n)
+	for k := 1; k <= n; k++ {
+		term.Mul(term, d)
+		term.Scal(term, big.NewRat(1, int64(k)))
+		exp.Add(exp, term)
+	}
+	if !term.Equals(NewRatMatrix(n, n)) {
+		t.Fatalf("%v is not nilpotent", d)
+	}
+	if !IsAutomorphism(impl, exp) {
+		t.Errorf("exp(%v) = %v is not an automorphism of Cockle", d, exp)
+	}
+	if IsAutomorphism(impl, NewRatMatrix(n, n)) {
+		t.Error("zero is an automorphism of Cockle")
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+// A Doubleable is a pointer type *S whose values can be doubled by the
+// Cayley-Dickson construction. Cayley satisfies it, and so does every
+// Double built from a Doubleable. Split types such as Perplex also satisfy
+// it, but their Quad is indefinite, so their doubles have non-zero values of
+// zero quadrance.
+type Doubleable[S any] interface {
+	*S
+	Number[*S]
+	Quad() *big.Rat
+}
+
+// A Double represents the Cayley-Dickson double of S, with values
+// 		(l, r) = l + r e
+// where l and r are S values. Multiplication follows Cayley:
+// 		(a, b)(c, d) = (ac - d*b, da + bc*)
+// Doubling Cayley gives the 16-dimensional Sedenion type, doubling that gives
+// the 32-dimensional trigintaduonions, and so on.
+type Double[S any, T Doubleable[S]] struct {
+	l, r S
+}
+
+// Sedenion is the Cayley-Dickson double of Cayley.
+type Sedenion = Double[Cayley, *Cayley]
+
+// NewDouble returns a pointer to the Double value l + r e.
+func NewDouble[S any, T Doubleable[S]](l, r T) *Double[S, T] {
+	z := new(Double[S, T])
+	T(&z.l).Set(l)
+	T(&z.r).Set(r)
+	return z
+}
+
+// NewSedenion returns a pointer to the Sedenion value l + r e.
+func NewSedenion(l, r *Cayley) *Sedenion {
+	return NewDouble[Cayley](l, r)
+}
+
+// Real returns the (rational) real part of z.
+func (z *Double[S, T]) Real() *big.Rat {
+	return T(&z.l).Real()
+}
+
+// Components returns the rational components of z as a slice, those of l
+// followed by those of r. The results alias z.
+func (z *Double[S, T]) Components() []*big.Rat {
+	return append(T(&z.l).Components(), T(&z.r).Components()...)
+}
+
+// String returns the string representation of a Double value. The units
+// beyond the real one are written e1, e2, and so on.
+func (z *Double[S, T]) String() string {
+	v := z.Components()
+	a := make([]string, 2*len(v)+1)
+	br := brackets()
+	a[0] = br[0]
+	a[1] = fmt.Sprintf("%v", v[0].RatString())
+	for i := 1; i < len(v); i++ {
+		if v[i].Sign() < 0 {
+			a[2*i] = fmt.Sprintf("%v", v[i].RatString())
+		} else {
+			a[2*i] = fmt.Sprintf("+%v", v[i].RatString())
+		}
+		a[2*i+1] = fmt.Sprintf("e%d", i)
+	}
+	a[len(a)-1] = br[1]
+	return strings.Join(a, "")
+}
+
+// Equals returns true if y and z are equal.
+func (z *Double[S, T]) Equals(y *Double[S, T]) bool {
+	return T(&z.l).Equals(&y.l) && T(&z.r).Equals(&y.r)
+}
+
+// IsReal returns true if all the non-real components of z are zero.
+func (z *Double[S, T]) IsReal() bool {
+	return T(&z.l).IsReal() && T(&z.r).Equals(new(S))
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *Double[S, T]) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
+// Set sets z equal to y, and returns z.
+func (z *Double[S, T]) Set(y *Double[S, T]) *Double[S, T] {
+	T(&z.l).Set(&y.l)
+	T(&z.r).Set(&y.r)
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *Double[S, T]) Scal(y *Double[S, T], a *big.Rat) *Double[S, T] {
+	T(&z.l).Scal(&y.l, a)
+	T(&z.r).Scal(&y.r, a)
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *Double[S, T]) Neg(y *Double[S, T]) *Double[S, T] {
+	T(&z.l).Neg(&y.l)
+	T(&z.r).Neg(&y.r)
+	return z
+}
+
+// Conj sets z equal to the conjugate of y, and returns z.
+func (z *Double[S, T]) Conj(y *Double[S, T]) *Double[S, T] {
+	T(&z.l).Conj(&y.l)
+	T(&z.r).Neg(&y.r)
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *Double[S, T]) Add(x, y *Double[S, T]) *Double[S, T] {
+	T(&z.l).Add(&x.l, &y.l)
+	T(&z.r).Add(&x.r, &y.r)
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *Double[S, T]) Sub(x, y *Double[S, T]) *Double[S, T] {
+	T(&z.l).Sub(&x.l, &y.l)
+	T(&z.r).Sub(&x.r, &y.r)
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z.
+//
+// The multiplication rule is
+// 		Mul(l₁ + r₁e, l₂ + r₂e) = (l₁l₂ - r₂*r₁) + (r₂l₁ + r₁l₂*)e
+// Beyond Cayley, this multiplication is not alternative and has zero
+// divisors.
+func (z *Double[S, T]) Mul(x, y *Double[S, T]) *Double[S, T] {
+	a, b, c, d := T(&x.l), T(&x.r), T(&y.l), T(&y.r)
+	if z == x || z == y {
+		// z.l is written before z.r is computed
+		a, b = T(new(S)).Set(a), T(new(S)).Set(b)
+		c, d = T(new(S)).Set(c), T(new(S)).Set(d)
+	}
+	zl, zr := T(&z.l), T(&z.r)
+	temp := T(new(S))
+	zl.Sub(
+		zl.Mul(a, c),
+		temp.Mul(temp.Conj(d), b),
+	)
+	zr.Add(
+		zr.Mul(d, a),
+		temp.Mul(b, temp.Conj(c)),
+	)
+	return z
+}
+
+// Quad returns the quadrance of z. If z = l + r e, then
+// 		Quad(z) = Quad(l) + Quad(r)
+// For doubles of Cayley this is the sum of the squares of the components,
+// but for doubles of split types such as Perplex it is indefinite. Beyond
+// Cayley, Quad is not multiplicative.
+func (z *Double[S, T]) Quad() *big.Rat {
+	return new(big.Rat).Add(
+		T(&z.l).Quad(),
+		T(&z.r).Quad(),
+	)
+}
+
+// LeftMul returns the operator of left multiplication by z.
+func (z *Double[S, T]) LeftMul() *Operator {
+	x := new(Double[S, T]).Set(z)
+	n := len(z.Components())
+	return &Operator{n, func(v []*big.Rat) []*big.Rat {
+		y := new(Double[S, T])
+		for i, c := range y.Components() {
+			c.Set(v[i])
+		}
+		return y.Mul(x, y).Components()
+	}}
+}
+
+// IsZeroDivisor returns true if z is a zero divisor, that is, if there is a
+// non-zero y with zy = 0. Beyond Cayley there are non-zero zero divisors, such
+// as e3 + e10 in the sedenions, so IsZeroDivisor checks whether the matrix of
+// left multiplication by z is singular.
+func (z *Double[S, T]) IsZeroDivisor() bool {
+	return RatMatrixOf(z.LeftMul().Matrix()).Det().Sign() == 0
+}
+
+// Inv sets z equal to the inverse of y, and returns z. The inverse is
+// Conj(y)/Quad(y), which is a two-sided inverse even for zero divisors of
+// non-zero quadrance. If y is zero, or if Quad(y) is zero, as for the non-zero
+// isotropic values of the doubles of split types such as Perplex, then Inv
+// panics.
+func (z *Double[S, T]) Inv(y *Double[S, T]) *Double[S, T] {
+	if zero := new(Double[S, T]); y.Equals(zero) {
+		panic(denominatorError("inverse of zero"))
+	}
+	a := y.Quad()
+	if a.Sign() == 0 {
+		panic(divisorError("inverse of zero divisor"))
+	}
+	a.Inv(a)
+	return z.Scal(z.Conj(y), a)
+}
+
+// Generate returns a random Double value for quick.Check testing.
+func (z *Double[S, T]) Generate(rand *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(new(Double[S, T]).Random(rand, generateOptions))
+}
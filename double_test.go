@@ -0,0 +1,114 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"testing/quick"
+)
+
+var (
+	_ Number[*Sedenion]                    = new(Sedenion)
+	_ Number[*Double[Sedenion, *Sedenion]] = new(Double[Sedenion, *Sedenion])
+)
+
+// doubleUnit returns the i-th basis unit of the Double type of z.
+func doubleUnit[S any, T Doubleable[S]](z *Double[S, T], i int) *Double[S, T] {
+	u := new(Double[S, T])
+	u.Components()[i].SetInt64(1)
+	return u
+}
+
+func TestSedenionMulInv(t *testing.T) {
+	f := func(x *Sedenion) bool {
+		// t.Logf("x = %v", x)
+		one := new(Sedenion)
+		one.Real().SetInt64(1)
+		inv := new(Sedenion).Inv(x)
+		return new(Sedenion).Mul(x, inv).Equals(one) &&
+			new(Sedenion).Mul(inv, x).Equals(one)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSedenionMulConjQuad(t *testing.T) {
+	f := func(x *Sedenion) bool {
+		// t.Logf("x = %v", x)
+		p := new(Sedenion).Mul(x, new(Sedenion).Conj(x))
+		return p.IsReal() && p.Real().Cmp(x.Quad()) == 0
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSedenionZeroDivisor(t *testing.T) {
+	z := new(Sedenion)
+	x := z.Add(doubleUnit(z, 3), doubleUnit(z, 10))
+	if !x.IsZeroDivisor() {
+		t.Fatalf("%v is not a zero divisor", x)
+	}
+	kernel := RatMatrixOf(x.LeftMul().Matrix()).Kernel()
+	if len(kernel) == 0 {
+		t.Fatalf("no kernel for %v", x)
+	}
+	y := new(Sedenion)
+	for i, c := range y.Components() {
+		c.Set(kernel[0][i])
+	}
+	if p := new(Sedenion).Mul(x, y); !p.Equals(new(Sedenion)) {
+		t.Errorf("Mul(%v, %v) = %v, want 0", x, y, p)
+	}
+	if x.Quad().Cmp(big.NewRat(2, 1)) != 0 {
+		t.Errorf("Quad(%v) = %v, want 2", x, x.Quad())
+	}
+	if w := new(Sedenion).Add(doubleUnit(z, 3), doubleUnit(z, 4)); w.IsZeroDivisor() {
+		t.Errorf("%v is a zero divisor", w)
+	}
+}
+
+func TestTrigintaduonion(t *testing.T) {
+	type T32 = Double[Sedenion, *Sedenion]
+	z := new(T32)
+	if n := len(z.Components()); n != 32 {
+		t.Fatalf("len(Components) = %d, want 32", n)
+	}
+	for i := 1; i < 32; i++ {
+		u := doubleUnit(z, i)
+		if p := new(T32).Mul(u, u); !p.IsReal() || p.Real().Cmp(big.NewRat(-1, 1)) != 0 {
+			t.Errorf("Mul(%v, %v) = %v, want -1", u, u, p)
+		}
+	}
+	x := new(T32).Add(doubleUnit(z, 3), doubleUnit(z, 10))
+	if !x.IsZeroDivisor() {
+		t.Errorf("%v is not a zero divisor", x)
+	}
+	if s := doubleUnit(z, 17).String(); !strings.Contains(s, "+1e17+0e18") {
+		t.Errorf("String = %q", s)
+	}
+}
+
+func TestDoubleInvIsotropic(t *testing.T) {
+	type SplitDouble = Double[Perplex, *Perplex]
+	// s + e has Quad(s) + Quad(1) = -1 + 1 = 0
+	y := NewDouble[Perplex](
+		NewPerplex(big.NewRat(0, 1), big.NewRat(1, 1)),
+		NewPerplex(big.NewRat(1, 1), big.NewRat(0, 1)),
+	)
+	if y.Quad().Sign() != 0 {
+		t.Fatalf("Quad(%v) = %v, want 0", y, y.Quad())
+	}
+	defer func() {
+		err, _ := recover().(error)
+		if !errors.Is(err, ErrZeroDivisor) || errors.Is(err, ErrZeroDenominator) {
+			t.Errorf("Inv(%v) panicked with %v", y, err)
+		}
+	}()
+	new(SplitDouble).Inv(y)
+}
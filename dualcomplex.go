@@ -5,6 +5,7 @@ package rational
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"reflect"
@@ -18,23 +19,37 @@ type DualComplex struct {
 	l, r Complex
 }
 
-// Real returns the (rational) real part of z.
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
 func (z *DualComplex) Real() *big.Rat {
 	return (&z.l).Real()
 }
 
-// Rats returns the four rational components of z.
+// Rats returns the four rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
 func (z *DualComplex) Rats() (*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
 	return &z.l.l, &z.l.r, &z.r.l, &z.r.r
 }
 
+// RatsCopy returns copies of the four rational components of z.
+func (z *DualComplex) RatsCopy() (*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
+	return new(DualComplex).Set(z).Rats()
+}
+
+// Components returns the four rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *DualComplex) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
 // String returns the string representation of a DualComplex value.
 func (z *DualComplex) String() string {
 	v := make([]*big.Rat, 4)
 	v[0], v[1] = z.l.Rats()
 	v[2], v[3] = z.r.Rats()
 	a := make([]string, 9)
-	a[0] = leftBracket
+	br := brackets()
+	a[0] = br[0]
 	a[1] = fmt.Sprintf("%v", v[0].RatString())
 	i := 1
 	for j := 2; j < 8; j = j + 2 {
@@ -46,7 +61,7 @@ func (z *DualComplex) String() string {
 		a[j+1] = symbDualComplex[i]
 		i++
 	}
-	a[8] = rightBracket
+	a[8] = br[1]
 	return strings.Join(a, "")
 }
 
@@ -58,6 +73,16 @@ func (z *DualComplex) Equals(y *DualComplex) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *DualComplex) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Complex))
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *DualComplex) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *DualComplex) Set(y *DualComplex) *DualComplex {
 	z.l.Set(&y.l)
@@ -89,14 +114,19 @@ func (z *DualComplex) Neg(y *DualComplex) *DualComplex {
 	return z
 }
 
-// Conj sets z equal to the conjugate of y, and returns z.
+// Conj sets z equal to the conjugate of y, and returns z. This operation
+// changes the sign of all the components with Γ, and fixes the subalgebra of
+// values a+bi, a copy of Complex.
 func (z *DualComplex) Conj(y *DualComplex) *DualComplex {
 	z.l.Set(&y.l)
 	z.r.Neg(&y.r)
 	return z
 }
 
-// Star sets z equal to the star conjugate of y, and returns z.
+// Star sets z equal to the star conjugate of y, and returns z. This operation
+// changes the sign of all the components with i, and fixes the subalgebra of
+// values a+bΓ, a copy of Infra. Its composition with Conj changes the signs of
+// i and Γ, and fixes the values a+biΓ, another copy of Infra.
 func (z *DualComplex) Star(y *DualComplex) *DualComplex {
 	z.l.Conj(&y.l)
 	z.r.Conj(&y.r)
@@ -124,12 +154,26 @@ func (z *DualComplex) Sub(x, y *DualComplex) *DualComplex {
 // 		Mul(Γ, Γ) = 0
 // 		Mul(i, Γ) = Mul(Γ, i)
 // This binary operation is commutative and associative.
-func (z *DualComplex) Mul(x, y *DualComplex) *DualComplex {
-	a := new(Complex).Set(&x.l)
-	b := new(Complex).Set(&x.r)
-	c := new(Complex).Set(&y.l)
-	d := new(Complex).Set(&y.r)
-	temp := new(Complex)
+func (z *DualComplex) Mul(x, y *DualComplex) (product *DualComplex) {
+	if h := currentHook(); h != nil {
+		x, y := new(DualComplex).Set(x), new(DualComplex).Set(y)
+		defer func() { traceMul(h, "DualComplex.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	a, b, c, d := &x.l, &x.r, &y.l, &y.r
+	if z == x || z == y {
+		// z.l is written before z.r is computed
+		a, b = complexArena.get().Set(a), complexArena.get().Set(b)
+		c, d = complexArena.get().Set(c), complexArena.get().Set(d)
+		defer complexArena.put(a, b, c, d)
+	}
+	temp := complexArena.get()
+	defer complexArena.put(temp)
 	z.l.Mul(a, c)
 	z.r.Add(
 		z.r.Mul(a, d),
@@ -142,7 +186,7 @@ func (z *DualComplex) Mul(x, y *DualComplex) *DualComplex {
 // 		a² - b² + 2abi
 // Note that this is a complex number.
 func (z *DualComplex) Quad() *Complex {
-	quad := new(Complex)
+	quad := complexArena.get()
 	return quad.Mul(&z.l, &z.l)
 }
 
@@ -161,11 +205,16 @@ func (z *DualComplex) IsZeroDivisor() bool {
 
 // Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
 // then Inv panics.
-func (z *DualComplex) Inv(y *DualComplex) *DualComplex {
+func (z *DualComplex) Inv(y *DualComplex) (inverse *DualComplex) {
+	if h := currentHook(); h != nil {
+		y := new(DualComplex).Set(y)
+		defer func() { traceInv(h, "DualComplex.Inv", inverse != nil, z, y) }()
+	}
 	if y.IsZeroDivisor() {
-		panic("inverse of zero divisor")
+		panic(divisorError("inverse of zero divisor"))
 	}
 	quad := y.Quad()
+	defer complexArena.put(quad)
 	quad.Inv(quad)
 	z.Conj(y)
 	z.l.Mul(&z.l, quad)
@@ -177,7 +226,7 @@ func (z *DualComplex) Inv(y *DualComplex) *DualComplex {
 // Quo panics.
 func (z *DualComplex) Quo(x, y *DualComplex) *DualComplex {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(z.Inv(y), x)
 }
@@ -211,17 +260,146 @@ func (z *DualComplex) Möbius(y, a, b, c, d *DualComplex) *DualComplex {
 	return z.Mul(z, temp)
 }
 
+// LeftMul returns the linear operator that sends y to Mul(z, y), acting on
+// the rational components of y.
+func (z *DualComplex) LeftMul() *Operator {
+	a := new(DualComplex).Set(z)
+	return &Operator{4, func(v []*big.Rat) []*big.Rat {
+		y := NewDualComplex(v[0], v[1], v[2], v[3])
+		return rats(y.Mul(a, y).Rats())
+	}}
+}
+
+// RightMul returns the linear operator that sends y to Mul(y, z), acting on
+// the rational components of y.
+func (z *DualComplex) RightMul() *Operator {
+	a := new(DualComplex).Set(z)
+	return &Operator{4, func(v []*big.Rat) []*big.Rat {
+		y := NewDualComplex(v[0], v[1], v[2], v[3])
+		return rats(y.Mul(y, a).Rats())
+	}}
+}
+
+// WriteTo writes a canonical encoding of z to w, and returns the number of
+// bytes written. Equal values produce equal encodings, so any hash.Hash can be
+// used as w to hash z without building an intermediate byte slice.
+func (z *DualComplex) WriteTo(w io.Writer) (int64, error) {
+	return writeRats(w, rats(z.Rats()))
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// the rational parameter t, and returns z. The curve is evaluated exactly with
+// the De Casteljau algorithm. If p is empty, then Bezier panics.
+func (z *DualComplex) Bezier(t *big.Rat, p ...*DualComplex) *DualComplex {
+	if len(p) == 0 {
+		panic("no control points")
+	}
+	s := new(big.Rat).Sub(big.NewRat(1, 1), t)
+	b := make([]*DualComplex, len(p))
+	for i := range p {
+		b[i] = new(DualComplex).Set(p[i])
+	}
+	temp := new(DualComplex)
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			b[i].Add(b[i].Scal(b[i], s), temp.Scal(b[i+1], t))
+		}
+	}
+	return z.Set(b[0])
+}
+
+// Round sets z equal to y with each component rounded to a multiple of 1/den
+// in the direction given by mode, and returns z. Directed modes round every
+// component the same way, so interval bounds stay rigorous. If den is not
+// positive, then Round panics.
+func (z *DualComplex) Round(y *DualComplex, den *big.Int, mode big.RoundingMode) *DualComplex {
+	z.Set(y)
+	for _, c := range rats(z.Rats()) {
+		roundRat(c, c, den, mode)
+	}
+	return z
+}
+
+// Act returns the left action of z on the pair (a, b) of Complex values, which
+// is identified with the DualComplex value a + bΓ and multiplied on the left
+// by z. DualComplex is commutative and associative, so Act is a representation
+// of DualComplex on pairs of Complex values. Since Γ is nilpotent, the pairs
+// (0, b) form an invariant line, on which z acts by its first half.
+func (z *DualComplex) Act(a, b *Complex) (*Complex, *Complex) {
+	v := new(DualComplex)
+	v.l.Set(a)
+	v.r.Set(b)
+	v.Mul(z, v)
+	return &v.l, &v.r
+}
+
+// SolveCrossRatio sets z equal to the point y such that the cross-ratio of v,
+// w, x, and y is c, and returns z. With A = Inv(w - x) * (v - x) and
+// B = Inv(A) * c, the point is
+// 		(v*B - w) * Inv(B - 1)
+// If the point is not uniquely determined, then SolveCrossRatio panics.
+func (z *DualComplex) SolveCrossRatio(v, w, x, c *DualComplex) *DualComplex {
+	one := new(DualComplex)
+	one.Real().SetInt64(1)
+	b := new(DualComplex).Sub(v, x)
+	b.Mul(new(DualComplex).Inv(new(DualComplex).Sub(w, x)), b)
+	b.Mul(b.Inv(b), c)
+	temp := new(DualComplex).Sub(b, one)
+	temp.Inv(temp)
+	z.Mul(v, b)
+	z.Sub(z, w)
+	return z.Mul(z, temp)
+}
+
+// Exp sets z equal to the exponential of y, and returns z. The exponential
+// series terminates because y is nilpotent. If y is not nilpotent, then Exp
+// panics.
+func (z *DualComplex) Exp(y *DualComplex) *DualComplex {
+	if !y.IsZeroDivisor() {
+		panic("exponential of non-nilpotent")
+	}
+	zero := new(DualComplex)
+	sum, term := new(DualComplex), new(DualComplex)
+	sum.Real().SetInt64(1)
+	term.Real().SetInt64(1)
+	for k := int64(1); ; k++ {
+		term.Mul(term, y)
+		if term.Equals(zero) {
+			break
+		}
+		term.Scal(term, big.NewRat(1, k))
+		sum.Add(sum, term)
+	}
+	return z.Set(sum)
+}
+
+// Log sets z equal to the logarithm of y, and returns z. This is the inverse
+// of Exp: the logarithmic series terminates because y - 1 is nilpotent. If y -
+// 1 is not nilpotent, then Log panics.
+func (z *DualComplex) Log(y *DualComplex) *DualComplex {
+	one := new(DualComplex)
+	one.Real().SetInt64(1)
+	n := new(DualComplex).Sub(y, one)
+	if !n.IsZeroDivisor() {
+		panic("logarithm of non-unipotent")
+	}
+	zero := new(DualComplex)
+	sum, term := new(DualComplex), new(DualComplex).Set(one)
+	for k := int64(1); ; k++ {
+		term.Mul(term, n)
+		if term.Equals(zero) {
+			break
+		}
+		c := big.NewRat(1, k)
+		if k%2 == 0 {
+			c.Neg(c)
+		}
+		sum.Add(sum, new(DualComplex).Scal(term, c))
+	}
+	return z.Set(sum)
+}
+
 // Generate returns a random DualComplex value for quick.Check testing.
 func (z *DualComplex) Generate(rand *rand.Rand, size int) reflect.Value {
-	randomDualComplex := &DualComplex{
-		*NewComplex(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-		*NewComplex(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-	}
-	return reflect.ValueOf(randomDualComplex)
+	return reflect.ValueOf(new(DualComplex).Random(rand, generateOptions))
 }
@@ -341,3 +341,69 @@ func TestDualComplexComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Scalar operands
+
+func TestDualComplexMulReal(t *testing.T) {
+	f := func(x, y *DualComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(big.Rat).Set(y.Real())
+		s := new(DualComplex)
+		s.Real().Set(a)
+		if !s.IsReal() || x.IsReal() {
+			return false
+		}
+		l, r := new(DualComplex), new(DualComplex)
+		l.Mul(s, x)
+		r.Mul(x, s)
+		// an independent product: s x = (s + x)x - x x, with no real factor
+		xx := new(DualComplex).Mul(x, x)
+		sx := new(DualComplex).Add(s, x)
+		return l.Equals(new(DualComplex).Sub(new(DualComplex).Mul(sx, x), xx)) &&
+			r.Equals(new(DualComplex).Sub(new(DualComplex).Mul(x, sx), xx))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDualComplexIsPure(t *testing.T) {
+	f := func(x *DualComplex) bool {
+		// t.Logf("x = %v", x)
+		p := new(DualComplex).Set(x)
+		p.Real().SetInt64(0)
+		return p.IsPure() && !x.IsPure()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Exponential
+
+func TestDualComplexLogExp(t *testing.T) {
+	f := func(x *DualComplex) bool {
+		// t.Logf("x = %v", x)
+		x.l = Complex{}
+		l := new(DualComplex).Exp(x)
+		l.Log(l)
+		return l.Equals(x)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDualComplexExpAdd(t *testing.T) {
+	f := func(x, y *DualComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		x.l = Complex{}
+		y.l = Complex{}
+		l := new(DualComplex).Exp(new(DualComplex).Add(x, y))
+		r := new(DualComplex).Mul(new(DualComplex).Exp(x), new(DualComplex).Exp(y))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,366 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+var symbDualHamilton = [8]string{"", "i", "j", "k", "Γ", "iΓ", "jΓ", "kΓ"}
+
+// A DualHamilton represents a rational dual quaternion: a Hamilton value with
+// dual numbers as its scalars. The dual unit Γ commutes with i, j, and k, and
+// squares to zero. Dual quaternions describe rigid motions of space; see
+// NewDualHamiltonMotion.
+type DualHamilton struct {
+	l, r Hamilton
+}
+
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
+func (z *DualHamilton) Real() *big.Rat {
+	return (&z.l).Real()
+}
+
+// Rats returns the eight rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
+func (z *DualHamilton) Rats() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
+	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
+	return &z.l.l.l, &z.l.l.r, &z.l.r.l, &z.l.r.r,
+		&z.r.l.l, &z.r.l.r, &z.r.r.l, &z.r.r.r
+}
+
+// RatsCopy returns copies of the eight rational components of z.
+func (z *DualHamilton) RatsCopy() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
+	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
+	return new(DualHamilton).Set(z).Rats()
+}
+
+// Components returns the eight rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *DualHamilton) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
+// String returns the string representation of a DualHamilton value.
+//
+// If z corresponds to a + bi + cj + dk + eΓ + fiΓ + gjΓ + hkΓ, then the
+// string is "(a+bi+cj+dk+eΓ+fiΓ+gjΓ+hkΓ)", similar to complex128 values.
+func (z *DualHamilton) String() string {
+	v := z.Components()
+	a := make([]string, 17)
+	br := brackets()
+	a[0] = br[0]
+	a[1] = fmt.Sprintf("%v", v[0].RatString())
+	i := 1
+	for j := 2; j < 16; j = j + 2 {
+		if v[i].Sign() < 0 {
+			a[j] = fmt.Sprintf("%v", v[i].RatString())
+		} else {
+			a[j] = fmt.Sprintf("+%v", v[i].RatString())
+		}
+		a[j+1] = symbDualHamilton[i]
+		i++
+	}
+	a[16] = br[1]
+	return strings.Join(a, "")
+}
+
+// Equals returns true if y and z are equal.
+func (z *DualHamilton) Equals(y *DualHamilton) bool {
+	if !z.l.Equals(&y.l) || !z.r.Equals(&y.r) {
+		return false
+	}
+	return true
+}
+
+// IsReal returns true if all the non-real components of z are zero.
+func (z *DualHamilton) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Hamilton))
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *DualHamilton) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
+// Set sets z equal to y, and returns z.
+func (z *DualHamilton) Set(y *DualHamilton) *DualHamilton {
+	z.l.Set(&y.l)
+	z.r.Set(&y.r)
+	return z
+}
+
+// NewDualHamilton returns a pointer to the DualHamilton value
+// a+bi+cj+dk+eΓ+fiΓ+gjΓ+hkΓ.
+func NewDualHamilton(a, b, c, d, e, f, g, h *big.Rat) *DualHamilton {
+	z := new(DualHamilton)
+	z.l.l.l.Set(a)
+	z.l.l.r.Set(b)
+	z.l.r.l.Set(c)
+	z.l.r.r.Set(d)
+	z.r.l.l.Set(e)
+	z.r.l.r.Set(f)
+	z.r.r.l.Set(g)
+	z.r.r.r.Set(h)
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *DualHamilton) Scal(y *DualHamilton, a *big.Rat) *DualHamilton {
+	z.l.Scal(&y.l, a)
+	z.r.Scal(&y.r, a)
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *DualHamilton) Neg(y *DualHamilton) *DualHamilton {
+	z.l.Neg(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Conj sets z equal to the conjugate of y, and returns z. If y = p+qΓ, then
+// the conjugate is Conj(p)+Conj(q)Γ.
+func (z *DualHamilton) Conj(y *DualHamilton) *DualHamilton {
+	z.l.Conj(&y.l)
+	z.r.Conj(&y.r)
+	return z
+}
+
+// Star sets z equal to the star conjugate of y, and returns z. If y = p+qΓ,
+// then the star conjugate is p-qΓ.
+func (z *DualHamilton) Star(y *DualHamilton) *DualHamilton {
+	z.l.Set(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *DualHamilton) Add(x, y *DualHamilton) *DualHamilton {
+	z.l.Add(&x.l, &y.l)
+	z.r.Add(&x.r, &y.r)
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *DualHamilton) Sub(x, y *DualHamilton) *DualHamilton {
+	z.l.Sub(&x.l, &y.l)
+	z.r.Sub(&x.r, &y.r)
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z.
+//
+// The multiplication rules are:
+// 		Mul(i, i) = Mul(j, j) = Mul(k, k) = -1
+// 		Mul(Γ, Γ) = 0
+// 		Mul(i, j) = -Mul(j, i) = +k
+// 		Mul(i, k) = -Mul(k, i) = -j
+// 		Mul(j, k) = -Mul(k, j) = +i
+// 		Mul(i, Γ) = Mul(Γ, i)
+// 		Mul(j, Γ) = Mul(Γ, j)
+// 		Mul(k, Γ) = Mul(Γ, k)
+// This binary operation is noncommutative but associative. As rigid motions,
+// Mul(x, y) moves by y first, and then by x.
+func (z *DualHamilton) Mul(x, y *DualHamilton) (product *DualHamilton) {
+	if h := currentHook(); h != nil {
+		x, y := new(DualHamilton).Set(x), new(DualHamilton).Set(y)
+		defer func() { traceMul(h, "DualHamilton.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	a, b, c, d := &x.l, &x.r, &y.l, &y.r
+	if z == x || z == y {
+		// z.l is written before z.r is computed
+		a, b = hamiltonArena.get().Set(a), hamiltonArena.get().Set(b)
+		c, d = hamiltonArena.get().Set(c), hamiltonArena.get().Set(d)
+		defer hamiltonArena.put(a, b, c, d)
+	}
+	temp := hamiltonArena.get()
+	defer hamiltonArena.put(temp)
+	z.l.Mul(a, c)
+	z.r.Add(
+		z.r.Mul(a, d),
+		temp.Mul(b, c),
+	)
+	return z
+}
+
+// IsZeroDivisor returns true if z is a zero divisor. This is equivalent to z
+// being a multiple of Γ.
+func (z *DualHamilton) IsZeroDivisor() bool {
+	zero := new(Hamilton)
+	return z.l.Equals(zero)
+}
+
+// Inv sets z equal to the inverse of y, and returns z. If y = p+qΓ, then the
+// inverse is
+// 		Inv(p) - Inv(p)qInv(p)Γ
+// If y is a zero divisor, then Inv panics.
+func (z *DualHamilton) Inv(y *DualHamilton) (inverse *DualHamilton) {
+	if h := currentHook(); h != nil {
+		y := new(DualHamilton).Set(y)
+		defer func() { traceInv(h, "DualHamilton.Inv", inverse != nil, z, y) }()
+	}
+	if y.IsZeroDivisor() {
+		panic(divisorError("inverse of zero divisor"))
+	}
+	p := new(Hamilton).Inv(&y.l)
+	q := new(Hamilton).Mul(p, &y.r)
+	q.Mul(q, p)
+	z.l.Set(p)
+	z.r.Neg(q)
+	return z
+}
+
+// NewDualHamiltonMotion returns a pointer to the DualHamilton value
+// 		r + trΓ/2
+// which represents the rigid motion that rotates by r and then translates by
+// the pure quaternion t:
+// 		x ↦ r x Inv(r) + t
+// The rotation r need not have quadrance one, so every rotation with a
+// rational matrix has an exact representative. If r is zero, then
+// NewDualHamiltonMotion panics.
+func NewDualHamiltonMotion(r, t *Hamilton) *DualHamilton {
+	if r.Equals(new(Hamilton)) {
+		panic(denominatorError("motion with zero rotation"))
+	}
+	z := new(DualHamilton)
+	z.l.Set(r)
+	z.r.Mul(t, r)
+	z.r.Scal(&z.r, big.NewRat(1, 2))
+	return z
+}
+
+// Rotation returns a copy of the rotation part r of z = r+qΓ, which acts on
+// points by x ↦ r x Inv(r).
+func (z *DualHamilton) Rotation() *Hamilton {
+	return new(Hamilton).Set(&z.l)
+}
+
+// Translation returns the translation t of the rigid motion z = r+qΓ:
+// 		t = 2qInv(r)
+// For a value of NewDualHamiltonMotion, this is the translation it was built
+// with. If z is a zero divisor, then Translation panics.
+func (z *DualHamilton) Translation() *Hamilton {
+	if z.IsZeroDivisor() {
+		panic(divisorError("translation of zero divisor"))
+	}
+	t := new(Hamilton).Mul(&z.r, new(Hamilton).Inv(&z.l))
+	return t.Scal(t, big.NewRat(2, 1))
+}
+
+// Transform returns the point x moved by the rigid motion z = r+qΓ, which is
+// the sandwich product
+// 		z(1 + xΓ)Conj(Star(z))/Quad(r) = 1 + (r x Inv(r) + t)Γ
+// with t = Translation(z). The real part of x is left unchanged. If z is a
+// zero divisor, then Transform panics.
+func (z *DualHamilton) Transform(x *Hamilton) *Hamilton {
+	if z.IsZeroDivisor() {
+		panic(divisorError("motion by zero divisor"))
+	}
+	p := new(DualHamilton)
+	p.l.Real().SetInt64(1)
+	p.r.Set(x)
+	w := new(DualHamilton).Conj(new(DualHamilton).Star(z))
+	p.Mul(z, p)
+	p.Mul(p, w)
+	n := z.l.Quad()
+	return p.r.Scal(&p.r, n.Inv(n))
+}
+
+// ScrewAxis returns the screw decomposition of the rigid motion z: every
+// motion is a rotation about an axis line followed by a slide along it. The
+// axis has direction u, the pure part of the rotation, and passes through p,
+// its point nearest the origin. The slide d is parallel to u. If r = s+u and
+// t = Translation(z), with t = d + t⊥ split along u, then
+// 		d = (t·u)u/Quad(u),	p = (t⊥ + s u×t⊥/Quad(u))/2
+// These are rational, as is the angle of rotation θ through tan(θ/2)² =
+// Quad(u)/s². If z is a pure translation, so that u is zero, or if z is a
+// zero divisor, then there is no axis and ScrewAxis returns false.
+func (z *DualHamilton) ScrewAxis() (p, u, d *Hamilton, ok bool) {
+	if z.IsZeroDivisor() || z.l.IsReal() {
+		return nil, nil, nil, false
+	}
+	s := z.l.Real()
+	u = new(Hamilton).Set(&z.l)
+	u.Real().SetInt64(0)
+	t := z.Translation()
+	t.Real().SetInt64(0)
+	n := u.Quad()
+	n.Inv(n)
+	// for pure quaternions, the dot product is -Real(tu)
+	dot := new(Hamilton).Mul(t, u).Real()
+	dot.Neg(dot)
+	d = new(Hamilton).Scal(u, dot.Mul(dot, n))
+	perp := new(Hamilton).Sub(t, d)
+	// for orthogonal pure quaternions, the cross product is their product
+	p = new(Hamilton).Mul(u, perp)
+	p.Scal(p, new(big.Rat).Mul(s, n))
+	p.Add(p, perp)
+	p.Scal(p, big.NewRat(1, 2))
+	return p, u, d, true
+}
+
+// Exp sets z equal to the exponential of y, and returns z. The exponential
+// series terminates because y is nilpotent. If y is not nilpotent, then Exp
+// panics.
+func (z *DualHamilton) Exp(y *DualHamilton) *DualHamilton {
+	if !y.IsZeroDivisor() {
+		panic("exponential of non-nilpotent")
+	}
+	zero := new(DualHamilton)
+	sum, term := new(DualHamilton), new(DualHamilton)
+	sum.Real().SetInt64(1)
+	term.Real().SetInt64(1)
+	for k := int64(1); ; k++ {
+		term.Mul(term, y)
+		if term.Equals(zero) {
+			break
+		}
+		term.Scal(term, big.NewRat(1, k))
+		sum.Add(sum, term)
+	}
+	return z.Set(sum)
+}
+
+// Log sets z equal to the logarithm of y, and returns z. This is the inverse
+// of Exp: the logarithmic series terminates because y - 1 is nilpotent. If y -
+// 1 is not nilpotent, then Log panics.
+func (z *DualHamilton) Log(y *DualHamilton) *DualHamilton {
+	one := new(DualHamilton)
+	one.Real().SetInt64(1)
+	n := new(DualHamilton).Sub(y, one)
+	if !n.IsZeroDivisor() {
+		panic("logarithm of non-unipotent")
+	}
+	zero := new(DualHamilton)
+	sum, term := new(DualHamilton), new(DualHamilton).Set(one)
+	for k := int64(1); ; k++ {
+		term.Mul(term, n)
+		if term.Equals(zero) {
+			break
+		}
+		c := big.NewRat(1, k)
+		if k%2 == 0 {
+			c.Neg(c)
+		}
+		sum.Add(sum, new(DualHamilton).Scal(term, c))
+	}
+	return z.Set(sum)
+}
+
+// Generate returns a random DualHamilton value for quick.Check testing.
+func (z *DualHamilton) Generate(rand *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(new(DualHamilton).Random(rand, generateOptions))
+}
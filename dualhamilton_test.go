@@ -0,0 +1,141 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// Associativity
+
+func TestDualHamiltonMulAssociative(t *testing.T) {
+	f := func(x, y, z *DualHamilton) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		l, r := new(DualHamilton), new(DualHamilton)
+		l.Mul(l.Mul(x, y), z)
+		r.Mul(x, r.Mul(y, z))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+// Identity
+
+func TestDualHamiltonMulInvOne(t *testing.T) {
+	f := func(x *DualHamilton) bool {
+		// t.Logf("x = %v", x)
+		if x.IsZeroDivisor() {
+			return true
+		}
+		one := new(DualHamilton)
+		one.Real().SetInt64(1)
+		l := new(DualHamilton).Mul(x, new(DualHamilton).Inv(x))
+		r := new(DualHamilton).Mul(new(DualHamilton).Inv(x), x)
+		return l.Equals(one) && r.Equals(one)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+// Rigid motions
+
+func TestDualHamiltonTransform(t *testing.T) {
+	f := func(r, v, x *Hamilton) bool {
+		// t.Logf("r = %v, v = %v, x = %v", r, v, x)
+		if r.Equals(new(Hamilton)) {
+			return true
+		}
+		v.Real().SetInt64(0)
+		m := NewDualHamiltonMotion(r, v)
+		want := new(Hamilton).Rotate(r, x)
+		want.Add(want, v)
+		return m.Transform(x).Equals(want) && m.Translation().Equals(v) &&
+			m.Rotation().Equals(r)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDualHamiltonComposition(t *testing.T) {
+	f := func(r, s, v, w, x *Hamilton) bool {
+		// t.Logf("r = %v, s = %v, v = %v, w = %v, x = %v", r, s, v, w, x)
+		if r.Equals(new(Hamilton)) || s.Equals(new(Hamilton)) {
+			return true
+		}
+		v.Real().SetInt64(0)
+		w.Real().SetInt64(0)
+		a, b := NewDualHamiltonMotion(r, v), NewDualHamiltonMotion(s, w)
+		ab := new(DualHamilton).Mul(a, b)
+		return ab.Transform(x).Equals(a.Transform(b.Transform(x)))
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDualHamiltonScrewAxis(t *testing.T) {
+	f := func(r, v *Hamilton) bool {
+		// t.Logf("r = %v, v = %v", r, v)
+		if r.IsReal() {
+			return true
+		}
+		v.Real().SetInt64(0)
+		m := NewDualHamiltonMotion(r, v)
+		p, u, d, ok := m.ScrewAxis()
+		if !ok {
+			return false
+		}
+		// points on the axis slide along it
+		q := new(Hamilton).Add(p, u)
+		if !m.Transform(p).Equals(new(Hamilton).Add(p, d)) ||
+			!m.Transform(q).Equals(new(Hamilton).Add(q, d)) {
+			return false
+		}
+		// d is parallel to u and p is orthogonal to it
+		return new(Hamilton).Mul(d, u).IsReal() && new(Hamilton).Mul(p, u).Real().Sign() == 0
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+	one, zero := big.NewRat(1, 1), new(big.Rat)
+	m := NewDualHamiltonMotion(NewHamilton(one, zero, zero, zero), NewHamilton(zero, one, zero, zero))
+	if _, _, _, ok := m.ScrewAxis(); ok {
+		t.Errorf("ScrewAxis of the translation %v found an axis", m)
+	}
+}
+
+// Exponential
+
+func TestDualHamiltonLogExp(t *testing.T) {
+	f := func(x *DualHamilton) bool {
+		// t.Logf("x = %v", x)
+		x.l = Hamilton{}
+		l := new(DualHamilton).Exp(x)
+		l.Log(l)
+		return l.Equals(x)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDualHamiltonExpAdd(t *testing.T) {
+	f := func(x, y *DualHamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		x.l = Hamilton{}
+		y.l = Hamilton{}
+		l := new(DualHamilton).Exp(new(DualHamilton).Add(x, y))
+		r := new(DualHamilton).Mul(new(DualHamilton).Exp(x), new(DualHamilton).Exp(y))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
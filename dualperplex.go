@@ -5,6 +5,7 @@ package rational
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"reflect"
@@ -18,23 +19,37 @@ type DualPerplex struct {
 	l, r Perplex
 }
 
-// Real returns the (rational) real part of z.
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
 func (z *DualPerplex) Real() *big.Rat {
 	return (&z.l).Real()
 }
 
-// Rats returns the four rational components of z.
+// Rats returns the four rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
 func (z *DualPerplex) Rats() (*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
 	return &z.l.l, &z.l.r, &z.r.l, &z.r.r
 }
 
+// RatsCopy returns copies of the four rational components of z.
+func (z *DualPerplex) RatsCopy() (*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
+	return new(DualPerplex).Set(z).Rats()
+}
+
+// Components returns the four rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *DualPerplex) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
 // String returns the string representation of a DualPerplex value.
 func (z *DualPerplex) String() string {
 	v := make([]*big.Rat, 4)
 	v[0], v[1] = z.l.Rats()
 	v[2], v[3] = z.r.Rats()
 	a := make([]string, 9)
-	a[0] = leftBracket
+	br := brackets()
+	a[0] = br[0]
 	a[1] = fmt.Sprintf("%v", v[0].RatString())
 	i := 1
 	for j := 2; j < 8; j = j + 2 {
@@ -46,7 +61,7 @@ func (z *DualPerplex) String() string {
 		a[j+1] = symbDualPerplex[i]
 		i++
 	}
-	a[8] = rightBracket
+	a[8] = br[1]
 	return strings.Join(a, "")
 }
 
@@ -58,6 +73,16 @@ func (z *DualPerplex) Equals(y *DualPerplex) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *DualPerplex) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Perplex))
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *DualPerplex) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *DualPerplex) Set(y *DualPerplex) *DualPerplex {
 	z.l.Set(&y.l)
@@ -89,14 +114,19 @@ func (z *DualPerplex) Neg(y *DualPerplex) *DualPerplex {
 	return z
 }
 
-// Conj sets z equal to the conjugate of y, and returns z.
+// Conj sets z equal to the conjugate of y, and returns z. This operation
+// changes the sign of all the components with Γ, and fixes the subalgebra of
+// values a+bs, a copy of Perplex.
 func (z *DualPerplex) Conj(y *DualPerplex) *DualPerplex {
 	z.l.Set(&y.l)
 	z.r.Neg(&y.r)
 	return z
 }
 
-// Star sets z equal to the star conjugate of y, and returns z.
+// Star sets z equal to the star conjugate of y, and returns z. This operation
+// changes the sign of all the components with s, and fixes the subalgebra of
+// values a+bΓ, a copy of Infra. Its composition with Conj changes the signs of
+// s and Γ, and fixes the values a+bsΓ, another copy of Infra.
 func (z *DualPerplex) Star(y *DualPerplex) *DualPerplex {
 	z.l.Conj(&y.l)
 	z.r.Conj(&y.r)
@@ -124,12 +154,26 @@ func (z *DualPerplex) Sub(x, y *DualPerplex) *DualPerplex {
 // 		Mul(Γ, Γ) = 0
 // 		Mul(s, Γ) = Mul(Γ, s)
 // This binary operation is commutative and associative.
-func (z *DualPerplex) Mul(x, y *DualPerplex) *DualPerplex {
-	a := new(Perplex).Set(&x.l)
-	b := new(Perplex).Set(&x.r)
-	c := new(Perplex).Set(&y.l)
-	d := new(Perplex).Set(&y.r)
-	temp := new(Perplex)
+func (z *DualPerplex) Mul(x, y *DualPerplex) (product *DualPerplex) {
+	if h := currentHook(); h != nil {
+		x, y := new(DualPerplex).Set(x), new(DualPerplex).Set(y)
+		defer func() { traceMul(h, "DualPerplex.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	a, b, c, d := &x.l, &x.r, &y.l, &y.r
+	if z == x || z == y {
+		// z.l is written before z.r is computed
+		a, b = perplexArena.get().Set(a), perplexArena.get().Set(b)
+		c, d = perplexArena.get().Set(c), perplexArena.get().Set(d)
+		defer perplexArena.put(a, b, c, d)
+	}
+	temp := perplexArena.get()
+	defer perplexArena.put(temp)
 	z.l.Mul(a, c)
 	z.r.Add(
 		z.r.Mul(a, d),
@@ -142,7 +186,7 @@ func (z *DualPerplex) Mul(x, y *DualPerplex) *DualPerplex {
 // 		a² + b² + 2abs
 // Note that this is a perplex number.
 func (z *DualPerplex) Quad() *Perplex {
-	quad := new(Perplex)
+	quad := perplexArena.get()
 	return quad.Mul(&z.l, &z.l)
 }
 
@@ -160,11 +204,16 @@ func (z *DualPerplex) IsZeroDivisor() bool {
 
 // Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
 // then Inv panics.
-func (z *DualPerplex) Inv(y *DualPerplex) *DualPerplex {
+func (z *DualPerplex) Inv(y *DualPerplex) (inverse *DualPerplex) {
+	if h := currentHook(); h != nil {
+		y := new(DualPerplex).Set(y)
+		defer func() { traceInv(h, "DualPerplex.Inv", inverse != nil, z, y) }()
+	}
 	if y.IsZeroDivisor() {
-		panic("inverse of zero divisor")
+		panic(divisorError("inverse of zero divisor"))
 	}
 	quad := y.Quad()
+	defer perplexArena.put(quad)
 	quad.Inv(quad)
 	z.Conj(y)
 	z.l.Mul(&z.l, quad)
@@ -176,7 +225,7 @@ func (z *DualPerplex) Inv(y *DualPerplex) *DualPerplex {
 // Quo panics.
 func (z *DualPerplex) Quo(x, y *DualPerplex) *DualPerplex {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(z.Inv(y), x)
 }
@@ -210,17 +259,154 @@ func (z *DualPerplex) Möbius(y, a, b, c, d *DualPerplex) *DualPerplex {
 	return z.Mul(z, temp)
 }
 
+// LeftMul returns the linear operator that sends y to Mul(z, y), acting on
+// the rational components of y.
+func (z *DualPerplex) LeftMul() *Operator {
+	a := new(DualPerplex).Set(z)
+	return &Operator{4, func(v []*big.Rat) []*big.Rat {
+		y := NewDualPerplex(v[0], v[1], v[2], v[3])
+		return rats(y.Mul(a, y).Rats())
+	}}
+}
+
+// RightMul returns the linear operator that sends y to Mul(y, z), acting on
+// the rational components of y.
+func (z *DualPerplex) RightMul() *Operator {
+	a := new(DualPerplex).Set(z)
+	return &Operator{4, func(v []*big.Rat) []*big.Rat {
+		y := NewDualPerplex(v[0], v[1], v[2], v[3])
+		return rats(y.Mul(y, a).Rats())
+	}}
+}
+
+// WriteTo writes a canonical encoding of z to w, and returns the number of
+// bytes written. Equal values produce equal encodings, so any hash.Hash can be
+// used as w to hash z without building an intermediate byte slice.
+func (z *DualPerplex) WriteTo(w io.Writer) (int64, error) {
+	return writeRats(w, rats(z.Rats()))
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// the rational parameter t, and returns z. The curve is evaluated exactly with
+// the De Casteljau algorithm. If p is empty, then Bezier panics.
+func (z *DualPerplex) Bezier(t *big.Rat, p ...*DualPerplex) *DualPerplex {
+	if len(p) == 0 {
+		panic("no control points")
+	}
+	s := new(big.Rat).Sub(big.NewRat(1, 1), t)
+	b := make([]*DualPerplex, len(p))
+	for i := range p {
+		b[i] = new(DualPerplex).Set(p[i])
+	}
+	temp := new(DualPerplex)
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			b[i].Add(b[i].Scal(b[i], s), temp.Scal(b[i+1], t))
+		}
+	}
+	return z.Set(b[0])
+}
+
+// Less returns true if z precedes y in the lexicographic order on the
+// rational components. This is a total order, compatible with Equals, so
+// DualPerplex values can be sorted deterministically.
+func (z *DualPerplex) Less(y *DualPerplex) bool {
+	return lexLess(rats(z.Rats()), rats(y.Rats()))
+}
+
+// Round sets z equal to y with each component rounded to a multiple of 1/den
+// in the direction given by mode, and returns z. Directed modes round every
+// component the same way, so interval bounds stay rigorous. If den is not
+// positive, then Round panics.
+func (z *DualPerplex) Round(y *DualPerplex, den *big.Int, mode big.RoundingMode) *DualPerplex {
+	z.Set(y)
+	for _, c := range rats(z.Rats()) {
+		roundRat(c, c, den, mode)
+	}
+	return z
+}
+
+// Act returns the left action of z on the pair (a, b) of Perplex values, which
+// is identified with the DualPerplex value a + bΓ and multiplied on the left
+// by z. DualPerplex is commutative and associative, so Act is a representation
+// of DualPerplex. The pairs (0, b) form an invariant line, since Γ is
+// nilpotent.
+func (z *DualPerplex) Act(a, b *Perplex) (*Perplex, *Perplex) {
+	v := new(DualPerplex)
+	v.l.Set(a)
+	v.r.Set(b)
+	v.Mul(z, v)
+	return &v.l, &v.r
+}
+
+// SolveCrossRatio sets z equal to the point y such that the cross-ratio of v,
+// w, x, and y is c, and returns z. With A = Inv(w - x) * (v - x) and
+// B = Inv(A) * c, the point is
+// 		(v*B - w) * Inv(B - 1)
+// If the point is not uniquely determined, then SolveCrossRatio panics.
+func (z *DualPerplex) SolveCrossRatio(v, w, x, c *DualPerplex) *DualPerplex {
+	one := new(DualPerplex)
+	one.Real().SetInt64(1)
+	b := new(DualPerplex).Sub(v, x)
+	b.Mul(new(DualPerplex).Inv(new(DualPerplex).Sub(w, x)), b)
+	b.Mul(b.Inv(b), c)
+	temp := new(DualPerplex).Sub(b, one)
+	temp.Inv(temp)
+	z.Mul(v, b)
+	z.Sub(z, w)
+	return z.Mul(z, temp)
+}
+
+// Exp sets z equal to the exponential of y, and returns z. The exponential
+// series terminates because y is nilpotent, that is, a multiple of Γ; zero
+// divisors such as 1+s are not nilpotent. If y is not nilpotent, then Exp
+// panics.
+func (z *DualPerplex) Exp(y *DualPerplex) *DualPerplex {
+	if !y.l.Equals(new(Perplex)) {
+		panic("exponential of non-nilpotent")
+	}
+	zero := new(DualPerplex)
+	sum, term := new(DualPerplex), new(DualPerplex)
+	sum.Real().SetInt64(1)
+	term.Real().SetInt64(1)
+	for k := int64(1); ; k++ {
+		term.Mul(term, y)
+		if term.Equals(zero) {
+			break
+		}
+		term.Scal(term, big.NewRat(1, k))
+		sum.Add(sum, term)
+	}
+	return z.Set(sum)
+}
+
+// Log sets z equal to the logarithm of y, and returns z. This is the inverse
+// of Exp: the logarithmic series terminates because y - 1 is nilpotent. If y -
+// 1 is not nilpotent, then Log panics.
+func (z *DualPerplex) Log(y *DualPerplex) *DualPerplex {
+	one := new(DualPerplex)
+	one.Real().SetInt64(1)
+	n := new(DualPerplex).Sub(y, one)
+	if !n.l.Equals(new(Perplex)) {
+		panic("logarithm of non-unipotent")
+	}
+	zero := new(DualPerplex)
+	sum, term := new(DualPerplex), new(DualPerplex).Set(one)
+	for k := int64(1); ; k++ {
+		term.Mul(term, n)
+		if term.Equals(zero) {
+			break
+		}
+		c := big.NewRat(1, k)
+		if k%2 == 0 {
+			c.Neg(c)
+		}
+		sum.Add(sum, new(DualPerplex).Scal(term, c))
+	}
+	return z.Set(sum)
+}
+
 // Generate returns a random DualPerplex value for quick.Check testing.
 func (z *DualPerplex) Generate(rand *rand.Rand, size int) reflect.Value {
-	randomDualPerplex := &DualPerplex{
-		*NewPerplex(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-		*NewPerplex(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-	}
-	return reflect.ValueOf(randomDualPerplex)
+	return reflect.ValueOf(new(DualPerplex).Random(rand, generateOptions))
 }
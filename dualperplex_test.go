@@ -341,3 +341,100 @@ func TestDualPerplexComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Scalar operands
+
+func TestDualPerplexMulReal(t *testing.T) {
+	f := func(x, y *DualPerplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(big.Rat).Set(y.Real())
+		s := new(DualPerplex)
+		s.Real().Set(a)
+		if !s.IsReal() || x.IsReal() {
+			return false
+		}
+		l, r := new(DualPerplex), new(DualPerplex)
+		l.Mul(s, x)
+		r.Mul(x, s)
+		// an independent product: s x = (s + x)x - x x, with no real factor
+		xx := new(DualPerplex).Mul(x, x)
+		sx := new(DualPerplex).Add(s, x)
+		return l.Equals(new(DualPerplex).Sub(new(DualPerplex).Mul(sx, x), xx)) &&
+			r.Equals(new(DualPerplex).Sub(new(DualPerplex).Mul(x, sx), xx))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDualPerplexIsPure(t *testing.T) {
+	f := func(x *DualPerplex) bool {
+		// t.Logf("x = %v", x)
+		p := new(DualPerplex).Set(x)
+		p.Real().SetInt64(0)
+		return p.IsPure() && !x.IsPure()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Ordering
+
+func TestDualPerplexLessTrichotomy(t *testing.T) {
+	f := func(x, y *DualPerplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		n := 0
+		for _, b := range []bool{x.Less(y), y.Less(x), x.Equals(y)} {
+			if b {
+				n++
+			}
+		}
+		return n == 1 && !x.Less(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDualPerplexLessTransitive(t *testing.T) {
+	f := func(x, y, z *DualPerplex) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		if x.Less(y) && y.Less(z) {
+			return x.Less(z)
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Exponential
+
+func TestDualPerplexLogExp(t *testing.T) {
+	f := func(x *DualPerplex) bool {
+		// t.Logf("x = %v", x)
+		x.l = Perplex{}
+		l := new(DualPerplex).Exp(x)
+		l.Log(l)
+		return l.Equals(x)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDualPerplexExpAdd(t *testing.T) {
+	f := func(x, y *DualPerplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		x.l = Perplex{}
+		y.l = Perplex{}
+		l := new(DualPerplex).Exp(new(DualPerplex).Add(x, y))
+		r := new(DualPerplex).Mul(new(DualPerplex).Exp(x), new(DualPerplex).Exp(y))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,379 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// An EigenError reports that the eigenvalues of a matrix could not be
+// computed exactly.
+type EigenError struct {
+	Op   string  // the failing function
+	Poly Laurent // the characteristic data that failed to split, if any
+	Msg  string
+}
+
+func (e *EigenError) Error() string {
+	if e.Poly == nil {
+		return fmt.Sprintf("rational: %s: %s", e.Op, e.Msg)
+	}
+	return fmt.Sprintf("rational: %s: %s: %v", e.Op, e.Msg, e.Poly)
+}
+
+// adjoint returns the complex adjoint matrix of the Hamilton matrix m. Each
+// entry z1 + z2j of m is replaced by the block
+// 		⎡  z1       z2   ⎤
+// 		⎣ -Conj(z2) Conj(z1) ⎦
+func adjoint(m [2][2]*Hamilton) [4][4]*Complex {
+	var a [4][4]*Complex
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			z1, z2 := &m[i][j].l, &m[i][j].r
+			a[2*i][2*j] = new(Complex).Set(z1)
+			a[2*i][2*j+1] = new(Complex).Set(z2)
+			a[2*i+1][2*j] = new(Complex).Neg(new(Complex).Conj(z2))
+			a[2*i+1][2*j+1] = new(Complex).Conj(z1)
+		}
+	}
+	return a
+}
+
+// charPoly4 returns the coefficients of the characteristic polynomial of the
+// Complex matrix a, computed with the Faddeev–LeVerrier algorithm.
+func charPoly4(a [4][4]*Complex) [5]*Complex {
+	var c [5]*Complex
+	c[4] = NewComplex(big.NewRat(1, 1), new(big.Rat))
+	var m, am [4][4]*Complex
+	for i := range m {
+		for j := range m[i] {
+			m[i][j] = new(Complex)
+			am[i][j] = new(Complex)
+		}
+	}
+	temp := new(Complex)
+	for k := 1; k <= 4; k++ {
+		// m = a*m + c[5-k]*I
+		for i := range m {
+			for j := range m[i] {
+				am[i][j] = new(Complex)
+				for l := range m {
+					am[i][j].Add(am[i][j], temp.Mul(a[i][l], m[l][j]))
+				}
+			}
+		}
+		for i := range m {
+			for j := range m[i] {
+				m[i][j].Set(am[i][j])
+			}
+			m[i][i].Add(m[i][i], c[5-k])
+		}
+		// c[4-k] = -Tr(a*m)/k
+		tr := new(Complex)
+		for i := range m {
+			for l := range m {
+				tr.Add(tr, temp.Mul(a[i][l], m[l][i]))
+			}
+		}
+		c[4-k] = tr.Scal(tr, big.NewRat(-1, int64(k)))
+	}
+	return c
+}
+
+// eigenClass returns the standard representative a+bi, with b non-negative,
+// of the similarity class of Hamilton values with trace t and quadrance q.
+func eigenClass(t, q *big.Rat) (*Hamilton, bool) {
+	re := new(big.Rat).Quo(t, big.NewRat(2, 1))
+	im := new(big.Rat).Mul(re, re)
+	im.Sub(q, im)
+	b, ok := ratSqrt(im)
+	if !ok {
+		return nil, false
+	}
+	return NewHamilton(re, b, new(big.Rat), new(big.Rat)), true
+}
+
+// EigenR returns representatives of the similarity classes of right
+// eigenvalues of the Hamilton matrix
+// 		⎡ a b ⎤
+// 		⎣ c d ⎦
+// A right eigenvalue λ satisfies Mv = vλ for some non-zero column v. Every
+// quaternion similar to λ is again a right eigenvalue, so each class is
+// reported by its standard representative x+yi, with y non-negative.
+//
+// The classes are read off from the characteristic polynomial of the complex
+// adjoint matrix, which has rational coefficients and is the product of the
+// quadratics
+// 		t² - Trace(λ)t + Quad(λ)
+// for each class. If this polynomial does not split into such rational
+// factors, or if a representative is not rational, then EigenR returns an
+// *EigenError.
+func EigenR(a, b, c, d *Hamilton) ([]*Hamilton, error) {
+	m := [2][2]*Hamilton{{a, b}, {c, d}}
+	cp := charPoly4(adjoint(m))
+	p := make([]*big.Rat, 5)
+	for i := range cp {
+		p[i] = new(big.Rat).Set(cp[i].Real())
+	}
+	poly := Laurent{0: p[0], 1: p[1], 2: p[2], 3: p[3], 4: p[4]}
+	factors, ok := splitQuartic(p)
+	if !ok {
+		return nil, &EigenError{"EigenR", poly, "characteristic polynomial does not split over the rationals"}
+	}
+	var classes []*Hamilton
+	for _, f := range factors {
+		// f = t² + f[1]t + f[0]
+		z, ok := eigenClass(new(big.Rat).Neg(f[1]), f[0])
+		if !ok {
+			return nil, &EigenError{
+				"EigenR",
+				Laurent{0: f[0], 1: f[1], 2: big.NewRat(1, 1)},
+				"eigenvalue is not rational",
+			}
+		}
+		if len(classes) == 0 || !classes[0].Equals(z) {
+			classes = append(classes, z)
+		}
+	}
+	return classes, nil
+}
+
+// EigenL returns the left eigenvalues of the Hamilton matrix
+// 		⎡ a b ⎤
+// 		⎣ c d ⎦
+// A left eigenvalue λ satisfies Mv = λv for some non-zero column v. Unlike
+// right eigenvalues, left eigenvalues are not closed under similarity. If b
+// or c is zero, then the left eigenvalues are exactly a and d. Otherwise an
+// eigenvector can be scaled to (1, y), and then λ = a + by, where y solves the
+// unilateral quadratic equation
+// 		y² + Inv(b)(a - d)y - Inv(b)c = 0
+// which leftQuadratic solves through a real cubic. If some left eigenvalue is
+// not rational, or if there are infinitely many, as for
+// 		⎡  0 1 ⎤
+// 		⎣ -1 0 ⎦
+// whose left eigenvalues are the pure quaternions of quadrance one, then
+// EigenL returns an *EigenError.
+func EigenL(a, b, c, d *Hamilton) ([]*Hamilton, error) {
+	zero := new(Hamilton)
+	if b.Equals(zero) || c.Equals(zero) {
+		if a.Equals(d) {
+			return []*Hamilton{new(Hamilton).Set(a)}, nil
+		}
+		return []*Hamilton{new(Hamilton).Set(a), new(Hamilton).Set(d)}, nil
+	}
+	binv := new(Hamilton).Inv(b)
+	p := new(Hamilton).Mul(binv, new(Hamilton).Sub(a, d))
+	q := new(Hamilton).Neg(new(Hamilton).Mul(binv, c))
+	ys, err := leftQuadratic(p, q)
+	if err != nil {
+		err.Op = "EigenL"
+		return nil, err
+	}
+	eigen := make([]*Hamilton, len(ys))
+	for i, y := range ys {
+		eigen[i] = new(Hamilton).Add(a, y.Mul(b, y))
+	}
+	return eigen, nil
+}
+
+// leftQuadratic returns the solutions y of
+// 		y² + py + q = 0
+// Writing y = z - Re(p)/2 gives an equation of the same form in z, with p
+// pure, so suppose that p is pure. A solution with trace t and quadrance n
+// satisfies y² = ty - n, so that
+// 		y = Inv(t + p)(n - q)
+// and the conditions on the trace and quadrance of this value give n in terms
+// of t, with s = t² a root of the cubic
+// 		s³ + (2P + 4q₀)s² + (P² + 4q₀P - 4Q)s - 4D²
+// where P = Quad(p), q₀ = Re(q), Q is the quadrance of the pure part of q,
+// and D = Dot(p, q). If D is zero, then t may also be zero, and n is a root of
+// a real quadratic. If p is zero and q is real, then the solutions are the
+// square roots of -q, of which there are infinitely many if q is positive.
+func leftQuadratic(p, q *Hamilton) ([]*Hamilton, *EigenError) {
+	h := new(big.Rat).Quo(p.Real(), big.NewRat(2, 1))
+	// y = z - h turns the equation into z² + pz + q = 0 with p pure
+	q = new(Hamilton).Sub(q, new(Hamilton).Scal(p, h))
+	q.Real().Add(q.Real(), new(big.Rat).Mul(h, h))
+	p = new(Hamilton).Set(p)
+	p.Real().SetInt64(0)
+	q0 := new(big.Rat).Set(q.Real())
+	qv := new(Hamilton).Set(q)
+	qv.Real().SetInt64(0)
+	P, Q, D := p.Quad(), qv.Quad(), p.Dot(qv)
+	var zs []*Hamilton
+	add := func(t, n *big.Rat) {
+		// z = Inv(t + p)(n - q)
+		tp := new(Hamilton).Set(p)
+		tp.Real().Set(t)
+		nq := new(Hamilton).Neg(q)
+		nq.Real().Add(nq.Real(), n)
+		z := new(Hamilton).Mul(new(Hamilton).Inv(tp), nq)
+		for _, w := range zs {
+			if w.Equals(z) {
+				return
+			}
+		}
+		zs = append(zs, z)
+	}
+	irrational := func(poly Laurent) ([]*Hamilton, *EigenError) {
+		return nil, &EigenError{"", poly, "left eigenvalue is not rational"}
+	}
+	if P.Sign() == 0 && Q.Sign() == 0 {
+		// z² = -q₀
+		switch q0.Sign() {
+		case 0:
+			return []*Hamilton{NewHamilton(new(big.Rat).Neg(h), new(big.Rat), new(big.Rat), new(big.Rat))}, nil
+		case 1:
+			return nil, &EigenError{"", nil, "infinitely many left eigenvalues"}
+		}
+		r, ok := ratSqrt(new(big.Rat).Neg(q0))
+		if !ok {
+			return irrational(Laurent{0: q0, 2: big.NewRat(1, 1)})
+		}
+		add(new(big.Rat).Add(r, r), new(big.Rat).Neg(q0))
+		add(new(big.Rat).Neg(new(big.Rat).Add(r, r)), new(big.Rat).Neg(q0))
+	} else {
+		four := big.NewRat(4, 1)
+		cubic := []*big.Rat{
+			new(big.Rat).Neg(new(big.Rat).Mul(four, new(big.Rat).Mul(D, D))),
+			new(big.Rat).Sub(
+				new(big.Rat).Add(new(big.Rat).Mul(P, P), new(big.Rat).Mul(four, new(big.Rat).Mul(q0, P))),
+				new(big.Rat).Mul(four, Q),
+			),
+			new(big.Rat).Add(new(big.Rat).Add(P, P), new(big.Rat).Mul(four, q0)),
+			big.NewRat(1, 1),
+		}
+		poly := Laurent{0: cubic[0], 1: cubic[1], 2: cubic[2], 3: cubic[3]}
+		found := 0
+		for _, s := range ratRoots(cubic) {
+			if s.Sign() <= 0 {
+				continue
+			}
+			r, ok := ratSqrt(s)
+			if !ok {
+				return irrational(poly)
+			}
+			found++
+			for _, t := range []*big.Rat{r, new(big.Rat).Neg(r)} {
+				// n = q₀ + (s + P)/2 + D/t
+				n := new(big.Rat).Add(s, P)
+				n.Quo(n, big.NewRat(2, 1))
+				n.Add(n, q0)
+				add(t, n.Add(n, new(big.Rat).Quo(D, t)))
+			}
+		}
+		if found < positiveRoots(cubic) {
+			return irrational(poly)
+		}
+		if P.Sign() != 0 && D.Sign() == 0 {
+			// t = 0, and n² - (2q₀ + P)n + q₀² + Q = 0
+			m := new(big.Rat).Add(new(big.Rat).Add(q0, q0), P)
+			quad := []*big.Rat{
+				new(big.Rat).Add(new(big.Rat).Mul(q0, q0), Q),
+				m.Neg(m),
+				big.NewRat(1, 1),
+			}
+			found := 0
+			for _, n := range ratRoots(quad) {
+				if n.Sign() > 0 {
+					add(new(big.Rat), n)
+					found++
+				}
+			}
+			if found < positiveRoots(quad) {
+				return irrational(Laurent{0: quad[0], 1: quad[1], 2: quad[2]})
+			}
+		}
+	}
+	for _, z := range zs {
+		z.Real().Sub(z.Real(), h)
+	}
+	return zs, nil
+}
+
+// splitQuartic factors the monic quartic p into two monic quadratics with
+// rational coefficients and non-positive discriminants, and returns their
+// coefficients. The second result is false if no such factorization exists.
+func splitQuartic(p []*big.Rat) ([2][]*big.Rat, bool) {
+	var f [2][]*big.Rat
+	// depress with t = y - p[3]/4
+	h := new(big.Rat).Quo(p[3], big.NewRat(4, 1))
+	shift := func(q []*big.Rat, h *big.Rat) []*big.Rat {
+		// coefficients of q(y - h)
+		r := make([]*big.Rat, len(q))
+		for i := range r {
+			r[i] = new(big.Rat)
+		}
+		temp := new(big.Rat)
+		for i := len(q) - 1; i >= 0; i-- {
+			// r = r*(y - h) + q[i]
+			for k := len(r) - 1; k > 0; k-- {
+				r[k].Sub(r[k-1], temp.Mul(r[k], h))
+			}
+			r[0].Sub(q[i], temp.Mul(r[0], h))
+		}
+		return r
+	}
+	dep := shift(p, h)
+	P, Q, R := dep[2], dep[1], dep[0]
+	// (y² + sy + u)(y² - sy + v), with w = s² a root of the resolvent
+	// 		w³ + 2Pw² + (P² - 4R)w - Q²
+	two := big.NewRat(2, 1)
+	res := []*big.Rat{
+		new(big.Rat).Neg(new(big.Rat).Mul(Q, Q)),
+		new(big.Rat).Sub(new(big.Rat).Mul(P, P), new(big.Rat).Mul(big.NewRat(4, 1), R)),
+		new(big.Rat).Mul(two, P),
+		big.NewRat(1, 1),
+	}
+	try := func(s, u, v *big.Rat) bool {
+		g := [2][]*big.Rat{
+			shift([]*big.Rat{u, s, big.NewRat(1, 1)}, new(big.Rat).Neg(h)),
+			shift([]*big.Rat{v, new(big.Rat).Neg(s), big.NewRat(1, 1)}, new(big.Rat).Neg(h)),
+		}
+		for _, q := range g {
+			disc := new(big.Rat).Mul(q[1], q[1])
+			disc.Sub(disc, new(big.Rat).Mul(big.NewRat(4, 1), q[0]))
+			if disc.Sign() > 0 {
+				return false
+			}
+		}
+		f = g
+		return true
+	}
+	for _, w := range ratRoots(res) {
+		s, ok := ratSqrt(w)
+		if !ok {
+			continue
+		}
+		if s.Sign() == 0 {
+			// u + v = P, uv = R
+			disc := new(big.Rat).Mul(P, P)
+			disc.Sub(disc, new(big.Rat).Mul(big.NewRat(4, 1), R))
+			r, ok := ratSqrt(disc)
+			if !ok {
+				continue
+			}
+			u := new(big.Rat).Add(P, r)
+			u.Quo(u, two)
+			v := new(big.Rat).Sub(P, r)
+			v.Quo(v, two)
+			if try(s, u, v) {
+				return f, true
+			}
+			continue
+		}
+		// u + v = P + w, v - u = Q/s
+		sum := new(big.Rat).Add(P, w)
+		diff := new(big.Rat).Quo(Q, s)
+		u := new(big.Rat).Sub(sum, diff)
+		u.Quo(u, two)
+		v := new(big.Rat).Add(sum, diff)
+		v.Quo(v, two)
+		if try(s, u, v) {
+			return f, true
+		}
+	}
+	return f, false
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestEigenRTriangular(t *testing.T) {
+	a := NewHamilton(big.NewRat(1, 2), big.NewRat(3, 1), big.NewRat(4, 1), new(big.Rat))
+	b := NewHamilton(big.NewRat(7, 1), big.NewRat(-1, 3), big.NewRat(2, 1), big.NewRat(1, 1))
+	d := NewHamilton(big.NewRat(-2, 1), new(big.Rat), new(big.Rat), big.NewRat(-3, 4))
+	classes, err := EigenR(a, b, new(Hamilton), d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*Hamilton{
+		NewHamilton(big.NewRat(1, 2), big.NewRat(5, 1), new(big.Rat), new(big.Rat)),
+		NewHamilton(big.NewRat(-2, 1), big.NewRat(3, 4), new(big.Rat), new(big.Rat)),
+	}
+	if len(classes) != 2 {
+		t.Fatalf("EigenR = %v, want %v", classes, want)
+	}
+	if !(classes[0].Equals(want[0]) && classes[1].Equals(want[1])) &&
+		!(classes[0].Equals(want[1]) && classes[1].Equals(want[0])) {
+		t.Errorf("EigenR = %v, want %v", classes, want)
+	}
+}
+
+func TestEigenRSimilar(t *testing.T) {
+	// M = L U D Inv(U) Inv(L), with L and U unipotent.
+	one := NewHamilton(big.NewRat(1, 1), new(big.Rat), new(big.Rat), new(big.Rat))
+	zero := new(Hamilton)
+	u := NewHamilton(big.NewRat(1, 1), big.NewRat(2, 1), new(big.Rat), big.NewRat(-1, 1))
+	v := NewHamilton(new(big.Rat), big.NewRat(1, 1), big.NewRat(1, 2), new(big.Rat))
+	l1 := NewHamilton(big.NewRat(3, 1), new(big.Rat), big.NewRat(4, 1), new(big.Rat))
+	l2 := NewHamilton(big.NewRat(-1, 1), new(big.Rat), new(big.Rat), big.NewRat(2, 1))
+	mul := func(x, y [2][2]*Hamilton) [2][2]*Hamilton {
+		var z [2][2]*Hamilton
+		temp := new(Hamilton)
+		for i := 0; i < 2; i++ {
+			for j := 0; j < 2; j++ {
+				z[i][j] = new(Hamilton).Mul(x[i][0], y[0][j])
+				z[i][j].Add(z[i][j], temp.Mul(x[i][1], y[1][j]))
+			}
+		}
+		return z
+	}
+	m := mul(
+		mul([2][2]*Hamilton{{one, zero}, {v, one}}, [2][2]*Hamilton{{one, u}, {zero, one}}),
+		[2][2]*Hamilton{{l1, zero}, {zero, l2}},
+	)
+	m = mul(
+		mul(m, [2][2]*Hamilton{{one, new(Hamilton).Neg(u)}, {zero, one}}),
+		[2][2]*Hamilton{{one, zero}, {new(Hamilton).Neg(v), one}},
+	)
+	classes, err := EigenR(m[0][0], m[0][1], m[1][0], m[1][1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*Hamilton{
+		NewHamilton(big.NewRat(3, 1), big.NewRat(4, 1), new(big.Rat), new(big.Rat)),
+		NewHamilton(big.NewRat(-1, 1), big.NewRat(2, 1), new(big.Rat), new(big.Rat)),
+	}
+	if len(classes) != 2 {
+		t.Fatalf("EigenR = %v, want %v", classes, want)
+	}
+	if !(classes[0].Equals(want[0]) && classes[1].Equals(want[1])) &&
+		!(classes[0].Equals(want[1]) && classes[1].Equals(want[0])) {
+		t.Errorf("EigenR = %v, want %v", classes, want)
+	}
+}
+
+func TestEigenRIrrational(t *testing.T) {
+	zero := new(Hamilton)
+	one := NewHamilton(big.NewRat(1, 1), new(big.Rat), new(big.Rat), new(big.Rat))
+	two := NewHamilton(big.NewRat(2, 1), new(big.Rat), new(big.Rat), new(big.Rat))
+	if _, err := EigenR(zero, one, two, zero); err == nil {
+		t.Error("EigenR found rational eigenvalues ±√2")
+	} else if _, ok := err.(*EigenError); !ok {
+		t.Errorf("EigenR returned %T, want *EigenError", err)
+	}
+}
+
+func TestEigenL(t *testing.T) {
+	a := NewHamilton(big.NewRat(1, 1), big.NewRat(2, 1), new(big.Rat), new(big.Rat))
+	b := NewHamilton(new(big.Rat), new(big.Rat), big.NewRat(1, 1), new(big.Rat))
+	d := NewHamilton(new(big.Rat), new(big.Rat), new(big.Rat), big.NewRat(5, 1))
+	eigen, err := EigenL(a, b, new(Hamilton), d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(eigen) != 2 || !eigen[0].Equals(a) || !eigen[1].Equals(d) {
+		t.Errorf("EigenL = %v, want [%v %v]", eigen, a, d)
+	}
+	// λ = a + by is a left eigenvalue with eigenvector (1, y) when c = λy - dy
+	y := NewHamilton(big.NewRat(-1, 1), big.NewRat(0, 1), big.NewRat(2, 1), big.NewRat(1, 3))
+	l := new(Hamilton).Add(a, new(Hamilton).Mul(b, y))
+	c := new(Hamilton).Sub(new(Hamilton).Mul(l, y), new(Hamilton).Mul(d, y))
+	if eigen, err = EigenL(a, b, c, d); err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, x := range eigen {
+		// with v = (1, w) and w = -Inv(b)(a - x), the second row must vanish
+		w := new(Hamilton).Neg(new(Hamilton).Mul(new(Hamilton).Inv(b), new(Hamilton).Sub(a, x)))
+		if r := new(Hamilton).Add(c, new(Hamilton).Mul(new(Hamilton).Sub(d, x), w)); !r.Equals(new(Hamilton)) {
+			t.Errorf("%v is not a left eigenvalue", x)
+		}
+		found = found || x.Equals(l)
+	}
+	if len(eigen) != 2 || !found {
+		t.Errorf("EigenL = %v, want two values including %v", eigen, l)
+	}
+	zero, one := new(Hamilton), NewHamilton(big.NewRat(1, 1), new(big.Rat), new(big.Rat), new(big.Rat))
+	four := NewHamilton(big.NewRat(4, 1), new(big.Rat), new(big.Rat), new(big.Rat))
+	if eigen, err := EigenL(zero, one, four, zero); err != nil || len(eigen) != 2 ||
+		eigen[0].Real().Cmp(new(big.Rat).Neg(eigen[1].Real())) != 0 || eigen[0].Quad().Cmp(big.NewRat(4, 1)) != 0 {
+		t.Errorf("EigenL = %v, %v, want ±2", eigen, err)
+	}
+	two := NewHamilton(big.NewRat(2, 1), new(big.Rat), new(big.Rat), new(big.Rat))
+	if _, err := EigenL(zero, one, two, zero); err == nil {
+		t.Error("EigenL found rational eigenvalues ±√2")
+	}
+	// the pure quaternions of quadrance one
+	if _, err := EigenL(zero, one, new(Hamilton).Neg(one), zero); err == nil {
+		t.Error("EigenL listed infinitely many eigenvalues")
+	} else if _, ok := err.(*EigenError); !ok {
+		t.Errorf("EigenL returned %T, want *EigenError", err)
+	}
+}
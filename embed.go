@@ -0,0 +1,417 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+// Embed sets z equal to y, a Complex value, as the BiComplex value with the
+// components of y followed by zeros, and returns z. The embedding preserves
+// sums and products, and its image is the subalgebra spanned by 1 and i.
+func (z *BiComplex) Embed(y *Complex) *BiComplex {
+	z.l.Set(y)
+	z.r.Set(new(Complex))
+	return z
+}
+
+// ComplexPart returns the Complex part of z, its components along 1 and i, as
+// a new value. It undoes Embed, but unlike Embed it does not preserve
+// products.
+func (z *BiComplex) ComplexPart() *Complex {
+	return new(Complex).Set(&z.l)
+}
+
+// Embed sets z equal to y, a Perplex value, as the BiPerplex value with the
+// components of y followed by zeros, and returns z. The embedding preserves
+// sums and products, and its image is the subalgebra spanned by 1 and s.
+func (z *BiPerplex) Embed(y *Perplex) *BiPerplex {
+	z.l.Set(y)
+	z.r.Set(new(Perplex))
+	return z
+}
+
+// PerplexPart returns the Perplex part of z, its components along 1 and s, as
+// a new value. It undoes Embed, but unlike Embed it does not preserve
+// products.
+func (z *BiPerplex) PerplexPart() *Perplex {
+	return new(Perplex).Set(&z.l)
+}
+
+// Embed sets z equal to y, a Complex value, as the Cockle value with the
+// components of y followed by zeros, and returns z. The embedding preserves
+// sums and products, and its image is the subalgebra spanned by 1 and i.
+func (z *Cockle) Embed(y *Complex) *Cockle {
+	z.l.Set(y)
+	z.r.Set(new(Complex))
+	return z
+}
+
+// ComplexPart returns the Complex part of z, its components along 1 and i, as
+// a new value. It undoes Embed, but unlike Embed it does not preserve
+// products.
+func (z *Cockle) ComplexPart() *Complex {
+	return new(Complex).Set(&z.l)
+}
+
+// Embed sets z equal to y, a Complex value, as the DualComplex value with the
+// components of y followed by zeros, and returns z. The embedding preserves
+// sums and products, and its image is the subalgebra spanned by 1 and i.
+func (z *DualComplex) Embed(y *Complex) *DualComplex {
+	z.l.Set(y)
+	z.r.Set(new(Complex))
+	return z
+}
+
+// ComplexPart returns the Complex part of z, its components along 1 and i, as
+// a new value. It undoes Embed, but unlike Embed it does not preserve
+// products.
+func (z *DualComplex) ComplexPart() *Complex {
+	return new(Complex).Set(&z.l)
+}
+
+// Embed sets z equal to y, a Perplex value, as the DualPerplex value with the
+// components of y followed by zeros, and returns z. The embedding preserves
+// sums and products, and its image is the subalgebra spanned by 1 and s.
+func (z *DualPerplex) Embed(y *Perplex) *DualPerplex {
+	z.l.Set(y)
+	z.r.Set(new(Perplex))
+	return z
+}
+
+// PerplexPart returns the Perplex part of z, its components along 1 and s, as
+// a new value. It undoes Embed, but unlike Embed it does not preserve
+// products.
+func (z *DualPerplex) PerplexPart() *Perplex {
+	return new(Perplex).Set(&z.l)
+}
+
+// Embed sets z equal to y, a Complex value, as the Hamilton value with the
+// components of y followed by zeros, and returns z. The embedding preserves
+// sums and products, and its image is the subalgebra spanned by 1 and i.
+func (z *Hamilton) Embed(y *Complex) *Hamilton {
+	z.l.Set(y)
+	z.r.Set(new(Complex))
+	return z
+}
+
+// ComplexPart returns the Complex part of z, its components along 1 and i, as
+// a new value. It undoes Embed, but unlike Embed it does not preserve
+// products.
+func (z *Hamilton) ComplexPart() *Complex {
+	return new(Complex).Set(&z.l)
+}
+
+// Embed sets z equal to y, an Infra value, as the Hyper value with the
+// components of y followed by zeros, and returns z. The embedding preserves
+// sums and products, and its image is the subalgebra spanned by 1 and α.
+func (z *Hyper) Embed(y *Infra) *Hyper {
+	z.l.Set(y)
+	z.r.Set(new(Infra))
+	return z
+}
+
+// InfraPart returns the Infra part of z, its components along 1 and α, as a
+// new value. It undoes Embed, but unlike Embed it does not preserve products.
+func (z *Hyper) InfraPart() *Infra {
+	return new(Infra).Set(&z.l)
+}
+
+// Embed sets z equal to y, a Complex value, as the InfraComplex value with the
+// components of y followed by zeros, and returns z. The embedding preserves
+// sums and products, and its image is the subalgebra spanned by 1 and i.
+func (z *InfraComplex) Embed(y *Complex) *InfraComplex {
+	z.l.Set(y)
+	z.r.Set(new(Complex))
+	return z
+}
+
+// ComplexPart returns the Complex part of z, its components along 1 and i, as
+// a new value. It undoes Embed, but unlike Embed it does not preserve
+// products.
+func (z *InfraComplex) ComplexPart() *Complex {
+	return new(Complex).Set(&z.l)
+}
+
+// Embed sets z equal to y, a Perplex value, as the InfraPerplex value with the
+// components of y followed by zeros, and returns z. The embedding preserves
+// sums and products, and its image is the subalgebra spanned by 1 and s.
+func (z *InfraPerplex) Embed(y *Perplex) *InfraPerplex {
+	z.l.Set(y)
+	z.r.Set(new(Perplex))
+	return z
+}
+
+// PerplexPart returns the Perplex part of z, its components along 1 and s, as
+// a new value. It undoes Embed, but unlike Embed it does not preserve
+// products.
+func (z *InfraPerplex) PerplexPart() *Perplex {
+	return new(Perplex).Set(&z.l)
+}
+
+// Embed sets z equal to y, an Infra value, as the Supra value with the
+// components of y followed by zeros, and returns z. The embedding preserves
+// sums and products, and its image is the subalgebra spanned by 1 and α.
+func (z *Supra) Embed(y *Infra) *Supra {
+	z.l.Set(y)
+	z.r.Set(new(Infra))
+	return z
+}
+
+// InfraPart returns the Infra part of z, its components along 1 and α, as a
+// new value. It undoes Embed, but unlike Embed it does not preserve products.
+func (z *Supra) InfraPart() *Infra {
+	return new(Infra).Set(&z.l)
+}
+
+// Embed sets z equal to y, a Cockle value, as the BiCockle value with the
+// components of y followed by zeros, and returns z. The embedding preserves
+// sums and products, and its image is the subalgebra spanned by 1, i, t, and
+// u.
+func (z *BiCockle) Embed(y *Cockle) *BiCockle {
+	z.l.Set(y)
+	z.r.Set(new(Cockle))
+	return z
+}
+
+// CocklePart returns the Cockle part of z, its components along 1, i, t, and
+// u, as a new value. It undoes Embed, but unlike Embed it does not preserve
+// products.
+func (z *BiCockle) CocklePart() *Cockle {
+	return new(Cockle).Set(&z.l)
+}
+
+// Embed sets z equal to y, a Hamilton value, as the BiHamilton value with the
+// components of y followed by zeros, and returns z. The embedding preserves
+// sums and products, and its image is the subalgebra spanned by 1, i, j, and
+// k.
+func (z *BiHamilton) Embed(y *Hamilton) *BiHamilton {
+	z.l.Set(y)
+	z.r.Set(new(Hamilton))
+	return z
+}
+
+// HamiltonPart returns the Hamilton part of z, its components along 1, i, j,
+// and k, as a new value. It undoes Embed, but unlike Embed it does not
+// preserve products.
+func (z *BiHamilton) HamiltonPart() *Hamilton {
+	return new(Hamilton).Set(&z.l)
+}
+
+// Embed sets z equal to y, a Hamilton value, as the Cayley value with the
+// components of y followed by zeros, and returns z. The embedding preserves
+// sums and products, and its image is the subalgebra spanned by 1, i, j, and
+// k.
+func (z *Cayley) Embed(y *Hamilton) *Cayley {
+	z.l.Set(y)
+	z.r.Set(new(Hamilton))
+	return z
+}
+
+// HamiltonPart returns the Hamilton part of z, its components along 1, i, j,
+// and k, as a new value. It undoes Embed, but unlike Embed it does not
+// preserve products.
+func (z *Cayley) HamiltonPart() *Hamilton {
+	return new(Hamilton).Set(&z.l)
+}
+
+// Embed sets z equal to y, a Cockle value, as the InfraCockle value with the
+// components of y followed by zeros, and returns z. The embedding preserves
+// sums and products, and its image is the subalgebra spanned by 1, i, t, and
+// u.
+func (z *InfraCockle) Embed(y *Cockle) *InfraCockle {
+	z.l.Set(y)
+	z.r.Set(new(Cockle))
+	return z
+}
+
+// CocklePart returns the Cockle part of z, its components along 1, i, t, and
+// u, as a new value. It undoes Embed, but unlike Embed it does not preserve
+// products.
+func (z *InfraCockle) CocklePart() *Cockle {
+	return new(Cockle).Set(&z.l)
+}
+
+// Embed sets z equal to y, a Hamilton value, as the InfraHamilton value with
+// the components of y followed by zeros, and returns z. The embedding
+// preserves sums and products, and its image is the subalgebra spanned by 1,
+// i, j, and k.
+func (z *InfraHamilton) Embed(y *Hamilton) *InfraHamilton {
+	z.l.Set(y)
+	z.r.Set(new(Hamilton))
+	return z
+}
+
+// HamiltonPart returns the Hamilton part of z, its components along 1, i, j,
+// and k, as a new value. It undoes Embed, but unlike Embed it does not
+// preserve products.
+func (z *InfraHamilton) HamiltonPart() *Hamilton {
+	return new(Hamilton).Set(&z.l)
+}
+
+// Embed sets z equal to y, an InfraComplex value, as the SupraComplex value
+// with the components of y followed by zeros, and returns z. The embedding
+// preserves sums and products, and its image is the subalgebra spanned by 1,
+// i, α, and β.
+func (z *SupraComplex) Embed(y *InfraComplex) *SupraComplex {
+	z.l.Set(y)
+	z.r.Set(new(InfraComplex))
+	return z
+}
+
+// InfraComplexPart returns the InfraComplex part of z, its components along 1,
+// i, α, and β, as a new value. It undoes Embed, but unlike Embed it does not
+// preserve products.
+func (z *SupraComplex) InfraComplexPart() *InfraComplex {
+	return new(InfraComplex).Set(&z.l)
+}
+
+// Embed sets z equal to y, an InfraPerplex value, as the SupraPerplex value
+// with the components of y followed by zeros, and returns z. The embedding
+// preserves sums and products, and its image is the subalgebra spanned by 1,
+// s, ρ, and σ.
+func (z *SupraPerplex) Embed(y *InfraPerplex) *SupraPerplex {
+	z.l.Set(y)
+	z.r.Set(new(InfraPerplex))
+	return z
+}
+
+// InfraPerplexPart returns the InfraPerplex part of z, its components along 1,
+// s, ρ, and σ, as a new value. It undoes Embed, but unlike Embed it does not
+// preserve products.
+func (z *SupraPerplex) InfraPerplexPart() *InfraPerplex {
+	return new(InfraPerplex).Set(&z.l)
+}
+
+// Embed sets z equal to y, a BiComplex value, as the TriComplex value with the
+// components of y followed by zeros, and returns z. The embedding preserves
+// sums and products, and its image is the subalgebra spanned by 1, i, J, and
+// iJ.
+func (z *TriComplex) Embed(y *BiComplex) *TriComplex {
+	z.l.Set(y)
+	z.r.Set(new(BiComplex))
+	return z
+}
+
+// BiComplexPart returns the BiComplex part of z, its components along 1, i, J,
+// and iJ, as a new value. It undoes Embed, but unlike Embed it does not
+// preserve products.
+func (z *TriComplex) BiComplexPart() *BiComplex {
+	return new(BiComplex).Set(&z.l)
+}
+
+// Embed sets z equal to y, a Hyper value, as the TriNilplex value with the
+// components of y followed by zeros, and returns z. The embedding preserves
+// sums and products, and its image is the subalgebra spanned by 1, α, Γ, and
+// αΓ.
+func (z *TriNilplex) Embed(y *Hyper) *TriNilplex {
+	z.l.Set(y)
+	z.r.Set(new(Hyper))
+	return z
+}
+
+// HyperPart returns the Hyper part of z, its components along 1, α, Γ, and αΓ,
+// as a new value. It undoes Embed, but unlike Embed it does not preserve
+// products.
+func (z *TriNilplex) HyperPart() *Hyper {
+	return new(Hyper).Set(&z.l)
+}
+
+// Embed sets z equal to y, a BiPerplex value, as the TriPerplex value with the
+// components of y followed by zeros, and returns z. The embedding preserves
+// sums and products, and its image is the subalgebra spanned by 1, s, T, and
+// sT.
+func (z *TriPerplex) Embed(y *BiPerplex) *TriPerplex {
+	z.l.Set(y)
+	z.r.Set(new(BiPerplex))
+	return z
+}
+
+// BiPerplexPart returns the BiPerplex part of z, its components along 1, s, T,
+// and sT, as a new value. It undoes Embed, but unlike Embed it does not
+// preserve products.
+func (z *TriPerplex) BiPerplexPart() *BiPerplex {
+	return new(BiPerplex).Set(&z.l)
+}
+
+// Embed sets z equal to y, a Supra value, as the Ultra value with the
+// components of y followed by zeros, and returns z. The embedding preserves
+// sums and products, and its image is the subalgebra spanned by 1, α, β, and
+// γ.
+func (z *Ultra) Embed(y *Supra) *Ultra {
+	z.l.Set(y)
+	z.r.Set(new(Supra))
+	return z
+}
+
+// SupraPart returns the Supra part of z, its components along 1, α, β, and γ,
+// as a new value. It undoes Embed, but unlike Embed it does not preserve
+// products.
+func (z *Ultra) SupraPart() *Supra {
+	return new(Supra).Set(&z.l)
+}
+
+// Embed sets z equal to y, a Hamilton value, as the Zorn value with the
+// components of y followed by zeros, and returns z. The embedding preserves
+// sums and products, and its image is the subalgebra spanned by 1, i, j, and
+// k.
+func (z *Zorn) Embed(y *Hamilton) *Zorn {
+	z.l.Set(y)
+	z.r.Set(new(Hamilton))
+	return z
+}
+
+// HamiltonPart returns the Hamilton part of z, its components along 1, i, j,
+// and k, as a new value. It undoes Embed, but unlike Embed it does not
+// preserve products.
+func (z *Zorn) HamiltonPart() *Hamilton {
+	return new(Hamilton).Set(&z.l)
+}
+
+// Embed sets z equal to y, a Hamilton value, as the DualHamilton value with
+// the components of y followed by zeros, and returns z. The embedding
+// preserves sums and products, and its image is the subalgebra spanned by 1,
+// i, j, and k.
+func (z *DualHamilton) Embed(y *Hamilton) *DualHamilton {
+	z.l.Set(y)
+	z.r.Set(new(Hamilton))
+	return z
+}
+
+// HamiltonPart returns the Hamilton part of z, its components along 1, i, j,
+// and k, as a new value. It undoes Embed, but unlike Embed it does not
+// preserve products.
+func (z *DualHamilton) HamiltonPart() *Hamilton {
+	return new(Hamilton).Set(&z.l)
+}
+
+// Embed sets z equal to y, a ComplexInt value, as the HamiltonInt value with
+// the components of y followed by zeros, and returns z. The embedding
+// preserves sums and products, and its image is the subalgebra spanned by 1
+// and i.
+func (z *HamiltonInt) Embed(y *ComplexInt) *HamiltonInt {
+	z.l.Set(y)
+	z.r.Set(new(ComplexInt))
+	return z
+}
+
+// ComplexIntPart returns the ComplexInt part of z, its components along 1 and
+// i, as a new value. It undoes Embed, but unlike Embed it does not preserve
+// products.
+func (z *HamiltonInt) ComplexIntPart() *ComplexInt {
+	return new(ComplexInt).Set(&z.l)
+}
+
+// Embed sets z equal to y, a HamiltonInt value, as the CayleyInt value with
+// the components of y followed by zeros, and returns z. The embedding
+// preserves sums and products, and its image is the subalgebra spanned by 1,
+// i, j, and k.
+func (z *CayleyInt) Embed(y *HamiltonInt) *CayleyInt {
+	z.l.Set(y)
+	z.r.Set(new(HamiltonInt))
+	return z
+}
+
+// HamiltonIntPart returns the HamiltonInt part of z, its components along 1,
+// i, j, and k, as a new value. It undoes Embed, but unlike Embed it does not
+// preserve products.
+func (z *CayleyInt) HamiltonIntPart() *HamiltonInt {
+	return new(HamiltonInt).Set(&z.l)
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+	"testing/quick"
+)
+
+func checkEmbed[L any, U Elem[L], S any, T interface {
+	Elem[S]
+	Embed(y U) *S
+}](t *testing.T, part func(T) U) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 20; n++ {
+		x, y, xy := U(new(L)), U(new(L)), U(new(L))
+		random(x, r, nil)
+		random(y, r, nil)
+		xy.Mul(x, y)
+		ex, ey, exy, p := T(new(S)), T(new(S)), T(new(S)), T(new(S))
+		ex.Embed(x)
+		ey.Embed(y)
+		exy.Embed(xy)
+		if p.Mul(ex, ey); !p.Equals(exy) {
+			t.Errorf("%T: Embed(%v) Embed(%v) = %v, want %v", p, x, y, p, exy)
+		}
+		if !part(ex).Equals(x) {
+			t.Errorf("%T: part of Embed(%v) = %v", p, x, part(ex))
+		}
+		if ex.Real().Cmp(x.Real()) != 0 {
+			t.Errorf("%T: real part of Embed(%v) = %v", p, x, ex.Real())
+		}
+	}
+}
+
+func TestEmbed(t *testing.T) {
+	checkEmbed[Complex, *Complex, BiComplex](t, (*BiComplex).ComplexPart)
+	checkEmbed[Perplex, *Perplex, BiPerplex](t, (*BiPerplex).PerplexPart)
+	checkEmbed[Complex, *Complex, Cockle](t, (*Cockle).ComplexPart)
+	checkEmbed[Complex, *Complex, DualComplex](t, (*DualComplex).ComplexPart)
+	checkEmbed[Perplex, *Perplex, DualPerplex](t, (*DualPerplex).PerplexPart)
+	checkEmbed[Complex, *Complex, Hamilton](t, (*Hamilton).ComplexPart)
+	checkEmbed[Infra, *Infra, Hyper](t, (*Hyper).InfraPart)
+	checkEmbed[Complex, *Complex, InfraComplex](t, (*InfraComplex).ComplexPart)
+	checkEmbed[Perplex, *Perplex, InfraPerplex](t, (*InfraPerplex).PerplexPart)
+	checkEmbed[Infra, *Infra, Supra](t, (*Supra).InfraPart)
+	checkEmbed[Cockle, *Cockle, BiCockle](t, (*BiCockle).CocklePart)
+	checkEmbed[Hamilton, *Hamilton, BiHamilton](t, (*BiHamilton).HamiltonPart)
+	checkEmbed[Hamilton, *Hamilton, Cayley](t, (*Cayley).HamiltonPart)
+	checkEmbed[Cockle, *Cockle, InfraCockle](t, (*InfraCockle).CocklePart)
+	checkEmbed[Hamilton, *Hamilton, InfraHamilton](t, (*InfraHamilton).HamiltonPart)
+	checkEmbed[InfraComplex, *InfraComplex, SupraComplex](t, (*SupraComplex).InfraComplexPart)
+	checkEmbed[InfraPerplex, *InfraPerplex, SupraPerplex](t, (*SupraPerplex).InfraPerplexPart)
+	checkEmbed[BiComplex, *BiComplex, TriComplex](t, (*TriComplex).BiComplexPart)
+	checkEmbed[Hyper, *Hyper, TriNilplex](t, (*TriNilplex).HyperPart)
+	checkEmbed[BiPerplex, *BiPerplex, TriPerplex](t, (*TriPerplex).BiPerplexPart)
+	checkEmbed[Supra, *Supra, Ultra](t, (*Ultra).SupraPart)
+	checkEmbed[Hamilton, *Hamilton, Zorn](t, (*Zorn).HamiltonPart)
+	checkEmbed[Hamilton, *Hamilton, DualHamilton](t, (*DualHamilton).HamiltonPart)
+}
+
+func TestEmbedTower(t *testing.T) {
+	f := func(x *Complex) bool {
+		// t.Logf("x = %v", x)
+		c := new(Cayley).Embed(new(Hamilton).Embed(x))
+		return c.HamiltonPart().ComplexPart().Equals(x) && c.Real().Cmp(x.Real()) == 0
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestEmbedLattice(t *testing.T) {
+	f := func(x, y *ComplexInt) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(CayleyInt).Embed(new(HamiltonInt).Embed(x))
+		b := new(CayleyInt).Embed(new(HamiltonInt).Embed(y))
+		p := new(CayleyInt).Mul(a, b)
+		return p.HamiltonIntPart().ComplexIntPart().Equals(new(ComplexInt).Mul(x, y)) &&
+			p.Quad().Cmp(new(big.Int).Mul(x.Quad(), y.Quad())) == 0
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// DivMod sets z to the quotient of x and y and m to the remainder, and
+// returns the pair (z, m). The quotient is x/y rounded to the nearest
+// Gaussian integer, so that
+// 		x = yz + m,	Quad(m) ≤ Quad(y)/2
+// and repeated division terminates. If y is zero, then DivMod panics.
+func (z *ComplexInt) DivMod(x, y, m *ComplexInt) (*ComplexInt, *ComplexInt) {
+	if y.Quad().Sign() == 0 {
+		panic(denominatorError("division by zero"))
+	}
+	w := new(Complex).Quo(x.Rat(), y.Rat())
+	q := NewComplexInt(roundInt(&w.l, big.ToNearestEven), roundInt(&w.r, big.ToNearestEven))
+	r := new(ComplexInt).Sub(x, new(ComplexInt).Mul(y, q))
+	z.Set(q)
+	m.Set(r)
+	return z, m
+}
+
+// GCD sets z equal to the greatest common divisor of x and y, and returns z.
+// The divisor is unique up to the units ±1 and ±i; GCD returns the one with
+// positive real part and non-negative imaginary part. If x and y are both
+// zero, then z is zero.
+func (z *ComplexInt) GCD(x, y *ComplexInt) *ComplexInt {
+	a, b := new(ComplexInt).Set(x), new(ComplexInt).Set(y)
+	q := new(ComplexInt)
+	for b.Quad().Sign() != 0 {
+		q.DivMod(a, b, a)
+		a, b = b, a
+	}
+	// rotate a into the first quadrant
+	i := NewComplexInt(big.NewInt(0), big.NewInt(1))
+	for k := 0; k < 4 && a.Quad().Sign() != 0; k++ {
+		if a.l.Sign() > 0 && a.r.Sign() >= 0 {
+			break
+		}
+		a.Mul(a, i)
+	}
+	return z.Set(a)
+}
+
+// roundLipschitz returns the components of w rounded to the nearest
+// integers.
+func roundLipschitz(w *Hamilton) *HamiltonInt {
+	v := make([]*big.Int, 4)
+	for i, c := range w.Components() {
+		v[i] = roundInt(c, big.ToNearestEven)
+	}
+	return NewHamiltonInt(v[0], v[1], v[2], v[3])
+}
+
+// DivModL sets z to the left quotient of x and y and m to the remainder, and
+// returns the pair (z, m). The quotient is QuoL(x, y) rounded to the nearest
+// Lipschitz integer, so that
+// 		x = yz + m,	Quad(m) ≤ Quad(y)
+// Equality is possible, since the Lipschitz integers are not Euclidean; use
+// HurwitzInt for a remainder that always decreases. If y is zero, then
+// DivModL panics.
+func (z *HamiltonInt) DivModL(x, y, m *HamiltonInt) (*HamiltonInt, *HamiltonInt) {
+	if y.Quad().Sign() == 0 {
+		panic(denominatorError("division by zero"))
+	}
+	q := roundLipschitz(new(Hamilton).QuoL(x.Rat(), y.Rat()))
+	r := new(HamiltonInt).Sub(x, new(HamiltonInt).Mul(y, q))
+	z.Set(q)
+	m.Set(r)
+	return z, m
+}
+
+// DivModR sets z to the right quotient of x and y and m to the remainder,
+// and returns the pair (z, m). The quotient is QuoR(x, y) rounded to the
+// nearest Lipschitz integer, so that
+// 		x = zy + m,	Quad(m) ≤ Quad(y)
+// Equality is possible, since the Lipschitz integers are not Euclidean; use
+// HurwitzInt for a remainder that always decreases. If y is zero, then
+// DivModR panics.
+func (z *HamiltonInt) DivModR(x, y, m *HamiltonInt) (*HamiltonInt, *HamiltonInt) {
+	if y.Quad().Sign() == 0 {
+		panic(denominatorError("division by zero"))
+	}
+	q := roundLipschitz(new(Hamilton).QuoR(x.Rat(), y.Rat()))
+	r := new(HamiltonInt).Sub(x, new(HamiltonInt).Mul(q, y))
+	z.Set(q)
+	m.Set(r)
+	return z, m
+}
+
+// roundHurwitz returns the Hurwitz integer nearest to w: the nearer of the
+// nearest Lipschitz integer and the nearest point with half-odd components.
+func roundHurwitz(w *Hamilton) *HurwitzInt {
+	p := new(HurwitzInt).SetHamiltonInt(roundLipschitz(w))
+	v := make([]*big.Int, 4)
+	for i, c := range w.Components() {
+		// the nearest half-odd value is floor(c) + ½
+		v[i] = roundInt(c, big.ToNegativeInf)
+	}
+	h := NewHurwitzInt(v[0], v[1], v[2], v[3])
+	dp := new(Hamilton).Sub(w, p.Rat()).Quad()
+	dh := new(Hamilton).Sub(w, h.Rat()).Quad()
+	if dh.Cmp(dp) < 0 {
+		return h
+	}
+	return p
+}
+
+// DivModL sets z to the left quotient of x and y and m to the remainder, and
+// returns the pair (z, m). The quotient is QuoL(x, y) rounded to the nearest
+// Hurwitz integer, so that
+// 		x = yz + m,	Quad(m) ≤ Quad(y)/2
+// If y is zero, then DivModL panics.
+func (z *HurwitzInt) DivModL(x, y, m *HurwitzInt) (*HurwitzInt, *HurwitzInt) {
+	if y.Quad().Sign() == 0 {
+		panic(denominatorError("division by zero"))
+	}
+	q := roundHurwitz(new(Hamilton).QuoL(x.Rat(), y.Rat()))
+	r := new(HurwitzInt).Sub(x, new(HurwitzInt).Mul(y, q))
+	z.Set(q)
+	m.Set(r)
+	return z, m
+}
+
+// DivModR sets z to the right quotient of x and y and m to the remainder,
+// and returns the pair (z, m). The quotient is QuoR(x, y) rounded to the
+// nearest Hurwitz integer, so that
+// 		x = zy + m,	Quad(m) ≤ Quad(y)/2
+// If y is zero, then DivModR panics.
+func (z *HurwitzInt) DivModR(x, y, m *HurwitzInt) (*HurwitzInt, *HurwitzInt) {
+	if y.Quad().Sign() == 0 {
+		panic(denominatorError("division by zero"))
+	}
+	q := roundHurwitz(new(Hamilton).QuoR(x.Rat(), y.Rat()))
+	r := new(HurwitzInt).Sub(x, new(HurwitzInt).Mul(q, y))
+	z.Set(q)
+	m.Set(r)
+	return z, m
+}
+
+// GCDL sets z equal to a greatest common left divisor of x and y, and returns
+// z. The divisor d satisfies x = da and y = db for Hurwitz integers a and b,
+// and generates the right ideal xH + yH. It is unique up to multiplication
+// on the right by one of the 24 units. Lipschitz integers can be converted
+// with SetHamiltonInt; their common divisors need not be Lipschitz. If x and
+// y are both zero, then z is zero.
+func (z *HurwitzInt) GCDL(x, y *HurwitzInt) *HurwitzInt {
+	a, b := new(HurwitzInt).Set(x), new(HurwitzInt).Set(y)
+	q := new(HurwitzInt)
+	for b.Quad().Sign() != 0 {
+		q.DivModL(a, b, a)
+		a, b = b, a
+	}
+	return z.Set(a)
+}
+
+// GCDR sets z equal to a greatest common right divisor of x and y, and
+// returns z. The divisor d satisfies x = ad and y = bd for Hurwitz integers a
+// and b, and generates the left ideal Hx + Hy. It is unique up to
+// multiplication on the left by one of the 24 units. Lipschitz integers can
+// be converted with SetHamiltonInt; their common divisors need not be
+// Lipschitz. If x and y are both zero, then z is zero.
+func (z *HurwitzInt) GCDR(x, y *HurwitzInt) *HurwitzInt {
+	a, b := new(HurwitzInt).Set(x), new(HurwitzInt).Set(y)
+	q := new(HurwitzInt)
+	for b.Quad().Sign() != 0 {
+		q.DivModR(a, b, a)
+		a, b = b, a
+	}
+	return z.Set(a)
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestComplexIntDivMod(t *testing.T) {
+	f := func(x, y *ComplexInt) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		if y.Quad().Sign() == 0 {
+			return true
+		}
+		q, m := new(ComplexInt).DivMod(x, y, new(ComplexInt))
+		sum := new(ComplexInt).Add(new(ComplexInt).Mul(y, q), m)
+		two := new(big.Int).Lsh(m.Quad(), 1)
+		return sum.Equals(x) && two.Cmp(y.Quad()) <= 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexIntGCD(t *testing.T) {
+	f := func(x, y, d *ComplexInt) bool {
+		// t.Logf("x = %v, y = %v, d = %v", x, y, d)
+		a, b := new(ComplexInt).Mul(x, d), new(ComplexInt).Mul(y, d)
+		g := new(ComplexInt).GCD(a, b)
+		if g.Quad().Sign() == 0 {
+			return d.Quad().Sign() == 0 || (x.Quad().Sign() == 0 && y.Quad().Sign() == 0)
+		}
+		// d divides g, and g divides a and b
+		_, m := new(ComplexInt).DivMod(g, d, new(ComplexInt))
+		_, ma := new(ComplexInt).DivMod(a, g, new(ComplexInt))
+		_, mb := new(ComplexInt).DivMod(b, g, new(ComplexInt))
+		return m.Quad().Sign() == 0 && ma.Quad().Sign() == 0 && mb.Quad().Sign() == 0 &&
+			g.l.Sign() > 0 && g.r.Sign() >= 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	// 5 = (2+i)(2-i) and 2+i = i(1-2i)
+	g := new(ComplexInt).GCD(NewComplexInt(big.NewInt(5), big.NewInt(0)), NewComplexInt(big.NewInt(1), big.NewInt(-2)))
+	if !g.Equals(NewComplexInt(big.NewInt(2), big.NewInt(1))) {
+		t.Errorf("GCD(5, 1-2i) = %v, want 2+i", g)
+	}
+}
+
+func TestHamiltonIntDivMod(t *testing.T) {
+	f := func(x, y *HamiltonInt) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		if y.Quad().Sign() == 0 {
+			return true
+		}
+		ql, ml := new(HamiltonInt).DivModL(x, y, new(HamiltonInt))
+		qr, mr := new(HamiltonInt).DivModR(x, y, new(HamiltonInt))
+		l := new(HamiltonInt).Add(new(HamiltonInt).Mul(y, ql), ml)
+		r := new(HamiltonInt).Add(new(HamiltonInt).Mul(qr, y), mr)
+		return l.Equals(x) && r.Equals(x) &&
+			ml.Quad().Cmp(y.Quad()) <= 0 && mr.Quad().Cmp(y.Quad()) <= 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHurwitzIntDivMod(t *testing.T) {
+	f := func(x, y *HurwitzInt) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		if y.Quad().Sign() == 0 {
+			return true
+		}
+		ql, ml := new(HurwitzInt).DivModL(x, y, new(HurwitzInt))
+		qr, mr := new(HurwitzInt).DivModR(x, y, new(HurwitzInt))
+		l := new(HurwitzInt).Add(new(HurwitzInt).Mul(y, ql), ml)
+		r := new(HurwitzInt).Add(new(HurwitzInt).Mul(qr, y), mr)
+		two := big.NewInt(2)
+		return l.Equals(x) && r.Equals(x) &&
+			new(big.Int).Mul(ml.Quad(), two).Cmp(y.Quad()) <= 0 &&
+			new(big.Int).Mul(mr.Quad(), two).Cmp(y.Quad()) <= 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHurwitzIntGCD(t *testing.T) {
+	f := func(x, y, d *HurwitzInt) bool {
+		// t.Logf("x = %v, y = %v, d = %v", x, y, d)
+		if d.Quad().Sign() == 0 {
+			return true
+		}
+		gl := new(HurwitzInt).GCDL(new(HurwitzInt).Mul(d, x), new(HurwitzInt).Mul(d, y))
+		gr := new(HurwitzInt).GCDR(new(HurwitzInt).Mul(x, d), new(HurwitzInt).Mul(y, d))
+		// d is a left divisor of gl and a right divisor of gr
+		_, ml := new(HurwitzInt).DivModL(gl, d, new(HurwitzInt))
+		_, mr := new(HurwitzInt).DivModR(gr, d, new(HurwitzInt))
+		return ml.Quad().Sign() == 0 && mr.Quad().Sign() == 0
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 50}); err != nil {
+		t.Error(err)
+	}
+}
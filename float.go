@@ -0,0 +1,666 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// float64s returns the nearest float64 values of v.
+func float64s(v []*big.Rat) []float64 {
+	f := make([]float64, len(v))
+	for i, x := range v {
+		f[i], _ = x.Float64()
+	}
+	return f
+}
+
+// floats returns the values of v rounded to big.Float values of precision
+// prec.
+func floats(v []*big.Rat, prec uint) []*big.Float {
+	f := make([]*big.Float, len(v))
+	for i, x := range v {
+		f[i] = new(big.Float).SetPrec(prec).SetRat(x)
+	}
+	return f
+}
+
+// ratsFromFloat64 returns the exact rational values of f. Every finite
+// float64 is a dyadic rational, so no rounding takes place. If any value in f
+// is infinite or NaN, then ratsFromFloat64 panics.
+func ratsFromFloat64(f ...float64) []*big.Rat {
+	v := make([]*big.Rat, len(f))
+	for i, x := range f {
+		if v[i] = new(big.Rat).SetFloat64(x); v[i] == nil {
+			panic(fmt.Sprintf("non-finite float64 %v", x))
+		}
+	}
+	return v
+}
+
+// Float64s returns the components of z as the nearest float64 values, for
+// plotting or numerical code. Components too large or too small for a
+// float64 become infinite or zero.
+func (z *Complex) Float64s() []float64 {
+	return float64s(z.Components())
+}
+
+// Floats returns the components of z rounded to big.Float values of
+// precision prec. If prec is 0, then 64 is used.
+func (z *Complex) Floats(prec uint) []*big.Float {
+	if prec == 0 {
+		prec = 64
+	}
+	return floats(z.Components(), prec)
+}
+
+// NewComplexFromFloat64 returns a pointer to the Complex value with the exact
+// float64 components a, b. If any of them is infinite or NaN, then
+// NewComplexFromFloat64 panics.
+func NewComplexFromFloat64(a, b float64) *Complex {
+	z := new(Complex)
+	setComponents(z.Components(), ratsFromFloat64(a, b))
+	return z
+}
+
+// Float64s returns the components of z as the nearest float64 values, for
+// plotting or numerical code. Components too large or too small for a
+// float64 become infinite or zero.
+func (z *Infra) Float64s() []float64 {
+	return float64s(z.Components())
+}
+
+// Floats returns the components of z rounded to big.Float values of
+// precision prec. If prec is 0, then 64 is used.
+func (z *Infra) Floats(prec uint) []*big.Float {
+	if prec == 0 {
+		prec = 64
+	}
+	return floats(z.Components(), prec)
+}
+
+// NewInfraFromFloat64 returns a pointer to the Infra value with the exact
+// float64 components a, b. If any of them is infinite or NaN, then
+// NewInfraFromFloat64 panics.
+func NewInfraFromFloat64(a, b float64) *Infra {
+	z := new(Infra)
+	setComponents(z.Components(), ratsFromFloat64(a, b))
+	return z
+}
+
+// Float64s returns the components of z as the nearest float64 values, for
+// plotting or numerical code. Components too large or too small for a
+// float64 become infinite or zero.
+func (z *Perplex) Float64s() []float64 {
+	return float64s(z.Components())
+}
+
+// Floats returns the components of z rounded to big.Float values of
+// precision prec. If prec is 0, then 64 is used.
+func (z *Perplex) Floats(prec uint) []*big.Float {
+	if prec == 0 {
+		prec = 64
+	}
+	return floats(z.Components(), prec)
+}
+
+// NewPerplexFromFloat64 returns a pointer to the Perplex value with the exact
+// float64 components a, b. If any of them is infinite or NaN, then
+// NewPerplexFromFloat64 panics.
+func NewPerplexFromFloat64(a, b float64) *Perplex {
+	z := new(Perplex)
+	setComponents(z.Components(), ratsFromFloat64(a, b))
+	return z
+}
+
+// Float64s returns the components of z as the nearest float64 values, for
+// plotting or numerical code. Components too large or too small for a
+// float64 become infinite or zero.
+func (z *BiComplex) Float64s() []float64 {
+	return float64s(z.Components())
+}
+
+// Floats returns the components of z rounded to big.Float values of
+// precision prec. If prec is 0, then 64 is used.
+func (z *BiComplex) Floats(prec uint) []*big.Float {
+	if prec == 0 {
+		prec = 64
+	}
+	return floats(z.Components(), prec)
+}
+
+// NewBiComplexFromFloat64 returns a pointer to the BiComplex value with the
+// exact float64 components a, b, c, d. If any of them is infinite or NaN, then
+// NewBiComplexFromFloat64 panics.
+func NewBiComplexFromFloat64(a, b, c, d float64) *BiComplex {
+	z := new(BiComplex)
+	setComponents(z.Components(), ratsFromFloat64(a, b, c, d))
+	return z
+}
+
+// Float64s returns the components of z as the nearest float64 values, for
+// plotting or numerical code. Components too large or too small for a
+// float64 become infinite or zero.
+func (z *BiPerplex) Float64s() []float64 {
+	return float64s(z.Components())
+}
+
+// Floats returns the components of z rounded to big.Float values of
+// precision prec. If prec is 0, then 64 is used.
+func (z *BiPerplex) Floats(prec uint) []*big.Float {
+	if prec == 0 {
+		prec = 64
+	}
+	return floats(z.Components(), prec)
+}
+
+// NewBiPerplexFromFloat64 returns a pointer to the BiPerplex value with the
+// exact float64 components a, b, c, d. If any of them is infinite or NaN, then
+// NewBiPerplexFromFloat64 panics.
+func NewBiPerplexFromFloat64(a, b, c, d float64) *BiPerplex {
+	z := new(BiPerplex)
+	setComponents(z.Components(), ratsFromFloat64(a, b, c, d))
+	return z
+}
+
+// Float64s returns the components of z as the nearest float64 values, for
+// plotting or numerical code. Components too large or too small for a
+// float64 become infinite or zero.
+func (z *Cockle) Float64s() []float64 {
+	return float64s(z.Components())
+}
+
+// Floats returns the components of z rounded to big.Float values of
+// precision prec. If prec is 0, then 64 is used.
+func (z *Cockle) Floats(prec uint) []*big.Float {
+	if prec == 0 {
+		prec = 64
+	}
+	return floats(z.Components(), prec)
+}
+
+// NewCockleFromFloat64 returns a pointer to the Cockle value with the exact
+// float64 components a, b, c, d. If any of them is infinite or NaN, then
+// NewCockleFromFloat64 panics.
+func NewCockleFromFloat64(a, b, c, d float64) *Cockle {
+	z := new(Cockle)
+	setComponents(z.Components(), ratsFromFloat64(a, b, c, d))
+	return z
+}
+
+// Float64s returns the components of z as the nearest float64 values, for
+// plotting or numerical code. Components too large or too small for a
+// float64 become infinite or zero.
+func (z *DualComplex) Float64s() []float64 {
+	return float64s(z.Components())
+}
+
+// Floats returns the components of z rounded to big.Float values of
+// precision prec. If prec is 0, then 64 is used.
+func (z *DualComplex) Floats(prec uint) []*big.Float {
+	if prec == 0 {
+		prec = 64
+	}
+	return floats(z.Components(), prec)
+}
+
+// NewDualComplexFromFloat64 returns a pointer to the DualComplex value with
+// the exact float64 components a, b, c, d. If any of them is infinite or NaN,
+// then NewDualComplexFromFloat64 panics.
+func NewDualComplexFromFloat64(a, b, c, d float64) *DualComplex {
+	z := new(DualComplex)
+	setComponents(z.Components(), ratsFromFloat64(a, b, c, d))
+	return z
+}
+
+// Float64s returns the components of z as the nearest float64 values, for
+// plotting or numerical code. Components too large or too small for a
+// float64 become infinite or zero.
+func (z *DualPerplex) Float64s() []float64 {
+	return float64s(z.Components())
+}
+
+// Floats returns the components of z rounded to big.Float values of
+// precision prec. If prec is 0, then 64 is used.
+func (z *DualPerplex) Floats(prec uint) []*big.Float {
+	if prec == 0 {
+		prec = 64
+	}
+	return floats(z.Components(), prec)
+}
+
+// NewDualPerplexFromFloat64 returns a pointer to the DualPerplex value with
+// the exact float64 components a, b, c, d. If any of them is infinite or NaN,
+// then NewDualPerplexFromFloat64 panics.
+func NewDualPerplexFromFloat64(a, b, c, d float64) *DualPerplex {
+	z := new(DualPerplex)
+	setComponents(z.Components(), ratsFromFloat64(a, b, c, d))
+	return z
+}
+
+// Float64s returns the components of z as the nearest float64 values, for
+// plotting or numerical code. Components too large or too small for a
+// float64 become infinite or zero.
+func (z *Hamilton) Float64s() []float64 {
+	return float64s(z.Components())
+}
+
+// Floats returns the components of z rounded to big.Float values of
+// precision prec. If prec is 0, then 64 is used.
+func (z *Hamilton) Floats(prec uint) []*big.Float {
+	if prec == 0 {
+		prec = 64
+	}
+	return floats(z.Components(), prec)
+}
+
+// NewHamiltonFromFloat64 returns a pointer to the Hamilton value with the
+// exact float64 components a, b, c, d. If any of them is infinite or NaN, then
+// NewHamiltonFromFloat64 panics.
+func NewHamiltonFromFloat64(a, b, c, d float64) *Hamilton {
+	z := new(Hamilton)
+	setComponents(z.Components(), ratsFromFloat64(a, b, c, d))
+	return z
+}
+
+// Float64s returns the components of z as the nearest float64 values, for
+// plotting or numerical code. Components too large or too small for a
+// float64 become infinite or zero.
+func (z *Hyper) Float64s() []float64 {
+	return float64s(z.Components())
+}
+
+// Floats returns the components of z rounded to big.Float values of
+// precision prec. If prec is 0, then 64 is used.
+func (z *Hyper) Floats(prec uint) []*big.Float {
+	if prec == 0 {
+		prec = 64
+	}
+	return floats(z.Components(), prec)
+}
+
+// NewHyperFromFloat64 returns a pointer to the Hyper value with the exact
+// float64 components a, b, c, d. If any of them is infinite or NaN, then
+// NewHyperFromFloat64 panics.
+func NewHyperFromFloat64(a, b, c, d float64) *Hyper {
+	z := new(Hyper)
+	setComponents(z.Components(), ratsFromFloat64(a, b, c, d))
+	return z
+}
+
+// Float64s returns the components of z as the nearest float64 values, for
+// plotting or numerical code. Components too large or too small for a
+// float64 become infinite or zero.
+func (z *InfraComplex) Float64s() []float64 {
+	return float64s(z.Components())
+}
+
+// Floats returns the components of z rounded to big.Float values of
+// precision prec. If prec is 0, then 64 is used.
+func (z *InfraComplex) Floats(prec uint) []*big.Float {
+	if prec == 0 {
+		prec = 64
+	}
+	return floats(z.Components(), prec)
+}
+
+// NewInfraComplexFromFloat64 returns a pointer to the InfraComplex value with
+// the exact float64 components a, b, c, d. If any of them is infinite or NaN,
+// then NewInfraComplexFromFloat64 panics.
+func NewInfraComplexFromFloat64(a, b, c, d float64) *InfraComplex {
+	z := new(InfraComplex)
+	setComponents(z.Components(), ratsFromFloat64(a, b, c, d))
+	return z
+}
+
+// Float64s returns the components of z as the nearest float64 values, for
+// plotting or numerical code. Components too large or too small for a
+// float64 become infinite or zero.
+func (z *InfraPerplex) Float64s() []float64 {
+	return float64s(z.Components())
+}
+
+// Floats returns the components of z rounded to big.Float values of
+// precision prec. If prec is 0, then 64 is used.
+func (z *InfraPerplex) Floats(prec uint) []*big.Float {
+	if prec == 0 {
+		prec = 64
+	}
+	return floats(z.Components(), prec)
+}
+
+// NewInfraPerplexFromFloat64 returns a pointer to the InfraPerplex value with
+// the exact float64 components a, b, c, d. If any of them is infinite or NaN,
+// then NewInfraPerplexFromFloat64 panics.
+func NewInfraPerplexFromFloat64(a, b, c, d float64) *InfraPerplex {
+	z := new(InfraPerplex)
+	setComponents(z.Components(), ratsFromFloat64(a, b, c, d))
+	return z
+}
+
+// Float64s returns the components of z as the nearest float64 values, for
+// plotting or numerical code. Components too large or too small for a
+// float64 become infinite or zero.
+func (z *Supra) Float64s() []float64 {
+	return float64s(z.Components())
+}
+
+// Floats returns the components of z rounded to big.Float values of
+// precision prec. If prec is 0, then 64 is used.
+func (z *Supra) Floats(prec uint) []*big.Float {
+	if prec == 0 {
+		prec = 64
+	}
+	return floats(z.Components(), prec)
+}
+
+// NewSupraFromFloat64 returns a pointer to the Supra value with the exact
+// float64 components a, b, c, d. If any of them is infinite or NaN, then
+// NewSupraFromFloat64 panics.
+func NewSupraFromFloat64(a, b, c, d float64) *Supra {
+	z := new(Supra)
+	setComponents(z.Components(), ratsFromFloat64(a, b, c, d))
+	return z
+}
+
+// Float64s returns the components of z as the nearest float64 values, for
+// plotting or numerical code. Components too large or too small for a
+// float64 become infinite or zero.
+func (z *BiCockle) Float64s() []float64 {
+	return float64s(z.Components())
+}
+
+// Floats returns the components of z rounded to big.Float values of
+// precision prec. If prec is 0, then 64 is used.
+func (z *BiCockle) Floats(prec uint) []*big.Float {
+	if prec == 0 {
+		prec = 64
+	}
+	return floats(z.Components(), prec)
+}
+
+// NewBiCockleFromFloat64 returns a pointer to the BiCockle value with the
+// exact float64 components a, b, c, d, e, f, g, h. If any of them is infinite
+// or NaN, then NewBiCockleFromFloat64 panics.
+func NewBiCockleFromFloat64(a, b, c, d, e, f, g, h float64) *BiCockle {
+	z := new(BiCockle)
+	setComponents(z.Components(), ratsFromFloat64(a, b, c, d, e, f, g, h))
+	return z
+}
+
+// Float64s returns the components of z as the nearest float64 values, for
+// plotting or numerical code. Components too large or too small for a
+// float64 become infinite or zero.
+func (z *BiHamilton) Float64s() []float64 {
+	return float64s(z.Components())
+}
+
+// Floats returns the components of z rounded to big.Float values of
+// precision prec. If prec is 0, then 64 is used.
+func (z *BiHamilton) Floats(prec uint) []*big.Float {
+	if prec == 0 {
+		prec = 64
+	}
+	return floats(z.Components(), prec)
+}
+
+// NewBiHamiltonFromFloat64 returns a pointer to the BiHamilton value with the
+// exact float64 components a, b, c, d, e, f, g, h. If any of them is infinite
+// or NaN, then NewBiHamiltonFromFloat64 panics.
+func NewBiHamiltonFromFloat64(a, b, c, d, e, f, g, h float64) *BiHamilton {
+	z := new(BiHamilton)
+	setComponents(z.Components(), ratsFromFloat64(a, b, c, d, e, f, g, h))
+	return z
+}
+
+// Float64s returns the components of z as the nearest float64 values, for
+// plotting or numerical code. Components too large or too small for a
+// float64 become infinite or zero.
+func (z *Cayley) Float64s() []float64 {
+	return float64s(z.Components())
+}
+
+// Floats returns the components of z rounded to big.Float values of
+// precision prec. If prec is 0, then 64 is used.
+func (z *Cayley) Floats(prec uint) []*big.Float {
+	if prec == 0 {
+		prec = 64
+	}
+	return floats(z.Components(), prec)
+}
+
+// NewCayleyFromFloat64 returns a pointer to the Cayley value with the exact
+// float64 components a, b, c, d, e, f, g, h. If any of them is infinite or
+// NaN, then NewCayleyFromFloat64 panics.
+func NewCayleyFromFloat64(a, b, c, d, e, f, g, h float64) *Cayley {
+	z := new(Cayley)
+	setComponents(z.Components(), ratsFromFloat64(a, b, c, d, e, f, g, h))
+	return z
+}
+
+// Float64s returns the components of z as the nearest float64 values, for
+// plotting or numerical code. Components too large or too small for a
+// float64 become infinite or zero.
+func (z *InfraCockle) Float64s() []float64 {
+	return float64s(z.Components())
+}
+
+// Floats returns the components of z rounded to big.Float values of
+// precision prec. If prec is 0, then 64 is used.
+func (z *InfraCockle) Floats(prec uint) []*big.Float {
+	if prec == 0 {
+		prec = 64
+	}
+	return floats(z.Components(), prec)
+}
+
+// NewInfraCockleFromFloat64 returns a pointer to the InfraCockle value with
+// the exact float64 components a, b, c, d, e, f, g, h. If any of them is
+// infinite or NaN, then NewInfraCockleFromFloat64 panics.
+func NewInfraCockleFromFloat64(a, b, c, d, e, f, g, h float64) *InfraCockle {
+	z := new(InfraCockle)
+	setComponents(z.Components(), ratsFromFloat64(a, b, c, d, e, f, g, h))
+	return z
+}
+
+// Float64s returns the components of z as the nearest float64 values, for
+// plotting or numerical code. Components too large or too small for a
+// float64 become infinite or zero.
+func (z *InfraHamilton) Float64s() []float64 {
+	return float64s(z.Components())
+}
+
+// Floats returns the components of z rounded to big.Float values of
+// precision prec. If prec is 0, then 64 is used.
+func (z *InfraHamilton) Floats(prec uint) []*big.Float {
+	if prec == 0 {
+		prec = 64
+	}
+	return floats(z.Components(), prec)
+}
+
+// NewInfraHamiltonFromFloat64 returns a pointer to the InfraHamilton value
+// with the exact float64 components a, b, c, d, e, f, g, h. If any of them is
+// infinite or NaN, then NewInfraHamiltonFromFloat64 panics.
+func NewInfraHamiltonFromFloat64(a, b, c, d, e, f, g, h float64) *InfraHamilton {
+	z := new(InfraHamilton)
+	setComponents(z.Components(), ratsFromFloat64(a, b, c, d, e, f, g, h))
+	return z
+}
+
+// Float64s returns the components of z as the nearest float64 values, for
+// plotting or numerical code. Components too large or too small for a
+// float64 become infinite or zero.
+func (z *SupraComplex) Float64s() []float64 {
+	return float64s(z.Components())
+}
+
+// Floats returns the components of z rounded to big.Float values of
+// precision prec. If prec is 0, then 64 is used.
+func (z *SupraComplex) Floats(prec uint) []*big.Float {
+	if prec == 0 {
+		prec = 64
+	}
+	return floats(z.Components(), prec)
+}
+
+// NewSupraComplexFromFloat64 returns a pointer to the SupraComplex value with
+// the exact float64 components a, b, c, d, e, f, g, h. If any of them is
+// infinite or NaN, then NewSupraComplexFromFloat64 panics.
+func NewSupraComplexFromFloat64(a, b, c, d, e, f, g, h float64) *SupraComplex {
+	z := new(SupraComplex)
+	setComponents(z.Components(), ratsFromFloat64(a, b, c, d, e, f, g, h))
+	return z
+}
+
+// Float64s returns the components of z as the nearest float64 values, for
+// plotting or numerical code. Components too large or too small for a
+// float64 become infinite or zero.
+func (z *SupraPerplex) Float64s() []float64 {
+	return float64s(z.Components())
+}
+
+// Floats returns the components of z rounded to big.Float values of
+// precision prec. If prec is 0, then 64 is used.
+func (z *SupraPerplex) Floats(prec uint) []*big.Float {
+	if prec == 0 {
+		prec = 64
+	}
+	return floats(z.Components(), prec)
+}
+
+// NewSupraPerplexFromFloat64 returns a pointer to the SupraPerplex value with
+// the exact float64 components a, b, c, d, e, f, g, h. If any of them is
+// infinite or NaN, then NewSupraPerplexFromFloat64 panics.
+func NewSupraPerplexFromFloat64(a, b, c, d, e, f, g, h float64) *SupraPerplex {
+	z := new(SupraPerplex)
+	setComponents(z.Components(), ratsFromFloat64(a, b, c, d, e, f, g, h))
+	return z
+}
+
+// Float64s returns the components of z as the nearest float64 values, for
+// plotting or numerical code. Components too large or too small for a
+// float64 become infinite or zero.
+func (z *TriComplex) Float64s() []float64 {
+	return float64s(z.Components())
+}
+
+// Floats returns the components of z rounded to big.Float values of
+// precision prec. If prec is 0, then 64 is used.
+func (z *TriComplex) Floats(prec uint) []*big.Float {
+	if prec == 0 {
+		prec = 64
+	}
+	return floats(z.Components(), prec)
+}
+
+// NewTriComplexFromFloat64 returns a pointer to the TriComplex value with the
+// exact float64 components a, b, c, d, e, f, g, h. If any of them is infinite
+// or NaN, then NewTriComplexFromFloat64 panics.
+func NewTriComplexFromFloat64(a, b, c, d, e, f, g, h float64) *TriComplex {
+	z := new(TriComplex)
+	setComponents(z.Components(), ratsFromFloat64(a, b, c, d, e, f, g, h))
+	return z
+}
+
+// Float64s returns the components of z as the nearest float64 values, for
+// plotting or numerical code. Components too large or too small for a
+// float64 become infinite or zero.
+func (z *TriNilplex) Float64s() []float64 {
+	return float64s(z.Components())
+}
+
+// Floats returns the components of z rounded to big.Float values of
+// precision prec. If prec is 0, then 64 is used.
+func (z *TriNilplex) Floats(prec uint) []*big.Float {
+	if prec == 0 {
+		prec = 64
+	}
+	return floats(z.Components(), prec)
+}
+
+// NewTriNilplexFromFloat64 returns a pointer to the TriNilplex value with the
+// exact float64 components a, b, c, d, e, f, g, h. If any of them is infinite
+// or NaN, then NewTriNilplexFromFloat64 panics.
+func NewTriNilplexFromFloat64(a, b, c, d, e, f, g, h float64) *TriNilplex {
+	z := new(TriNilplex)
+	setComponents(z.Components(), ratsFromFloat64(a, b, c, d, e, f, g, h))
+	return z
+}
+
+// Float64s returns the components of z as the nearest float64 values, for
+// plotting or numerical code. Components too large or too small for a
+// float64 become infinite or zero.
+func (z *TriPerplex) Float64s() []float64 {
+	return float64s(z.Components())
+}
+
+// Floats returns the components of z rounded to big.Float values of
+// precision prec. If prec is 0, then 64 is used.
+func (z *TriPerplex) Floats(prec uint) []*big.Float {
+	if prec == 0 {
+		prec = 64
+	}
+	return floats(z.Components(), prec)
+}
+
+// NewTriPerplexFromFloat64 returns a pointer to the TriPerplex value with the
+// exact float64 components a, b, c, d, e, f, g, h. If any of them is infinite
+// or NaN, then NewTriPerplexFromFloat64 panics.
+func NewTriPerplexFromFloat64(a, b, c, d, e, f, g, h float64) *TriPerplex {
+	z := new(TriPerplex)
+	setComponents(z.Components(), ratsFromFloat64(a, b, c, d, e, f, g, h))
+	return z
+}
+
+// Float64s returns the components of z as the nearest float64 values, for
+// plotting or numerical code. Components too large or too small for a
+// float64 become infinite or zero.
+func (z *Ultra) Float64s() []float64 {
+	return float64s(z.Components())
+}
+
+// Floats returns the components of z rounded to big.Float values of
+// precision prec. If prec is 0, then 64 is used.
+func (z *Ultra) Floats(prec uint) []*big.Float {
+	if prec == 0 {
+		prec = 64
+	}
+	return floats(z.Components(), prec)
+}
+
+// NewUltraFromFloat64 returns a pointer to the Ultra value with the exact
+// float64 components a, b, c, d, e, f, g, h. If any of them is infinite or
+// NaN, then NewUltraFromFloat64 panics.
+func NewUltraFromFloat64(a, b, c, d, e, f, g, h float64) *Ultra {
+	z := new(Ultra)
+	setComponents(z.Components(), ratsFromFloat64(a, b, c, d, e, f, g, h))
+	return z
+}
+
+// Float64s returns the components of z as the nearest float64 values, for
+// plotting or numerical code. Components too large or too small for a
+// float64 become infinite or zero.
+func (z *Zorn) Float64s() []float64 {
+	return float64s(z.Components())
+}
+
+// Floats returns the components of z rounded to big.Float values of
+// precision prec. If prec is 0, then 64 is used.
+func (z *Zorn) Floats(prec uint) []*big.Float {
+	if prec == 0 {
+		prec = 64
+	}
+	return floats(z.Components(), prec)
+}
+
+// NewZornFromFloat64 returns a pointer to the Zorn value with the exact
+// float64 components a, b, c, d, e, f, g, h. If any of them is infinite or
+// NaN, then NewZornFromFloat64 panics.
+func NewZornFromFloat64(a, b, c, d, e, f, g, h float64) *Zorn {
+	z := new(Zorn)
+	setComponents(z.Components(), ratsFromFloat64(a, b, c, d, e, f, g, h))
+	return z
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestHamiltonFromFloat64(t *testing.T) {
+	f := func(a, b, c, d float64) bool {
+		// t.Logf("a = %v, b = %v, c = %v, d = %v", a, b, c, d)
+		z := NewHamiltonFromFloat64(a, b, c, d)
+		g := z.Float64s()
+		return g[0] == a && g[1] == b && g[2] == c && g[3] == d
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexFromFloat64Exact(t *testing.T) {
+	z := NewComplexFromFloat64(0.1, -1.5)
+	if z.l.Cmp(big.NewRat(3602879701896397, 36028797018963968)) != 0 {
+		t.Errorf("real part of %v is not the dyadic value of 0.1", z)
+	}
+	if z.r.Cmp(big.NewRat(-3, 2)) != 0 {
+		t.Errorf("imaginary part of %v is not -3/2", z)
+	}
+}
+
+func TestCayleyFloats(t *testing.T) {
+	f := func(x *Cayley) bool {
+		// t.Logf("x = %v", x)
+		for i, v := range x.Floats(200) {
+			if v.Prec() != 200 {
+				return false
+			}
+			g, _ := v.Float64()
+			if g != x.Float64s()[i] {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	if p := new(Cayley).Floats(0)[0].Prec(); p != 64 {
+		t.Errorf("Floats(0) has precision %d, want 64", p)
+	}
+}
+
+func TestFromFloat64NaN(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("NewPerplexFromFloat64 with NaN did not panic")
+		}
+	}()
+	NewPerplexFromFloat64(1, math.NaN())
+}
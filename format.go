@@ -0,0 +1,382 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+var bracketPair atomic.Value
+
+// SetBrackets sets the brackets that String writes around values of every
+// type, and the verbs v, s, q, x, and X of Format, to left and right, and
+// returns the previous brackets. The default brackets are ⦗ and ⦘, and
+// SetBrackets("(", ")") gives plain ASCII ones for logs and other tools. The
+// brackets are shared by the whole process, like the hook of SetHook. The
+// Parse functions accept the current brackets, the default brackets, and
+// parentheses.
+func SetBrackets(left, right string) (oldLeft, oldRight string) {
+	old := brackets()
+	bracketPair.Store([2]string{left, right})
+	return old[0], old[1]
+}
+
+// brackets returns the left and right brackets that String writes.
+func brackets() [2]string {
+	if b, ok := bracketPair.Load().([2]string); ok {
+		return b
+	}
+	return [2]string{leftBracket, rightBracket}
+}
+
+// A Notation describes how the Format methods write values for a verb: the
+// brackets around a value, the signs between its terms, and the text for its
+// coefficients and unit symbols. Every term is written, including those with
+// zero coefficients, as String does.
+type Notation struct {
+	Left, Right string // the brackets around the value
+	Plus, Minus string // the signs between terms, such as "+" or " - "
+	Times       string // between a coefficient and its unit symbol
+	// Symbols maps the runes of unit symbols to their text, and Join
+	// separates the runes of a symbol such as "iJ". Runes that are not in
+	// Symbols are written as is.
+	Symbols map[rune]string
+	Join    string
+	// Coeff returns the text for the absolute value of a coefficient. If
+	// Coeff is nil, then RatString is used.
+	Coeff func(a *big.Rat) string
+}
+
+// LaTeXNotation is the notation for the verb L, as in
+// 		\frac{1}{2} + 0\mathbf{i} - 3\mathbf{j} + 1\mathbf{k}
+// with bold Latin and plain Greek unit symbols.
+var LaTeXNotation = &Notation{
+	Plus:  " + ",
+	Minus: " - ",
+	Symbols: func() map[rune]string {
+		m := map[rune]string{
+			'α': `\alpha`,
+			'β': `\beta`,
+			'γ': `\gamma`,
+			'δ': `\delta`,
+			'ε': `\epsilon`,
+			'ζ': `\zeta`,
+			'η': `\eta`,
+			'ρ': `\rho`,
+			'σ': `\sigma`,
+			'τ': `\tau`,
+			'υ': `\upsilon`,
+			'φ': `\phi`,
+			'ψ': `\psi`,
+			'Γ': `\Gamma`,
+			'Λ': `\Lambda`,
+		}
+		for _, r := range "HJKTUijkmnpqrstu" {
+			m[r] = `\mathbf{` + string(r) + `}`
+		}
+		return m
+	}(),
+	Coeff: func(a *big.Rat) string {
+		if a.IsInt() {
+			return a.Num().String()
+		}
+		return fmt.Sprintf(`\frac{%v}{%v}`, a.Num(), a.Denom())
+	},
+}
+
+// ASCIINotation is the notation for the verb A, as in
+// 		(1/2+0*i-3*j+1*k)
+// with ASCII brackets and Greek unit symbols spelled out, so that αΓ is
+// written as alpha*Gamma.
+var ASCIINotation = &Notation{
+	Left:  "(",
+	Right: ")",
+	Plus:  "+",
+	Minus: "-",
+	Times: "*",
+	Symbols: map[rune]string{
+		'α': "alpha",
+		'β': "beta",
+		'γ': "gamma",
+		'δ': "delta",
+		'ε': "epsilon",
+		'ζ': "zeta",
+		'η': "eta",
+		'ρ': "rho",
+		'σ': "sigma",
+		'τ': "tau",
+		'υ': "upsilon",
+		'φ': "phi",
+		'ψ': "psi",
+		'Γ': "Gamma",
+		'Λ': "Lambda",
+	},
+	Join: "*",
+}
+
+var (
+	notationMu sync.RWMutex
+	notations  = map[rune]*Notation{'L': LaTeXNotation, 'A': ASCIINotation}
+)
+
+// SetNotation installs n as the notation for verb, so that the Format methods
+// write values with n for that verb, and returns the previous notation for
+// verb, or nil. A nil n removes the notation. The verbs v, s, q, x, and X are
+// reserved for String, and SetNotation panics if verb is one of them.
+func SetNotation(verb rune, n *Notation) *Notation {
+	if strings.ContainsRune("vsqxX", verb) {
+		panic("reserved verb")
+	}
+	notationMu.Lock()
+	defer notationMu.Unlock()
+	old := notations[verb]
+	if n == nil {
+		delete(notations, verb)
+	} else {
+		notations[verb] = n
+	}
+	return old
+}
+
+// write returns the components v, whose unit symbols are symb, in notation n.
+func (n *Notation) write(v []*big.Rat, symb []string) string {
+	var b strings.Builder
+	b.WriteString(n.Left)
+	for k, c := range v {
+		switch {
+		case c.Sign() < 0 && k == 0:
+			b.WriteString("-")
+		case c.Sign() < 0:
+			b.WriteString(n.Minus)
+		case k > 0:
+			b.WriteString(n.Plus)
+		}
+		a := new(big.Rat).Abs(c)
+		if n.Coeff == nil {
+			b.WriteString(a.RatString())
+		} else {
+			b.WriteString(n.Coeff(a))
+		}
+		if k == 0 {
+			continue
+		}
+		b.WriteString(n.Times)
+		for i, r := range []rune(symb[k]) {
+			if i > 0 {
+				b.WriteString(n.Join)
+			}
+			if s, ok := n.Symbols[r]; ok {
+				b.WriteString(s)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteString(n.Right)
+	return b.String()
+}
+
+// format implements fmt.Formatter for z, whose components are v and whose
+// unit symbols are symb.
+func format(f fmt.State, verb rune, z fmt.Stringer, v []*big.Rat, symb []string) {
+	if strings.ContainsRune("vsqxX", verb) {
+		fmt.Fprintf(f, fmt.FormatString(f, verb), z.String())
+		return
+	}
+	notationMu.RLock()
+	n := notations[verb]
+	notationMu.RUnlock()
+	if n == nil {
+		fmt.Fprintf(f, "%%!%c(%s)", verb, z.String())
+		return
+	}
+	io.WriteString(f, n.write(v, symb))
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *Complex) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), symbComplex[:])
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *Infra) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), []string{"", "α"})
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *Perplex) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), []string{"", "s"})
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *BiComplex) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), symbBiComplex[:])
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *BiPerplex) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), symbBiPerplex[:])
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *Cockle) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), symbCockle[:])
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *DualComplex) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), symbDualComplex[:])
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *DualPerplex) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), symbDualPerplex[:])
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *Hamilton) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), symbHamilton[:])
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *Hyper) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), symbHyper[:])
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *InfraComplex) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), symbInfraComplex[:])
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *InfraPerplex) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), symbInfraPerplex[:])
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *Supra) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), symbSupra[:])
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *BiCockle) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), symbBiCockle[:])
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *BiHamilton) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), symbBiHamilton[:])
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *Cayley) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), symbCayley[:])
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *InfraCockle) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), symbInfraCockle[:])
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *InfraHamilton) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), symbInfraHamilton[:])
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *SupraComplex) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), symbSupraComplex[:])
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *SupraPerplex) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), symbSupraPerplex[:])
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *TriComplex) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), symbTriComplex[:])
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *TriNilplex) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), symbTriNilplex[:])
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *TriPerplex) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), symbTriPerplex[:])
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *Ultra) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), symbUltra[:])
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *Zorn) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), symbZorn[:])
+}
+
+// Format implements fmt.Formatter. The verbs v, s, q, x, and X format String,
+// L formats LaTeX, as in LaTeXNotation, and A formats plain ASCII, as in
+// ASCIINotation. Other verbs use the notations installed by SetNotation.
+func (z *DualHamilton) Format(f fmt.State, verb rune) {
+	format(f, verb, z, z.Components(), symbDualHamilton[:])
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+func TestFormat(t *testing.T) {
+	x := NewHamilton(big.NewRat(1, 2), big.NewRat(0, 1), big.NewRat(-3, 1), big.NewRat(1, 1))
+	y := NewHyper(big.NewRat(-1, 1), big.NewRat(2, 3), big.NewRat(0, 1), big.NewRat(5, 1))
+	tests := []struct {
+		format string
+		v      fmt.Formatter
+		want   string
+	}{
+		{"%v", x, x.String()},
+		{"%s", y, y.String()},
+		{"%L", x, `\frac{1}{2} + 0\mathbf{i} - 3\mathbf{j} + 1\mathbf{k}`},
+		{"%A", x, "(1/2+0*i-3*j+1*k)"},
+		{"%L", y, `-1 + \frac{2}{3}\alpha + 0\Gamma + 5\alpha\Gamma`},
+		{"%A", y, "(-1+2/3*alpha+0*Gamma+5*alpha*Gamma)"},
+		{"%d", x, "%!d(" + x.String() + ")"},
+	}
+	for _, test := range tests {
+		if got := fmt.Sprintf(test.format, test.v); got != test.want {
+			t.Errorf("Sprintf(%q, %v) = %s, want %s", test.format, test.v, got, test.want)
+		}
+	}
+	if got := fmt.Sprintf("%-30v|", x); len([]rune(got)) != 31 || !strings.HasPrefix(got, x.String()) {
+		t.Errorf("Sprintf(%%-30v) = %q", got)
+	}
+}
+
+func TestFormatASCII(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for name := range halves {
+		path := chain(name)
+		z := lift(new(big.Rat), path, len(path)-1)
+		for _, c := range z.(interface{ Components() []*big.Rat }).Components() {
+			c.Set(randomRat(r, defaultRandomOptions))
+		}
+		s := fmt.Sprintf("%A", z)
+		for _, c := range s {
+			if c > unicode.MaxASCII {
+				t.Errorf("%s: %s is not ASCII", name, s)
+				break
+			}
+		}
+	}
+}
+
+func TestSetNotation(t *testing.T) {
+	n := &Notation{
+		Left:    "[",
+		Right:   "]",
+		Plus:    ", ",
+		Minus:   ", -",
+		Times:   " ",
+		Symbols: map[rune]string{'i': "e1", 'j': "e2", 'k': "e3"},
+	}
+	if old := SetNotation('U', n); old != nil {
+		t.Errorf("SetNotation('U') returned %v", old)
+	}
+	defer SetNotation('U', nil)
+	x := NewHamilton(big.NewRat(1, 1), big.NewRat(-2, 1), big.NewRat(3, 4), big.NewRat(0, 1))
+	if got, want := fmt.Sprintf("%U", x), "[1, -2 e1, 3/4 e2, 0 e3]"; got != want {
+		t.Errorf("Sprintf(%%U) = %s, want %s", got, want)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("SetNotation('v') did not panic")
+		}
+	}()
+	SetNotation('v', n)
+}
+
+func TestSetBrackets(t *testing.T) {
+	l, r := SetBrackets("(", ")")
+	defer SetBrackets(l, r)
+	if l != "⦗" || r != "⦘" {
+		t.Errorf("default brackets %q and %q", l, r)
+	}
+	values := []fmt.Stringer{
+		NewSedenion(new(Cayley), new(Cayley)),
+		NewComplexInt(big.NewInt(1), big.NewInt(2)),
+		NewJetConst(big.NewRat(1, 1), 2),
+	}
+	for name := range halves {
+		path := chain(name)
+		values = append(values, lift(big.NewRat(-1, 2), path, len(path)-1))
+	}
+	for _, v := range values {
+		if s := v.String(); !strings.HasPrefix(s, "(") || !strings.HasSuffix(s, ")") || strings.ContainsAny(s, "⦗⦘") {
+			t.Errorf("%T: String = %s", v, s)
+		}
+		if s := fmt.Sprintf("%v", v); !strings.HasPrefix(s, "(") {
+			t.Errorf("%T: Sprintf(%%v) = %s", v, s)
+		}
+	}
+	SetBrackets("<", ">")
+	x := NewHamilton(big.NewRat(1, 2), big.NewRat(0, 1), big.NewRat(-3, 1), big.NewRat(1, 1))
+	if s := x.String(); s != "<1/2+0i-3j+1k>" {
+		t.Errorf("String = %s", s)
+	}
+	if y, err := ParseHamilton(x.String()); err != nil || !y.Equals(x) {
+		t.Errorf("ParseHamilton(%s) = %v, %v", x, y, err)
+	}
+}
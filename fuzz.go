@@ -0,0 +1,757 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"slices"
+)
+
+// An Op is an operation on component vectors. Unary operations ignore y.
+type Op func(x, y []*big.Rat) []*big.Rat
+
+// An Impl is one implementation of an algebra, described by the dimension of
+// its component space and by its named operations.
+type Impl struct {
+	Name string
+	Dim  int
+	Ops  map[string]Op
+}
+
+// A Divergence describes the first operation on which two implementations
+// disagree.
+type Divergence struct {
+	Step     int
+	Op       string
+	X, Y     []*big.Rat
+	Got, Exp []*big.Rat
+}
+
+func (d *Divergence) Error() string {
+	return fmt.Sprintf("rational: step %d: %s(%v, %v) = %v, want %v",
+		d.Step, d.Op, d.X, d.Y, d.Got, d.Exp)
+}
+
+// short returns true if every component of v has a numerator and a
+// denominator of at most 256 bits.
+func short(v []*big.Rat) bool {
+	for _, x := range v {
+		if x.Num().BitLen() > 256 || x.Denom().BitLen() > 256 {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff runs a random sequence of steps operations on the implementations got
+// and exp, and returns the first divergence, or nil if there is none. Only the
+// operations that both implementations provide are exercised. The results of
+// each step are fed back as operands of later steps, so long chains of
+// operations are covered, as long as their components stay short. If the
+// dimensions differ, then Diff panics.
+func Diff(got, exp *Impl, r *rand.Rand, steps int) *Divergence {
+	if got.Dim != exp.Dim {
+		panic("dimension mismatch")
+	}
+	var names []string
+	for name := range exp.Ops {
+		if _, ok := got.Ops[name]; ok {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	// map order is random; sort the names so that r alone fixes the sequence
+	slices.Sort(names)
+	random := func() []*big.Rat {
+		v := make([]*big.Rat, exp.Dim)
+		for i := range v {
+			v[i] = big.NewRat(r.Int63n(19)-9, r.Int63n(9)+1)
+		}
+		return v
+	}
+	pool := [][]*big.Rat{random(), random()}
+	for step := 0; step < steps; step++ {
+		name := names[r.Intn(len(names))]
+		x, y := pool[r.Intn(len(pool))], pool[r.Intn(len(pool))]
+		if r.Intn(4) == 0 {
+			x = random()
+		}
+		a, b := got.Ops[name](x, y), exp.Ops[name](x, y)
+		if !equalRats(a, b) {
+			return &Divergence{step, name, x, y, a, b}
+		}
+		// keep the pool small, and its values short, so that each step stays
+		// cheap
+		if !short(b) {
+			continue
+		}
+		if len(pool) < 8 {
+			pool = append(pool, b)
+		} else {
+			pool[r.Intn(len(pool))] = b
+		}
+	}
+	return nil
+}
+
+// ComplexImpl returns the Complex implementation, for use with Diff.
+func ComplexImpl() *Impl {
+	val := func(v []*big.Rat) *Complex {
+		return NewComplex(v[0], v[1])
+	}
+	return &Impl{"Complex", 2, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Complex).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Complex).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Complex).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(Complex).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(Complex).Conj(val(x)).Rats())
+		},
+	}}
+}
+
+// InfraImpl returns the Infra implementation, for use with Diff.
+func InfraImpl() *Impl {
+	val := func(v []*big.Rat) *Infra {
+		return NewInfra(v[0], v[1])
+	}
+	return &Impl{"Infra", 2, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Infra).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Infra).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Infra).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(Infra).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(Infra).Conj(val(x)).Rats())
+		},
+	}}
+}
+
+// PerplexImpl returns the Perplex implementation, for use with Diff.
+func PerplexImpl() *Impl {
+	val := func(v []*big.Rat) *Perplex {
+		return NewPerplex(v[0], v[1])
+	}
+	return &Impl{"Perplex", 2, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Perplex).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Perplex).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Perplex).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(Perplex).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(Perplex).Conj(val(x)).Rats())
+		},
+	}}
+}
+
+// BiComplexImpl returns the BiComplex implementation, for use with Diff.
+func BiComplexImpl() *Impl {
+	val := func(v []*big.Rat) *BiComplex {
+		return NewBiComplex(v[0], v[1], v[2], v[3])
+	}
+	return &Impl{"BiComplex", 4, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(BiComplex).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(BiComplex).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(BiComplex).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(BiComplex).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(BiComplex).Conj(val(x)).Rats())
+		},
+		"Star": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(BiComplex).Star(val(x)).Rats())
+		},
+	}}
+}
+
+// BiPerplexImpl returns the BiPerplex implementation, for use with Diff.
+func BiPerplexImpl() *Impl {
+	val := func(v []*big.Rat) *BiPerplex {
+		return NewBiPerplex(v[0], v[1], v[2], v[3])
+	}
+	return &Impl{"BiPerplex", 4, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(BiPerplex).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(BiPerplex).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(BiPerplex).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(BiPerplex).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(BiPerplex).Conj(val(x)).Rats())
+		},
+		"Star": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(BiPerplex).Star(val(x)).Rats())
+		},
+	}}
+}
+
+// CockleImpl returns the Cockle implementation, for use with Diff.
+func CockleImpl() *Impl {
+	val := func(v []*big.Rat) *Cockle {
+		return NewCockle(v[0], v[1], v[2], v[3])
+	}
+	return &Impl{"Cockle", 4, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Cockle).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Cockle).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Cockle).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(Cockle).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(Cockle).Conj(val(x)).Rats())
+		},
+	}}
+}
+
+// DualComplexImpl returns the DualComplex implementation, for use with Diff.
+func DualComplexImpl() *Impl {
+	val := func(v []*big.Rat) *DualComplex {
+		return NewDualComplex(v[0], v[1], v[2], v[3])
+	}
+	return &Impl{"DualComplex", 4, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(DualComplex).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(DualComplex).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(DualComplex).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(DualComplex).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(DualComplex).Conj(val(x)).Rats())
+		},
+		"Star": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(DualComplex).Star(val(x)).Rats())
+		},
+	}}
+}
+
+// DualPerplexImpl returns the DualPerplex implementation, for use with Diff.
+func DualPerplexImpl() *Impl {
+	val := func(v []*big.Rat) *DualPerplex {
+		return NewDualPerplex(v[0], v[1], v[2], v[3])
+	}
+	return &Impl{"DualPerplex", 4, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(DualPerplex).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(DualPerplex).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(DualPerplex).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(DualPerplex).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(DualPerplex).Conj(val(x)).Rats())
+		},
+		"Star": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(DualPerplex).Star(val(x)).Rats())
+		},
+	}}
+}
+
+// HamiltonImpl returns the Hamilton implementation, for use with Diff.
+func HamiltonImpl() *Impl {
+	val := func(v []*big.Rat) *Hamilton {
+		return NewHamilton(v[0], v[1], v[2], v[3])
+	}
+	return &Impl{"Hamilton", 4, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Hamilton).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Hamilton).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Hamilton).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(Hamilton).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(Hamilton).Conj(val(x)).Rats())
+		},
+	}}
+}
+
+// HyperImpl returns the Hyper implementation, for use with Diff.
+func HyperImpl() *Impl {
+	val := func(v []*big.Rat) *Hyper {
+		return NewHyper(v[0], v[1], v[2], v[3])
+	}
+	return &Impl{"Hyper", 4, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Hyper).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Hyper).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Hyper).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(Hyper).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(Hyper).Conj(val(x)).Rats())
+		},
+		"Star": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(Hyper).Star(val(x)).Rats())
+		},
+	}}
+}
+
+// InfraComplexImpl returns the InfraComplex implementation, for use with Diff.
+func InfraComplexImpl() *Impl {
+	val := func(v []*big.Rat) *InfraComplex {
+		return NewInfraComplex(v[0], v[1], v[2], v[3])
+	}
+	return &Impl{"InfraComplex", 4, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(InfraComplex).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(InfraComplex).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(InfraComplex).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(InfraComplex).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(InfraComplex).Conj(val(x)).Rats())
+		},
+	}}
+}
+
+// InfraPerplexImpl returns the InfraPerplex implementation, for use with Diff.
+func InfraPerplexImpl() *Impl {
+	val := func(v []*big.Rat) *InfraPerplex {
+		return NewInfraPerplex(v[0], v[1], v[2], v[3])
+	}
+	return &Impl{"InfraPerplex", 4, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(InfraPerplex).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(InfraPerplex).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(InfraPerplex).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(InfraPerplex).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(InfraPerplex).Conj(val(x)).Rats())
+		},
+	}}
+}
+
+// SupraImpl returns the Supra implementation, for use with Diff.
+func SupraImpl() *Impl {
+	val := func(v []*big.Rat) *Supra {
+		return NewSupra(v[0], v[1], v[2], v[3])
+	}
+	return &Impl{"Supra", 4, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Supra).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Supra).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Supra).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(Supra).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(Supra).Conj(val(x)).Rats())
+		},
+	}}
+}
+
+// BiCockleImpl returns the BiCockle implementation, for use with Diff.
+func BiCockleImpl() *Impl {
+	val := func(v []*big.Rat) *BiCockle {
+		return NewBiCockle(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+	}
+	return &Impl{"BiCockle", 8, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(BiCockle).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(BiCockle).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(BiCockle).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(BiCockle).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(BiCockle).Conj(val(x)).Rats())
+		},
+		"Star": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(BiCockle).Star(val(x)).Rats())
+		},
+	}}
+}
+
+// BiHamiltonImpl returns the BiHamilton implementation, for use with Diff.
+func BiHamiltonImpl() *Impl {
+	val := func(v []*big.Rat) *BiHamilton {
+		return NewBiHamilton(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+	}
+	return &Impl{"BiHamilton", 8, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(BiHamilton).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(BiHamilton).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(BiHamilton).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(BiHamilton).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(BiHamilton).Conj(val(x)).Rats())
+		},
+		"Star": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(BiHamilton).Star(val(x)).Rats())
+		},
+	}}
+}
+
+// CayleyImpl returns the Cayley implementation, for use with Diff.
+func CayleyImpl() *Impl {
+	val := func(v []*big.Rat) *Cayley {
+		return NewCayley(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+	}
+	return &Impl{"Cayley", 8, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Cayley).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Cayley).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Cayley).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(Cayley).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(Cayley).Conj(val(x)).Rats())
+		},
+	}}
+}
+
+// InfraCockleImpl returns the InfraCockle implementation, for use with Diff.
+func InfraCockleImpl() *Impl {
+	val := func(v []*big.Rat) *InfraCockle {
+		return NewInfraCockle(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+	}
+	return &Impl{"InfraCockle", 8, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(InfraCockle).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(InfraCockle).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(InfraCockle).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(InfraCockle).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(InfraCockle).Conj(val(x)).Rats())
+		},
+	}}
+}
+
+// DualHamiltonImpl returns the DualHamilton implementation, for use with Diff.
+func DualHamiltonImpl() *Impl {
+	val := func(v []*big.Rat) *DualHamilton {
+		return NewDualHamilton(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+	}
+	return &Impl{"DualHamilton", 8, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(DualHamilton).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(DualHamilton).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(DualHamilton).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(DualHamilton).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(DualHamilton).Conj(val(x)).Rats())
+		},
+		"Star": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(DualHamilton).Star(val(x)).Rats())
+		},
+	}}
+}
+
+// InfraHamiltonImpl returns the InfraHamilton implementation, for use with Diff.
+func InfraHamiltonImpl() *Impl {
+	val := func(v []*big.Rat) *InfraHamilton {
+		return NewInfraHamilton(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+	}
+	return &Impl{"InfraHamilton", 8, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(InfraHamilton).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(InfraHamilton).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(InfraHamilton).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(InfraHamilton).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(InfraHamilton).Conj(val(x)).Rats())
+		},
+	}}
+}
+
+// SupraComplexImpl returns the SupraComplex implementation, for use with Diff.
+func SupraComplexImpl() *Impl {
+	val := func(v []*big.Rat) *SupraComplex {
+		return NewSupraComplex(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+	}
+	return &Impl{"SupraComplex", 8, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(SupraComplex).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(SupraComplex).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(SupraComplex).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(SupraComplex).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(SupraComplex).Conj(val(x)).Rats())
+		},
+	}}
+}
+
+// SupraPerplexImpl returns the SupraPerplex implementation, for use with Diff.
+func SupraPerplexImpl() *Impl {
+	val := func(v []*big.Rat) *SupraPerplex {
+		return NewSupraPerplex(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+	}
+	return &Impl{"SupraPerplex", 8, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(SupraPerplex).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(SupraPerplex).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(SupraPerplex).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(SupraPerplex).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(SupraPerplex).Conj(val(x)).Rats())
+		},
+	}}
+}
+
+// TriComplexImpl returns the TriComplex implementation, for use with Diff.
+func TriComplexImpl() *Impl {
+	val := func(v []*big.Rat) *TriComplex {
+		return NewTriComplex(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+	}
+	return &Impl{"TriComplex", 8, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(TriComplex).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(TriComplex).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(TriComplex).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(TriComplex).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(TriComplex).Conj(val(x)).Rats())
+		},
+		"Star": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(TriComplex).Star(val(x)).Rats())
+		},
+	}}
+}
+
+// TriNilplexImpl returns the TriNilplex implementation, for use with Diff.
+func TriNilplexImpl() *Impl {
+	val := func(v []*big.Rat) *TriNilplex {
+		return NewTriNilplex(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+	}
+	return &Impl{"TriNilplex", 8, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(TriNilplex).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(TriNilplex).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(TriNilplex).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(TriNilplex).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(TriNilplex).Conj(val(x)).Rats())
+		},
+		"Star": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(TriNilplex).Star(val(x)).Rats())
+		},
+	}}
+}
+
+// TriPerplexImpl returns the TriPerplex implementation, for use with Diff.
+func TriPerplexImpl() *Impl {
+	val := func(v []*big.Rat) *TriPerplex {
+		return NewTriPerplex(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+	}
+	return &Impl{"TriPerplex", 8, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(TriPerplex).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(TriPerplex).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(TriPerplex).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(TriPerplex).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(TriPerplex).Conj(val(x)).Rats())
+		},
+		"Star": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(TriPerplex).Star(val(x)).Rats())
+		},
+	}}
+}
+
+// UltraImpl returns the Ultra implementation, for use with Diff.
+func UltraImpl() *Impl {
+	val := func(v []*big.Rat) *Ultra {
+		return NewUltra(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+	}
+	return &Impl{"Ultra", 8, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Ultra).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Ultra).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Ultra).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(Ultra).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(Ultra).Conj(val(x)).Rats())
+		},
+	}}
+}
+
+// ZornImpl returns the Zorn implementation, for use with Diff.
+func ZornImpl() *Impl {
+	val := func(v []*big.Rat) *Zorn {
+		return NewZorn(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+	}
+	return &Impl{"Zorn", 8, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Zorn).Add(val(x), val(y)).Rats())
+		},
+		"Sub": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Zorn).Sub(val(x), val(y)).Rats())
+		},
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return rats(new(Zorn).Mul(val(x), val(y)).Rats())
+		},
+		"Neg": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(Zorn).Neg(val(x)).Rats())
+		},
+		"Conj": func(x, _ []*big.Rat) []*big.Rat {
+			return rats(new(Zorn).Conj(val(x)).Rats())
+		},
+	}}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestDiffOperator(t *testing.T) {
+	// Multiplication through the left regular representation.
+	exp := CockleImpl()
+	got := &Impl{"Cockle (operator)", 4, map[string]Op{
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return NewCockle(x[0], x[1], x[2], x[3]).LeftMul().Apply(y)
+		},
+		"Add": exp.Ops["Add"],
+	}}
+	if d := Diff(got, exp, rand.New(rand.NewSource(1)), 200); d != nil {
+		t.Error(d)
+	}
+}
+
+func TestDiffSoA(t *testing.T) {
+	exp := HamiltonImpl()
+	got := &Impl{"Hamilton (SoA)", 4, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			a, b := NewSoA(4, 1), NewSoA(4, 1)
+			a.SetRow(0, x)
+			b.SetRow(0, y)
+			return a.Add(a, b).Row(0)
+		},
+	}}
+	if d := Diff(got, exp, rand.New(rand.NewSource(2)), 200); d != nil {
+		t.Error(d)
+	}
+}
+
+func TestDiffDivergence(t *testing.T) {
+	// Ultra multiplication is noncommutative, so swapping operands diverges.
+	exp := UltraImpl()
+	got := &Impl{"Ultra (swapped)", 8, map[string]Op{
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			return exp.Ops["Mul"](y, x)
+		},
+	}}
+	if d := Diff(got, exp, rand.New(rand.NewSource(3)), 200); d == nil {
+		t.Error("Diff found no divergence")
+	}
+}
+
+func TestDiffShortResult(t *testing.T) {
+	// A result with too few components diverges instead of panicking.
+	exp := HamiltonImpl()
+	got := &Impl{"Hamilton (truncated)", 4, map[string]Op{
+		"Add": func(x, y []*big.Rat) []*big.Rat {
+			return exp.Ops["Add"](x, y)[:3]
+		},
+	}}
+	if d := Diff(got, exp, rand.New(rand.NewSource(4)), 10); d == nil || d.Step != 0 {
+		t.Errorf("Diff = %v, want a divergence at step 0", d)
+	}
+}
@@ -0,0 +1,566 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"slices"
+)
+
+// isUnitRat returns true if q is 1 or -1.
+func isUnitRat(q *big.Rat) bool {
+	return q.IsInt() && q.Num().IsInt64() && (q.Num().Int64() == 1 || q.Num().Int64() == -1)
+}
+
+// elemOrder returns the least n ≥ 1 with zⁿ = 1, or zero if there is none. A
+// value of finite order n generates a subalgebra whose dimension d is at least
+// the sum of φ(k) over the orders k of its eigenvalues, so n is at most 6, 12,
+// or 60 for d = 2, 4, or 8; bound is the one for the dimension of the type.
+func elemOrder[S any, T Elem[S]](z T, bound int) int {
+	one := T(new(S))
+	one.Real().SetInt64(1)
+	pow := T(new(S))
+	pow.Set(z)
+	for n := 1; n <= bound; n++ {
+		if pow.Equals(one) {
+			return n
+		}
+		pow.Mul(pow, z)
+	}
+	return 0
+}
+
+// IsUnit returns true if the quadrance of z is 1 or -1. Every value of finite
+// order is a unit.
+func (z *Complex) IsUnit() bool {
+	return isUnitRat(z.Quad())
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *Complex) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 6)
+}
+
+// IsUnit returns true if the quadrance of z is 1 or -1. Every value of finite
+// order is a unit.
+func (z *Infra) IsUnit() bool {
+	return isUnitRat(z.Quad())
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *Infra) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 6)
+}
+
+// IsUnit returns true if the quadrance of z is 1 or -1. Every value of finite
+// order is a unit.
+func (z *Perplex) IsUnit() bool {
+	return isUnitRat(z.Quad())
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *Perplex) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 6)
+}
+
+// IsUnit returns true if the quadrance of z, a Complex, is itself a unit in
+// the sense of Complex.IsUnit. Every value of finite order is a unit.
+func (z *BiComplex) IsUnit() bool {
+	return z.Quad().IsUnit()
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *BiComplex) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 12)
+}
+
+// IsUnit returns true if the quadrance of z, a Perplex, is itself a unit in
+// the sense of Perplex.IsUnit. Every value of finite order is a unit.
+func (z *BiPerplex) IsUnit() bool {
+	return z.Quad().IsUnit()
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *BiPerplex) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 12)
+}
+
+// IsUnit returns true if the quadrance of z is 1 or -1. Every value of finite
+// order is a unit.
+func (z *Cockle) IsUnit() bool {
+	return isUnitRat(z.Quad())
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *Cockle) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 12)
+}
+
+// IsUnit returns true if the quadrance of z, a Complex, is itself a unit in
+// the sense of Complex.IsUnit. Every value of finite order is a unit.
+func (z *DualComplex) IsUnit() bool {
+	return z.Quad().IsUnit()
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *DualComplex) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 12)
+}
+
+// IsUnit returns true if the quadrance of z, a Perplex, is itself a unit in
+// the sense of Perplex.IsUnit. Every value of finite order is a unit.
+func (z *DualPerplex) IsUnit() bool {
+	return z.Quad().IsUnit()
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *DualPerplex) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 12)
+}
+
+// IsUnit returns true if the quadrance of z is 1 or -1. Every value of finite
+// order is a unit.
+func (z *Hamilton) IsUnit() bool {
+	return isUnitRat(z.Quad())
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *Hamilton) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 12)
+}
+
+// IsUnit returns true if the quadrance of z, an Infra, is itself a unit in
+// the sense of Infra.IsUnit. Every value of finite order is a unit.
+func (z *Hyper) IsUnit() bool {
+	return z.Quad().IsUnit()
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *Hyper) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 12)
+}
+
+// IsUnit returns true if the quadrance of z is 1 or -1. Every value of finite
+// order is a unit.
+func (z *InfraComplex) IsUnit() bool {
+	return isUnitRat(z.Quad())
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *InfraComplex) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 12)
+}
+
+// IsUnit returns true if the quadrance of z is 1 or -1. Every value of finite
+// order is a unit.
+func (z *InfraPerplex) IsUnit() bool {
+	return isUnitRat(z.Quad())
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *InfraPerplex) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 12)
+}
+
+// IsUnit returns true if the quadrance of z is 1 or -1. Every value of finite
+// order is a unit.
+func (z *Supra) IsUnit() bool {
+	return isUnitRat(z.Quad())
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *Supra) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 12)
+}
+
+// IsUnit returns true if the quadrance of z, a Complex, is itself a unit in
+// the sense of Complex.IsUnit. Every value of finite order is a unit.
+func (z *BiCockle) IsUnit() bool {
+	return z.quad().IsUnit()
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *BiCockle) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 60)
+}
+
+// IsUnit returns true if the quadrance of z, a Complex, is itself a unit in
+// the sense of Complex.IsUnit. Every value of finite order is a unit.
+func (z *BiHamilton) IsUnit() bool {
+	return z.quad().IsUnit()
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *BiHamilton) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 60)
+}
+
+// IsUnit returns true if the quadrance of z is 1 or -1. Every value of finite
+// order is a unit.
+func (z *Cayley) IsUnit() bool {
+	return isUnitRat(z.Quad())
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *Cayley) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 60)
+}
+
+// IsUnit returns true if the quadrance of z is 1 or -1. Every value of finite
+// order is a unit.
+func (z *InfraCockle) IsUnit() bool {
+	return isUnitRat(z.Quad())
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *InfraCockle) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 60)
+}
+
+// IsUnit returns true if the quadrance of z is 1 or -1. Every value of finite
+// order is a unit.
+func (z *InfraHamilton) IsUnit() bool {
+	return isUnitRat(z.Quad())
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *InfraHamilton) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 60)
+}
+
+// IsUnit returns true if the quadrance of z is 1 or -1. Every value of finite
+// order is a unit.
+func (z *SupraComplex) IsUnit() bool {
+	return isUnitRat(z.Quad())
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *SupraComplex) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 60)
+}
+
+// IsUnit returns true if the quadrance of z is 1 or -1. Every value of finite
+// order is a unit.
+func (z *SupraPerplex) IsUnit() bool {
+	return isUnitRat(z.Quad())
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *SupraPerplex) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 60)
+}
+
+// IsUnit returns true if the quadrance of z, a BiComplex, is itself a unit in
+// the sense of BiComplex.IsUnit. Every value of finite order is a unit.
+func (z *TriComplex) IsUnit() bool {
+	return z.Quad().IsUnit()
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *TriComplex) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 60)
+}
+
+// IsUnit returns true if the quadrance of z, a Hyper, is itself a unit in
+// the sense of Hyper.IsUnit. Every value of finite order is a unit.
+func (z *TriNilplex) IsUnit() bool {
+	return z.Quad().IsUnit()
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *TriNilplex) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 60)
+}
+
+// IsUnit returns true if the quadrance of z, a BiPerplex, is itself a unit in
+// the sense of BiPerplex.IsUnit. Every value of finite order is a unit.
+func (z *TriPerplex) IsUnit() bool {
+	return z.Quad().IsUnit()
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *TriPerplex) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 60)
+}
+
+// IsUnit returns true if the quadrance of z is 1 or -1. Every value of finite
+// order is a unit.
+func (z *Ultra) IsUnit() bool {
+	return isUnitRat(z.Quad())
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *Ultra) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 60)
+}
+
+// IsUnit returns true if the quadrance of z is 1 or -1. Every value of finite
+// order is a unit.
+func (z *Zorn) IsUnit() bool {
+	return isUnitRat(z.Quad())
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *Zorn) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 60)
+}
+
+// IsUnit returns true if z = p+qΓ with Quad(p) = 1, so that z Conj(z) is a
+// dual number with real part 1. Every value of finite order is a unit, and
+// every rigid motion has a unit representative when its rotation does.
+func (z *DualHamilton) IsUnit() bool {
+	return isUnitRat(z.l.Quad())
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with zⁿ
+// = 1, or zero if there is none.
+func (z *DualHamilton) Order() int {
+	if !z.IsUnit() {
+		return 0
+	}
+	return elemOrder(z, 60)
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with
+// zⁿ = 1, or zero if there is none.
+func (z *ComplexInt) Order() int {
+	return z.Rat().Order()
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with
+// zⁿ = 1, or zero if there is none.
+func (z *HamiltonInt) Order() int {
+	return z.Rat().Order()
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with
+// zⁿ = 1, or zero if there is none. The 24 Hurwitz units have orders 1, 2, 3,
+// 4, and 6.
+func (z *HurwitzInt) Order() int {
+	return z.Rat().Order()
+}
+
+// Order returns the order of z in its group of units: the least n ≥ 1 with
+// zⁿ = 1, or zero if there is none.
+func (z *CayleyInt) Order() int {
+	return z.Rat().Order()
+}
+
+// ComplexIntUnits returns the four Gaussian units 1, i, -1, and -i, in the
+// order of their powers of i.
+func ComplexIntUnits() []*ComplexInt {
+	v := make([]*ComplexInt, 4)
+	v[0] = NewComplexInt(big.NewInt(1), big.NewInt(0))
+	i := NewComplexInt(big.NewInt(0), big.NewInt(1))
+	for n := 1; n < 4; n++ {
+		v[n] = new(ComplexInt).Mul(v[n-1], i)
+	}
+	return v
+}
+
+// HamiltonIntUnits returns the eight Lipschitz units ±1, ±i, ±j, and ±k,
+// sorted by Cmp. They form the quaternion group Q₈.
+func HamiltonIntUnits() []*HamiltonInt {
+	var v []*HamiltonInt
+	for i := 0; i < 4; i++ {
+		for _, s := range []int64{1, -1} {
+			c := make([]*big.Int, 4)
+			for k := range c {
+				c[k] = new(big.Int)
+			}
+			c[i].SetInt64(s)
+			v = append(v, NewHamiltonInt(c[0], c[1], c[2], c[3]))
+		}
+	}
+	slices.SortFunc(v, (*HamiltonInt).Cmp)
+	return v
+}
+
+// HurwitzIntUnits returns the 24 Hurwitz units, sorted by Cmp: the eight
+// Lipschitz units and the sixteen values (±1±i±j±k)/2. They form the binary
+// tetrahedral group.
+func HurwitzIntUnits() []*HurwitzInt {
+	var v []*HurwitzInt
+	for _, u := range HamiltonIntUnits() {
+		v = append(v, new(HurwitzInt).SetHamiltonInt(u))
+	}
+	for s := 0; s < 16; s++ {
+		c := make([]*big.Int, 4)
+		for k := range c {
+			// the component is c + ½, so -1 gives -½
+			c[k] = big.NewInt(-int64(s >> k & 1))
+		}
+		v = append(v, NewHurwitzInt(c[0], c[1], c[2], c[3]))
+	}
+	slices.SortFunc(v, (*HurwitzInt).Cmp)
+	return v
+}
+
+// CayleyIntUnits returns the sixteen units ±1, ±i, ±j, ±k, ±m, ±n, ±p, and
+// ±q of the Gravesian integers, sorted by Cmp.
+func CayleyIntUnits() []*CayleyInt {
+	var v []*CayleyInt
+	for i := 0; i < 8; i++ {
+		for _, s := range []int64{1, -1} {
+			c := make([]*big.Int, 8)
+			for k := range c {
+				c[k] = new(big.Int)
+			}
+			c[i].SetInt64(s)
+			v = append(v, NewCayleyInt(c[0], c[1], c[2], c[3], c[4], c[5], c[6], c[7]))
+		}
+	}
+	slices.SortFunc(v, (*CayleyInt).Cmp)
+	return v
+}
+
+// octavianTriples holds seven triples of imaginary units, as indices in the
+// order of Cayley.Components. They form a Fano plane which, unlike the one of
+// the multiplication table, makes the octavian units closed under products.
+var octavianTriples = [7][3]int{
+	{1, 2, 3}, {1, 4, 7}, {1, 5, 6}, {2, 4, 6}, {2, 5, 7}, {3, 4, 5}, {3, 6, 7},
+}
+
+// OctavianUnits returns the 240 units of a ring of integral octonions, the
+// octavians, sorted by Cmp. They are the sixteen Gravesian units ±1, ±i, ...,
+// ±q, and the values with four components ±½ on the sets {1, a, b, c} and
+// their complements, for the seven triples (a, b, c) of a Fano plane chosen so
+// that the units are closed under Mul. Scaled by √2, they are the roots of E₈.
+// The Hurwitz units, with i, j, and k, are among them.
+func OctavianUnits() []*Cayley {
+	var v []*Cayley
+	for i := 0; i < 8; i++ {
+		for _, s := range []int64{1, -1} {
+			u := new(Cayley)
+			u.Components()[i].SetInt64(s)
+			v = append(v, u)
+		}
+	}
+	var blocks [][]int
+	for _, t := range octavianTriples {
+		in := []int{0, t[0], t[1], t[2]}
+		var out []int
+		for k := 1; k < 8; k++ {
+			if !slices.Contains(in, k) {
+				out = append(out, k)
+			}
+		}
+		blocks = append(blocks, in, out)
+	}
+	for _, b := range blocks {
+		for s := 0; s < 16; s++ {
+			u := new(Cayley)
+			for n, k := range b {
+				c := u.Components()[k]
+				c.SetFrac64(1, 2)
+				if s>>n&1 == 1 {
+					c.Neg(c)
+				}
+			}
+			v = append(v, u)
+		}
+	}
+	slices.SortFunc(v, (*Cayley).Cmp)
+	return v
+}
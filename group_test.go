@@ -0,0 +1,117 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestUnitGroups(t *testing.T) {
+	c := ComplexIntUnits()
+	h := HamiltonIntUnits()
+	w := HurwitzIntUnits()
+	g := CayleyIntUnits()
+	o := OctavianUnits()
+	if len(c) != 4 || len(h) != 8 || len(w) != 24 || len(g) != 16 || len(o) != 240 {
+		t.Fatalf("unit counts %d, %d, %d, %d, %d", len(c), len(h), len(w), len(g), len(o))
+	}
+	for _, u := range c {
+		if !u.IsUnit() || u.Order() == 0 {
+			t.Errorf("%v is not a unit of finite order", u)
+		}
+	}
+	for _, u := range h {
+		if !u.IsUnit() || u.Order() == 0 {
+			t.Errorf("%v is not a unit of finite order", u)
+		}
+	}
+	for _, u := range g {
+		if !u.IsUnit() || u.Order() == 0 {
+			t.Errorf("%v is not a unit of finite order", u)
+		}
+	}
+	orders := make(map[int]int)
+	for _, u := range w {
+		if !u.IsUnit() {
+			t.Errorf("%v is not a unit", u)
+		}
+		orders[u.Order()]++
+	}
+	// the binary tetrahedral group has one element of order 1, one of order
+	// 2, six of order 4, and eight each of orders 3 and 6
+	for n, want := range map[int]int{1: 1, 2: 1, 3: 8, 4: 6, 6: 8} {
+		if orders[n] != want {
+			t.Errorf("%d Hurwitz units of order %d, want %d", orders[n], n, want)
+		}
+	}
+	keys := make(map[string]bool)
+	for _, u := range o {
+		if !u.IsUnit() || u.Order() == 0 {
+			t.Errorf("%v is not a unit of finite order", u)
+		}
+		keys[u.Key()] = true
+	}
+	if len(keys) != 240 {
+		t.Fatalf("%d distinct octavian units", len(keys))
+	}
+	for _, x := range o {
+		for _, y := range o {
+			if p := new(Cayley).Mul(x, y); !keys[p.Key()] {
+				t.Fatalf("(%v)(%v) = %v is not an octavian unit", x, y, p)
+			}
+		}
+	}
+	for _, u := range w {
+		v := new(Cayley)
+		for k, c := range u.Rat().Components() {
+			v.Components()[k].Set(c)
+		}
+		if !keys[v.Key()] {
+			t.Errorf("Hurwitz unit %v is not an octavian unit", u)
+		}
+	}
+}
+
+func TestOrder(t *testing.T) {
+	half := big.NewRat(1, 2)
+	tests := []struct {
+		z    interface{ Order() int }
+		want int
+	}{
+		{NewComplex(big.NewRat(0, 1), big.NewRat(1, 1)), 4},
+		{NewComplex(big.NewRat(3, 5), big.NewRat(4, 5)), 0},
+		{NewComplex(big.NewRat(2, 1), big.NewRat(0, 1)), 0},
+		{NewPerplex(big.NewRat(-1, 1), big.NewRat(0, 1)), 2},
+		{NewHamilton(half, half, half, half), 6},
+		{NewHamilton(new(big.Rat).Neg(half), half, half, half), 3},
+		{NewCockle(big.NewRat(0, 1), big.NewRat(0, 1), big.NewRat(1, 1), big.NewRat(0, 1)), 2},
+		{NewInfra(big.NewRat(1, 1), big.NewRat(1, 1)), 0},
+		{NewInfra(big.NewRat(-1, 1), big.NewRat(0, 1)), 2},
+	}
+	for _, test := range tests {
+		if got := test.z.Order(); got != test.want {
+			t.Errorf("Order(%v) = %d, want %d", test.z, got, test.want)
+		}
+	}
+}
+
+func TestHamiltonOrderPower(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		n := x.Order()
+		if n == 0 {
+			return true
+		}
+		p := new(Hamilton).Set(x)
+		for k := 1; k < n; k++ {
+			p.Mul(p, x)
+		}
+		return p.Equals(NewHamilton(big.NewRat(1, 1), big.NewRat(0, 1), big.NewRat(0, 1), big.NewRat(0, 1)))
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
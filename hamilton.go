@@ -5,6 +5,7 @@ package rational
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"reflect"
@@ -18,16 +19,29 @@ type Hamilton struct {
 	l, r Complex
 }
 
-// Real returns the (rational) real part of z.
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
 func (z *Hamilton) Real() *big.Rat {
 	return (&z.l).Real()
 }
 
-// Rats returns the four rational components of z.
+// Rats returns the four rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
 func (z *Hamilton) Rats() (*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
 	return &z.l.l, &z.l.r, &z.r.l, &z.r.r
 }
 
+// RatsCopy returns copies of the four rational components of z.
+func (z *Hamilton) RatsCopy() (*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
+	return new(Hamilton).Set(z).Rats()
+}
+
+// Components returns the four rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *Hamilton) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
 // String returns the string representation of a Hamilton value.
 //
 // If z corresponds to a + bi + cj + dk, then the string is"(a+bi+cj+dk)",
@@ -37,7 +51,8 @@ func (z *Hamilton) String() string {
 	v[0], v[1] = z.l.Rats()
 	v[2], v[3] = z.r.Rats()
 	a := make([]string, 9)
-	a[0] = leftBracket
+	br := brackets()
+	a[0] = br[0]
 	a[1] = fmt.Sprintf("%v", v[0].RatString())
 	i := 1
 	for j := 2; j < 8; j = j + 2 {
@@ -49,7 +64,7 @@ func (z *Hamilton) String() string {
 		a[j+1] = symbHamilton[i]
 		i++
 	}
-	a[8] = rightBracket
+	a[8] = br[1]
 	return strings.Join(a, "")
 }
 
@@ -61,6 +76,16 @@ func (z *Hamilton) Equals(y *Hamilton) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *Hamilton) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Complex))
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *Hamilton) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *Hamilton) Set(y *Hamilton) *Hamilton {
 	z.l.Set(&y.l)
@@ -121,12 +146,26 @@ func (z *Hamilton) Sub(x, y *Hamilton) *Hamilton {
 // 		Mul(j, k) = -Mul(k, j) = i
 // 		Mul(k, i) = -Mul(i, k) = j
 // This binary operation is noncommutative but associative.
-func (z *Hamilton) Mul(x, y *Hamilton) *Hamilton {
-	a := new(Complex).Set(&x.l)
-	b := new(Complex).Set(&x.r)
-	c := new(Complex).Set(&y.l)
-	d := new(Complex).Set(&y.r)
-	temp := new(Complex)
+func (z *Hamilton) Mul(x, y *Hamilton) (product *Hamilton) {
+	if h := currentHook(); h != nil {
+		x, y := new(Hamilton).Set(x), new(Hamilton).Set(y)
+		defer func() { traceMul(h, "Hamilton.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	a, b, c, d := &x.l, &x.r, &y.l, &y.r
+	if z == x || z == y {
+		// z.l is written before z.r is computed
+		a, b = complexArena.get().Set(a), complexArena.get().Set(b)
+		c, d = complexArena.get().Set(c), complexArena.get().Set(d)
+		defer complexArena.put(a, b, c, d)
+	}
+	temp := complexArena.get()
+	defer complexArena.put(temp)
 	z.l.Sub(
 		z.l.Mul(a, c),
 		temp.Mul(temp.Conj(d), b),
@@ -152,19 +191,23 @@ func (z *Hamilton) Commutator(x, y *Hamilton) *Hamilton {
 // 		a² + b² + c² + d²
 // This is always non-negative.
 func (z *Hamilton) Quad() *big.Rat {
-	return new(big.Rat).Add(
-		z.l.Quad(),
-		z.r.Quad(),
-	)
+	q, r := z.l.Quad(), z.r.Quad()
+	defer putRat(r)
+	return q.Add(q, r)
 }
 
 // Inv sets z equal to the inverse of y, and returns z. If y is zero, then Inv
 // panics.
-func (z *Hamilton) Inv(y *Hamilton) *Hamilton {
+func (z *Hamilton) Inv(y *Hamilton) (inverse *Hamilton) {
+	if h := currentHook(); h != nil {
+		y := new(Hamilton).Set(y)
+		defer func() { traceInv(h, "Hamilton.Inv", inverse != nil, z, y) }()
+	}
 	if zero := new(Hamilton); y.Equals(zero) {
-		panic("inverse of zero")
+		panic(denominatorError("inverse of zero"))
 	}
 	a := y.Quad()
+	defer putRat(a)
 	a.Inv(a)
 	return z.Scal(z.Conj(y), a)
 }
@@ -174,7 +217,7 @@ func (z *Hamilton) Inv(y *Hamilton) *Hamilton {
 // Then it returns z. If y is zero, then QuoL panics.
 func (z *Hamilton) QuoL(x, y *Hamilton) *Hamilton {
 	if zero := new(Hamilton); y.Equals(zero) {
-		panic("denominator is zero")
+		panic(denominatorError("denominator is zero"))
 	}
 	return z.Mul(z.Inv(y), x)
 }
@@ -184,7 +227,7 @@ func (z *Hamilton) QuoL(x, y *Hamilton) *Hamilton {
 // Then it returns z. If y is zero, then QuoR panics.
 func (z *Hamilton) QuoR(x, y *Hamilton) *Hamilton {
 	if zero := new(Hamilton); y.Equals(zero) {
-		panic("denominator is zero")
+		panic(denominatorError("denominator is zero"))
 	}
 	return z.Mul(x, z.Inv(y))
 }
@@ -267,17 +310,164 @@ func (z *Hamilton) Dot(y *Hamilton) *big.Rat {
 	return new(big.Rat).Add(z.l.Dot(&y.l), z.r.Dot(&y.r))
 }
 
+// LeftMul returns the linear operator that sends y to Mul(z, y), acting on
+// the rational components of y.
+func (z *Hamilton) LeftMul() *Operator {
+	a := new(Hamilton).Set(z)
+	return &Operator{4, func(v []*big.Rat) []*big.Rat {
+		y := NewHamilton(v[0], v[1], v[2], v[3])
+		return rats(y.Mul(a, y).Rats())
+	}}
+}
+
+// RightMul returns the linear operator that sends y to Mul(y, z), acting on
+// the rational components of y.
+func (z *Hamilton) RightMul() *Operator {
+	a := new(Hamilton).Set(z)
+	return &Operator{4, func(v []*big.Rat) []*big.Rat {
+		y := NewHamilton(v[0], v[1], v[2], v[3])
+		return rats(y.Mul(y, a).Rats())
+	}}
+}
+
+// MinPoly returns the minimal polynomial of z. If z is real, then the
+// minimal polynomial is
+// 		t - Real(z)
+// Otherwise, it is
+// 		t² - 2Real(z)t + Quad(z)
+func (z *Hamilton) MinPoly() Laurent {
+	re := new(big.Rat).Set(z.Real())
+	pure := new(Hamilton).Set(z)
+	pure.Real().SetInt64(0)
+	if pure.Equals(new(Hamilton)) {
+		return Laurent{0: re.Neg(re), 1: big.NewRat(1, 1)}
+	}
+	return Laurent{
+		0: z.Quad(),
+		1: re.Mul(re, big.NewRat(-2, 1)),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// EvaluateMinPoly returns true if z satisfies its minimal polynomial.
+func (z *Hamilton) EvaluateMinPoly() bool {
+	return satisfies(z, z.MinPoly())
+}
+
+// PowViaMinPoly sets z equal to y raised to the power n, and returns z. High
+// powers are reduced with the minimal polynomial of y, so only O(log n)
+// rational multiplications are needed.
+func (z *Hamilton) PowViaMinPoly(y *Hamilton, n uint64) *Hamilton {
+	p, q := quadPow(y.MinPoly(), n)
+	z.Scal(y, p)
+	z.Real().Add(z.Real(), q)
+	return z
+}
+
+// WriteTo writes a canonical encoding of z to w, and returns the number of
+// bytes written. Equal values produce equal encodings, so any hash.Hash can be
+// used as w to hash z without building an intermediate byte slice.
+func (z *Hamilton) WriteTo(w io.Writer) (int64, error) {
+	return writeRats(w, rats(z.Rats()))
+}
+
+// IsRootOfUnity returns the order n of z and true if z is a root of unity
+// with n less than or equal to maxOrder. Otherwise it returns 0 and false. The
+// order is read from the real part and quadrance of z, since
+// 		z² - 2Re(z)z + Quad(z) = 0
+// so no powers of z are computed. Roots of unity are integral, but z need not
+// be: any other value is simply not a root of unity.
+func (z *Hamilton) IsRootOfUnity(maxOrder uint64) (uint64, bool) {
+	n := rootOfUnityOrder(z.Real(), z.Quad())
+	if n == 0 || n > maxOrder {
+		return 0, false
+	}
+	return n, true
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// the rational parameter t, and returns z. The curve is evaluated exactly with
+// the De Casteljau algorithm. If p is empty, then Bezier panics.
+func (z *Hamilton) Bezier(t *big.Rat, p ...*Hamilton) *Hamilton {
+	if len(p) == 0 {
+		panic("no control points")
+	}
+	s := new(big.Rat).Sub(big.NewRat(1, 1), t)
+	b := make([]*Hamilton, len(p))
+	for i := range p {
+		b[i] = new(Hamilton).Set(p[i])
+	}
+	temp := new(Hamilton)
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			b[i].Add(b[i].Scal(b[i], s), temp.Scal(b[i+1], t))
+		}
+	}
+	return z.Set(b[0])
+}
+
+// Round sets z equal to y with each component rounded to a multiple of 1/den
+// in the direction given by mode, and returns z. Directed modes round every
+// component the same way, so interval bounds stay rigorous. If den is not
+// positive, then Round panics.
+func (z *Hamilton) Round(y *Hamilton, den *big.Int, mode big.RoundingMode) *Hamilton {
+	z.Set(y)
+	for _, c := range rats(z.Rats()) {
+		roundRat(c, c, den, mode)
+	}
+	return z
+}
+
+// Act returns the left action of z on the pair (a, b) of Complex values, which
+// is identified with the Hamilton value a + bj and multiplied on the left by
+// z. This is the classical representation of the quaternions on pairs of
+// complex numbers. Hamilton is associative but not commutative: acting by
+// Mul(x, y) is acting by y and then by x, in that order.
+func (z *Hamilton) Act(a, b *Complex) (*Complex, *Complex) {
+	v := new(Hamilton)
+	v.l.Set(a)
+	v.r.Set(b)
+	v.Mul(z, v)
+	return &v.l, &v.r
+}
+
+// SolveCrossRatioL sets z equal to the point y such that the left
+// cross-ratio of v, w, x, and y is c, and returns z. With
+// A = Inv(w - x) * (v - x) and B = Inv(A) * c, the point is
+// 		(v*B - w) * Inv(B - 1)
+// If the point is not uniquely determined, then SolveCrossRatioL panics.
+func (z *Hamilton) SolveCrossRatioL(v, w, x, c *Hamilton) *Hamilton {
+	one := new(Hamilton)
+	one.Real().SetInt64(1)
+	b := new(Hamilton).Sub(v, x)
+	b.Mul(new(Hamilton).Inv(new(Hamilton).Sub(w, x)), b)
+	b.Mul(b.Inv(b), c)
+	temp := new(Hamilton).Sub(b, one)
+	temp.Inv(temp)
+	z.Mul(v, b)
+	z.Sub(z, w)
+	return z.Mul(z, temp)
+}
+
+// SolveCrossRatioR sets z equal to the point y such that the right
+// cross-ratio of v, w, x, and y is c, and returns z. With
+// A = (v - x) * Inv(w - x) and B = Inv(A) * c, the point is
+// 		Inv(B - 1) * (B*v - w)
+// If the point is not uniquely determined, then SolveCrossRatioR panics.
+func (z *Hamilton) SolveCrossRatioR(v, w, x, c *Hamilton) *Hamilton {
+	one := new(Hamilton)
+	one.Real().SetInt64(1)
+	b := new(Hamilton).Sub(v, x)
+	b.Mul(b, new(Hamilton).Inv(new(Hamilton).Sub(w, x)))
+	b.Mul(b.Inv(b), c)
+	temp := new(Hamilton).Sub(b, one)
+	temp.Inv(temp)
+	z.Mul(b, v)
+	z.Sub(z, w)
+	return z.Mul(temp, z)
+}
+
 // Generate returns a random Hamilton value for quick.Check testing.
 func (z *Hamilton) Generate(rand *rand.Rand, size int) reflect.Value {
-	randomHamilton := &Hamilton{
-		*NewComplex(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-		*NewComplex(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-	}
-	return reflect.ValueOf(randomHamilton)
+	return reflect.ValueOf(new(Hamilton).Random(rand, generateOptions))
 }
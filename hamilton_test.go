@@ -4,6 +4,7 @@
 package rational
 
 import (
+	"math"
 	"math/big"
 	"testing"
 	"testing/quick"
@@ -343,3 +344,156 @@ func TestHamiltonComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Scalar operands
+
+func TestHamiltonMulReal(t *testing.T) {
+	f := func(x, y *Hamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(big.Rat).Set(y.Real())
+		s := new(Hamilton)
+		s.Real().Set(a)
+		if !s.IsReal() || x.IsReal() {
+			return false
+		}
+		l, r := new(Hamilton), new(Hamilton)
+		l.Mul(s, x)
+		r.Mul(x, s)
+		// an independent product: s x = (s + x)x - x x, with no real factor
+		xx := new(Hamilton).Mul(x, x)
+		sx := new(Hamilton).Add(s, x)
+		return l.Equals(new(Hamilton).Sub(new(Hamilton).Mul(sx, x), xx)) &&
+			r.Equals(new(Hamilton).Sub(new(Hamilton).Mul(x, sx), xx))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonIsPure(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		p := new(Hamilton).Set(x)
+		p.Real().SetInt64(0)
+		return p.IsPure() && !x.IsPure()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Roots of unity
+
+func TestHamiltonIsRootOfUnity(t *testing.T) {
+	h := big.NewRat(1, 2)
+	mh := big.NewRat(-1, 2)
+	tests := []struct {
+		z     *Hamilton
+		order uint64
+	}{
+		{NewHamilton(h, h, h, h), 6},
+		{NewHamilton(mh, h, h, h), 3},
+		{NewHamilton(new(big.Rat), new(big.Rat), big.NewRat(1, 1), new(big.Rat)), 4},
+	}
+	for _, test := range tests {
+		if order, ok := test.z.IsRootOfUnity(12); !ok || order != test.order {
+			t.Errorf("IsRootOfUnity(%v) = %d, %v, want %d, true",
+				test.z, order, ok, test.order)
+		}
+	}
+	z := NewHamilton(h, h, h, h)
+	if _, ok := z.IsRootOfUnity(5); ok {
+		t.Errorf("IsRootOfUnity(%v) found order greater than 5", z)
+	}
+	z = NewHamilton(big.NewRat(3, 5), new(big.Rat), new(big.Rat), big.NewRat(4, 5))
+	if _, ok := z.IsRootOfUnity(math.MaxUint64); ok {
+		t.Errorf("IsRootOfUnity(%v) = true, want false", z)
+	}
+}
+
+// Curves
+
+func TestHamiltonBezierEndpoints(t *testing.T) {
+	zero, one := new(big.Rat), big.NewRat(1, 1)
+	f := func(x, y, z *Hamilton) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		l := new(Hamilton).Bezier(zero, x, y, z)
+		r := new(Hamilton).Bezier(one, x, y, z)
+		return l.Equals(x) && r.Equals(z)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonBezierQuadratic(t *testing.T) {
+	a := big.NewRat(2, 7)
+	b := new(big.Rat).Sub(big.NewRat(1, 1), a)
+	f := func(x, y, z *Hamilton) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		l := new(Hamilton).Bezier(a, x, y, z)
+		r, temp := new(Hamilton), new(Hamilton)
+		r.Scal(x, new(big.Rat).Mul(b, b))
+		r.Add(r, temp.Scal(y, new(big.Rat).Mul(big.NewRat(2, 1), new(big.Rat).Mul(a, b))))
+		r.Add(r, temp.Scal(z, new(big.Rat).Mul(a, a)))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Copies
+
+func TestHamiltonRatsCopy(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		y := new(Hamilton).Set(x)
+		v := rats(x.RatsCopy())
+		for _, c := range v {
+			c.Add(c, big.NewRat(1, 1))
+		}
+		return x.Equals(y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Module structure
+
+func TestHamiltonActAssociative(t *testing.T) {
+	f := func(x, y, w *Hamilton) bool {
+		// t.Logf("x = %v, y = %v, w = %v", x, y, w)
+		a, b := new(Hamilton).Mul(x, y).Act(&w.l, &w.r)
+		c, d := x.Act(y.Act(&w.l, &w.r))
+		return a.Equals(c) && b.Equals(d)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Cross-ratio
+
+func TestHamiltonSolveCrossRatioL(t *testing.T) {
+	f := func(v, w, x, y *Hamilton) bool {
+		// t.Logf("v = %v, w = %v, x = %v, y = %v", v, w, x, y)
+		c := new(Hamilton).CrossRatioL(v, w, x, y)
+		return new(Hamilton).SolveCrossRatioL(v, w, x, c).Equals(y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonSolveCrossRatioR(t *testing.T) {
+	f := func(v, w, x, y *Hamilton) bool {
+		// t.Logf("v = %v, w = %v, x = %v, y = %v", v, w, x, y)
+		c := new(Hamilton).CrossRatioR(v, w, x, y)
+		return new(Hamilton).SolveCrossRatioR(v, w, x, c).Equals(y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
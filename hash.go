@@ -0,0 +1,480 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"io"
+	"math/big"
+	"strings"
+)
+
+// writeRats writes a canonical encoding of the rationals in v to w, and
+// returns the number of bytes written. Each rational is encoded as a sign
+// byte, followed by the length-prefixed big-endian bytes of the absolute
+// value of its numerator and of its denominator. Since a big.Rat is always
+// kept in lowest terms, equal values produce equal encodings.
+func writeRats(w io.Writer, v []*big.Rat) (int64, error) {
+	var n int64
+	var head [5]byte
+	var buf []byte
+	put := func(x *big.Int) error {
+		size := (x.BitLen() + 7) / 8
+		if cap(buf) < size {
+			buf = make([]byte, size)
+		}
+		buf = buf[:size]
+		x.FillBytes(buf)
+		binary.BigEndian.PutUint32(head[1:], uint32(size))
+		m, err := w.Write(head[1:])
+		n += int64(m)
+		if err != nil {
+			return err
+		}
+		m, err = w.Write(buf)
+		n += int64(m)
+		return err
+	}
+	for _, x := range v {
+		head[0] = byte(x.Sign() + 1)
+		m, err := w.Write(head[:1])
+		n += int64(m)
+		if err != nil {
+			return n, err
+		}
+		if err := put(x.Num()); err != nil {
+			return n, err
+		}
+		if err := put(x.Denom()); err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// ratKey returns the canonical form of the components v: their RatStrings,
+// separated by commas.
+func ratKey(v []*big.Rat) string {
+	s := make([]string, len(v))
+	for i, c := range v {
+		s[i] = c.RatString()
+	}
+	return strings.Join(s, ",")
+}
+
+// ratHash returns the 64-bit FNV-1a hash of the encoding of v by writeRats.
+func ratHash(v []*big.Rat) uint64 {
+	h := fnv.New64a()
+	writeRats(h, v)
+	return h.Sum64()
+}
+
+// intRats returns the components v as rationals.
+func intRats(v []*big.Int) []*big.Rat {
+	w := make([]*big.Rat, len(v))
+	for i, x := range v {
+		w[i] = new(big.Rat).SetInt(x)
+	}
+	return w
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *Complex) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *Complex) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *Infra) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *Infra) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *Perplex) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *Perplex) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *BiComplex) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *BiComplex) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *BiPerplex) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *BiPerplex) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *Cockle) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *Cockle) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *DualComplex) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *DualComplex) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *DualPerplex) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *DualPerplex) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *Hamilton) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *Hamilton) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *Hyper) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *Hyper) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *InfraComplex) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *InfraComplex) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *InfraPerplex) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *InfraPerplex) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *Supra) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *Supra) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *BiCockle) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *BiCockle) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *BiHamilton) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *BiHamilton) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *Cayley) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *Cayley) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *InfraCockle) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *InfraCockle) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *InfraHamilton) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *InfraHamilton) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *SupraComplex) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *SupraComplex) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *SupraPerplex) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *SupraPerplex) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *TriComplex) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *TriComplex) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *TriNilplex) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *TriNilplex) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *TriPerplex) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *TriPerplex) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *Ultra) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *Ultra) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *Zorn) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *Zorn) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *DualHamilton) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *DualHamilton) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// of the same type have equal keys if and only if they are equal. It holds
+// the components of z in the order of Components, as RatStrings separated by
+// commas.
+func (z *Double[S, T]) Key() string {
+	return ratKey(z.Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *Double[S, T]) Hash() uint64 {
+	return ratHash(z.Components())
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// have equal keys if and only if they are equal. It holds the components of z
+// in the order of Components, separated by commas.
+func (z *ComplexInt) Key() string {
+	return ratKey(intRats(z.Components()))
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *ComplexInt) Hash() uint64 {
+	return ratHash(intRats(z.Components()))
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// have equal keys if and only if they are equal. It holds the components of z
+// in the order of Components, separated by commas.
+func (z *HamiltonInt) Key() string {
+	return ratKey(intRats(z.Components()))
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *HamiltonInt) Hash() uint64 {
+	return ratHash(intRats(z.Components()))
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// have equal keys if and only if they are equal. It holds the components of z
+// in the order of Components, separated by commas.
+func (z *CayleyInt) Key() string {
+	return ratKey(intRats(z.Components()))
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *CayleyInt) Hash() uint64 {
+	return ratHash(intRats(z.Components()))
+}
+
+// Key returns a canonical string form of z, for use as a map key: two values
+// have equal keys if and only if they are equal. It holds the components of
+// Rat(z), which may be halves, separated by commas.
+func (z *HurwitzInt) Key() string {
+	return ratKey(z.Rat().Components())
+}
+
+// Hash returns a 64-bit hash of z. Equal values have equal hashes.
+func (z *HurwitzInt) Hash() uint64 {
+	return ratHash(z.Rat().Components())
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestHamiltonWriteToEqual(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		h1, h2 := sha256.New(), sha256.New()
+		x.WriteTo(h1)
+		new(Hamilton).Set(x).WriteTo(h2)
+		return bytes.Equal(h1.Sum(nil), h2.Sum(nil))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyWriteToNotEqual(t *testing.T) {
+	f := func(x, y *Cayley) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		if x.Equals(y) {
+			return true
+		}
+		h1, h2 := sha256.New(), sha256.New()
+		x.WriteTo(h1)
+		y.WriteTo(h2)
+		return !bytes.Equal(h1.Sum(nil), h2.Sum(nil))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexWriteToCanonical(t *testing.T) {
+	x := NewComplex(big.NewRat(2, 4), big.NewRat(-6, 3))
+	y := NewComplex(big.NewRat(1, 2), big.NewRat(-2, 1))
+	var a, b bytes.Buffer
+	n, err := x.WriteTo(&a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != int64(a.Len()) {
+		t.Errorf("WriteTo returned %d, but wrote %d bytes", n, a.Len())
+	}
+	y.WriteTo(&b)
+	if !bytes.Equal(a.Bytes(), b.Bytes()) {
+		t.Errorf("encodings of %v and %v differ", x, y)
+	}
+	z := NewComplex(big.NewRat(1, 2), big.NewRat(2, 1))
+	b.Reset()
+	z.WriteTo(&b)
+	if bytes.Equal(a.Bytes(), b.Bytes()) {
+		t.Errorf("encodings of %v and %v are equal", x, z)
+	}
+}
+
+func TestZornKey(t *testing.T) {
+	f := func(x, y *Zorn) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		c := new(Zorn).Set(x)
+		if x.Key() != c.Key() || x.Hash() != c.Hash() {
+			return false
+		}
+		return x.Equals(y) == (x.Key() == y.Key())
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestKeyDeduplicates(t *testing.T) {
+	set := make(map[string]*Complex)
+	for _, x := range []*Complex{
+		NewComplex(big.NewRat(2, 4), big.NewRat(-6, 3)),
+		NewComplex(big.NewRat(1, 2), big.NewRat(-2, 1)),
+		NewComplex(big.NewRat(1, 2), big.NewRat(2, 1)),
+	} {
+		set[x.Key()] = x
+	}
+	if len(set) != 2 {
+		t.Errorf("%d distinct keys, want 2", len(set))
+	}
+	if k := NewComplex(big.NewRat(1, 2), big.NewRat(-2, 1)).Key(); k != "1/2,-2" {
+		t.Errorf("Key = %q, want %q", k, "1/2,-2")
+	}
+	h := NewHurwitzInt(big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(-1))
+	if k := h.Key(); k != "1/2,1/2,1/2,-1/2" {
+		t.Errorf("Key = %q, want %q", k, "1/2,1/2,1/2,-1/2")
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// A Hook observes arithmetic operations, for tracing, teaching, or debugging.
+// Operations built from lower-dimensional ones report those as well, so a
+// Hamilton product is preceded by the Complex products it is made of. A hook
+// is installed for the whole process by SetHook, or for a single call tree by
+// Trace and TraceContext.
+type Hook interface {
+	// OnMul is called after z has been set to the product of x and y.
+	OnMul(z, x, y fmt.Stringer)
+	// OnInv is called after z has been set to the inverse of y.
+	OnInv(z, y fmt.Stringer)
+	// OnPanic is called when the operation op panics, while the panic
+	// propagates. The panic is not recovered, so its value and stack trace
+	// reach the caller unchanged.
+	OnPanic(op string)
+}
+
+// A GrowthHook is a Hook that also watches the size of results, to diagnose
+// or cap the growth of numerators and denominators in iterative algorithms.
+// If the installed hook is a GrowthHook, then after each product or inverse
+// whose Complexity exceeds MaxBits, OnGrowth is called with the name of the
+// operation, such as "Hamilton.Mul", the result z, and its Complexity. To cap
+// growth, OnGrowth may panic, or round z to a smaller denominator.
+type GrowthHook interface {
+	Hook
+	MaxBits() int
+	OnGrowth(op string, z fmt.Stringer, bits int)
+}
+
+type hookBox struct {
+	h Hook
+}
+
+var hook atomic.Value
+
+// SetHook installs h as the hook for every operation in the process, on
+// every goroutine, and returns the previous hook. A nil h removes the hook.
+// Operations on every goroutine report to it, so it must be safe for
+// concurrent use, and the last SetHook wins. To trace a single call tree
+// without affecting other goroutines, use Trace instead.
+func SetHook(h Hook) Hook {
+	old := globalHook()
+	hook.Store(hookBox{h})
+	return old
+}
+
+// globalHook returns the hook installed by SetHook, or nil.
+func globalHook() Hook {
+	if b, ok := hook.Load().(hookBox); ok {
+		return b.h
+	}
+	return nil
+}
+
+var (
+	// scopes holds the hooks installed by Trace, keyed by goroutine id.
+	scopes sync.Map
+	// scoped counts the active Trace calls, so that operations look up
+	// their goroutine only while there is one.
+	scoped atomic.Int64
+)
+
+// goid returns the id of the calling goroutine, read from the header
+// "goroutine N [" of its stack trace. Go does not expose goroutine-local
+// state, and the operations take no context, so this is how Trace scopes a
+// hook to its caller.
+func goid() uint64 {
+	var buf [64]byte
+	b := buf[:runtime.Stack(buf[:], false)]
+	b = bytes.TrimPrefix(b, []byte("goroutine "))
+	if i := bytes.IndexByte(b, ' '); i >= 0 {
+		b = b[:i]
+	}
+	id, _ := strconv.ParseUint(string(b), 10, 64)
+	return id
+}
+
+// scopedHook returns the hook installed by Trace for the calling goroutine,
+// and true, or nil and false if there is none.
+func scopedHook() (Hook, bool) {
+	if scoped.Load() == 0 {
+		return nil, false
+	}
+	if b, ok := scopes.Load(goid()); ok {
+		return b.(hookBox).h, true
+	}
+	return nil, false
+}
+
+// Trace calls f with h installed as the hook of the calling goroutine, and
+// returns when f returns. The operations of f report to h instead of the
+// hook of SetHook, and so do those of the goroutines started by the parallel
+// methods of Vec and by MulBatch on its behalf; operations on other
+// goroutines do not report to h. A nil h turns tracing off for f. Calls of
+// Trace may nest. While any Trace call is active, every operation looks up
+// its goroutine, so arithmetic is slower on all goroutines.
+func Trace(h Hook, f func()) {
+	id := goid()
+	old, nested := scopes.Load(id)
+	scopes.Store(id, hookBox{h})
+	scoped.Add(1)
+	defer func() {
+		if nested {
+			scopes.Store(id, old)
+		} else {
+			scopes.Delete(id)
+		}
+		scoped.Add(-1)
+	}()
+	f()
+}
+
+type hookKey struct{}
+
+// WithHook returns a copy of ctx that carries h, for TraceContext.
+func WithHook(ctx context.Context, h Hook) context.Context {
+	return context.WithValue(ctx, hookKey{}, hookBox{h})
+}
+
+// TraceContext calls f with the hook carried by ctx, as Trace does. If ctx
+// carries no hook, then TraceContext calls f with the hooks in effect.
+func TraceContext(ctx context.Context, f func()) {
+	if b, ok := ctx.Value(hookKey{}).(hookBox); ok {
+		Trace(b.h, f)
+		return
+	}
+	f()
+}
+
+// inScope returns f wrapped to run with the hook that Trace installed for
+// the calling goroutine, if any, so that goroutines started on behalf of a
+// traced call report to its hook.
+func inScope(f func()) func() {
+	if h, ok := scopedHook(); ok {
+		return func() { Trace(h, f) }
+	}
+	return f
+}
+
+// currentHook returns the hook of the calling goroutine: the one installed
+// by Trace, or else the one installed by SetHook, or nil.
+func currentHook() Hook {
+	if h, ok := scopedHook(); ok {
+		return h
+	}
+	return globalHook()
+}
+
+// traceMul is deferred by the Mul methods while a hook is installed. The
+// methods name their result, which stays nil unless they return, so done
+// tells a return from a panic without recovering the panic.
+func traceMul(h Hook, op string, done bool, z, x, y fmt.Stringer) {
+	if !done {
+		h.OnPanic(op)
+		return
+	}
+	h.OnMul(z, x, y)
+	traceGrowth(h, op, z)
+}
+
+// traceInv is deferred by the Inv methods while a hook is installed, as
+// traceMul is by the Mul methods.
+func traceInv(h Hook, op string, done bool, z, y fmt.Stringer) {
+	if !done {
+		h.OnPanic(op)
+		return
+	}
+	h.OnInv(z, y)
+	traceGrowth(h, op, z)
+}
+
+// traceGrowth calls OnGrowth if h is a GrowthHook and the Complexity of z
+// exceeds its MaxBits.
+func traceGrowth(h Hook, op string, z fmt.Stringer) {
+	g, ok := h.(GrowthHook)
+	if !ok {
+		return
+	}
+	c, ok := z.(interface{ Complexity() int })
+	if !ok {
+		return
+	}
+	if bits := c.Complexity(); bits > g.MaxBits() {
+		g.OnGrowth(op, z, bits)
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+)
+
+type recorder struct {
+	mu  sync.Mutex
+	ops []string
+}
+
+func (r *recorder) record(op string) {
+	r.mu.Lock()
+	r.ops = append(r.ops, op)
+	r.mu.Unlock()
+}
+
+func (r *recorder) OnMul(z, x, y fmt.Stringer) {
+	r.record(fmt.Sprintf("%v*%v=%v", x, y, z))
+}
+
+func (r *recorder) OnInv(z, y fmt.Stringer) {
+	r.record(fmt.Sprintf("1/%v=%v", y, z))
+}
+
+func (r *recorder) OnPanic(op string) {
+	r.record(op + " panicked")
+}
+
+func TestHook(t *testing.T) {
+	r := new(recorder)
+	defer SetHook(SetHook(r))
+	x := NewComplex(big.NewRat(1, 1), big.NewRat(2, 1))
+	y := NewComplex(big.NewRat(3, 1), big.NewRat(-1, 1))
+	x.Mul(x, y)
+	want := []string{"⦗1+2i⦘*⦗3-1i⦘=⦗5+5i⦘"}
+	if len(r.ops) != 1 || r.ops[0] != want[0] {
+		t.Fatalf("recorded %q, want %q", r.ops, want)
+	}
+	func() {
+		defer func() {
+			// the panic reaches the caller unchanged
+			if err, ok := recover().(error); !ok || !errors.Is(err, ErrZeroDenominator) {
+				t.Errorf("Inv of zero panicked with %v", err)
+			}
+		}()
+		new(Complex).Inv(new(Complex))
+	}()
+	if n := len(r.ops); n != 2 || r.ops[1] != "Complex.Inv panicked" {
+		t.Errorf("recorded %q after panic", r.ops)
+	}
+}
+
+func TestHookNested(t *testing.T) {
+	r := new(recorder)
+	defer SetHook(SetHook(r))
+	x := NewHamilton(big.NewRat(1, 1), big.NewRat(2, 1), big.NewRat(3, 1), big.NewRat(4, 1))
+	new(Hamilton).Mul(x, x)
+	if n := len(r.ops); n < 2 {
+		t.Errorf("recorded %d operations, want the Complex products too", n)
+	}
+	SetHook(nil)
+	r.ops = nil
+	new(Hamilton).Mul(x, x)
+	if len(r.ops) != 0 {
+		t.Errorf("recorded %q without a hook", r.ops)
+	}
+}
+
+func TestTrace(t *testing.T) {
+	global, r := new(recorder), new(recorder)
+	defer SetHook(SetHook(global))
+	x := NewComplex(big.NewRat(1, 1), big.NewRat(2, 1))
+	Trace(r, func() {
+		new(Complex).Mul(x, x)
+		// other goroutines keep the hook of SetHook
+		done := make(chan struct{})
+		go func() {
+			new(Complex).Mul(x, x)
+			close(done)
+		}()
+		<-done
+		// nested calls install their own hook, and nil turns tracing off
+		Trace(nil, func() {
+			new(Complex).Mul(x, x)
+		})
+		// goroutines started by MulBatch report to the hook of the caller
+		n := 4 * parallelChunk
+		xs, ys := make([]*Complex, n), make([]*Complex, n)
+		for i := range xs {
+			xs[i], ys[i] = x, x
+		}
+		MulBatch(make([]*Complex, n), xs, ys, 4)
+	})
+	if len(r.ops) != 1+4*parallelChunk {
+		t.Errorf("Trace recorded %d operations, want %d", len(r.ops), 1+4*parallelChunk)
+	}
+	if len(global.ops) != 1 {
+		t.Errorf("SetHook recorded %q, want one product", global.ops)
+	}
+	new(Complex).Mul(x, x)
+	if len(r.ops) != 1+4*parallelChunk || len(global.ops) != 2 {
+		t.Errorf("the hook of Trace outlived its call")
+	}
+}
+
+func TestTraceContext(t *testing.T) {
+	r := new(recorder)
+	ctx := WithHook(context.Background(), r)
+	x := NewComplex(big.NewRat(1, 1), big.NewRat(2, 1))
+	TraceContext(ctx, func() {
+		new(Complex).Inv(x)
+	})
+	if len(r.ops) != 1 {
+		t.Errorf("TraceContext recorded %q, want one inverse", r.ops)
+	}
+}
+
+type growthCap struct {
+	recorder
+	max    int
+	growth []string
+}
+
+func (g *growthCap) MaxBits() int { return g.max }
+
+func (g *growthCap) OnGrowth(op string, z fmt.Stringer, bits int) {
+	g.growth = append(g.growth, op)
+	if x, ok := z.(*Complex); ok {
+		RoundToDenominator(x, x, big.NewInt(1<<10))
+	}
+}
+
+func TestGrowthHook(t *testing.T) {
+	g := &growthCap{max: 16}
+	defer SetHook(SetHook(g))
+	x := NewComplex(big.NewRat(1, 3), big.NewRat(2, 7))
+	z := NewComplex(big.NewRat(1, 1), big.NewRat(0, 1))
+	for i := 0; i < 20; i++ {
+		z.Mul(z, x)
+		if bits := z.Complexity(); bits > 32 {
+			t.Fatalf("Complexity = %d after %d steps", bits, i+1)
+		}
+	}
+	if len(g.growth) == 0 || g.growth[0] != "Complex.Mul" {
+		t.Errorf("growth = %q", g.growth)
+	}
+}
+
+func TestComplexity(t *testing.T) {
+	z := NewHamilton(big.NewRat(1, 255), big.NewRat(-256, 1), big.NewRat(0, 1), big.NewRat(3, 4))
+	if got := z.Complexity(); got != 9 {
+		t.Errorf("Complexity(%v) = %d, want 9", z, got)
+	}
+}
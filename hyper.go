@@ -5,6 +5,7 @@ package rational
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"reflect"
@@ -18,23 +19,37 @@ type Hyper struct {
 	l, r Infra
 }
 
-// Real returns the (rational) real part of z.
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
 func (z *Hyper) Real() *big.Rat {
 	return (&z.l).Real()
 }
 
-// Rats returns the four rational components of z.
+// Rats returns the four rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
 func (z *Hyper) Rats() (*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
 	return &z.l.l, &z.l.r, &z.r.l, &z.r.r
 }
 
+// RatsCopy returns copies of the four rational components of z.
+func (z *Hyper) RatsCopy() (*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
+	return new(Hyper).Set(z).Rats()
+}
+
+// Components returns the four rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *Hyper) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
 // String returns the string representation of a Hyper value.
 func (z *Hyper) String() string {
 	v := make([]*big.Rat, 4)
 	v[0], v[1] = z.l.Rats()
 	v[2], v[3] = z.r.Rats()
 	a := make([]string, 9)
-	a[0] = leftBracket
+	br := brackets()
+	a[0] = br[0]
 	a[1] = fmt.Sprintf("%v", v[0].RatString())
 	i := 1
 	for j := 2; j < 8; j = j + 2 {
@@ -46,7 +61,7 @@ func (z *Hyper) String() string {
 		a[j+1] = symbHyper[i]
 		i++
 	}
-	a[8] = rightBracket
+	a[8] = br[1]
 	return strings.Join(a, "")
 }
 
@@ -58,6 +73,16 @@ func (z *Hyper) Equals(y *Hyper) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *Hyper) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Infra))
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *Hyper) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *Hyper) Set(y *Hyper) *Hyper {
 	z.l.Set(&y.l)
@@ -89,14 +114,19 @@ func (z *Hyper) Neg(y *Hyper) *Hyper {
 	return z
 }
 
-// Conj sets z equal to the conjugate of y, and returns z.
+// Conj sets z equal to the conjugate of y, and returns z. This operation
+// changes the sign of all the components with Γ, and fixes the subalgebra of
+// values a+bα, a copy of Infra.
 func (z *Hyper) Conj(y *Hyper) *Hyper {
 	z.l.Set(&y.l)
 	z.r.Neg(&y.r)
 	return z
 }
 
-// Star sets z equal to the star conjugate of y, and returns z.
+// Star sets z equal to the star conjugate of y, and returns z. This operation
+// changes the sign of all the components with α, and fixes the subalgebra of
+// values a+bΓ, another copy of Infra. Its composition with Conj changes the
+// signs of α and Γ, and fixes the values a+bαΓ, a third copy of Infra.
 func (z *Hyper) Star(y *Hyper) *Hyper {
 	z.l.Conj(&y.l)
 	z.r.Conj(&y.r)
@@ -123,12 +153,26 @@ func (z *Hyper) Sub(x, y *Hyper) *Hyper {
 // 		Mul(α, α) = Mul(Γ, Γ) = 0
 // 		Mul(α, Γ) = Mul(Γ, α)
 // This binary operation is commutative and associative.
-func (z *Hyper) Mul(x, y *Hyper) *Hyper {
-	a := new(Infra).Set(&x.l)
-	b := new(Infra).Set(&x.r)
-	c := new(Infra).Set(&y.l)
-	d := new(Infra).Set(&y.r)
-	temp := new(Infra)
+func (z *Hyper) Mul(x, y *Hyper) (product *Hyper) {
+	if h := currentHook(); h != nil {
+		x, y := new(Hyper).Set(x), new(Hyper).Set(y)
+		defer func() { traceMul(h, "Hyper.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	a, b, c, d := &x.l, &x.r, &y.l, &y.r
+	if z == x || z == y {
+		// z.l is written before z.r is computed
+		a, b = infraArena.get().Set(a), infraArena.get().Set(b)
+		c, d = infraArena.get().Set(c), infraArena.get().Set(d)
+		defer infraArena.put(a, b, c, d)
+	}
+	temp := infraArena.get()
+	defer infraArena.put(temp)
 	z.l.Mul(a, c)
 	z.r.Add(
 		z.r.Mul(a, d),
@@ -141,7 +185,7 @@ func (z *Hyper) Mul(x, y *Hyper) *Hyper {
 // 		a² + 2abα
 // Note that this is an infra number.
 func (z *Hyper) Quad() *Infra {
-	quad := new(Infra)
+	quad := infraArena.get()
 	return quad.Mul(&z.l, &z.l)
 }
 
@@ -159,11 +203,16 @@ func (z *Hyper) IsZeroDivisor() bool {
 
 // Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
 // then Inv panics.
-func (z *Hyper) Inv(y *Hyper) *Hyper {
+func (z *Hyper) Inv(y *Hyper) (inverse *Hyper) {
+	if h := currentHook(); h != nil {
+		y := new(Hyper).Set(y)
+		defer func() { traceInv(h, "Hyper.Inv", inverse != nil, z, y) }()
+	}
 	if y.IsZeroDivisor() {
-		panic("inverse of zero divisor")
+		panic(divisorError("inverse of zero divisor"))
 	}
 	quad := y.Quad()
+	defer infraArena.put(quad)
 	quad.Inv(quad)
 	z.Conj(y)
 	z.l.Mul(&z.l, quad)
@@ -175,7 +224,7 @@ func (z *Hyper) Inv(y *Hyper) *Hyper {
 // Quo panics.
 func (z *Hyper) Quo(x, y *Hyper) *Hyper {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(z.Inv(y), x)
 }
@@ -209,17 +258,163 @@ func (z *Hyper) Möbius(y, a, b, c, d *Hyper) *Hyper {
 	return z.Mul(z, temp)
 }
 
+// Rescale sets z equal to the image of y under the automorphism that scales
+// the nilpotent generator with the given unit index by a, and returns z. The
+// valid units are 1 (α) and 2 (Γ); any other unit makes Rescale panic.
+func (z *Hyper) Rescale(y *Hyper, unit int, a *big.Rat) *Hyper {
+	switch unit {
+	case 1:
+		z.l.Rescale(&y.l, 1, a)
+		z.r.Rescale(&y.r, 1, a)
+	case 2:
+		z.l.Set(&y.l)
+		z.r.Scal(&y.r, a)
+	default:
+		panic("invalid unit")
+	}
+	return z
+}
+
+// LeftMul returns the linear operator that sends y to Mul(z, y), acting on
+// the rational components of y.
+func (z *Hyper) LeftMul() *Operator {
+	a := new(Hyper).Set(z)
+	return &Operator{4, func(v []*big.Rat) []*big.Rat {
+		y := NewHyper(v[0], v[1], v[2], v[3])
+		return rats(y.Mul(a, y).Rats())
+	}}
+}
+
+// RightMul returns the linear operator that sends y to Mul(y, z), acting on
+// the rational components of y.
+func (z *Hyper) RightMul() *Operator {
+	a := new(Hyper).Set(z)
+	return &Operator{4, func(v []*big.Rat) []*big.Rat {
+		y := NewHyper(v[0], v[1], v[2], v[3])
+		return rats(y.Mul(y, a).Rats())
+	}}
+}
+
+// WriteTo writes a canonical encoding of z to w, and returns the number of
+// bytes written. Equal values produce equal encodings, so any hash.Hash can be
+// used as w to hash z without building an intermediate byte slice.
+func (z *Hyper) WriteTo(w io.Writer) (int64, error) {
+	return writeRats(w, rats(z.Rats()))
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// the rational parameter t, and returns z. The curve is evaluated exactly with
+// the De Casteljau algorithm. If p is empty, then Bezier panics.
+func (z *Hyper) Bezier(t *big.Rat, p ...*Hyper) *Hyper {
+	if len(p) == 0 {
+		panic("no control points")
+	}
+	s := new(big.Rat).Sub(big.NewRat(1, 1), t)
+	b := make([]*Hyper, len(p))
+	for i := range p {
+		b[i] = new(Hyper).Set(p[i])
+	}
+	temp := new(Hyper)
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			b[i].Add(b[i].Scal(b[i], s), temp.Scal(b[i+1], t))
+		}
+	}
+	return z.Set(b[0])
+}
+
+// Round sets z equal to y with each component rounded to a multiple of 1/den
+// in the direction given by mode, and returns z. Directed modes round every
+// component the same way, so interval bounds stay rigorous. If den is not
+// positive, then Round panics.
+func (z *Hyper) Round(y *Hyper, den *big.Int, mode big.RoundingMode) *Hyper {
+	z.Set(y)
+	for _, c := range rats(z.Rats()) {
+		roundRat(c, c, den, mode)
+	}
+	return z
+}
+
+// Act returns the left action of z on the pair (a, b) of Infra values, which
+// is identified with the Hyper value a + bΓ and multiplied on the left by z.
+// Hyper is commutative and associative, so acting by a product is acting by
+// its factors in turn. The nilpotent Γ leaves the pairs (0, b) invariant, so
+// that z acts on them by its first half alone.
+func (z *Hyper) Act(a, b *Infra) (*Infra, *Infra) {
+	v := new(Hyper)
+	v.l.Set(a)
+	v.r.Set(b)
+	v.Mul(z, v)
+	return &v.l, &v.r
+}
+
+// SolveCrossRatio sets z equal to the point y such that the cross-ratio of v,
+// w, x, and y is c, and returns z. With A = Inv(w - x) * (v - x) and
+// B = Inv(A) * c, the point is
+// 		(v*B - w) * Inv(B - 1)
+// If the point is not uniquely determined, then SolveCrossRatio panics.
+func (z *Hyper) SolveCrossRatio(v, w, x, c *Hyper) *Hyper {
+	one := new(Hyper)
+	one.Real().SetInt64(1)
+	b := new(Hyper).Sub(v, x)
+	b.Mul(new(Hyper).Inv(new(Hyper).Sub(w, x)), b)
+	b.Mul(b.Inv(b), c)
+	temp := new(Hyper).Sub(b, one)
+	temp.Inv(temp)
+	z.Mul(v, b)
+	z.Sub(z, w)
+	return z.Mul(z, temp)
+}
+
+// Exp sets z equal to the exponential of y, and returns z. The exponential
+// series terminates because y is nilpotent. If y is not nilpotent, then Exp
+// panics.
+func (z *Hyper) Exp(y *Hyper) *Hyper {
+	if !y.IsZeroDivisor() {
+		panic("exponential of non-nilpotent")
+	}
+	zero := new(Hyper)
+	sum, term := new(Hyper), new(Hyper)
+	sum.Real().SetInt64(1)
+	term.Real().SetInt64(1)
+	for k := int64(1); ; k++ {
+		term.Mul(term, y)
+		if term.Equals(zero) {
+			break
+		}
+		term.Scal(term, big.NewRat(1, k))
+		sum.Add(sum, term)
+	}
+	return z.Set(sum)
+}
+
+// Log sets z equal to the logarithm of y, and returns z. This is the inverse
+// of Exp: the logarithmic series terminates because y - 1 is nilpotent. If y -
+// 1 is not nilpotent, then Log panics.
+func (z *Hyper) Log(y *Hyper) *Hyper {
+	one := new(Hyper)
+	one.Real().SetInt64(1)
+	n := new(Hyper).Sub(y, one)
+	if !n.IsZeroDivisor() {
+		panic("logarithm of non-unipotent")
+	}
+	zero := new(Hyper)
+	sum, term := new(Hyper), new(Hyper).Set(one)
+	for k := int64(1); ; k++ {
+		term.Mul(term, n)
+		if term.Equals(zero) {
+			break
+		}
+		c := big.NewRat(1, k)
+		if k%2 == 0 {
+			c.Neg(c)
+		}
+		sum.Add(sum, new(Hyper).Scal(term, c))
+	}
+	return z.Set(sum)
+}
+
 // Generate returns a random Hyper value for quick.Check testing.
 func (z *Hyper) Generate(rand *rand.Rand, size int) reflect.Value {
-	randomHyper := &Hyper{
-		*NewInfra(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-		*NewInfra(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-	}
-	return reflect.ValueOf(randomHyper)
+	return reflect.ValueOf(new(Hyper).Random(rand, generateOptions))
 }
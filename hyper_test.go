@@ -341,3 +341,90 @@ func TestHyperComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Automorphism
+
+func TestHyperRescaleMulDistributive(t *testing.T) {
+	a := big.NewRat(3, 5)
+	f := func(x, y *Hyper) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		for _, u := range []int{1, 2} {
+			l, r := new(Hyper), new(Hyper)
+			l.Rescale(l.Mul(x, y), u, a)
+			r.Mul(new(Hyper).Rescale(x, u, a), r.Rescale(y, u, a))
+			if !l.Equals(r) {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Scalar operands
+
+func TestHyperMulReal(t *testing.T) {
+	f := func(x, y *Hyper) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(big.Rat).Set(y.Real())
+		s := new(Hyper)
+		s.Real().Set(a)
+		if !s.IsReal() || x.IsReal() {
+			return false
+		}
+		l, r := new(Hyper), new(Hyper)
+		l.Mul(s, x)
+		r.Mul(x, s)
+		// an independent product: s x = (s + x)x - x x, with no real factor
+		xx := new(Hyper).Mul(x, x)
+		sx := new(Hyper).Add(s, x)
+		return l.Equals(new(Hyper).Sub(new(Hyper).Mul(sx, x), xx)) &&
+			r.Equals(new(Hyper).Sub(new(Hyper).Mul(x, sx), xx))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHyperIsPure(t *testing.T) {
+	f := func(x *Hyper) bool {
+		// t.Logf("x = %v", x)
+		p := new(Hyper).Set(x)
+		p.Real().SetInt64(0)
+		return p.IsPure() && !x.IsPure()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Exponential
+
+func TestHyperLogExp(t *testing.T) {
+	f := func(x *Hyper) bool {
+		// t.Logf("x = %v", x)
+		x.Real().SetInt64(0)
+		l := new(Hyper).Exp(x)
+		l.Log(l)
+		return l.Equals(x)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHyperExpAdd(t *testing.T) {
+	f := func(x, y *Hyper) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		x.Real().SetInt64(0)
+		y.Real().SetInt64(0)
+		l := new(Hyper).Exp(new(Hyper).Add(x, y))
+		r := new(Hyper).Mul(new(Hyper).Exp(x), new(Hyper).Exp(y))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// IdempotentDecompose returns the coordinates p and m of z along the
+// idempotents e₊ = (1+s)/2 and e₋ = (1-s)/2, so that
+// 		z = pe₊ + me₋
+// If z = a+bs, then p = a+b and m = a-b. Since e₊e₋ = 0, products and
+// inverses act on p and m separately.
+func (z *Perplex) IdempotentDecompose() (p, m *big.Rat) {
+	p = new(big.Rat).Add(&z.l, &z.r)
+	m = new(big.Rat).Sub(&z.l, &z.r)
+	return
+}
+
+// NewPerplexIdempotent returns a pointer to the Perplex value pe₊ + me₋,
+// with e₊ = (1+s)/2 and e₋ = (1-s)/2.
+func NewPerplexIdempotent(p, m *big.Rat) *Perplex {
+	half := big.NewRat(1, 2)
+	a := new(big.Rat).Add(p, m)
+	b := new(big.Rat).Sub(p, m)
+	return NewPerplex(a.Mul(a, half), b.Mul(b, half))
+}
+
+// IdempotentDecompose returns the Complex coordinates p and m of z along the
+// idempotents e₊ = (1+iJ)/2 and e₋ = (1-iJ)/2, so that
+// 		z = pe₊ + me₋
+// If z = w+vJ with Complex w and v, then p = w-iv and m = w+iv. Since
+// e₊e₋ = 0, products and inverses act on p and m separately, so that
+// BiComplex is isomorphic to pairs of Complex values.
+func (z *BiComplex) IdempotentDecompose() (p, m *Complex) {
+	iv := new(Complex).Mul(NewComplex(big.NewRat(0, 1), big.NewRat(1, 1)), &z.r)
+	p = new(Complex).Sub(&z.l, iv)
+	m = new(Complex).Add(&z.l, iv)
+	return
+}
+
+// NewBiComplexIdempotent returns a pointer to the BiComplex value pe₊ + me₋,
+// with e₊ = (1+iJ)/2 and e₋ = (1-iJ)/2.
+func NewBiComplexIdempotent(p, m *Complex) *BiComplex {
+	half := big.NewRat(1, 2)
+	z := new(BiComplex)
+	z.l.Add(p, m)
+	z.l.Scal(&z.l, half)
+	z.r.Sub(p, m)
+	z.r.Mul(NewComplex(big.NewRat(0, 1), half), &z.r)
+	return z
+}
+
+// IdempotentDecompose returns the BiComplex coordinates p and m of z along
+// the idempotents e₊ = (1+iK)/2 and e₋ = (1-iK)/2, so that
+// 		z = pe₊ + me₋
+// If z = w+vK with BiComplex w and v, then p = w-iv and m = w+iv. Since
+// e₊e₋ = 0, products and inverses act on p and m separately.
+func (z *TriComplex) IdempotentDecompose() (p, m *BiComplex) {
+	i := NewBiComplex(big.NewRat(0, 1), big.NewRat(1, 1), big.NewRat(0, 1), big.NewRat(0, 1))
+	iv := new(BiComplex).Mul(i, &z.r)
+	p = new(BiComplex).Sub(&z.l, iv)
+	m = new(BiComplex).Add(&z.l, iv)
+	return
+}
+
+// NewTriComplexIdempotent returns a pointer to the TriComplex value
+// pe₊ + me₋, with e₊ = (1+iK)/2 and e₋ = (1-iK)/2.
+func NewTriComplexIdempotent(p, m *BiComplex) *TriComplex {
+	half := big.NewRat(1, 2)
+	i := NewBiComplex(big.NewRat(0, 1), half, big.NewRat(0, 1), big.NewRat(0, 1))
+	z := new(TriComplex)
+	z.l.Add(p, m)
+	z.l.Scal(&z.l, half)
+	z.r.Sub(p, m)
+	z.r.Mul(i, &z.r)
+	return z
+}
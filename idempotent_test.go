@@ -0,0 +1,52 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestPerplexIdempotentDecompose(t *testing.T) {
+	f := func(x, y *Perplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		p, m := x.IdempotentDecompose()
+		q, n := y.IdempotentDecompose()
+		r, o := new(Perplex).Mul(x, y).IdempotentDecompose()
+		return NewPerplexIdempotent(p, m).Equals(x) &&
+			r.Cmp(new(big.Rat).Mul(p, q)) == 0 && o.Cmp(new(big.Rat).Mul(m, n)) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBiComplexIdempotentDecompose(t *testing.T) {
+	f := func(x, y *BiComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		p, m := x.IdempotentDecompose()
+		q, n := y.IdempotentDecompose()
+		r, o := new(BiComplex).Mul(x, y).IdempotentDecompose()
+		return NewBiComplexIdempotent(p, m).Equals(x) &&
+			r.Equals(new(Complex).Mul(p, q)) && o.Equals(new(Complex).Mul(m, n))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTriComplexIdempotentDecompose(t *testing.T) {
+	f := func(x, y *TriComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		p, m := x.IdempotentDecompose()
+		q, n := y.IdempotentDecompose()
+		r, o := new(TriComplex).Mul(x, y).IdempotentDecompose()
+		return NewTriComplexIdempotent(p, m).Equals(x) &&
+			r.Equals(new(BiComplex).Mul(p, q)) && o.Equals(new(BiComplex).Mul(m, n))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
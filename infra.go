@@ -5,6 +5,7 @@ package rational
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"reflect"
@@ -16,23 +17,37 @@ type Infra struct {
 	l, r big.Rat
 }
 
-// Real returns the (rational) real part of z.
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
 func (z *Infra) Real() *big.Rat {
 	return &z.l
 }
 
-// Rats returns the two rational components of z.
+// Rats returns the two rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
 func (z *Infra) Rats() (*big.Rat, *big.Rat) {
 	return &z.l, &z.r
 }
 
+// RatsCopy returns copies of the two rational components of z.
+func (z *Infra) RatsCopy() (*big.Rat, *big.Rat) {
+	return new(Infra).Set(z).Rats()
+}
+
+// Components returns the two rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *Infra) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
 // String returns the string version of a Infra value.
 //
 // If z corresponds to a + bα, then the string is "(a+bα)", similar to
 // complex128 values.
 func (z *Infra) String() string {
 	a := make([]string, 5)
-	a[0] = leftBracket
+	br := brackets()
+	a[0] = br[0]
 	a[1] = fmt.Sprintf("%v", z.l.RatString())
 	if z.r.Sign() == -1 {
 		a[2] = fmt.Sprintf("%v", z.r.RatString())
@@ -40,7 +55,7 @@ func (z *Infra) String() string {
 		a[2] = fmt.Sprintf("+%v", z.r.RatString())
 	}
 	a[3] = "α"
-	a[4] = rightBracket
+	a[4] = br[1]
 	return strings.Join(a, "")
 }
 
@@ -52,6 +67,16 @@ func (z *Infra) Equals(y *Infra) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *Infra) IsReal() bool {
+	return z.r.Sign() == 0
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *Infra) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *Infra) Set(y *Infra) *Infra {
 	z.l.Set(&y.l)
@@ -107,17 +132,21 @@ func (z *Infra) Sub(x, y *Infra) *Infra {
 // The multiplication rule is:
 // 		Mul(α, α) = 0
 // This binary operation is commutative and associative.
-func (z *Infra) Mul(x, y *Infra) *Infra {
-	a := new(big.Rat).Set(&x.l)
-	b := new(big.Rat).Set(&x.r)
-	c := new(big.Rat).Set(&y.l)
-	d := new(big.Rat).Set(&y.r)
-	temp := new(big.Rat)
-	z.l.Mul(a, c)
-	z.r.Add(
-		z.r.Mul(d, a),
-		temp.Mul(b, c),
-	)
+func (z *Infra) Mul(x, y *Infra) (product *Infra) {
+	if h := currentHook(); h != nil {
+		x, y := new(Infra).Set(x), new(Infra).Set(y)
+		defer func() { traceMul(h, "Infra.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	// z.r is normalized once, and z.l is only written after z.r is
+	// computed, so z may alias x or y.
+	dot2(&z.r, &y.r, &x.l, &x.r, &y.l, 1)
+	z.l.Mul(&x.l, &y.l)
 	return z
 }
 
@@ -125,7 +154,7 @@ func (z *Infra) Mul(x, y *Infra) *Infra {
 // 		a²
 // This is always non-negative.
 func (z *Infra) Quad() *big.Rat {
-	return new(big.Rat).Mul(&z.l, &z.l)
+	return getRat().Mul(&z.l, &z.l)
 }
 
 // IsZeroDivisor returns true if z is a zero divisor. This is equivalent to z
@@ -137,11 +166,16 @@ func (z *Infra) IsZeroDivisor() bool {
 
 // Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
 // then Inv panics.
-func (z *Infra) Inv(y *Infra) *Infra {
+func (z *Infra) Inv(y *Infra) (inverse *Infra) {
+	if h := currentHook(); h != nil {
+		y := new(Infra).Set(y)
+		defer func() { traceInv(h, "Infra.Inv", inverse != nil, z, y) }()
+	}
 	if y.IsZeroDivisor() {
-		panic("inverse of zero divisor")
+		panic(divisorError("inverse of zero divisor"))
 	}
 	a := y.Quad()
+	defer putRat(a)
 	a.Inv(a)
 	return z.Scal(z.Conj(y), a)
 }
@@ -150,7 +184,7 @@ func (z *Infra) Inv(y *Infra) *Infra {
 // divisor, then Quo panics.
 func (z *Infra) Quo(x, y *Infra) *Infra {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(x, z.Inv(y))
 }
@@ -240,11 +274,222 @@ func (z *Infra) Dot(y *Infra) *big.Rat {
 	return dot.Mul(&z.l, &y.l)
 }
 
+// Rescale sets z equal to the image of y under the automorphism that scales
+// the dual unit α by a, and returns z. The only valid unit is 1 (α); any
+// other unit makes Rescale panic.
+func (z *Infra) Rescale(y *Infra, unit int, a *big.Rat) *Infra {
+	if unit != 1 {
+		panic("invalid unit")
+	}
+	z.l.Set(&y.l)
+	z.r.Mul(&y.r, a)
+	return z
+}
+
+// LeftMul returns the linear operator that sends y to Mul(z, y), acting on
+// the rational components of y.
+func (z *Infra) LeftMul() *Operator {
+	a := new(Infra).Set(z)
+	return &Operator{2, func(v []*big.Rat) []*big.Rat {
+		y := NewInfra(v[0], v[1])
+		return rats(y.Mul(a, y).Rats())
+	}}
+}
+
+// RightMul returns the linear operator that sends y to Mul(y, z), acting on
+// the rational components of y.
+func (z *Infra) RightMul() *Operator {
+	a := new(Infra).Set(z)
+	return &Operator{2, func(v []*big.Rat) []*big.Rat {
+		y := NewInfra(v[0], v[1])
+		return rats(y.Mul(y, a).Rats())
+	}}
+}
+
+// MinPoly returns the minimal polynomial of z. If z is real, then the
+// minimal polynomial is
+// 		t - Real(z)
+// Otherwise, it is
+// 		t² - 2Real(z)t + Quad(z)
+func (z *Infra) MinPoly() Laurent {
+	re := new(big.Rat).Set(z.Real())
+	pure := new(Infra).Set(z)
+	pure.Real().SetInt64(0)
+	if pure.Equals(new(Infra)) {
+		return Laurent{0: re.Neg(re), 1: big.NewRat(1, 1)}
+	}
+	return Laurent{
+		0: z.Quad(),
+		1: re.Mul(re, big.NewRat(-2, 1)),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// EvaluateMinPoly returns true if z satisfies its minimal polynomial.
+func (z *Infra) EvaluateMinPoly() bool {
+	return satisfies(z, z.MinPoly())
+}
+
+// PowViaMinPoly sets z equal to y raised to the power n, and returns z. High
+// powers are reduced with the minimal polynomial of y, so only O(log n)
+// rational multiplications are needed.
+func (z *Infra) PowViaMinPoly(y *Infra, n uint64) *Infra {
+	p, q := quadPow(y.MinPoly(), n)
+	z.Scal(y, p)
+	z.Real().Add(z.Real(), q)
+	return z
+}
+
+// WriteTo writes a canonical encoding of z to w, and returns the number of
+// bytes written. Equal values produce equal encodings, so any hash.Hash can be
+// used as w to hash z without building an intermediate byte slice.
+func (z *Infra) WriteTo(w io.Writer) (int64, error) {
+	return writeRats(w, rats(z.Rats()))
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// the rational parameter t, and returns z. The curve is evaluated exactly with
+// the De Casteljau algorithm. If p is empty, then Bezier panics.
+func (z *Infra) Bezier(t *big.Rat, p ...*Infra) *Infra {
+	if len(p) == 0 {
+		panic("no control points")
+	}
+	s := new(big.Rat).Sub(big.NewRat(1, 1), t)
+	b := make([]*Infra, len(p))
+	for i := range p {
+		b[i] = new(Infra).Set(p[i])
+	}
+	temp := new(Infra)
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			b[i].Add(b[i].Scal(b[i], s), temp.Scal(b[i+1], t))
+		}
+	}
+	return z.Set(b[0])
+}
+
+// Exp sets z equal to the exponential of y, and returns z. The exponential
+// series terminates because y is nilpotent. If y is not nilpotent, then Exp
+// panics.
+func (z *Infra) Exp(y *Infra) *Infra {
+	if !y.IsZeroDivisor() {
+		panic("exponential of non-nilpotent")
+	}
+	zero := new(Infra)
+	sum, term := new(Infra), new(Infra)
+	sum.Real().SetInt64(1)
+	term.Real().SetInt64(1)
+	for k := int64(1); ; k++ {
+		term.Mul(term, y)
+		if term.Equals(zero) {
+			break
+		}
+		term.Scal(term, big.NewRat(1, k))
+		sum.Add(sum, term)
+	}
+	return z.Set(sum)
+}
+
+// Log sets z equal to the logarithm of y, and returns z. This is the inverse
+// of Exp: the logarithmic series terminates because y - 1 is nilpotent. If
+// y - 1 is not nilpotent, then Log panics.
+func (z *Infra) Log(y *Infra) *Infra {
+	one := new(Infra)
+	one.Real().SetInt64(1)
+	n := new(Infra).Sub(y, one)
+	if !n.IsZeroDivisor() {
+		panic("logarithm of non-unipotent")
+	}
+	zero := new(Infra)
+	sum, term := new(Infra), new(Infra).Set(one)
+	for k := int64(1); ; k++ {
+		term.Mul(term, n)
+		if term.Equals(zero) {
+			break
+		}
+		c := big.NewRat(1, k)
+		if k%2 == 0 {
+			c.Neg(c)
+		}
+		sum.Add(sum, new(Infra).Scal(term, c))
+	}
+	return z.Set(sum)
+}
+
+// Round sets z equal to y with each component rounded to a multiple of 1/den
+// in the direction given by mode, and returns z. Directed modes round every
+// component the same way, so interval bounds stay rigorous. If den is not
+// positive, then Round panics.
+func (z *Infra) Round(y *Infra, den *big.Int, mode big.RoundingMode) *Infra {
+	z.Set(y)
+	for _, c := range rats(z.Rats()) {
+		roundRat(c, c, den, mode)
+	}
+	return z
+}
+
+// Act returns the left action of z on the pair (a, b) of rationals, which is
+// identified with the Infra value a + bα and multiplied on the left by z. If z
+// = x+yα, then
+// 		(a, b) ↦ (xa, ya + xb)
+// which for x = 1 is a shear of the plane. Infra is associative, so Act is a
+// representation of Infra on pairs of rationals.
+func (z *Infra) Act(a, b *big.Rat) (*big.Rat, *big.Rat) {
+	v := new(Infra)
+	v.l.Set(a)
+	v.r.Set(b)
+	v.Mul(z, v)
+	return &v.l, &v.r
+}
+
+// SolveCrossRatio sets z equal to the point y such that the cross-ratio of v,
+// w, x, and y is c, and returns z. With A = Inv(w - x) * (v - x) and
+// B = Inv(A) * c, the point is
+// 		(v*B - w) * Inv(B - 1)
+// If the point is not uniquely determined, then SolveCrossRatio panics.
+func (z *Infra) SolveCrossRatio(v, w, x, c *Infra) *Infra {
+	one := new(Infra)
+	one.Real().SetInt64(1)
+	b := new(Infra).Sub(v, x)
+	b.Mul(new(Infra).Inv(new(Infra).Sub(w, x)), b)
+	b.Mul(b.Inv(b), c)
+	temp := new(Infra).Sub(b, one)
+	temp.Inv(temp)
+	z.Mul(v, b)
+	z.Sub(z, w)
+	return z.Mul(z, temp)
+}
+
+// Shear sets z equal to the unit Infra 1+tα with shear parameter t, and
+// returns z. This is the exponential of tα.
+func (z *Infra) Shear(t *big.Rat) *Infra {
+	z.l.SetInt64(1)
+	z.r.Set(t)
+	return z
+}
+
+// Slope returns the shear parameter b/a of z = a+bα. The slope of a product
+// is the sum of the slopes of its factors, so Slope turns MotionCompose into
+// addition exactly. If z is a zero divisor, then Slope panics.
+func (z *Infra) Slope() *big.Rat {
+	if z.IsZeroDivisor() {
+		panic(divisorError("slope of zero divisor"))
+	}
+	return new(big.Rat).Quo(&z.r, &z.l)
+}
+
+// MotionCompose sets z equal to the composition of the parabolic motions x
+// and y, and returns z. This is the product xy, whose slope is the sum of the
+// slopes of x and y. If x or y is not a unit Infra, then MotionCompose panics.
+func (z *Infra) MotionCompose(x, y *Infra) *Infra {
+	one := big.NewRat(1, 1)
+	if x.Quad().Cmp(one) != 0 || y.Quad().Cmp(one) != 0 {
+		panic("motion of non-unit")
+	}
+	return z.Mul(x, y)
+}
+
 // Generate returns a random Infra value for quick.Check testing.
 func (z *Infra) Generate(rand *rand.Rand, size int) reflect.Value {
-	randomInfra := &Infra{
-		*big.NewRat(rand.Int63(), rand.Int63()),
-		*big.NewRat(rand.Int63(), rand.Int63()),
-	}
-	return reflect.ValueOf(randomInfra)
+	return reflect.ValueOf(new(Infra).Random(rand, generateOptions))
 }
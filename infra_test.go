@@ -339,3 +339,107 @@ func TestInfraComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Automorphism
+
+func TestInfraRescaleMulDistributive(t *testing.T) {
+	a := big.NewRat(3, 5)
+	f := func(x, y *Infra) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		for _, u := range []int{1} {
+			l, r := new(Infra), new(Infra)
+			l.Rescale(l.Mul(x, y), u, a)
+			r.Mul(new(Infra).Rescale(x, u, a), r.Rescale(y, u, a))
+			if !l.Equals(r) {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Scalar operands
+
+func TestInfraMulReal(t *testing.T) {
+	f := func(x, y *Infra) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(big.Rat).Set(y.Real())
+		s := new(Infra)
+		s.Real().Set(a)
+		if !s.IsReal() || x.IsReal() {
+			return false
+		}
+		l, r := new(Infra), new(Infra)
+		l.Mul(s, x)
+		r.Mul(x, s)
+		// an independent product: s x = (s + x)x - x x, with no real factor
+		xx := new(Infra).Mul(x, x)
+		sx := new(Infra).Add(s, x)
+		return l.Equals(new(Infra).Sub(new(Infra).Mul(sx, x), xx)) &&
+			r.Equals(new(Infra).Sub(new(Infra).Mul(x, sx), xx))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraIsPure(t *testing.T) {
+	f := func(x *Infra) bool {
+		// t.Logf("x = %v", x)
+		p := new(Infra).Set(x)
+		p.Real().SetInt64(0)
+		return p.IsPure() && !x.IsPure()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Exponential
+
+func TestInfraLogExp(t *testing.T) {
+	f := func(x *Infra) bool {
+		// t.Logf("x = %v", x)
+		x.Real().SetInt64(0)
+		l := new(Infra).Exp(x)
+		l.Log(l)
+		return l.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraExpHomomorphism(t *testing.T) {
+	f := func(x, y *Infra) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		x.Real().SetInt64(0)
+		y.Real().SetInt64(0)
+		l := new(Infra).Exp(new(Infra).Add(x, y))
+		r := new(Infra).Mul(new(Infra).Exp(x), new(Infra).Exp(y))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Parabolic motions
+
+func TestInfraMotionCompose(t *testing.T) {
+	f := func(m, n int32, p, q uint16) bool {
+		// t.Logf("m = %v, n = %v, p = %v, q = %v", m, n, p, q)
+		s := big.NewRat(int64(m), int64(p)+1)
+		u := big.NewRat(int64(n), int64(q)+1)
+		z := new(Infra).MotionCompose(new(Infra).Shear(s), new(Infra).Shear(u))
+		return z.Equals(new(Infra).Shear(new(big.Rat).Add(s, u))) &&
+			z.Slope().Cmp(new(big.Rat).Add(s, u)) == 0 &&
+			z.Equals(new(Infra).Exp(NewInfra(new(big.Rat), z.Slope())))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
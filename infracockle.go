@@ -5,6 +5,7 @@ package rational
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"reflect"
@@ -18,18 +19,32 @@ type InfraCockle struct {
 	l, r Cockle
 }
 
-// Real returns the (rational) real part of z.
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
 func (z *InfraCockle) Real() *big.Rat {
 	return (&z.l).Real()
 }
 
-// Rats returns the eight rational components of z.
+// Rats returns the eight rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
 func (z *InfraCockle) Rats() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
 	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
 	return &z.l.l.l, &z.l.l.r, &z.l.r.l, &z.l.r.r,
 		&z.r.l.l, &z.r.l.r, &z.r.r.l, &z.r.r.r
 }
 
+// RatsCopy returns copies of the eight rational components of z.
+func (z *InfraCockle) RatsCopy() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
+	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
+	return new(InfraCockle).Set(z).Rats()
+}
+
+// Components returns the eight rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *InfraCockle) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
 // String returns the string representation of an InfraCockle value.
 //
 // If z corresponds to a + bi + ct + du + eρ + fσ + gτ + hυ, then the string
@@ -39,7 +54,8 @@ func (z *InfraCockle) String() string {
 	v[0], v[1], v[2], v[3] = z.l.Rats()
 	v[4], v[5], v[6], v[7] = z.r.Rats()
 	a := make([]string, 17)
-	a[0] = leftBracket
+	br := brackets()
+	a[0] = br[0]
 	a[1] = fmt.Sprintf("%v", v[0].RatString())
 	i := 1
 	for j := 2; j < 16; j = j + 2 {
@@ -51,7 +67,7 @@ func (z *InfraCockle) String() string {
 		a[j+1] = symbInfraCockle[i]
 		i++
 	}
-	a[16] = rightBracket
+	a[16] = br[1]
 	return strings.Join(a, "")
 }
 
@@ -63,6 +79,16 @@ func (z *InfraCockle) Equals(y *InfraCockle) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *InfraCockle) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Cockle))
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *InfraCockle) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *InfraCockle) Set(y *InfraCockle) *InfraCockle {
 	z.l.Set(&y.l)
@@ -148,12 +174,26 @@ func (z *InfraCockle) Sub(x, y *InfraCockle) *InfraCockle {
 // 		Mul(σ, υ) = Mul(υ, σ) = 0
 // 		Mul(τ, υ) = Mul(υ, τ) = 0
 // This binary operation is noncommutative and nonassociative.
-func (z *InfraCockle) Mul(x, y *InfraCockle) *InfraCockle {
-	a := new(Cockle).Set(&x.l)
-	b := new(Cockle).Set(&x.r)
-	c := new(Cockle).Set(&y.l)
-	d := new(Cockle).Set(&y.r)
-	temp := new(Cockle)
+func (z *InfraCockle) Mul(x, y *InfraCockle) (product *InfraCockle) {
+	if h := currentHook(); h != nil {
+		x, y := new(InfraCockle).Set(x), new(InfraCockle).Set(y)
+		defer func() { traceMul(h, "InfraCockle.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	a, b, c, d := &x.l, &x.r, &y.l, &y.r
+	if z == x || z == y {
+		// z.l is written before z.r is computed
+		a, b = cockleArena.get().Set(a), cockleArena.get().Set(b)
+		c, d = cockleArena.get().Set(c), cockleArena.get().Set(d)
+		defer cockleArena.put(a, b, c, d)
+	}
+	temp := cockleArena.get()
+	defer cockleArena.put(temp)
 	z.l.Mul(a, c)
 	z.r.Add(
 		z.r.Mul(d, a),
@@ -199,11 +239,16 @@ func (z *InfraCockle) IsZeroDivisor() bool {
 
 // Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
 // then Inv panics.
-func (z *InfraCockle) Inv(y *InfraCockle) *InfraCockle {
+func (z *InfraCockle) Inv(y *InfraCockle) (inverse *InfraCockle) {
+	if h := currentHook(); h != nil {
+		y := new(InfraCockle).Set(y)
+		defer func() { traceInv(h, "InfraCockle.Inv", inverse != nil, z, y) }()
+	}
 	if y.IsZeroDivisor() {
-		panic("inverse of zero divisor")
+		panic(divisorError("inverse of zero divisor"))
 	}
 	a := y.Quad()
+	defer putRat(a)
 	a.Inv(a)
 	return z.Scal(z.Conj(y), a)
 }
@@ -213,7 +258,7 @@ func (z *InfraCockle) Inv(y *InfraCockle) *InfraCockle {
 // Then it returns z. If y is a zero divisor, then QuoL panics.
 func (z *InfraCockle) QuoL(x, y *InfraCockle) *InfraCockle {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(z.Inv(y), x)
 }
@@ -223,26 +268,120 @@ func (z *InfraCockle) QuoL(x, y *InfraCockle) *InfraCockle {
 // Then it returns z. If y is a zero divisor, then QuoR panics.
 func (z *InfraCockle) QuoR(x, y *InfraCockle) *InfraCockle {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(x, z.Inv(y))
 }
 
+// LeftMul returns the linear operator that sends y to Mul(z, y), acting on
+// the rational components of y.
+func (z *InfraCockle) LeftMul() *Operator {
+	a := new(InfraCockle).Set(z)
+	return &Operator{8, func(v []*big.Rat) []*big.Rat {
+		y := NewInfraCockle(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+		return rats(y.Mul(a, y).Rats())
+	}}
+}
+
+// RightMul returns the linear operator that sends y to Mul(y, z), acting on
+// the rational components of y.
+func (z *InfraCockle) RightMul() *Operator {
+	a := new(InfraCockle).Set(z)
+	return &Operator{8, func(v []*big.Rat) []*big.Rat {
+		y := NewInfraCockle(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+		return rats(y.Mul(y, a).Rats())
+	}}
+}
+
+// MinPoly returns the minimal polynomial of z. If z is real, then the
+// minimal polynomial is
+// 		t - Real(z)
+// Otherwise, it is
+// 		t² - 2Real(z)t + Quad(z)
+func (z *InfraCockle) MinPoly() Laurent {
+	re := new(big.Rat).Set(z.Real())
+	pure := new(InfraCockle).Set(z)
+	pure.Real().SetInt64(0)
+	if pure.Equals(new(InfraCockle)) {
+		return Laurent{0: re.Neg(re), 1: big.NewRat(1, 1)}
+	}
+	return Laurent{
+		0: z.Quad(),
+		1: re.Mul(re, big.NewRat(-2, 1)),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// EvaluateMinPoly returns true if z satisfies its minimal polynomial.
+func (z *InfraCockle) EvaluateMinPoly() bool {
+	return satisfies(z, z.MinPoly())
+}
+
+// PowViaMinPoly sets z equal to y raised to the power n, and returns z. High
+// powers are reduced with the minimal polynomial of y, so only O(log n)
+// rational multiplications are needed.
+func (z *InfraCockle) PowViaMinPoly(y *InfraCockle, n uint64) *InfraCockle {
+	p, q := quadPow(y.MinPoly(), n)
+	z.Scal(y, p)
+	z.Real().Add(z.Real(), q)
+	return z
+}
+
+// WriteTo writes a canonical encoding of z to w, and returns the number of
+// bytes written. Equal values produce equal encodings, so any hash.Hash can be
+// used as w to hash z without building an intermediate byte slice.
+func (z *InfraCockle) WriteTo(w io.Writer) (int64, error) {
+	return writeRats(w, rats(z.Rats()))
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// the rational parameter t, and returns z. The curve is evaluated exactly with
+// the De Casteljau algorithm. If p is empty, then Bezier panics.
+func (z *InfraCockle) Bezier(t *big.Rat, p ...*InfraCockle) *InfraCockle {
+	if len(p) == 0 {
+		panic("no control points")
+	}
+	s := new(big.Rat).Sub(big.NewRat(1, 1), t)
+	b := make([]*InfraCockle, len(p))
+	for i := range p {
+		b[i] = new(InfraCockle).Set(p[i])
+	}
+	temp := new(InfraCockle)
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			b[i].Add(b[i].Scal(b[i], s), temp.Scal(b[i+1], t))
+		}
+	}
+	return z.Set(b[0])
+}
+
+// Round sets z equal to y with each component rounded to a multiple of 1/den
+// in the direction given by mode, and returns z. Directed modes round every
+// component the same way, so interval bounds stay rigorous. If den is not
+// positive, then Round panics.
+func (z *InfraCockle) Round(y *InfraCockle, den *big.Int, mode big.RoundingMode) *InfraCockle {
+	z.Set(y)
+	for _, c := range rats(z.Rats()) {
+		roundRat(c, c, den, mode)
+	}
+	return z
+}
+
+// Act returns the left action of z on the pair (a, b) of Cockle values, which
+// is identified with the InfraCockle value a + bρ and multiplied on the left
+// by z. InfraCockle is not associative, since Cockle is not commutative, so
+// Act is not a representation of InfraCockle: acting by Mul(x, y) need not be
+// acting by y and then by x. By alternativity, acting by x twice is acting by
+// Mul(x, x).
+func (z *InfraCockle) Act(a, b *Cockle) (*Cockle, *Cockle) {
+	v := new(InfraCockle)
+	v.l.Set(a)
+	v.r.Set(b)
+	v.Mul(z, v)
+	return &v.l, &v.r
+}
+
 // Generate returns a random InfraCockle value for quick.Check testing.
 func (z *InfraCockle) Generate(rand *rand.Rand, size int) reflect.Value {
-	randomInfraCockle := &InfraCockle{
-		*NewCockle(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-		*NewCockle(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-	}
-	return reflect.ValueOf(randomInfraCockle)
+	return reflect.ValueOf(new(InfraCockle).Random(rand, generateOptions))
 }
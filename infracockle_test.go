@@ -366,3 +366,40 @@ func TestInfraCockleComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Scalar operands
+
+func TestInfraCockleMulReal(t *testing.T) {
+	f := func(x, y *InfraCockle) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(big.Rat).Set(y.Real())
+		s := new(InfraCockle)
+		s.Real().Set(a)
+		if !s.IsReal() || x.IsReal() {
+			return false
+		}
+		l, r := new(InfraCockle), new(InfraCockle)
+		l.Mul(s, x)
+		r.Mul(x, s)
+		// an independent product: s x = (s + x)x - x x, with no real factor
+		xx := new(InfraCockle).Mul(x, x)
+		sx := new(InfraCockle).Add(s, x)
+		return l.Equals(new(InfraCockle).Sub(new(InfraCockle).Mul(sx, x), xx)) &&
+			r.Equals(new(InfraCockle).Sub(new(InfraCockle).Mul(x, sx), xx))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraCockleIsPure(t *testing.T) {
+	f := func(x *InfraCockle) bool {
+		// t.Logf("x = %v", x)
+		p := new(InfraCockle).Set(x)
+		p.Real().SetInt64(0)
+		return p.IsPure() && !x.IsPure()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -5,6 +5,7 @@ package rational
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"reflect"
@@ -18,16 +19,29 @@ type InfraComplex struct {
 	l, r Complex
 }
 
-// Real returns the (rational) real part of z.
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
 func (z *InfraComplex) Real() *big.Rat {
 	return (&z.l).Real()
 }
 
-// Rats returns the four rational components of z.
+// Rats returns the four rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
 func (z *InfraComplex) Rats() (*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
 	return &z.l.l, &z.l.r, &z.r.l, &z.r.r
 }
 
+// RatsCopy returns copies of the four rational components of z.
+func (z *InfraComplex) RatsCopy() (*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
+	return new(InfraComplex).Set(z).Rats()
+}
+
+// Components returns the four rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *InfraComplex) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
 // String returns the string representation of an InfraComplex value.
 //
 // If z corresponds to a + bi + cβ + dγ, then the string is"(a+bi+cβ+dγ)",
@@ -37,7 +51,8 @@ func (z *InfraComplex) String() string {
 	v[0], v[1] = z.l.Rats()
 	v[2], v[3] = z.r.Rats()
 	a := make([]string, 9)
-	a[0] = leftBracket
+	br := brackets()
+	a[0] = br[0]
 	a[1] = fmt.Sprintf("%v", v[0].RatString())
 	i := 1
 	for j := 2; j < 8; j = j + 2 {
@@ -49,7 +64,7 @@ func (z *InfraComplex) String() string {
 		a[j+1] = symbInfraComplex[i]
 		i++
 	}
-	a[8] = rightBracket
+	a[8] = br[1]
 	return strings.Join(a, "")
 }
 
@@ -61,6 +76,16 @@ func (z *InfraComplex) Equals(y *InfraComplex) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *InfraComplex) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Complex))
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *InfraComplex) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *InfraComplex) Set(y *InfraComplex) *InfraComplex {
 	z.l.Set(&y.l)
@@ -122,12 +147,26 @@ func (z *InfraComplex) Sub(x, y *InfraComplex) *InfraComplex {
 // 		Mul(i, β) = -Mul(β, i) = γ
 // 		Mul(γ, i) = -Mul(i, γ) = β
 // This binary operation is noncommutative but associative.
-func (z *InfraComplex) Mul(x, y *InfraComplex) *InfraComplex {
-	a := new(Complex).Set(&x.l)
-	b := new(Complex).Set(&x.r)
-	c := new(Complex).Set(&y.l)
-	d := new(Complex).Set(&y.r)
-	temp := new(Complex)
+func (z *InfraComplex) Mul(x, y *InfraComplex) (product *InfraComplex) {
+	if h := currentHook(); h != nil {
+		x, y := new(InfraComplex).Set(x), new(InfraComplex).Set(y)
+		defer func() { traceMul(h, "InfraComplex.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	a, b, c, d := &x.l, &x.r, &y.l, &y.r
+	if z == x || z == y {
+		// z.l is written before z.r is computed
+		a, b = complexArena.get().Set(a), complexArena.get().Set(b)
+		c, d = complexArena.get().Set(c), complexArena.get().Set(d)
+		defer complexArena.put(a, b, c, d)
+	}
+	temp := complexArena.get()
+	defer complexArena.put(temp)
 	z.l.Mul(a, c)
 	z.r.Add(
 		z.r.Mul(d, a),
@@ -162,11 +201,16 @@ func (z *InfraComplex) IsZeroDivisor() bool {
 
 // Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
 // then Inv panics.
-func (z *InfraComplex) Inv(y *InfraComplex) *InfraComplex {
+func (z *InfraComplex) Inv(y *InfraComplex) (inverse *InfraComplex) {
+	if h := currentHook(); h != nil {
+		y := new(InfraComplex).Set(y)
+		defer func() { traceInv(h, "InfraComplex.Inv", inverse != nil, z, y) }()
+	}
 	if y.IsZeroDivisor() {
-		panic("inverse of zero divisor")
+		panic(divisorError("inverse of zero divisor"))
 	}
 	a := y.Quad()
+	defer putRat(a)
 	a.Inv(a)
 	return z.Scal(z.Conj(y), a)
 }
@@ -176,7 +220,7 @@ func (z *InfraComplex) Inv(y *InfraComplex) *InfraComplex {
 // Then it returns z. If y is a zero divisor, then QuoL panics.
 func (z *InfraComplex) QuoL(x, y *InfraComplex) *InfraComplex {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(z.Inv(y), x)
 }
@@ -186,7 +230,7 @@ func (z *InfraComplex) QuoL(x, y *InfraComplex) *InfraComplex {
 // Then it returns z. If y is a zero divisor, then QuoR panics.
 func (z *InfraComplex) QuoR(x, y *InfraComplex) *InfraComplex {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(x, z.Inv(y))
 }
@@ -254,17 +298,150 @@ func (z *InfraComplex) Dot(y *InfraComplex) *big.Rat {
 	return z.l.Dot(&y.l)
 }
 
+// LeftMul returns the linear operator that sends y to Mul(z, y), acting on
+// the rational components of y.
+func (z *InfraComplex) LeftMul() *Operator {
+	a := new(InfraComplex).Set(z)
+	return &Operator{4, func(v []*big.Rat) []*big.Rat {
+		y := NewInfraComplex(v[0], v[1], v[2], v[3])
+		return rats(y.Mul(a, y).Rats())
+	}}
+}
+
+// RightMul returns the linear operator that sends y to Mul(y, z), acting on
+// the rational components of y.
+func (z *InfraComplex) RightMul() *Operator {
+	a := new(InfraComplex).Set(z)
+	return &Operator{4, func(v []*big.Rat) []*big.Rat {
+		y := NewInfraComplex(v[0], v[1], v[2], v[3])
+		return rats(y.Mul(y, a).Rats())
+	}}
+}
+
+// MinPoly returns the minimal polynomial of z. If z is real, then the
+// minimal polynomial is
+// 		t - Real(z)
+// Otherwise, it is
+// 		t² - 2Real(z)t + Quad(z)
+func (z *InfraComplex) MinPoly() Laurent {
+	re := new(big.Rat).Set(z.Real())
+	pure := new(InfraComplex).Set(z)
+	pure.Real().SetInt64(0)
+	if pure.Equals(new(InfraComplex)) {
+		return Laurent{0: re.Neg(re), 1: big.NewRat(1, 1)}
+	}
+	return Laurent{
+		0: z.Quad(),
+		1: re.Mul(re, big.NewRat(-2, 1)),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// EvaluateMinPoly returns true if z satisfies its minimal polynomial.
+func (z *InfraComplex) EvaluateMinPoly() bool {
+	return satisfies(z, z.MinPoly())
+}
+
+// PowViaMinPoly sets z equal to y raised to the power n, and returns z. High
+// powers are reduced with the minimal polynomial of y, so only O(log n)
+// rational multiplications are needed.
+func (z *InfraComplex) PowViaMinPoly(y *InfraComplex, n uint64) *InfraComplex {
+	p, q := quadPow(y.MinPoly(), n)
+	z.Scal(y, p)
+	z.Real().Add(z.Real(), q)
+	return z
+}
+
+// WriteTo writes a canonical encoding of z to w, and returns the number of
+// bytes written. Equal values produce equal encodings, so any hash.Hash can be
+// used as w to hash z without building an intermediate byte slice.
+func (z *InfraComplex) WriteTo(w io.Writer) (int64, error) {
+	return writeRats(w, rats(z.Rats()))
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// the rational parameter t, and returns z. The curve is evaluated exactly with
+// the De Casteljau algorithm. If p is empty, then Bezier panics.
+func (z *InfraComplex) Bezier(t *big.Rat, p ...*InfraComplex) *InfraComplex {
+	if len(p) == 0 {
+		panic("no control points")
+	}
+	s := new(big.Rat).Sub(big.NewRat(1, 1), t)
+	b := make([]*InfraComplex, len(p))
+	for i := range p {
+		b[i] = new(InfraComplex).Set(p[i])
+	}
+	temp := new(InfraComplex)
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			b[i].Add(b[i].Scal(b[i], s), temp.Scal(b[i+1], t))
+		}
+	}
+	return z.Set(b[0])
+}
+
+// Round sets z equal to y with each component rounded to a multiple of 1/den
+// in the direction given by mode, and returns z. Directed modes round every
+// component the same way, so interval bounds stay rigorous. If den is not
+// positive, then Round panics.
+func (z *InfraComplex) Round(y *InfraComplex, den *big.Int, mode big.RoundingMode) *InfraComplex {
+	z.Set(y)
+	for _, c := range rats(z.Rats()) {
+		roundRat(c, c, den, mode)
+	}
+	return z
+}
+
+// Act returns the left action of z on the pair (a, b) of Complex values, which
+// is identified with the InfraComplex value a + bβ and multiplied on the left
+// by z. InfraComplex is associative, so Act is a representation of
+// InfraComplex. Since β is nilpotent, the pairs (0, b) form an invariant
+// subspace, on which z acts by left multiplication by its first half.
+func (z *InfraComplex) Act(a, b *Complex) (*Complex, *Complex) {
+	v := new(InfraComplex)
+	v.l.Set(a)
+	v.r.Set(b)
+	v.Mul(z, v)
+	return &v.l, &v.r
+}
+
+// SolveCrossRatioL sets z equal to the point y such that the left
+// cross-ratio of v, w, x, and y is c, and returns z. With
+// A = Inv(w - x) * (v - x) and B = Inv(A) * c, the point is
+// 		(v*B - w) * Inv(B - 1)
+// If the point is not uniquely determined, then SolveCrossRatioL panics.
+func (z *InfraComplex) SolveCrossRatioL(v, w, x, c *InfraComplex) *InfraComplex {
+	one := new(InfraComplex)
+	one.Real().SetInt64(1)
+	b := new(InfraComplex).Sub(v, x)
+	b.Mul(new(InfraComplex).Inv(new(InfraComplex).Sub(w, x)), b)
+	b.Mul(b.Inv(b), c)
+	temp := new(InfraComplex).Sub(b, one)
+	temp.Inv(temp)
+	z.Mul(v, b)
+	z.Sub(z, w)
+	return z.Mul(z, temp)
+}
+
+// SolveCrossRatioR sets z equal to the point y such that the right
+// cross-ratio of v, w, x, and y is c, and returns z. With
+// A = (v - x) * Inv(w - x) and B = Inv(A) * c, the point is
+// 		Inv(B - 1) * (B*v - w)
+// If the point is not uniquely determined, then SolveCrossRatioR panics.
+func (z *InfraComplex) SolveCrossRatioR(v, w, x, c *InfraComplex) *InfraComplex {
+	one := new(InfraComplex)
+	one.Real().SetInt64(1)
+	b := new(InfraComplex).Sub(v, x)
+	b.Mul(b, new(InfraComplex).Inv(new(InfraComplex).Sub(w, x)))
+	b.Mul(b.Inv(b), c)
+	temp := new(InfraComplex).Sub(b, one)
+	temp.Inv(temp)
+	z.Mul(b, v)
+	z.Sub(z, w)
+	return z.Mul(temp, z)
+}
+
 // Generate returns a random InfraComplex value for quick.Check testing.
 func (z *InfraComplex) Generate(rand *rand.Rand, size int) reflect.Value {
-	randomInfraComplex := &InfraComplex{
-		*NewComplex(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-		*NewComplex(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-	}
-	return reflect.ValueOf(randomInfraComplex)
+	return reflect.ValueOf(new(InfraComplex).Random(rand, generateOptions))
 }
@@ -343,3 +343,40 @@ func TestInfraComplexComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Scalar operands
+
+func TestInfraComplexMulReal(t *testing.T) {
+	f := func(x, y *InfraComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(big.Rat).Set(y.Real())
+		s := new(InfraComplex)
+		s.Real().Set(a)
+		if !s.IsReal() || x.IsReal() {
+			return false
+		}
+		l, r := new(InfraComplex), new(InfraComplex)
+		l.Mul(s, x)
+		r.Mul(x, s)
+		// an independent product: s x = (s + x)x - x x, with no real factor
+		xx := new(InfraComplex).Mul(x, x)
+		sx := new(InfraComplex).Add(s, x)
+		return l.Equals(new(InfraComplex).Sub(new(InfraComplex).Mul(sx, x), xx)) &&
+			r.Equals(new(InfraComplex).Sub(new(InfraComplex).Mul(x, sx), xx))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraComplexIsPure(t *testing.T) {
+	f := func(x *InfraComplex) bool {
+		// t.Logf("x = %v", x)
+		p := new(InfraComplex).Set(x)
+		p.Real().SetInt64(0)
+		return p.IsPure() && !x.IsPure()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -5,6 +5,7 @@ package rational
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"reflect"
@@ -18,18 +19,32 @@ type InfraHamilton struct {
 	l, r Hamilton
 }
 
-// Real returns the (rational) real part of z.
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
 func (z *InfraHamilton) Real() *big.Rat {
 	return (&z.l).Real()
 }
 
-// Rats returns the eight components of z.
+// Rats returns the eight rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
 func (z *InfraHamilton) Rats() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
 	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
 	return &z.l.l.l, &z.l.l.r, &z.l.r.l, &z.l.r.r,
 		&z.r.l.l, &z.r.l.r, &z.r.r.l, &z.r.r.r
 }
 
+// RatsCopy returns copies of the eight rational components of z.
+func (z *InfraHamilton) RatsCopy() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
+	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
+	return new(InfraHamilton).Set(z).Rats()
+}
+
+// Components returns the eight rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *InfraHamilton) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
 // String returns the string representation of an InfraHamilton value.
 //
 // If z corresponds to a + bi + cj + dk + eα + fβ + gγ + hδ, then the string
@@ -39,7 +54,8 @@ func (z *InfraHamilton) String() string {
 	v[0], v[1], v[2], v[3] = z.l.Rats()
 	v[4], v[5], v[6], v[7] = z.r.Rats()
 	a := make([]string, 17)
-	a[0] = leftBracket
+	br := brackets()
+	a[0] = br[0]
 	a[1] = fmt.Sprintf("%v", v[0].RatString())
 	i := 1
 	for j := 2; j < 16; j = j + 2 {
@@ -51,7 +67,7 @@ func (z *InfraHamilton) String() string {
 		a[j+1] = symbInfraHamilton[i]
 		i++
 	}
-	a[16] = rightBracket
+	a[16] = br[1]
 	return strings.Join(a, "")
 }
 
@@ -63,6 +79,16 @@ func (z *InfraHamilton) Equals(y *InfraHamilton) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *InfraHamilton) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Hamilton))
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *InfraHamilton) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *InfraHamilton) Set(y *InfraHamilton) *InfraHamilton {
 	z.l.Set(&y.l)
@@ -147,12 +173,26 @@ func (z *InfraHamilton) Sub(x, y *InfraHamilton) *InfraHamilton {
 // 		Mul(β, δ) = Mul(δ, β) = 0
 // 		Mul(γ, δ) = Mul(δ, γ) = 0
 // This binary operation is noncommutative and nonassociative.
-func (z *InfraHamilton) Mul(x, y *InfraHamilton) *InfraHamilton {
-	a := new(Hamilton).Set(&x.l)
-	b := new(Hamilton).Set(&x.r)
-	c := new(Hamilton).Set(&y.l)
-	d := new(Hamilton).Set(&y.r)
-	temp := new(Hamilton)
+func (z *InfraHamilton) Mul(x, y *InfraHamilton) (product *InfraHamilton) {
+	if h := currentHook(); h != nil {
+		x, y := new(InfraHamilton).Set(x), new(InfraHamilton).Set(y)
+		defer func() { traceMul(h, "InfraHamilton.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	a, b, c, d := &x.l, &x.r, &y.l, &y.r
+	if z == x || z == y {
+		// z.l is written before z.r is computed
+		a, b = hamiltonArena.get().Set(a), hamiltonArena.get().Set(b)
+		c, d = hamiltonArena.get().Set(c), hamiltonArena.get().Set(d)
+		defer hamiltonArena.put(a, b, c, d)
+	}
+	temp := hamiltonArena.get()
+	defer hamiltonArena.put(temp)
 	z.l.Mul(a, c)
 	z.r.Add(
 		z.r.Mul(d, a),
@@ -199,11 +239,16 @@ func (z *InfraHamilton) IsZeroDivisor() bool {
 
 // Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
 // then Inv panics.
-func (z *InfraHamilton) Inv(y *InfraHamilton) *InfraHamilton {
+func (z *InfraHamilton) Inv(y *InfraHamilton) (inverse *InfraHamilton) {
+	if h := currentHook(); h != nil {
+		y := new(InfraHamilton).Set(y)
+		defer func() { traceInv(h, "InfraHamilton.Inv", inverse != nil, z, y) }()
+	}
 	if y.IsZeroDivisor() {
-		panic("inverse of zero divisor")
+		panic(divisorError("inverse of zero divisor"))
 	}
 	a := y.Quad()
+	defer putRat(a)
 	a.Inv(a)
 	return z.Scal(z.Conj(y), a)
 }
@@ -213,7 +258,7 @@ func (z *InfraHamilton) Inv(y *InfraHamilton) *InfraHamilton {
 // Then it returns z. If y is a zero divisor, then QuoL panics.
 func (z *InfraHamilton) QuoL(x, y *InfraHamilton) *InfraHamilton {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(z.Inv(y), x)
 }
@@ -223,26 +268,185 @@ func (z *InfraHamilton) QuoL(x, y *InfraHamilton) *InfraHamilton {
 // Then it returns z. If y is a zero divisor, then QuoR panics.
 func (z *InfraHamilton) QuoR(x, y *InfraHamilton) *InfraHamilton {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(x, z.Inv(y))
 }
 
+// LeftMul returns the linear operator that sends y to Mul(z, y), acting on
+// the rational components of y.
+func (z *InfraHamilton) LeftMul() *Operator {
+	a := new(InfraHamilton).Set(z)
+	return &Operator{8, func(v []*big.Rat) []*big.Rat {
+		y := NewInfraHamilton(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+		return rats(y.Mul(a, y).Rats())
+	}}
+}
+
+// RightMul returns the linear operator that sends y to Mul(y, z), acting on
+// the rational components of y.
+func (z *InfraHamilton) RightMul() *Operator {
+	a := new(InfraHamilton).Set(z)
+	return &Operator{8, func(v []*big.Rat) []*big.Rat {
+		y := NewInfraHamilton(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+		return rats(y.Mul(y, a).Rats())
+	}}
+}
+
+// MinPoly returns the minimal polynomial of z. If z is real, then the
+// minimal polynomial is
+// 		t - Real(z)
+// Otherwise, it is
+// 		t² - 2Real(z)t + Quad(z)
+func (z *InfraHamilton) MinPoly() Laurent {
+	re := new(big.Rat).Set(z.Real())
+	pure := new(InfraHamilton).Set(z)
+	pure.Real().SetInt64(0)
+	if pure.Equals(new(InfraHamilton)) {
+		return Laurent{0: re.Neg(re), 1: big.NewRat(1, 1)}
+	}
+	return Laurent{
+		0: z.Quad(),
+		1: re.Mul(re, big.NewRat(-2, 1)),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// EvaluateMinPoly returns true if z satisfies its minimal polynomial.
+func (z *InfraHamilton) EvaluateMinPoly() bool {
+	return satisfies(z, z.MinPoly())
+}
+
+// PowViaMinPoly sets z equal to y raised to the power n, and returns z. High
+// powers are reduced with the minimal polynomial of y, so only O(log n)
+// rational multiplications are needed.
+func (z *InfraHamilton) PowViaMinPoly(y *InfraHamilton, n uint64) *InfraHamilton {
+	p, q := quadPow(y.MinPoly(), n)
+	z.Scal(y, p)
+	z.Real().Add(z.Real(), q)
+	return z
+}
+
+// WriteTo writes a canonical encoding of z to w, and returns the number of
+// bytes written. Equal values produce equal encodings, so any hash.Hash can be
+// used as w to hash z without building an intermediate byte slice.
+func (z *InfraHamilton) WriteTo(w io.Writer) (int64, error) {
+	return writeRats(w, rats(z.Rats()))
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// the rational parameter t, and returns z. The curve is evaluated exactly with
+// the De Casteljau algorithm. If p is empty, then Bezier panics.
+func (z *InfraHamilton) Bezier(t *big.Rat, p ...*InfraHamilton) *InfraHamilton {
+	if len(p) == 0 {
+		panic("no control points")
+	}
+	s := new(big.Rat).Sub(big.NewRat(1, 1), t)
+	b := make([]*InfraHamilton, len(p))
+	for i := range p {
+		b[i] = new(InfraHamilton).Set(p[i])
+	}
+	temp := new(InfraHamilton)
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			b[i].Add(b[i].Scal(b[i], s), temp.Scal(b[i+1], t))
+		}
+	}
+	return z.Set(b[0])
+}
+
+// Exp sets z equal to the exponential of y, and returns z. The exponential
+// series terminates because y is nilpotent. If y is not nilpotent, then Exp
+// panics.
+func (z *InfraHamilton) Exp(y *InfraHamilton) *InfraHamilton {
+	if !y.IsZeroDivisor() {
+		panic("exponential of non-nilpotent")
+	}
+	zero := new(InfraHamilton)
+	sum, term := new(InfraHamilton), new(InfraHamilton)
+	sum.Real().SetInt64(1)
+	term.Real().SetInt64(1)
+	for k := int64(1); ; k++ {
+		term.Mul(term, y)
+		if term.Equals(zero) {
+			break
+		}
+		term.Scal(term, big.NewRat(1, k))
+		sum.Add(sum, term)
+	}
+	return z.Set(sum)
+}
+
+// Log sets z equal to the logarithm of y, and returns z. This is the inverse
+// of Exp: the logarithmic series terminates because y - 1 is nilpotent. If
+// y - 1 is not nilpotent, then Log panics.
+func (z *InfraHamilton) Log(y *InfraHamilton) *InfraHamilton {
+	one := new(InfraHamilton)
+	one.Real().SetInt64(1)
+	n := new(InfraHamilton).Sub(y, one)
+	if !n.IsZeroDivisor() {
+		panic("logarithm of non-unipotent")
+	}
+	zero := new(InfraHamilton)
+	sum, term := new(InfraHamilton), new(InfraHamilton).Set(one)
+	for k := int64(1); ; k++ {
+		term.Mul(term, n)
+		if term.Equals(zero) {
+			break
+		}
+		c := big.NewRat(1, k)
+		if k%2 == 0 {
+			c.Neg(c)
+		}
+		sum.Add(sum, new(InfraHamilton).Scal(term, c))
+	}
+	return z.Set(sum)
+}
+
+// BCH sets z equal to the Baker–Campbell–Hausdorff combination of x and y,
+// truncated after the terms of degree three:
+// 		x + y + [x, y]/2 + ([x, [x, y]] + [y, [y, x]])/12
+// Then it returns z. For nilpotent x and y every omitted term vanishes, so
+// Exp(BCH(x, y)) = Mul(Exp(x), Exp(y)) exactly.
+func (z *InfraHamilton) BCH(x, y *InfraHamilton) *InfraHamilton {
+	c := new(InfraHamilton).Commutator(x, y)
+	t1 := new(InfraHamilton).Commutator(x, c)
+	t2 := new(InfraHamilton).Commutator(y, c)
+	t1.Sub(t1, t2)
+	t1.Scal(t1, big.NewRat(1, 12))
+	c.Scal(c, big.NewRat(1, 2))
+	z.Add(x, y)
+	z.Add(z, c)
+	return z.Add(z, t1)
+}
+
+// Round sets z equal to y with each component rounded to a multiple of 1/den
+// in the direction given by mode, and returns z. Directed modes round every
+// component the same way, so interval bounds stay rigorous. If den is not
+// positive, then Round panics.
+func (z *InfraHamilton) Round(y *InfraHamilton, den *big.Int, mode big.RoundingMode) *InfraHamilton {
+	z.Set(y)
+	for _, c := range rats(z.Rats()) {
+		roundRat(c, c, den, mode)
+	}
+	return z
+}
+
+// Act returns the left action of z on the pair (a, b) of Hamilton values,
+// which is identified with the InfraHamilton value a + bα and multiplied on
+// the left by z. Doubling the non-commutative Hamilton makes InfraHamilton
+// non-associative, so Act is not a representation: acting by a product is not
+// in general acting by its factors in turn. Acting by x twice is acting by
+// Mul(x, x), since InfraHamilton is alternative.
+func (z *InfraHamilton) Act(a, b *Hamilton) (*Hamilton, *Hamilton) {
+	v := new(InfraHamilton)
+	v.l.Set(a)
+	v.r.Set(b)
+	v.Mul(z, v)
+	return &v.l, &v.r
+}
+
 // Generate returns a random InfraHamilton value for quick.Check testing.
 func (z *InfraHamilton) Generate(rand *rand.Rand, size int) reflect.Value {
-	randomInfraHamilton := &InfraHamilton{
-		*NewHamilton(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-		*NewHamilton(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-	}
-	return reflect.ValueOf(randomInfraHamilton)
+	return reflect.ValueOf(new(InfraHamilton).Random(rand, generateOptions))
 }
@@ -378,3 +378,101 @@ func TestInfraHamiltonComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Scalar operands
+
+func TestInfraHamiltonMulReal(t *testing.T) {
+	f := func(x, y *InfraHamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(big.Rat).Set(y.Real())
+		s := new(InfraHamilton)
+		s.Real().Set(a)
+		if !s.IsReal() || x.IsReal() {
+			return false
+		}
+		l, r := new(InfraHamilton), new(InfraHamilton)
+		l.Mul(s, x)
+		r.Mul(x, s)
+		// an independent product: s x = (s + x)x - x x, with no real factor
+		xx := new(InfraHamilton).Mul(x, x)
+		sx := new(InfraHamilton).Add(s, x)
+		return l.Equals(new(InfraHamilton).Sub(new(InfraHamilton).Mul(sx, x), xx)) &&
+			r.Equals(new(InfraHamilton).Sub(new(InfraHamilton).Mul(x, sx), xx))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraHamiltonIsPure(t *testing.T) {
+	f := func(x *InfraHamilton) bool {
+		// t.Logf("x = %v", x)
+		p := new(InfraHamilton).Set(x)
+		p.Real().SetInt64(0)
+		return p.IsPure() && !x.IsPure()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Curves
+
+func TestInfraHamiltonBezierEndpoints(t *testing.T) {
+	zero, one := new(big.Rat), big.NewRat(1, 1)
+	f := func(x, y, z *InfraHamilton) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		l := new(InfraHamilton).Bezier(zero, x, y, z)
+		r := new(InfraHamilton).Bezier(one, x, y, z)
+		return l.Equals(x) && r.Equals(z)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraHamiltonBezierQuadratic(t *testing.T) {
+	a := big.NewRat(2, 7)
+	b := new(big.Rat).Sub(big.NewRat(1, 1), a)
+	f := func(x, y, z *InfraHamilton) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		l := new(InfraHamilton).Bezier(a, x, y, z)
+		r, temp := new(InfraHamilton), new(InfraHamilton)
+		r.Scal(x, new(big.Rat).Mul(b, b))
+		r.Add(r, temp.Scal(y, new(big.Rat).Mul(big.NewRat(2, 1), new(big.Rat).Mul(a, b))))
+		r.Add(r, temp.Scal(z, new(big.Rat).Mul(a, a)))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Exponential
+
+func TestInfraHamiltonLogExp(t *testing.T) {
+	f := func(x *InfraHamilton) bool {
+		// t.Logf("x = %v", x)
+		x.l = Hamilton{}
+		l := new(InfraHamilton).Exp(x)
+		l.Log(l)
+		return l.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraHamiltonExpBCH(t *testing.T) {
+	f := func(x, y *InfraHamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		x.l = Hamilton{}
+		y.l = Hamilton{}
+		l := new(InfraHamilton).Exp(new(InfraHamilton).BCH(x, y))
+		r := new(InfraHamilton).Mul(new(InfraHamilton).Exp(x), new(InfraHamilton).Exp(y))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
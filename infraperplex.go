@@ -5,6 +5,7 @@ package rational
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"reflect"
@@ -18,16 +19,29 @@ type InfraPerplex struct {
 	l, r Perplex
 }
 
-// Real returns the (rational) real part of z.
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
 func (z *InfraPerplex) Real() *big.Rat {
 	return (&z.l).Real()
 }
 
-// Rats returns the four rational components of z.
+// Rats returns the four rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
 func (z *InfraPerplex) Rats() (*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
 	return &z.l.l, &z.l.r, &z.r.l, &z.r.r
 }
 
+// RatsCopy returns copies of the four rational components of z.
+func (z *InfraPerplex) RatsCopy() (*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
+	return new(InfraPerplex).Set(z).Rats()
+}
+
+// Components returns the four rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *InfraPerplex) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
 // String returns the string representation of an InfraPerplex value.
 //
 // If z corresponds to a + bs + cτ + dυ, then the string is"(a+bs+cτ+dυ)",
@@ -37,7 +51,8 @@ func (z *InfraPerplex) String() string {
 	v[0], v[1] = z.l.Rats()
 	v[2], v[3] = z.r.Rats()
 	a := make([]string, 9)
-	a[0] = leftBracket
+	br := brackets()
+	a[0] = br[0]
 	a[1] = fmt.Sprintf("%v", v[0].RatString())
 	i := 1
 	for j := 2; j < 8; j = j + 2 {
@@ -49,7 +64,7 @@ func (z *InfraPerplex) String() string {
 		a[j+1] = symbInfraPerplex[i]
 		i++
 	}
-	a[8] = rightBracket
+	a[8] = br[1]
 	return strings.Join(a, "")
 }
 
@@ -61,6 +76,16 @@ func (z *InfraPerplex) Equals(y *InfraPerplex) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *InfraPerplex) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Perplex))
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *InfraPerplex) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *InfraPerplex) Set(y *InfraPerplex) *InfraPerplex {
 	z.l.Set(&y.l)
@@ -122,12 +147,26 @@ func (z *InfraPerplex) Sub(x, y *InfraPerplex) *InfraPerplex {
 // 		Mul(s, τ) = -Mul(τ, s) = υ
 // 		Mul(s, υ) = -Mul(υ, s) = τ
 // This binary operation is noncommutative but associative.
-func (z *InfraPerplex) Mul(x, y *InfraPerplex) *InfraPerplex {
-	a := new(Perplex).Set(&x.l)
-	b := new(Perplex).Set(&x.r)
-	c := new(Perplex).Set(&y.l)
-	d := new(Perplex).Set(&y.r)
-	temp := new(Perplex)
+func (z *InfraPerplex) Mul(x, y *InfraPerplex) (product *InfraPerplex) {
+	if h := currentHook(); h != nil {
+		x, y := new(InfraPerplex).Set(x), new(InfraPerplex).Set(y)
+		defer func() { traceMul(h, "InfraPerplex.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	a, b, c, d := &x.l, &x.r, &y.l, &y.r
+	if z == x || z == y {
+		// z.l is written before z.r is computed
+		a, b = perplexArena.get().Set(a), perplexArena.get().Set(b)
+		c, d = perplexArena.get().Set(c), perplexArena.get().Set(d)
+		defer perplexArena.put(a, b, c, d)
+	}
+	temp := perplexArena.get()
+	defer perplexArena.put(temp)
 	z.l.Mul(a, c)
 	z.r.Add(
 		z.r.Mul(d, a),
@@ -161,11 +200,16 @@ func (z *InfraPerplex) IsZeroDivisor() bool {
 
 // Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
 // then Inv panics.
-func (z *InfraPerplex) Inv(y *InfraPerplex) *InfraPerplex {
+func (z *InfraPerplex) Inv(y *InfraPerplex) (inverse *InfraPerplex) {
+	if h := currentHook(); h != nil {
+		y := new(InfraPerplex).Set(y)
+		defer func() { traceInv(h, "InfraPerplex.Inv", inverse != nil, z, y) }()
+	}
 	if y.IsZeroDivisor() {
-		panic("inverse of zero divisor")
+		panic(divisorError("inverse of zero divisor"))
 	}
 	a := y.Quad()
+	defer putRat(a)
 	a.Inv(a)
 	return z.Scal(z.Conj(y), a)
 }
@@ -175,7 +219,7 @@ func (z *InfraPerplex) Inv(y *InfraPerplex) *InfraPerplex {
 // Then it returns z. If y is a zero divisor, then QuoL panics.
 func (z *InfraPerplex) QuoL(x, y *InfraPerplex) *InfraPerplex {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(z.Inv(y), x)
 }
@@ -185,7 +229,7 @@ func (z *InfraPerplex) QuoL(x, y *InfraPerplex) *InfraPerplex {
 // Then it returns z. If y is a zero divisor, then QuoR panics.
 func (z *InfraPerplex) QuoR(x, y *InfraPerplex) *InfraPerplex {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(x, z.Inv(y))
 }
@@ -253,17 +297,149 @@ func (z *InfraPerplex) Dot(y *InfraPerplex) *big.Rat {
 	return z.l.Dot(&y.l)
 }
 
+// LeftMul returns the linear operator that sends y to Mul(z, y), acting on
+// the rational components of y.
+func (z *InfraPerplex) LeftMul() *Operator {
+	a := new(InfraPerplex).Set(z)
+	return &Operator{4, func(v []*big.Rat) []*big.Rat {
+		y := NewInfraPerplex(v[0], v[1], v[2], v[3])
+		return rats(y.Mul(a, y).Rats())
+	}}
+}
+
+// RightMul returns the linear operator that sends y to Mul(y, z), acting on
+// the rational components of y.
+func (z *InfraPerplex) RightMul() *Operator {
+	a := new(InfraPerplex).Set(z)
+	return &Operator{4, func(v []*big.Rat) []*big.Rat {
+		y := NewInfraPerplex(v[0], v[1], v[2], v[3])
+		return rats(y.Mul(y, a).Rats())
+	}}
+}
+
+// MinPoly returns the minimal polynomial of z. If z is real, then the
+// minimal polynomial is
+// 		t - Real(z)
+// Otherwise, it is
+// 		t² - 2Real(z)t + Quad(z)
+func (z *InfraPerplex) MinPoly() Laurent {
+	re := new(big.Rat).Set(z.Real())
+	pure := new(InfraPerplex).Set(z)
+	pure.Real().SetInt64(0)
+	if pure.Equals(new(InfraPerplex)) {
+		return Laurent{0: re.Neg(re), 1: big.NewRat(1, 1)}
+	}
+	return Laurent{
+		0: z.Quad(),
+		1: re.Mul(re, big.NewRat(-2, 1)),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// EvaluateMinPoly returns true if z satisfies its minimal polynomial.
+func (z *InfraPerplex) EvaluateMinPoly() bool {
+	return satisfies(z, z.MinPoly())
+}
+
+// PowViaMinPoly sets z equal to y raised to the power n, and returns z. High
+// powers are reduced with the minimal polynomial of y, so only O(log n)
+// rational multiplications are needed.
+func (z *InfraPerplex) PowViaMinPoly(y *InfraPerplex, n uint64) *InfraPerplex {
+	p, q := quadPow(y.MinPoly(), n)
+	z.Scal(y, p)
+	z.Real().Add(z.Real(), q)
+	return z
+}
+
+// WriteTo writes a canonical encoding of z to w, and returns the number of
+// bytes written. Equal values produce equal encodings, so any hash.Hash can be
+// used as w to hash z without building an intermediate byte slice.
+func (z *InfraPerplex) WriteTo(w io.Writer) (int64, error) {
+	return writeRats(w, rats(z.Rats()))
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// the rational parameter t, and returns z. The curve is evaluated exactly with
+// the De Casteljau algorithm. If p is empty, then Bezier panics.
+func (z *InfraPerplex) Bezier(t *big.Rat, p ...*InfraPerplex) *InfraPerplex {
+	if len(p) == 0 {
+		panic("no control points")
+	}
+	s := new(big.Rat).Sub(big.NewRat(1, 1), t)
+	b := make([]*InfraPerplex, len(p))
+	for i := range p {
+		b[i] = new(InfraPerplex).Set(p[i])
+	}
+	temp := new(InfraPerplex)
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			b[i].Add(b[i].Scal(b[i], s), temp.Scal(b[i+1], t))
+		}
+	}
+	return z.Set(b[0])
+}
+
+// Round sets z equal to y with each component rounded to a multiple of 1/den
+// in the direction given by mode, and returns z. Directed modes round every
+// component the same way, so interval bounds stay rigorous. If den is not
+// positive, then Round panics.
+func (z *InfraPerplex) Round(y *InfraPerplex, den *big.Int, mode big.RoundingMode) *InfraPerplex {
+	z.Set(y)
+	for _, c := range rats(z.Rats()) {
+		roundRat(c, c, den, mode)
+	}
+	return z
+}
+
+// Act returns the left action of z on the pair (a, b) of Perplex values, which
+// is identified with the InfraPerplex value a + bτ and multiplied on the left
+// by z. InfraPerplex is associative, so acting by Mul(x, y) is acting by y and
+// then by x. The pairs (0, b) are invariant, as τ is nilpotent.
+func (z *InfraPerplex) Act(a, b *Perplex) (*Perplex, *Perplex) {
+	v := new(InfraPerplex)
+	v.l.Set(a)
+	v.r.Set(b)
+	v.Mul(z, v)
+	return &v.l, &v.r
+}
+
+// SolveCrossRatioL sets z equal to the point y such that the left
+// cross-ratio of v, w, x, and y is c, and returns z. With
+// A = Inv(w - x) * (v - x) and B = Inv(A) * c, the point is
+// 		(v*B - w) * Inv(B - 1)
+// If the point is not uniquely determined, then SolveCrossRatioL panics.
+func (z *InfraPerplex) SolveCrossRatioL(v, w, x, c *InfraPerplex) *InfraPerplex {
+	one := new(InfraPerplex)
+	one.Real().SetInt64(1)
+	b := new(InfraPerplex).Sub(v, x)
+	b.Mul(new(InfraPerplex).Inv(new(InfraPerplex).Sub(w, x)), b)
+	b.Mul(b.Inv(b), c)
+	temp := new(InfraPerplex).Sub(b, one)
+	temp.Inv(temp)
+	z.Mul(v, b)
+	z.Sub(z, w)
+	return z.Mul(z, temp)
+}
+
+// SolveCrossRatioR sets z equal to the point y such that the right
+// cross-ratio of v, w, x, and y is c, and returns z. With
+// A = (v - x) * Inv(w - x) and B = Inv(A) * c, the point is
+// 		Inv(B - 1) * (B*v - w)
+// If the point is not uniquely determined, then SolveCrossRatioR panics.
+func (z *InfraPerplex) SolveCrossRatioR(v, w, x, c *InfraPerplex) *InfraPerplex {
+	one := new(InfraPerplex)
+	one.Real().SetInt64(1)
+	b := new(InfraPerplex).Sub(v, x)
+	b.Mul(b, new(InfraPerplex).Inv(new(InfraPerplex).Sub(w, x)))
+	b.Mul(b.Inv(b), c)
+	temp := new(InfraPerplex).Sub(b, one)
+	temp.Inv(temp)
+	z.Mul(b, v)
+	z.Sub(z, w)
+	return z.Mul(temp, z)
+}
+
 // Generate returns a random InfraPerplex value for quick.Check testing.
 func (z *InfraPerplex) Generate(rand *rand.Rand, size int) reflect.Value {
-	randomInfraPerplex := &InfraPerplex{
-		*NewPerplex(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-		*NewPerplex(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-	}
-	return reflect.ValueOf(randomInfraPerplex)
+	return reflect.ValueOf(new(InfraPerplex).Random(rand, generateOptions))
 }
@@ -331,3 +331,40 @@ func TestInfraPerplexComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Scalar operands
+
+func TestInfraPerplexMulReal(t *testing.T) {
+	f := func(x, y *InfraPerplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(big.Rat).Set(y.Real())
+		s := new(InfraPerplex)
+		s.Real().Set(a)
+		if !s.IsReal() || x.IsReal() {
+			return false
+		}
+		l, r := new(InfraPerplex), new(InfraPerplex)
+		l.Mul(s, x)
+		r.Mul(x, s)
+		// an independent product: s x = (s + x)x - x x, with no real factor
+		xx := new(InfraPerplex).Mul(x, x)
+		sx := new(InfraPerplex).Add(s, x)
+		return l.Equals(new(InfraPerplex).Sub(new(InfraPerplex).Mul(sx, x), xx)) &&
+			r.Equals(new(InfraPerplex).Sub(new(InfraPerplex).Mul(x, sx), xx))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraPerplexIsPure(t *testing.T) {
+	f := func(x *InfraPerplex) bool {
+		// t.Logf("x = %v", x)
+		p := new(InfraPerplex).Set(x)
+		p.Real().SetInt64(0)
+		return p.IsPure() && !x.IsPure()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// A NumQuat is a float64 quaternion, laid out like the Quaternion type of
+// gonum's num package, so that values convert with a plain struct
+// conversion.
+type NumQuat struct {
+	Real, Imag, Jmag, Kmag float64
+}
+
+// A NumPerplex is a float64 perplex number, in the style of NumQuat. Its
+// value is Real + Split s, with s² = +1.
+type NumPerplex struct {
+	Real, Split float64
+}
+
+// setFloat64s sets the components v of a value to the exact values of f, and
+// returns true. If any value in f is infinite or NaN, then v is left
+// unchanged and setFloat64s returns false.
+func setFloat64s(v []*big.Rat, f ...float64) bool {
+	w := make([]*big.Rat, len(f))
+	for i, x := range f {
+		if w[i] = new(big.Rat).SetFloat64(x); w[i] == nil {
+			return false
+		}
+	}
+	setComponents(v, w)
+	return true
+}
+
+// Complex128 returns the nearest complex128 value to z.
+func (z *Complex) Complex128() complex128 {
+	f := z.Float64s()
+	return complex(f[0], f[1])
+}
+
+// FromComplex128 sets z equal to the exact value of c, and returns true. If
+// either part of c is infinite or NaN, then z is left unchanged and
+// FromComplex128 returns false.
+func (z *Complex) FromComplex128(c complex128) bool {
+	return setFloat64s(z.Components(), real(c), imag(c))
+}
+
+// ToNumQuat returns the nearest NumQuat value to z.
+func (z *Hamilton) ToNumQuat() NumQuat {
+	f := z.Float64s()
+	return NumQuat{f[0], f[1], f[2], f[3]}
+}
+
+// FromNumQuat sets z equal to the exact value of q, and returns true. If any
+// part of q is infinite or NaN, then z is left unchanged and FromNumQuat
+// returns false.
+func (z *Hamilton) FromNumQuat(q NumQuat) bool {
+	return setFloat64s(z.Components(), q.Real, q.Imag, q.Jmag, q.Kmag)
+}
+
+// ToNumPerplex returns the nearest NumPerplex value to z.
+func (z *Perplex) ToNumPerplex() NumPerplex {
+	f := z.Float64s()
+	return NumPerplex{f[0], f[1]}
+}
+
+// FromNumPerplex sets z equal to the exact value of p, and returns true. If
+// either part of p is infinite or NaN, then z is left unchanged and
+// FromNumPerplex returns false.
+func (z *Perplex) FromNumPerplex(p NumPerplex) bool {
+	return setFloat64s(z.Components(), p.Real, p.Split)
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestComplex128(t *testing.T) {
+	f := func(c complex128) bool {
+		// t.Logf("c = %v", c)
+		z := new(Complex)
+		return z.FromComplex128(c) && z.Complex128() == c
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	z := NewComplex(big.NewRat(1, 2), big.NewRat(3, 1))
+	if z.FromComplex128(complex(math.Inf(1), 0)) || !z.Equals(NewComplex(big.NewRat(1, 2), big.NewRat(3, 1))) {
+		t.Errorf("FromComplex128(+Inf) changed z to %v", z)
+	}
+}
+
+func TestNumQuat(t *testing.T) {
+	f := func(q NumQuat) bool {
+		// t.Logf("q = %v", q)
+		z := new(Hamilton)
+		return z.FromNumQuat(q) && z.ToNumQuat() == q
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	// products agree with float64 arithmetic on small integers
+	x := NewHamilton(big.NewRat(1, 1), big.NewRat(2, 1), big.NewRat(3, 1), big.NewRat(4, 1))
+	y := NewHamilton(big.NewRat(5, 1), big.NewRat(6, 1), big.NewRat(7, 1), big.NewRat(8, 1))
+	if got, want := new(Hamilton).Mul(x, y).ToNumQuat(), (NumQuat{-60, 12, 30, 24}); got != want {
+		t.Errorf("Mul = %v, want %v", got, want)
+	}
+}
+
+func TestNumPerplex(t *testing.T) {
+	f := func(p NumPerplex) bool {
+		// t.Logf("p = %v", p)
+		z := new(Perplex)
+		return z.FromNumPerplex(p) && z.ToNumPerplex() == p
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	if new(Perplex).FromNumPerplex(NumPerplex{math.NaN(), 0}) {
+		t.Error("FromNumPerplex(NaN) succeeded")
+	}
+}
@@ -0,0 +1,722 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+)
+
+// The failures of inverses and quotients. Every *DivisionError and
+// *SolveError wraps ErrZeroDivisor, and a *DivisionError whose denominator is
+// zero also wraps ErrZeroDenominator, so that callers can tell them apart with
+// errors.Is. The panics of Inv, Quo, Rotate, and the like are error values
+// wrapping the same sentinels, whose messages are the former panic strings.
+// Code that recovers these panics with recover().(string) must assert error
+// instead.
+var (
+	ErrZeroDivisor     = errors.New("rational: zero divisor")
+	ErrZeroDenominator = errors.New("rational: zero denominator")
+)
+
+// A panicError is the value of a panic that wraps a sentinel error. Its
+// message is the bare panic message.
+type panicError struct {
+	msg string
+	err error
+}
+
+func (e *panicError) Error() string {
+	return e.msg
+}
+
+func (e *panicError) Unwrap() error {
+	return e.err
+}
+
+// divisorError returns the value of a panic with the message msg for a
+// denominator that is a zero divisor.
+func divisorError(msg string) error {
+	return &panicError{msg, ErrZeroDivisor}
+}
+
+// denominatorError returns the value of a panic with the message msg for a
+// denominator that is zero. Since zero is also a zero divisor, it wraps both
+// sentinels.
+func denominatorError(msg string) error {
+	return &panicError{msg, errors.Join(ErrZeroDenominator, ErrZeroDivisor)}
+}
+
+// A DivisionError reports an inverse or a quotient whose denominator is zero
+// or a zero divisor. It is returned by the Err variants of Inv, Quo, QuoL,
+// and QuoR, which do not panic.
+type DivisionError struct {
+	Type string       // the type, such as "Hamilton"
+	Op   string       // the operation, such as "Inv"
+	Y    fmt.Stringer // the denominator
+}
+
+func (e *DivisionError) Error() string {
+	return fmt.Sprintf("rational: %s.%s: %v is not invertible", e.Type, e.Op, e.Y)
+}
+
+// Unwrap returns ErrZeroDivisor, together with ErrZeroDenominator if the
+// denominator is zero.
+func (e *DivisionError) Unwrap() []error {
+	zero := false
+	switch y := e.Y.(type) {
+	case interface{ Components() []*big.Rat }:
+		zero = !slices.ContainsFunc(y.Components(), func(c *big.Rat) bool { return c.Sign() != 0 })
+	case interface{ Components() []*big.Int }:
+		zero = !slices.ContainsFunc(y.Components(), func(c *big.Int) bool { return c.Sign() != 0 })
+	}
+	if zero {
+		return []error{ErrZeroDivisor, ErrZeroDenominator}
+	}
+	return []error{ErrZeroDivisor}
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is zero, then z
+// is left unchanged and InvErr returns a *DivisionError instead of panicking.
+func (z *Complex) InvErr(y *Complex) (*Complex, error) {
+	if new(Complex).Equals(y) {
+		return nil, &DivisionError{"Complex", "Inv", new(Complex).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// QuoErr sets z equal to Quo(x, y), and returns z. If y is zero, then z is
+// left unchanged and QuoErr returns a *DivisionError instead of panicking.
+func (z *Complex) QuoErr(x, y *Complex) (*Complex, error) {
+	if new(Complex).Equals(y) {
+		return nil, &DivisionError{"Complex", "Quo", new(Complex).Set(y)}
+	}
+	return z.Quo(x, y), nil
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then z is left unchanged and InvErr returns a *DivisionError
+// instead of panicking.
+func (z *Infra) InvErr(y *Infra) (*Infra, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"Infra", "Inv", new(Infra).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// QuoErr sets z equal to Quo(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoErr returns a *DivisionError instead of
+// panicking.
+func (z *Infra) QuoErr(x, y *Infra) (*Infra, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"Infra", "Quo", new(Infra).Set(y)}
+	}
+	return z.Quo(x, y), nil
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then z is left unchanged and InvErr returns a *DivisionError
+// instead of panicking.
+func (z *Perplex) InvErr(y *Perplex) (*Perplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"Perplex", "Inv", new(Perplex).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// QuoErr sets z equal to Quo(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoErr returns a *DivisionError instead of
+// panicking.
+func (z *Perplex) QuoErr(x, y *Perplex) (*Perplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"Perplex", "Quo", new(Perplex).Set(y)}
+	}
+	return z.Quo(x, y), nil
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then z is left unchanged and InvErr returns a *DivisionError
+// instead of panicking.
+func (z *BiComplex) InvErr(y *BiComplex) (*BiComplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"BiComplex", "Inv", new(BiComplex).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// QuoErr sets z equal to Quo(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoErr returns a *DivisionError instead of
+// panicking.
+func (z *BiComplex) QuoErr(x, y *BiComplex) (*BiComplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"BiComplex", "Quo", new(BiComplex).Set(y)}
+	}
+	return z.Quo(x, y), nil
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then z is left unchanged and InvErr returns a *DivisionError
+// instead of panicking.
+func (z *BiPerplex) InvErr(y *BiPerplex) (*BiPerplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"BiPerplex", "Inv", new(BiPerplex).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// QuoErr sets z equal to Quo(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoErr returns a *DivisionError instead of
+// panicking.
+func (z *BiPerplex) QuoErr(x, y *BiPerplex) (*BiPerplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"BiPerplex", "Quo", new(BiPerplex).Set(y)}
+	}
+	return z.Quo(x, y), nil
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then z is left unchanged and InvErr returns a *DivisionError
+// instead of panicking.
+func (z *Cockle) InvErr(y *Cockle) (*Cockle, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"Cockle", "Inv", new(Cockle).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// QuoLErr sets z equal to QuoL(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoLErr returns a *DivisionError instead of
+// panicking.
+func (z *Cockle) QuoLErr(x, y *Cockle) (*Cockle, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"Cockle", "QuoL", new(Cockle).Set(y)}
+	}
+	return z.QuoL(x, y), nil
+}
+
+// QuoRErr sets z equal to QuoR(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoRErr returns a *DivisionError instead of
+// panicking.
+func (z *Cockle) QuoRErr(x, y *Cockle) (*Cockle, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"Cockle", "QuoR", new(Cockle).Set(y)}
+	}
+	return z.QuoR(x, y), nil
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then z is left unchanged and InvErr returns a *DivisionError
+// instead of panicking.
+func (z *DualComplex) InvErr(y *DualComplex) (*DualComplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"DualComplex", "Inv", new(DualComplex).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// QuoErr sets z equal to Quo(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoErr returns a *DivisionError instead of
+// panicking.
+func (z *DualComplex) QuoErr(x, y *DualComplex) (*DualComplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"DualComplex", "Quo", new(DualComplex).Set(y)}
+	}
+	return z.Quo(x, y), nil
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then z is left unchanged and InvErr returns a *DivisionError
+// instead of panicking.
+func (z *DualPerplex) InvErr(y *DualPerplex) (*DualPerplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"DualPerplex", "Inv", new(DualPerplex).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// QuoErr sets z equal to Quo(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoErr returns a *DivisionError instead of
+// panicking.
+func (z *DualPerplex) QuoErr(x, y *DualPerplex) (*DualPerplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"DualPerplex", "Quo", new(DualPerplex).Set(y)}
+	}
+	return z.Quo(x, y), nil
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is zero, then z
+// is left unchanged and InvErr returns a *DivisionError instead of panicking.
+func (z *Hamilton) InvErr(y *Hamilton) (*Hamilton, error) {
+	if new(Hamilton).Equals(y) {
+		return nil, &DivisionError{"Hamilton", "Inv", new(Hamilton).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// QuoLErr sets z equal to QuoL(x, y), and returns z. If y is zero, then z is
+// left unchanged and QuoLErr returns a *DivisionError instead of panicking.
+func (z *Hamilton) QuoLErr(x, y *Hamilton) (*Hamilton, error) {
+	if new(Hamilton).Equals(y) {
+		return nil, &DivisionError{"Hamilton", "QuoL", new(Hamilton).Set(y)}
+	}
+	return z.QuoL(x, y), nil
+}
+
+// QuoRErr sets z equal to QuoR(x, y), and returns z. If y is zero, then z is
+// left unchanged and QuoRErr returns a *DivisionError instead of panicking.
+func (z *Hamilton) QuoRErr(x, y *Hamilton) (*Hamilton, error) {
+	if new(Hamilton).Equals(y) {
+		return nil, &DivisionError{"Hamilton", "QuoR", new(Hamilton).Set(y)}
+	}
+	return z.QuoR(x, y), nil
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then z is left unchanged and InvErr returns a *DivisionError
+// instead of panicking.
+func (z *Hyper) InvErr(y *Hyper) (*Hyper, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"Hyper", "Inv", new(Hyper).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// QuoErr sets z equal to Quo(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoErr returns a *DivisionError instead of
+// panicking.
+func (z *Hyper) QuoErr(x, y *Hyper) (*Hyper, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"Hyper", "Quo", new(Hyper).Set(y)}
+	}
+	return z.Quo(x, y), nil
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then z is left unchanged and InvErr returns a *DivisionError
+// instead of panicking.
+func (z *InfraComplex) InvErr(y *InfraComplex) (*InfraComplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"InfraComplex", "Inv", new(InfraComplex).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// QuoLErr sets z equal to QuoL(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoLErr returns a *DivisionError instead of
+// panicking.
+func (z *InfraComplex) QuoLErr(x, y *InfraComplex) (*InfraComplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"InfraComplex", "QuoL", new(InfraComplex).Set(y)}
+	}
+	return z.QuoL(x, y), nil
+}
+
+// QuoRErr sets z equal to QuoR(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoRErr returns a *DivisionError instead of
+// panicking.
+func (z *InfraComplex) QuoRErr(x, y *InfraComplex) (*InfraComplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"InfraComplex", "QuoR", new(InfraComplex).Set(y)}
+	}
+	return z.QuoR(x, y), nil
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then z is left unchanged and InvErr returns a *DivisionError
+// instead of panicking.
+func (z *InfraPerplex) InvErr(y *InfraPerplex) (*InfraPerplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"InfraPerplex", "Inv", new(InfraPerplex).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// QuoLErr sets z equal to QuoL(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoLErr returns a *DivisionError instead of
+// panicking.
+func (z *InfraPerplex) QuoLErr(x, y *InfraPerplex) (*InfraPerplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"InfraPerplex", "QuoL", new(InfraPerplex).Set(y)}
+	}
+	return z.QuoL(x, y), nil
+}
+
+// QuoRErr sets z equal to QuoR(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoRErr returns a *DivisionError instead of
+// panicking.
+func (z *InfraPerplex) QuoRErr(x, y *InfraPerplex) (*InfraPerplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"InfraPerplex", "QuoR", new(InfraPerplex).Set(y)}
+	}
+	return z.QuoR(x, y), nil
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then z is left unchanged and InvErr returns a *DivisionError
+// instead of panicking.
+func (z *Supra) InvErr(y *Supra) (*Supra, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"Supra", "Inv", new(Supra).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// QuoLErr sets z equal to QuoL(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoLErr returns a *DivisionError instead of
+// panicking.
+func (z *Supra) QuoLErr(x, y *Supra) (*Supra, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"Supra", "QuoL", new(Supra).Set(y)}
+	}
+	return z.QuoL(x, y), nil
+}
+
+// QuoRErr sets z equal to QuoR(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoRErr returns a *DivisionError instead of
+// panicking.
+func (z *Supra) QuoRErr(x, y *Supra) (*Supra, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"Supra", "QuoR", new(Supra).Set(y)}
+	}
+	return z.QuoR(x, y), nil
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then z is left unchanged and InvErr returns a *DivisionError
+// instead of panicking.
+func (z *BiCockle) InvErr(y *BiCockle) (*BiCockle, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"BiCockle", "Inv", new(BiCockle).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// QuoLErr sets z equal to QuoL(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoLErr returns a *DivisionError instead of
+// panicking.
+func (z *BiCockle) QuoLErr(x, y *BiCockle) (*BiCockle, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"BiCockle", "QuoL", new(BiCockle).Set(y)}
+	}
+	return z.QuoL(x, y), nil
+}
+
+// QuoRErr sets z equal to QuoR(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoRErr returns a *DivisionError instead of
+// panicking.
+func (z *BiCockle) QuoRErr(x, y *BiCockle) (*BiCockle, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"BiCockle", "QuoR", new(BiCockle).Set(y)}
+	}
+	return z.QuoR(x, y), nil
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then z is left unchanged and InvErr returns a *DivisionError
+// instead of panicking.
+func (z *BiHamilton) InvErr(y *BiHamilton) (*BiHamilton, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"BiHamilton", "Inv", new(BiHamilton).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// QuoLErr sets z equal to QuoL(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoLErr returns a *DivisionError instead of
+// panicking.
+func (z *BiHamilton) QuoLErr(x, y *BiHamilton) (*BiHamilton, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"BiHamilton", "QuoL", new(BiHamilton).Set(y)}
+	}
+	return z.QuoL(x, y), nil
+}
+
+// QuoRErr sets z equal to QuoR(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoRErr returns a *DivisionError instead of
+// panicking.
+func (z *BiHamilton) QuoRErr(x, y *BiHamilton) (*BiHamilton, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"BiHamilton", "QuoR", new(BiHamilton).Set(y)}
+	}
+	return z.QuoR(x, y), nil
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is zero, then z
+// is left unchanged and InvErr returns a *DivisionError instead of panicking.
+func (z *Cayley) InvErr(y *Cayley) (*Cayley, error) {
+	if new(Cayley).Equals(y) {
+		return nil, &DivisionError{"Cayley", "Inv", new(Cayley).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// QuoLErr sets z equal to QuoL(x, y), and returns z. If y is zero, then z is
+// left unchanged and QuoLErr returns a *DivisionError instead of panicking.
+func (z *Cayley) QuoLErr(x, y *Cayley) (*Cayley, error) {
+	if new(Cayley).Equals(y) {
+		return nil, &DivisionError{"Cayley", "QuoL", new(Cayley).Set(y)}
+	}
+	return z.QuoL(x, y), nil
+}
+
+// QuoRErr sets z equal to QuoR(x, y), and returns z. If y is zero, then z is
+// left unchanged and QuoRErr returns a *DivisionError instead of panicking.
+func (z *Cayley) QuoRErr(x, y *Cayley) (*Cayley, error) {
+	if new(Cayley).Equals(y) {
+		return nil, &DivisionError{"Cayley", "QuoR", new(Cayley).Set(y)}
+	}
+	return z.QuoR(x, y), nil
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then z is left unchanged and InvErr returns a *DivisionError
+// instead of panicking.
+func (z *DualHamilton) InvErr(y *DualHamilton) (*DualHamilton, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"DualHamilton", "Inv", new(DualHamilton).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then z is left unchanged and InvErr returns a *DivisionError
+// instead of panicking.
+func (z *InfraCockle) InvErr(y *InfraCockle) (*InfraCockle, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"InfraCockle", "Inv", new(InfraCockle).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// QuoLErr sets z equal to QuoL(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoLErr returns a *DivisionError instead of
+// panicking.
+func (z *InfraCockle) QuoLErr(x, y *InfraCockle) (*InfraCockle, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"InfraCockle", "QuoL", new(InfraCockle).Set(y)}
+	}
+	return z.QuoL(x, y), nil
+}
+
+// QuoRErr sets z equal to QuoR(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoRErr returns a *DivisionError instead of
+// panicking.
+func (z *InfraCockle) QuoRErr(x, y *InfraCockle) (*InfraCockle, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"InfraCockle", "QuoR", new(InfraCockle).Set(y)}
+	}
+	return z.QuoR(x, y), nil
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then z is left unchanged and InvErr returns a *DivisionError
+// instead of panicking.
+func (z *InfraHamilton) InvErr(y *InfraHamilton) (*InfraHamilton, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"InfraHamilton", "Inv", new(InfraHamilton).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// QuoLErr sets z equal to QuoL(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoLErr returns a *DivisionError instead of
+// panicking.
+func (z *InfraHamilton) QuoLErr(x, y *InfraHamilton) (*InfraHamilton, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"InfraHamilton", "QuoL", new(InfraHamilton).Set(y)}
+	}
+	return z.QuoL(x, y), nil
+}
+
+// QuoRErr sets z equal to QuoR(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoRErr returns a *DivisionError instead of
+// panicking.
+func (z *InfraHamilton) QuoRErr(x, y *InfraHamilton) (*InfraHamilton, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"InfraHamilton", "QuoR", new(InfraHamilton).Set(y)}
+	}
+	return z.QuoR(x, y), nil
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then z is left unchanged and InvErr returns a *DivisionError
+// instead of panicking.
+func (z *SupraComplex) InvErr(y *SupraComplex) (*SupraComplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"SupraComplex", "Inv", new(SupraComplex).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// QuoLErr sets z equal to QuoL(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoLErr returns a *DivisionError instead of
+// panicking.
+func (z *SupraComplex) QuoLErr(x, y *SupraComplex) (*SupraComplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"SupraComplex", "QuoL", new(SupraComplex).Set(y)}
+	}
+	return z.QuoL(x, y), nil
+}
+
+// QuoRErr sets z equal to QuoR(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoRErr returns a *DivisionError instead of
+// panicking.
+func (z *SupraComplex) QuoRErr(x, y *SupraComplex) (*SupraComplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"SupraComplex", "QuoR", new(SupraComplex).Set(y)}
+	}
+	return z.QuoR(x, y), nil
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then z is left unchanged and InvErr returns a *DivisionError
+// instead of panicking.
+func (z *SupraPerplex) InvErr(y *SupraPerplex) (*SupraPerplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"SupraPerplex", "Inv", new(SupraPerplex).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// QuoLErr sets z equal to QuoL(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoLErr returns a *DivisionError instead of
+// panicking.
+func (z *SupraPerplex) QuoLErr(x, y *SupraPerplex) (*SupraPerplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"SupraPerplex", "QuoL", new(SupraPerplex).Set(y)}
+	}
+	return z.QuoL(x, y), nil
+}
+
+// QuoRErr sets z equal to QuoR(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoRErr returns a *DivisionError instead of
+// panicking.
+func (z *SupraPerplex) QuoRErr(x, y *SupraPerplex) (*SupraPerplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"SupraPerplex", "QuoR", new(SupraPerplex).Set(y)}
+	}
+	return z.QuoR(x, y), nil
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then z is left unchanged and InvErr returns a *DivisionError
+// instead of panicking.
+func (z *TriComplex) InvErr(y *TriComplex) (*TriComplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"TriComplex", "Inv", new(TriComplex).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// QuoErr sets z equal to Quo(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoErr returns a *DivisionError instead of
+// panicking.
+func (z *TriComplex) QuoErr(x, y *TriComplex) (*TriComplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"TriComplex", "Quo", new(TriComplex).Set(y)}
+	}
+	return z.Quo(x, y), nil
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then z is left unchanged and InvErr returns a *DivisionError
+// instead of panicking.
+func (z *TriNilplex) InvErr(y *TriNilplex) (*TriNilplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"TriNilplex", "Inv", new(TriNilplex).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// QuoErr sets z equal to Quo(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoErr returns a *DivisionError instead of
+// panicking.
+func (z *TriNilplex) QuoErr(x, y *TriNilplex) (*TriNilplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"TriNilplex", "Quo", new(TriNilplex).Set(y)}
+	}
+	return z.Quo(x, y), nil
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then z is left unchanged and InvErr returns a *DivisionError
+// instead of panicking.
+func (z *TriPerplex) InvErr(y *TriPerplex) (*TriPerplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"TriPerplex", "Inv", new(TriPerplex).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// QuoErr sets z equal to Quo(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoErr returns a *DivisionError instead of
+// panicking.
+func (z *TriPerplex) QuoErr(x, y *TriPerplex) (*TriPerplex, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"TriPerplex", "Quo", new(TriPerplex).Set(y)}
+	}
+	return z.Quo(x, y), nil
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then z is left unchanged and InvErr returns a *DivisionError
+// instead of panicking.
+func (z *Ultra) InvErr(y *Ultra) (*Ultra, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"Ultra", "Inv", new(Ultra).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// QuoLErr sets z equal to QuoL(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoLErr returns a *DivisionError instead of
+// panicking.
+func (z *Ultra) QuoLErr(x, y *Ultra) (*Ultra, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"Ultra", "QuoL", new(Ultra).Set(y)}
+	}
+	return z.QuoL(x, y), nil
+}
+
+// QuoRErr sets z equal to QuoR(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoRErr returns a *DivisionError instead of
+// panicking.
+func (z *Ultra) QuoRErr(x, y *Ultra) (*Ultra, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"Ultra", "QuoR", new(Ultra).Set(y)}
+	}
+	return z.QuoR(x, y), nil
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then z is left unchanged and InvErr returns a *DivisionError
+// instead of panicking.
+func (z *Zorn) InvErr(y *Zorn) (*Zorn, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"Zorn", "Inv", new(Zorn).Set(y)}
+	}
+	return z.Inv(y), nil
+}
+
+// QuoLErr sets z equal to QuoL(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoLErr returns a *DivisionError instead of
+// panicking.
+func (z *Zorn) QuoLErr(x, y *Zorn) (*Zorn, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"Zorn", "QuoL", new(Zorn).Set(y)}
+	}
+	return z.QuoL(x, y), nil
+}
+
+// QuoRErr sets z equal to QuoR(x, y), and returns z. If y is a zero divisor,
+// then z is left unchanged and QuoRErr returns a *DivisionError instead of
+// panicking.
+func (z *Zorn) QuoRErr(x, y *Zorn) (*Zorn, error) {
+	if y.IsZeroDivisor() {
+		return nil, &DivisionError{"Zorn", "QuoR", new(Zorn).Set(y)}
+	}
+	return z.QuoR(x, y), nil
+}
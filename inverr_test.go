@@ -0,0 +1,103 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestInvErr(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		z, err := new(Hamilton).InvErr(x)
+		return err == nil && z.Equals(new(Hamilton).Inv(x))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	one := big.NewRat(1, 1)
+	z := NewPerplex(one, one)
+	if _, err := new(Perplex).InvErr(z); err == nil {
+		t.Errorf("InvErr(%v) succeeded", z)
+	}
+	w := NewComplex(one, one)
+	_, err := w.QuoErr(w, new(Complex))
+	var e *DivisionError
+	if !errors.As(err, &e) || e.Type != "Complex" || e.Op != "Quo" {
+		t.Errorf("QuoErr error = %v", err)
+	}
+	if !w.Equals(NewComplex(one, one)) {
+		t.Errorf("QuoErr changed z to %v", w)
+	}
+}
+
+func TestQuoLQuoRErr(t *testing.T) {
+	f := func(x, y *Zorn) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		if y.IsZeroDivisor() {
+			_, err := new(Zorn).QuoLErr(x, y)
+			return err != nil
+		}
+		l, err := new(Zorn).QuoLErr(x, y)
+		if err != nil || !l.Equals(new(Zorn).QuoL(x, y)) {
+			return false
+		}
+		r, err := new(Zorn).QuoRErr(x, y)
+		return err == nil && r.Equals(new(Zorn).QuoR(x, y))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	zero := new(Zorn)
+	if _, err := new(Zorn).QuoRErr(zero, zero); err == nil {
+		t.Error("QuoRErr by zero succeeded")
+	}
+}
+
+func TestSentinelErrors(t *testing.T) {
+	// a zero divisor that is not zero, and zero
+	s := NewPerplex(big.NewRat(1, 1), big.NewRat(1, 1))
+	_, err := new(Perplex).InvErr(s)
+	if !errors.Is(err, ErrZeroDivisor) || errors.Is(err, ErrZeroDenominator) {
+		t.Errorf("InvErr(%v) error = %v", s, err)
+	}
+	_, err = new(Perplex).InvErr(new(Perplex))
+	if !errors.Is(err, ErrZeroDivisor) || !errors.Is(err, ErrZeroDenominator) {
+		t.Errorf("InvErr(0) error = %v", err)
+	}
+	_, err = new(Cockle).SolveL(new(Cockle), new(Cockle))
+	if !errors.Is(err, ErrZeroDivisor) {
+		t.Errorf("SolveL error = %v", err)
+	}
+	// the panics wrap the same sentinels
+	recovered := func(f func()) (err error) {
+		defer func() {
+			err, _ = recover().(error)
+		}()
+		f()
+		return nil
+	}
+	err = recovered(func() { new(Perplex).Inv(s) })
+	if !errors.Is(err, ErrZeroDivisor) || errors.Is(err, ErrZeroDenominator) {
+		t.Errorf("Inv(%v) panicked with %v", s, err)
+	}
+	err = recovered(func() { new(Complex).Inv(new(Complex)) })
+	if !errors.Is(err, ErrZeroDenominator) || err.Error() != "inverse of zero" {
+		t.Errorf("Inv(0) panicked with %v", err)
+	}
+	one := NewHamilton(big.NewRat(1, 1), big.NewRat(0, 1), big.NewRat(0, 1), big.NewRat(0, 1))
+	err = recovered(func() { new(Hamilton).Rotate(new(Hamilton), one) })
+	if !errors.Is(err, ErrZeroDenominator) || err.Error() != "rotation by zero" {
+		t.Errorf("Rotate(0) panicked with %v", err)
+	}
+	// 1+t is a zero divisor
+	q := NewCockle(big.NewRat(1, 1), big.NewRat(0, 1), big.NewRat(1, 1), big.NewRat(0, 1))
+	err = recovered(func() { new(Cockle).Rotate(q, new(Cockle)) })
+	if !errors.Is(err, ErrZeroDivisor) || errors.Is(err, ErrZeroDenominator) {
+		t.Errorf("Rotate(%v) panicked with %v", q, err)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// An InvolutionTable describes how the involutions of an algebra compose with
+// each other and interact with multiplication. Every entry is computed from
+// the basis units, which determine the linear maps involved.
+type InvolutionTable struct {
+	Name        string   `json:"name"`
+	Involutions []string `json:"involutions"`
+	// Compose[i][j] names the map x ↦ f(g(x)), where f and g are the i-th
+	// and j-th involutions: "Id" for the identity, the name of an
+	// involution, or "f∘g" if it is neither.
+	Compose [][]string `json:"compose"`
+	// Commute[i][j] is true if the i-th and j-th involutions commute.
+	Commute [][]bool `json:"commute"`
+	// Auto[i] is true if the i-th involution f is an automorphism, so that
+	// f(xy) = f(x)f(y).
+	Auto []bool `json:"auto"`
+	// Anti[i] is true if the i-th involution f is an antiautomorphism, so
+	// that f(xy) = f(y)f(x).
+	Anti []bool `json:"anti"`
+}
+
+// involutionTable computes the involution table of a.
+func (a algebra) involutionTable() *InvolutionTable {
+	impl := a.impl()
+	n, m := impl.Dim, len(a.involutions)
+	mul := impl.Ops["Mul"]
+	t := &InvolutionTable{
+		Name:        impl.Name,
+		Involutions: a.involutions,
+		Compose:     make([][]string, m),
+		Commute:     make([][]bool, m),
+		Auto:        make([]bool, m),
+		Anti:        make([]bool, m),
+	}
+	// images of the basis units under each map
+	image := func(f func([]*big.Rat) []*big.Rat) [][]*big.Rat {
+		v := make([][]*big.Rat, n)
+		for i := range v {
+			v[i] = f(unit(n, i))
+		}
+		return v
+	}
+	equal := func(v, w [][]*big.Rat) bool {
+		for i := range v {
+			if !equalRats(v[i], w[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	id := image(func(x []*big.Rat) []*big.Rat { return x })
+	ops := make([]Op, m)
+	images := make([][][]*big.Rat, m)
+	for i, name := range a.involutions {
+		ops[i] = impl.Ops[name]
+		images[i] = image(func(x []*big.Rat) []*big.Rat { return ops[i](x, nil) })
+	}
+	comp := make([][][][]*big.Rat, m)
+	for i := range ops {
+		comp[i] = make([][][]*big.Rat, m)
+		t.Compose[i] = make([]string, m)
+		for j := range ops {
+			comp[i][j] = image(func(x []*big.Rat) []*big.Rat {
+				return ops[i](ops[j](x, nil), nil)
+			})
+			switch {
+			case equal(comp[i][j], id):
+				t.Compose[i][j] = "Id"
+			default:
+				t.Compose[i][j] = a.involutions[i] + "∘" + a.involutions[j]
+				for k := range ops {
+					if equal(comp[i][j], images[k]) {
+						t.Compose[i][j] = a.involutions[k]
+						break
+					}
+				}
+			}
+		}
+	}
+	for i := range ops {
+		t.Commute[i] = make([]bool, m)
+		for j := range ops {
+			t.Commute[i][j] = equal(comp[i][j], comp[j][i])
+		}
+		t.Auto[i], t.Anti[i] = true, true
+		for j := 0; j < n; j++ {
+			for k := 0; k < n; k++ {
+				f := images[i]
+				xy := ops[i](mul(unit(n, j), unit(n, k)), nil)
+				t.Auto[i] = t.Auto[i] && equalRats(xy, mul(f[j], f[k]))
+				t.Anti[i] = t.Anti[i] && equalRats(xy, mul(f[k], f[j]))
+			}
+		}
+	}
+	return t
+}
+
+// InvolutionTables returns the involution tables of every type of this
+// package, ordered by dimension and name.
+func InvolutionTables() []*InvolutionTable {
+	v := make([]*InvolutionTable, len(algebras))
+	for i, a := range algebras {
+		v[i] = a.involutionTable()
+	}
+	return v
+}
+
+// InvolutionTableOf returns the involution table of the type with the given
+// name, such as "BiComplex". The second result is false if there is no such
+// type.
+func InvolutionTableOf(name string) (*InvolutionTable, bool) {
+	for _, a := range algebras {
+		if impl := a.impl(); impl.Name == name {
+			return a.involutionTable(), true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "testing"
+
+func TestInvolutionLaws(t *testing.T) {
+	schemas := Schemas()
+	for n, table := range InvolutionTables() {
+		commutative := schemas[n].Commutative
+		for i, f := range table.Involutions {
+			if table.Compose[i][i] != "Id" {
+				t.Errorf("%s: %s∘%s = %s, want Id", table.Name, f, f, table.Compose[i][i])
+			}
+			for j, g := range table.Involutions {
+				if !table.Commute[i][j] {
+					t.Errorf("%s: %s and %s do not commute", table.Name, f, g)
+				}
+				if i != j && table.Compose[i][j] != f+"∘"+g {
+					t.Errorf("%s: %s∘%s = %s, want a new map", table.Name, f, g, table.Compose[i][j])
+				}
+			}
+			switch f {
+			case "Neg":
+				if table.Auto[i] || table.Anti[i] {
+					t.Errorf("%s: Neg preserves products", table.Name)
+				}
+			case "Conj":
+				if !table.Anti[i] {
+					t.Errorf("%s: Conj is not an antiautomorphism", table.Name)
+				}
+				if table.Auto[i] != commutative {
+					t.Errorf("%s: Conj automorphism = %v, want %v", table.Name, table.Auto[i], commutative)
+				}
+			case "Star":
+				if !table.Auto[i] {
+					t.Errorf("%s: Star is not an automorphism", table.Name)
+				}
+			}
+		}
+	}
+}
+
+func TestInvolutionTableOf(t *testing.T) {
+	table, ok := InvolutionTableOf("Hyper")
+	if !ok {
+		t.Fatal("no involution table for Hyper")
+	}
+	if len(table.Involutions) != 3 || table.Compose[1][2] != "Conj∘Star" {
+		t.Errorf("Hyper involution table = %+v", table)
+	}
+	if _, ok := InvolutionTableOf("Sedenion"); ok {
+		t.Error("found an involution table for Sedenion")
+	}
+}
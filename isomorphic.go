@@ -0,0 +1,515 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+)
+
+// An IsomorphismError reports that AreIsomorphic found no isomorphism. If
+// Invariant is not empty, then it names an invariant that differs, which
+// proves that there is none.
+type IsomorphismError struct {
+	A, B      string // the names of the algebras
+	Invariant string // the differing invariant, or empty
+}
+
+func (e *IsomorphismError) Error() string {
+	if e.Invariant == "" {
+		return fmt.Sprintf("rational: no isomorphism from %s to %s was found", e.A, e.B)
+	}
+	return fmt.Sprintf("rational: %s and %s are not isomorphic: %s", e.A, e.B, e.Invariant)
+}
+
+// leftMat returns the matrix of left multiplication by x in impl.
+func leftMat(impl *Impl, x []*big.Rat) *RatMatrix {
+	n := impl.Dim
+	m := NewRatMatrix(n, n)
+	for j := 0; j < n; j++ {
+		for i, c := range impl.Ops["Mul"](x, unit(n, j)) {
+			m.At(i, j).Set(c)
+		}
+	}
+	return m
+}
+
+// traceForm returns the Gram matrix of the trace form of impl,
+// 		T(x, y) = Trace(L(x)L(y))
+// where L(x) is left multiplication by x. An isomorphism φ conjugates L(x)
+// into L(φ(x)), so the trace form is an invariant.
+func traceForm(impl *Impl) *RatMatrix {
+	n := impl.Dim
+	l := make([]*RatMatrix, n)
+	for i := range l {
+		l[i] = leftMat(impl, unit(n, i))
+	}
+	t := NewRatMatrix(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			t.At(i, j).Set(new(RatMatrix).Mul(l[i], l[j]).Trace())
+		}
+	}
+	return t
+}
+
+// inertia returns the numbers of positive, negative, and zero entries of a
+// diagonal form congruent to the symmetric matrix m, and the product of the
+// non-zero entries. Its class modulo squares is an invariant of the form.
+func inertia(m *RatMatrix) (pos, neg, zero int, disc *big.Rat) {
+	a := new(RatMatrix).Set(m)
+	n, _ := a.Dims()
+	disc = big.NewRat(1, 1)
+	// addTo adds row and column j to row and column i
+	addTo := func(i, j int) {
+		for k := 0; k < n; k++ {
+			a.At(i, k).Add(a.At(i, k), a.At(j, k))
+		}
+		for k := 0; k < n; k++ {
+			a.At(k, i).Add(a.At(k, i), a.At(k, j))
+		}
+	}
+	swap := func(i, j int) {
+		for k := 0; k < n; k++ {
+			a.e[i*n+k], a.e[j*n+k] = a.e[j*n+k], a.e[i*n+k]
+		}
+		for k := 0; k < n; k++ {
+			a.e[k*n+i], a.e[k*n+j] = a.e[k*n+j], a.e[k*n+i]
+		}
+	}
+	temp := new(big.Rat)
+	for k := 0; k < n; k++ {
+		p := k
+		for p < n && a.At(p, p).Sign() == 0 {
+			p++
+		}
+		if p == n {
+			// a zero diagonal with a non-zero entry a(i, j) becomes the
+			// non-zero diagonal entry 2a(i, j) of i after adding j to i
+		search:
+			for i := k; i < n; i++ {
+				for j := k; j < n; j++ {
+					if a.At(i, j).Sign() != 0 {
+						addTo(i, j)
+						p = i
+						break search
+					}
+				}
+			}
+		}
+		if p == n {
+			zero += n - k
+			break
+		}
+		swap(k, p)
+		d := new(big.Rat).Set(a.At(k, k))
+		for i := k + 1; i < n; i++ {
+			f := new(big.Rat).Quo(a.At(i, k), d)
+			for j := k; j < n; j++ {
+				a.At(i, j).Sub(a.At(i, j), temp.Mul(f, a.At(k, j)))
+			}
+			for j := k; j < n; j++ {
+				a.At(j, i).Set(a.At(i, j))
+			}
+		}
+		if d.Sign() > 0 {
+			pos++
+		} else {
+			neg++
+		}
+		disc.Mul(disc, d)
+	}
+	return pos, neg, zero, disc
+}
+
+// centerDim returns the dimension of the center of impl, the values that
+// commute with every value.
+func centerDim(impl *Impl) int {
+	n := impl.Dim
+	m := NewRatMatrix(n*n, n)
+	for i := 0; i < n; i++ {
+		e := unit(n, i)
+		for j := 0; j < n; j++ {
+			xe, ex := impl.Ops["Mul"](unit(n, j), e), impl.Ops["Mul"](e, unit(n, j))
+			for k := 0; k < n; k++ {
+				m.At(i*n+k, j).Sub(xe[k], ex[k])
+			}
+		}
+	}
+	return len(m.Kernel())
+}
+
+// invariantDiff returns the first invariant that differs between a and b, or
+// the empty string if they all agree.
+func invariantDiff(a, b *Impl) string {
+	if a.Dim != b.Dim {
+		return "dimensions differ"
+	}
+	basis := func(n int) []string {
+		s := make([]string, n)
+		for i := range s {
+			s[i] = fmt.Sprint("e", i)
+		}
+		return s
+	}
+	ra, rb := CheckStructureConstants(a, basis(a.Dim)), CheckStructureConstants(b, basis(b.Dim))
+	switch {
+	case ra.Commutative != rb.Commutative:
+		return "one is commutative and the other is not"
+	case ra.Associative != rb.Associative:
+		return "one is associative and the other is not"
+	case ra.Alternative != rb.Alternative:
+		return "one is alternative and the other is not"
+	case centerDim(a) != centerDim(b):
+		return "centers have different dimensions"
+	}
+	pa, na, za, da := inertia(traceForm(a))
+	pb, nb, zb, db := inertia(traceForm(b))
+	switch {
+	case za != zb:
+		return "trace forms have radicals of different dimensions"
+	case pa != pb || na != nb:
+		return "trace forms have different signatures"
+	}
+	if _, ok := ratSqrt(da.Quo(da, db)); !ok {
+		return "trace forms have different discriminants"
+	}
+	return ""
+}
+
+// express returns the coefficients c with v = Σ c[k]vs[k], if there are
+// any. The vectors vs must be independent.
+func express(v []*big.Rat, vs [][]*big.Rat) ([]*big.Rat, bool) {
+	m := NewRatMatrix(len(v), len(vs)+1)
+	for i := range v {
+		for k, w := range vs {
+			m.At(i, k).Set(w[i])
+		}
+		m.At(i, len(vs)).Set(v[i])
+	}
+	for _, w := range m.Kernel() {
+		if last := w[len(vs)]; last.Sign() != 0 {
+			c := make([]*big.Rat, len(vs))
+			for k := range c {
+				c[k] = new(big.Rat).Quo(w[k], last)
+				c[k].Neg(c[k])
+			}
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+// combine returns Σ c[k]vs[k].
+func combine(c []*big.Rat, vs [][]*big.Rat) []*big.Rat {
+	v := make([]*big.Rat, len(vs[0]))
+	temp := new(big.Rat)
+	for i := range v {
+		v[i] = new(big.Rat)
+		for k, w := range vs {
+			v[i].Add(v[i], temp.Mul(c[k], w[i]))
+		}
+	}
+	return v
+}
+
+// A word is a product of generators: the identity if gen and l are both -1,
+// the generator gen if it is not -1, and otherwise the product of the words
+// l and r.
+type word struct {
+	gen, l, r int
+	v         []*big.Rat
+}
+
+// generate returns generators of impl, as indices of basis units, and words
+// in them that form a basis of impl.
+func generate(impl *Impl) (gens []int, words []word) {
+	n := impl.Dim
+	mul := impl.Ops["Mul"]
+	words = []word{{-1, -1, -1, unit(n, 0)}}
+	independent := func(v []*big.Rat) bool {
+		rows := make([][]*big.Rat, len(words)+1)
+		for i, w := range words {
+			rows[i] = w.v
+		}
+		rows[len(words)] = v
+		_, _, pivots, _ := RatMatrixOf(rows).reduce(nil)
+		return len(pivots) == len(rows)
+	}
+	for k := 1; k < n && len(words) < n; k++ {
+		if !independent(unit(n, k)) {
+			continue
+		}
+		gens = append(gens, k)
+		words = append(words, word{len(gens) - 1, -1, -1, unit(n, k)})
+		for grew := true; grew && len(words) < n; {
+			grew = false
+			for i := 0; i < len(words) && len(words) < n; i++ {
+				for j := 0; j < len(words) && len(words) < n; j++ {
+					if v := mul(words[i].v, words[j].v); independent(v) {
+						words = append(words, word{-1, i, j, v})
+						grew = true
+					}
+				}
+			}
+		}
+	}
+	return gens, words
+}
+
+// candidates returns the values of impl whose components lie in coeffs,
+// ordered by their number of non-zero components.
+func candidates(n int, coeffs []*big.Rat) [][]*big.Rat {
+	var all [][]*big.Rat
+	v := make([]int, n)
+	for {
+		w := make([]*big.Rat, n)
+		for i := range w {
+			w[i] = new(big.Rat).Set(coeffs[v[i]])
+		}
+		all = append(all, w)
+		i := 0
+		for i < n && v[i] == len(coeffs)-1 {
+			v[i] = 0
+			i++
+		}
+		if i == n {
+			break
+		}
+		v[i]++
+	}
+	support := func(w []*big.Rat) int {
+		s := 0
+		for _, x := range w {
+			if x.Sign() != 0 {
+				s++
+			}
+		}
+		return s
+	}
+	slices.SortStableFunc(all, func(x, y []*big.Rat) int {
+		return support(x) - support(y)
+	})
+	return all
+}
+
+// A relation records that gh + sign·hg, for generators g and h, equals a
+// combination of 1, g, and h, such as ij + ji = 0 in Hamilton. If g and h are
+// equal, then the relation is about g² alone.
+type relation struct {
+	g, h  int
+	sign  int64
+	coeff []*big.Rat // the coefficients of 1, g, and h
+}
+
+// relations returns the relations of gens in impl that involve at most two
+// generators: g², gh + hg, and gh - hg, whenever they lie in the span of 1,
+// g, and h.
+func relations(impl *Impl, gens []int) []relation {
+	n := impl.Dim
+	var rels []relation
+	for i := range gens {
+		for j := 0; j <= i; j++ {
+			for _, sign := range []int64{1, -1} {
+				rel := relation{i, j, sign, nil}
+				im := [][]*big.Rat{unit(n, gens[i]), unit(n, gens[j])}
+				s, vs := rel.eval(impl, im[0], im[1])
+				if c, ok := express(s, vs); ok {
+					rel.coeff = c
+					rels = append(rels, rel)
+				}
+				if i == j {
+					break
+				}
+			}
+		}
+	}
+	return rels
+}
+
+// eval returns gh + sign·hg in impl, or g² if rel is about one generator,
+// and the values 1, g, and h that it is compared with.
+func (rel relation) eval(impl *Impl, g, h []*big.Rat) (s []*big.Rat, vs [][]*big.Rat) {
+	n := impl.Dim
+	mul := impl.Ops["Mul"]
+	s = mul(g, h)
+	vs = [][]*big.Rat{unit(n, 0), g}
+	if rel.g != rel.h {
+		hg := mul(h, g)
+		temp := new(big.Rat)
+		for k := range s {
+			s[k].Add(s[k], temp.Mul(hg[k], big.NewRat(rel.sign, 1)))
+		}
+		vs = append(vs, h)
+	}
+	return s, vs
+}
+
+// holds returns true if the images im of the generators satisfy rel in impl.
+func (rel relation) holds(impl *Impl, im [][]*big.Rat) bool {
+	s, vs := rel.eval(impl, im[rel.g], im[rel.h])
+	return equalRats(s, combine(rel.coeff, vs))
+}
+
+// images returns the images in impl of words, when each generator k is sent
+// to im[k].
+func images(impl *Impl, words []word, im [][]*big.Rat) [][]*big.Rat {
+	mul := impl.Ops["Mul"]
+	v := make([][]*big.Rat, len(words))
+	for j, w := range words {
+		switch {
+		case w.gen >= 0:
+			v[j] = im[w.gen]
+		case w.l >= 0:
+			v[j] = mul(v[w.l], v[w.r])
+		default:
+			v[j] = unit(impl.Dim, 0)
+		}
+	}
+	return v
+}
+
+// extend returns the matrix of the linear map from a to b that sends each
+// word to the same word in the images im of the generators, if it is an
+// isomorphism.
+func extend(a, b *Impl, words []word, im [][]*big.Rat) (*RatMatrix, bool) {
+	n := a.Dim
+	p, q := NewRatMatrix(n, n), NewRatMatrix(n, n)
+	for j, v := range images(b, words, im) {
+		for i := 0; i < n; i++ {
+			p.At(i, j).Set(words[j].v[i])
+			q.At(i, j).Set(v[i])
+		}
+	}
+	if q.Det().Sign() == 0 {
+		return nil, false
+	}
+	m := new(RatMatrix).Mul(q, new(RatMatrix).Inv(p))
+	for i := 0; i < n; i++ {
+		x := m.Apply(unit(n, i))
+		for j := 0; j < n; j++ {
+			if !equalRats(m.Apply(a.Ops["Mul"](unit(n, i), unit(n, j))), b.Ops["Mul"](x, m.Apply(unit(n, j)))) {
+				return nil, false
+			}
+		}
+	}
+	return m, true
+}
+
+// quadraticImage returns the image of the generator e of an algebra a of
+// dimension 2 in the algebra b, which is exact. If e² = α + βe, then
+// f = e - β/2 squares to d = α + β²/4, and an isomorphism sends f to cf',
+// with f' of b squaring to d' and c² = d/d'.
+func quadraticImage(a, b *Impl) ([]*big.Rat, bool) {
+	complete := func(impl *Impl) (f []*big.Rat, d, half *big.Rat) {
+		e := unit(2, 1)
+		c, _ := express(impl.Ops["Mul"](e, e), [][]*big.Rat{unit(2, 0), e})
+		half = new(big.Rat).Quo(c[1], big.NewRat(2, 1))
+		d = new(big.Rat).Add(c[0], new(big.Rat).Mul(half, half))
+		return []*big.Rat{new(big.Rat).Neg(half), big.NewRat(1, 1)}, d, half
+	}
+	_, d, half := complete(a)
+	f, e, _ := complete(b)
+	c := big.NewRat(1, 1)
+	if d.Sign() != 0 || e.Sign() != 0 {
+		if d.Sign() == 0 || e.Sign() == 0 {
+			return nil, false
+		}
+		var ok bool
+		if c, ok = ratSqrt(new(big.Rat).Quo(d, e)); !ok {
+			return nil, false
+		}
+	}
+	// the image of e is cf' + β/2
+	v := []*big.Rat{new(big.Rat).Mul(c, f[0]), new(big.Rat).Mul(c, f[1])}
+	v[0].Add(v[0], half)
+	return v, true
+}
+
+// AreIsomorphic looks for an isomorphism from the algebra a to the algebra b,
+// whose "Mul" operations are required and whose first basis units are their
+// identities, as for CheckStructureConstants. It returns the change-of-basis
+// matrix m of the isomorphism, so that
+// 		m.Apply(Mul(x, y)) = Mul(m.Apply(x), m.Apply(y))
+// with the product of a on the left and that of b on the right.
+//
+// First, AreIsomorphic compares invariants: commutativity, associativity,
+// alternativity, the dimension of the center, and the signature and
+// discriminant of the trace form Trace(L(x)L(y)), where L(x) is left
+// multiplication by x. If one of them differs, it returns an
+// *IsomorphismError naming it. Otherwise it chooses generators of a among its
+// basis units, and searches for their images in b: in dimension 2 the image
+// is found exactly by completing the square; in dimension 4 among the values
+// with components in {0, ±1/2, ±1, ±2}; and in dimension 8 among those with
+// components in {0, ±1}. Each choice is checked against the relations of the
+// generators, and the linear map it determines against every product of
+// basis units. In dimensions 2 and 4 the invariants separate every pair of
+// constructs of this package that the search cannot match, so the answer is
+// exact for them; in dimension 8 an *IsomorphismError with no invariant only
+// means that the search failed. For example, InfraComplex and InfraPerplex
+// are not isomorphic, since their trace forms have different signatures, but
+// BiHamilton and BiCockle are.
+func AreIsomorphic(a, b *Impl) (*RatMatrix, error) {
+	if inv := invariantDiff(a, b); inv != "" {
+		return nil, &IsomorphismError{a.Name, b.Name, inv}
+	}
+	n := a.Dim
+	gens, words := generate(a)
+	var cands [][]*big.Rat
+	switch {
+	case n == 2:
+		if v, ok := quadraticImage(a, b); ok {
+			cands = [][]*big.Rat{v}
+		}
+	case n <= 4:
+		cands = candidates(n, []*big.Rat{
+			big.NewRat(0, 1), big.NewRat(1, 1), big.NewRat(-1, 1),
+			big.NewRat(1, 2), big.NewRat(-1, 2), big.NewRat(2, 1), big.NewRat(-2, 1),
+		})
+	default:
+		cands = candidates(n, []*big.Rat{big.NewRat(0, 1), big.NewRat(1, 1), big.NewRat(-1, 1)})
+	}
+	rels := relations(a, gens)
+	// the words before upto[k] involve only the first k+1 generators, and
+	// their images must be independent
+	upto := make([]int, len(gens))
+	for k := range upto {
+		upto[k] = len(words)
+	}
+	for j, w := range words {
+		if w.gen > 0 {
+			upto[w.gen-1] = j
+		}
+	}
+	im := make([][]*big.Rat, len(gens))
+	var search func(k int) *RatMatrix
+	search = func(k int) *RatMatrix {
+		if k == len(gens) {
+			m, _ := extend(a, b, words, im)
+			return m
+		}
+	next:
+		for _, v := range cands {
+			im[k] = v
+			for _, rel := range rels {
+				if rel.g == k && !rel.holds(b, im) {
+					continue next
+				}
+			}
+			v := images(b, words[:upto[k]], im)
+			if _, _, pivots, _ := RatMatrixOf(v).reduce(nil); len(pivots) < len(v) {
+				continue
+			}
+			if m := search(k + 1); m != nil {
+				return m
+			}
+		}
+		return nil
+	}
+	if m := search(0); m != nil {
+		return m, nil
+	}
+	return nil, &IsomorphismError{a.Name, b.Name, ""}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+// quadImpl returns the algebra of dimension 2 with basis 1 and e, where
+// e² = α + βe.
+func quadImpl(alpha, beta int64) *Impl {
+	return &Impl{"Quad", 2, map[string]Op{
+		"Mul": func(x, y []*big.Rat) []*big.Rat {
+			bd := new(big.Rat).Mul(x[1], y[1])
+			l := new(big.Rat).Mul(x[0], y[0])
+			l.Add(l, new(big.Rat).Mul(bd, big.NewRat(alpha, 1)))
+			r := new(big.Rat).Mul(x[0], y[1])
+			r.Add(r, new(big.Rat).Mul(x[1], y[0]))
+			r.Add(r, new(big.Rat).Mul(bd, big.NewRat(beta, 1)))
+			return []*big.Rat{l, r}
+		},
+	}}
+}
+
+// checkIsomorphism returns true if m preserves the products of basis units.
+func checkIsomorphism(a, b *Impl, m *RatMatrix) bool {
+	n := a.Dim
+	if m.Det().Sign() == 0 {
+		return false
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			x, y := unit(n, i), unit(n, j)
+			if !equalRats(m.Apply(a.Ops["Mul"](x, y)), b.Ops["Mul"](m.Apply(x), m.Apply(y))) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestAreIsomorphic(t *testing.T) {
+	for _, x := range algebras {
+		for _, y := range algebras {
+			a, b := x.impl(), y.impl()
+			if a.Dim != b.Dim {
+				continue
+			}
+			want := a.Name == b.Name ||
+				(a.Name == "BiHamilton" && b.Name == "BiCockle") ||
+				(a.Name == "BiCockle" && b.Name == "BiHamilton")
+			m, err := AreIsomorphic(a, b)
+			if want {
+				if err != nil || !checkIsomorphism(a, b, m) {
+					t.Errorf("AreIsomorphic(%s, %s) = %v, %v", a.Name, b.Name, m, err)
+				}
+				continue
+			}
+			var e *IsomorphismError
+			if !errors.As(err, &e) || e.Invariant == "" {
+				t.Errorf("AreIsomorphic(%s, %s) = %v, %v", a.Name, b.Name, m, err)
+			}
+		}
+	}
+}
+
+func TestAreIsomorphicQuadratic(t *testing.T) {
+	for _, test := range []struct {
+		a, b *Impl
+		ok   bool
+	}{
+		{quadImpl(-2, 2), ComplexImpl(), true},
+		{quadImpl(-4, 0), ComplexImpl(), true},
+		{quadImpl(9, 0), PerplexImpl(), true},
+		{quadImpl(-1, 2), InfraImpl(), true},
+		{quadImpl(2, 0), PerplexImpl(), false},
+		{quadImpl(-3, 0), quadImpl(-12, 0), true},
+		{quadImpl(-3, 0), ComplexImpl(), false},
+	} {
+		m, err := AreIsomorphic(test.a, test.b)
+		if test.ok && (err != nil || !checkIsomorphism(test.a, test.b, m)) {
+			t.Errorf("AreIsomorphic(%v, %s) = %v, %v", test.a.Ops["Mul"](unit(2, 1), unit(2, 1)), test.b.Name, m, err)
+		}
+		if !test.ok && err == nil {
+			t.Errorf("AreIsomorphic(%v, %s) = %v", test.a.Ops["Mul"](unit(2, 1), unit(2, 1)), test.b.Name, m)
+		}
+	}
+}
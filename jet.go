@@ -0,0 +1,221 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"strings"
+)
+
+// jetUnits holds the indices, in the order of TriNilplex.Components, of the
+// products α, αΓ, and αΓΛ, whose coefficients are the derivatives of orders
+// one, two, and three.
+var jetUnits = [4]int{0, 1, 3, 7}
+
+// A Jet holds the value and the derivatives of a function at a point, up to
+// order three, for exact automatic differentiation. A jet of order n is a
+// TriNilplex value, the function evaluated at
+// 		a + ε,	ε = α + Γ + Λ
+// with only the first n of the nilpotent units α, Γ, and Λ, so that order one
+// is the Infra value a + α and order two is the Hyper value a + α + Γ. Since
+// ε^k/k! is the sum of the products of k distinct units, the coefficient of
+// α, αΓ, and αΓΛ is the derivative of order one, two, and three. The
+// arithmetic methods apply the chain rule exactly; the operands must have the
+// same order.
+type Jet struct {
+	order int
+	x     TriNilplex
+}
+
+// NewJet returns a pointer to the jet of the identity function x ↦ x at a, of
+// the given order: its value is a, its first derivative is one, and its
+// higher derivatives are zero. If order is not between zero and three, then
+// NewJet panics.
+func NewJet(a *big.Rat, order int) *Jet {
+	z := NewJetConst(a, order)
+	for _, i := range []int{1, 2, 4}[:order] {
+		z.x.Components()[i].SetInt64(1)
+	}
+	return z
+}
+
+// NewJetConst returns a pointer to the jet of the constant function a, of the
+// given order. If order is not between zero and three, then NewJetConst
+// panics.
+func NewJetConst(a *big.Rat, order int) *Jet {
+	if order < 0 || order > 3 {
+		panic("jet order out of range")
+	}
+	z := &Jet{order: order}
+	z.x.Real().Set(a)
+	return z
+}
+
+// Order returns the highest order of the derivatives held by z.
+func (z *Jet) Order() int {
+	return z.order
+}
+
+// Value returns a copy of the value of z.
+func (z *Jet) Value() *big.Rat {
+	return new(big.Rat).Set(z.x.Real())
+}
+
+// Deriv returns a copy of the derivative of order k held by z. Deriv(0) is
+// the value. If k is negative or greater than Order(z), then Deriv panics.
+func (z *Jet) Deriv(k int) *big.Rat {
+	if k < 0 || k > z.order {
+		panic("derivative order out of range")
+	}
+	return new(big.Rat).Set(z.x.Components()[jetUnits[k]])
+}
+
+// Derivs returns copies of the value and the derivatives held by z, in
+// increasing order.
+func (z *Jet) Derivs() []*big.Rat {
+	v := make([]*big.Rat, z.order+1)
+	for k := range v {
+		v[k] = z.Deriv(k)
+	}
+	return v
+}
+
+// String returns the string representation of a Jet value. If z holds the
+// value v and the derivatives d1 and d2, then the string is "⦗v; d1, d2⦘".
+func (z *Jet) String() string {
+	a := make([]string, z.order)
+	for k := range a {
+		a[k] = z.Deriv(k + 1).RatString()
+	}
+	s := z.Value().RatString()
+	if z.order > 0 {
+		s += "; " + strings.Join(a, ", ")
+	}
+	br := brackets()
+	return br[0] + s + br[1]
+}
+
+// jetOrder returns the order of x and y. If their orders differ, then
+// jetOrder panics.
+func jetOrder(x, y *Jet) int {
+	if x.order != y.order {
+		panic("jets of different orders")
+	}
+	return x.order
+}
+
+// Equals returns true if y and z hold the same value and derivatives. If
+// their orders differ, then Equals panics.
+func (z *Jet) Equals(y *Jet) bool {
+	jetOrder(z, y)
+	return z.x.Equals(&y.x)
+}
+
+// Set sets z equal to y, and returns z.
+func (z *Jet) Set(y *Jet) *Jet {
+	z.order = y.order
+	z.x.Set(&y.x)
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *Jet) Scal(y *Jet, a *big.Rat) *Jet {
+	z.order = y.order
+	z.x.Scal(&y.x, a)
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *Jet) Neg(y *Jet) *Jet {
+	z.order = y.order
+	z.x.Neg(&y.x)
+	return z
+}
+
+// Add sets z equal to x+y, and returns z. If the orders of x and y differ,
+// then Add panics.
+func (z *Jet) Add(x, y *Jet) *Jet {
+	z.order = jetOrder(x, y)
+	z.x.Add(&x.x, &y.x)
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z. If the orders of x and y differ,
+// then Sub panics.
+func (z *Jet) Sub(x, y *Jet) *Jet {
+	z.order = jetOrder(x, y)
+	z.x.Sub(&x.x, &y.x)
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z. The derivatives
+// follow the Leibniz rule. If the orders of x and y differ, then Mul panics.
+func (z *Jet) Mul(x, y *Jet) *Jet {
+	z.order = jetOrder(x, y)
+	z.x.Mul(&x.x, &y.x)
+	return z
+}
+
+// Inv sets z equal to the reciprocal of y, and returns z. If the value of y is
+// zero, then Inv panics.
+func (z *Jet) Inv(y *Jet) *Jet {
+	if y.x.Real().Sign() == 0 {
+		panic(denominatorError("reciprocal of zero value"))
+	}
+	z.order = y.order
+	z.x.Inv(&y.x)
+	return z
+}
+
+// Quo sets z equal to the quotient of x and y, and returns z. If the value of
+// y is zero, or if the orders of x and y differ, then Quo panics.
+func (z *Jet) Quo(x, y *Jet) *Jet {
+	jetOrder(x, y)
+	inv := new(Jet).Inv(y)
+	return z.Mul(x, inv)
+}
+
+// EvalLaurent sets z equal to the Laurent polynomial p evaluated at x, and
+// returns z. If p has terms of negative degree and the value of x is zero,
+// then EvalLaurent panics.
+func (z *Jet) EvalLaurent(p Laurent, x *Jet) *Jet {
+	neg, nonneg := p.Degrees()
+	sum := NewJetConst(new(big.Rat), x.order)
+	if len(nonneg) > 0 {
+		pow := NewJetConst(big.NewRat(1, 1), x.order)
+		for d := int64(0); d <= nonneg[len(nonneg)-1]; d++ {
+			if c, ok := p[d]; ok {
+				sum.Add(sum, new(Jet).Scal(pow, c))
+			}
+			pow.Mul(pow, x)
+		}
+	}
+	if len(neg) > 0 {
+		inv := new(Jet).Inv(x)
+		pow := new(Jet).Set(inv)
+		for d := int64(-1); d >= neg[len(neg)-1]; d-- {
+			if c, ok := p[d]; ok {
+				sum.Add(sum, new(Jet).Scal(pow, c))
+			}
+			pow.Mul(pow, inv)
+		}
+	}
+	return z.Set(sum)
+}
+
+// EvalRational sets z equal to the rational function p/q evaluated at x, and
+// returns z. If the value of q at x is zero, then EvalRational panics.
+func (z *Jet) EvalRational(p, q Laurent, x *Jet) *Jet {
+	num := new(Jet).EvalLaurent(p, x)
+	den := new(Jet).EvalLaurent(q, x)
+	return z.Quo(num, den)
+}
+
+// Derivatives returns the value and the first order derivatives of the
+// rational function p/q at a, as computed by EvalRational. If the value of q
+// at a is zero, or if order is not between zero and three, then Derivatives
+// panics.
+func Derivatives(p, q Laurent, a *big.Rat, order int) []*big.Rat {
+	return new(Jet).EvalRational(p, q, NewJet(a, order)).Derivs()
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestJetPolynomial(t *testing.T) {
+	// f(x) = x³ - 2x + 5 at x = 3/2
+	p := Laurent{3: big.NewRat(1, 1), 1: big.NewRat(-2, 1), 0: big.NewRat(5, 1)}
+	got := Derivatives(p, Laurent{0: big.NewRat(1, 1)}, big.NewRat(3, 2), 3)
+	want := rats(big.NewRat(43, 8), big.NewRat(19, 4), big.NewRat(9, 1), big.NewRat(6, 1))
+	if !equalRats(got, want) {
+		t.Errorf("Derivatives = %v, want %v", got, want)
+	}
+}
+
+func TestJetRational(t *testing.T) {
+	// f(x) = 1/x = x⁻¹ at x = 2: 1/2, -1/4, 2/8, -6/16
+	want := rats(big.NewRat(1, 2), big.NewRat(-1, 4), big.NewRat(1, 4), big.NewRat(-3, 8))
+	one := Laurent{0: big.NewRat(1, 1)}
+	if got := Derivatives(one, Laurent{1: big.NewRat(1, 1)}, big.NewRat(2, 1), 3); !equalRats(got, want) {
+		t.Errorf("Derivatives of 1/x = %v, want %v", got, want)
+	}
+	if got := Derivatives(Laurent{-1: big.NewRat(1, 1)}, one, big.NewRat(2, 1), 3); !equalRats(got, want) {
+		t.Errorf("Derivatives of x⁻¹ = %v, want %v", got, want)
+	}
+	if s := NewJet(big.NewRat(2, 1), 2).String(); s != "⦗2; 1, 0⦘" {
+		t.Errorf("String = %q", s)
+	}
+}
+
+func TestJetProductRule(t *testing.T) {
+	f := func(a, b, c int64) bool {
+		// t.Logf("a = %v, b = %v, c = %v", a, b, c)
+		x := NewJet(big.NewRat(a, 1), 3)
+		// g = x + b, h = x² + c
+		g := new(Jet).Add(x, NewJetConst(big.NewRat(b, 1), 3))
+		h := new(Jet).Add(new(Jet).Mul(x, x), NewJetConst(big.NewRat(c, 1), 3))
+		gh := new(Jet).Mul(g, h)
+		// (gh)'' = g''h + 2g'h' + gh''
+		l := gh.Deriv(2)
+		r := new(big.Rat).Mul(big.NewRat(2, 1), new(big.Rat).Mul(g.Deriv(1), h.Deriv(1)))
+		r.Add(r, new(big.Rat).Mul(g.Value(), h.Deriv(2)))
+		return l.Cmp(r) == 0 && gh.Deriv(3).Cmp(big.NewRat(6, 1)) == 0
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
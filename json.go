@@ -0,0 +1,302 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+)
+
+// The JSON form of a value is an array with the RatString of each rational
+// component, in the order of Rats, such as
+// 		["3/4", "-1", "0", "5/2"]
+// for the Hamilton value 3/4-i+5/2k. Any string accepted by big.Rat's
+// SetString is accepted when decoding, so integers may also be written "2/1".
+
+// marshalRats returns the JSON form of the rationals in v.
+func marshalRats(v []*big.Rat) ([]byte, error) {
+	s := make([]string, len(v))
+	for i, x := range v {
+		s[i] = x.RatString()
+	}
+	return json.Marshal(s)
+}
+
+// unmarshalRats sets the rationals in v from their JSON form in data. A JSON
+// null leaves v unchanged.
+func unmarshalRats(typ string, data []byte, v []*big.Rat) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s []string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("rational: decoding %s: %v", typ, err)
+	}
+	if len(s) != len(v) {
+		return fmt.Errorf("rational: decoding %s: %d components, want %d", typ, len(s), len(v))
+	}
+	w := make([]*big.Rat, len(s))
+	for i := range s {
+		x, ok := new(big.Rat).SetString(s[i])
+		if !ok {
+			return fmt.Errorf("rational: decoding %s: invalid component %q", typ, s[i])
+		}
+		w[i] = x
+	}
+	for i := range v {
+		v[i].Set(w[i])
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z *Complex) MarshalJSON() ([]byte, error) {
+	return marshalRats(z.Components())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *Complex) UnmarshalJSON(data []byte) error {
+	return unmarshalRats("Complex", data, z.Components())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z *Infra) MarshalJSON() ([]byte, error) {
+	return marshalRats(z.Components())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *Infra) UnmarshalJSON(data []byte) error {
+	return unmarshalRats("Infra", data, z.Components())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z *Perplex) MarshalJSON() ([]byte, error) {
+	return marshalRats(z.Components())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *Perplex) UnmarshalJSON(data []byte) error {
+	return unmarshalRats("Perplex", data, z.Components())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z *BiComplex) MarshalJSON() ([]byte, error) {
+	return marshalRats(z.Components())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *BiComplex) UnmarshalJSON(data []byte) error {
+	return unmarshalRats("BiComplex", data, z.Components())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z *BiPerplex) MarshalJSON() ([]byte, error) {
+	return marshalRats(z.Components())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *BiPerplex) UnmarshalJSON(data []byte) error {
+	return unmarshalRats("BiPerplex", data, z.Components())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z *Cockle) MarshalJSON() ([]byte, error) {
+	return marshalRats(z.Components())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *Cockle) UnmarshalJSON(data []byte) error {
+	return unmarshalRats("Cockle", data, z.Components())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z *DualComplex) MarshalJSON() ([]byte, error) {
+	return marshalRats(z.Components())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *DualComplex) UnmarshalJSON(data []byte) error {
+	return unmarshalRats("DualComplex", data, z.Components())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z *DualPerplex) MarshalJSON() ([]byte, error) {
+	return marshalRats(z.Components())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *DualPerplex) UnmarshalJSON(data []byte) error {
+	return unmarshalRats("DualPerplex", data, z.Components())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z *Hamilton) MarshalJSON() ([]byte, error) {
+	return marshalRats(z.Components())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *Hamilton) UnmarshalJSON(data []byte) error {
+	return unmarshalRats("Hamilton", data, z.Components())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z *Hyper) MarshalJSON() ([]byte, error) {
+	return marshalRats(z.Components())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *Hyper) UnmarshalJSON(data []byte) error {
+	return unmarshalRats("Hyper", data, z.Components())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z *InfraComplex) MarshalJSON() ([]byte, error) {
+	return marshalRats(z.Components())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *InfraComplex) UnmarshalJSON(data []byte) error {
+	return unmarshalRats("InfraComplex", data, z.Components())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z *InfraPerplex) MarshalJSON() ([]byte, error) {
+	return marshalRats(z.Components())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *InfraPerplex) UnmarshalJSON(data []byte) error {
+	return unmarshalRats("InfraPerplex", data, z.Components())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z *Supra) MarshalJSON() ([]byte, error) {
+	return marshalRats(z.Components())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *Supra) UnmarshalJSON(data []byte) error {
+	return unmarshalRats("Supra", data, z.Components())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z *BiCockle) MarshalJSON() ([]byte, error) {
+	return marshalRats(z.Components())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *BiCockle) UnmarshalJSON(data []byte) error {
+	return unmarshalRats("BiCockle", data, z.Components())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z *BiHamilton) MarshalJSON() ([]byte, error) {
+	return marshalRats(z.Components())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *BiHamilton) UnmarshalJSON(data []byte) error {
+	return unmarshalRats("BiHamilton", data, z.Components())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z *Cayley) MarshalJSON() ([]byte, error) {
+	return marshalRats(z.Components())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *Cayley) UnmarshalJSON(data []byte) error {
+	return unmarshalRats("Cayley", data, z.Components())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z *InfraCockle) MarshalJSON() ([]byte, error) {
+	return marshalRats(z.Components())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *InfraCockle) UnmarshalJSON(data []byte) error {
+	return unmarshalRats("InfraCockle", data, z.Components())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z *InfraHamilton) MarshalJSON() ([]byte, error) {
+	return marshalRats(z.Components())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *InfraHamilton) UnmarshalJSON(data []byte) error {
+	return unmarshalRats("InfraHamilton", data, z.Components())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z *SupraComplex) MarshalJSON() ([]byte, error) {
+	return marshalRats(z.Components())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *SupraComplex) UnmarshalJSON(data []byte) error {
+	return unmarshalRats("SupraComplex", data, z.Components())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z *SupraPerplex) MarshalJSON() ([]byte, error) {
+	return marshalRats(z.Components())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *SupraPerplex) UnmarshalJSON(data []byte) error {
+	return unmarshalRats("SupraPerplex", data, z.Components())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z *TriComplex) MarshalJSON() ([]byte, error) {
+	return marshalRats(z.Components())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *TriComplex) UnmarshalJSON(data []byte) error {
+	return unmarshalRats("TriComplex", data, z.Components())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z *TriNilplex) MarshalJSON() ([]byte, error) {
+	return marshalRats(z.Components())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *TriNilplex) UnmarshalJSON(data []byte) error {
+	return unmarshalRats("TriNilplex", data, z.Components())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z *TriPerplex) MarshalJSON() ([]byte, error) {
+	return marshalRats(z.Components())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *TriPerplex) UnmarshalJSON(data []byte) error {
+	return unmarshalRats("TriPerplex", data, z.Components())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z *Ultra) MarshalJSON() ([]byte, error) {
+	return marshalRats(z.Components())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *Ultra) UnmarshalJSON(data []byte) error {
+	return unmarshalRats("Ultra", data, z.Components())
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z *Zorn) MarshalJSON() ([]byte, error) {
+	return marshalRats(z.Components())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *Zorn) UnmarshalJSON(data []byte) error {
+	return unmarshalRats("Zorn", data, z.Components())
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	tests := []interface{}{
+		func(x *Complex) bool {
+			// t.Logf("x = %v", x)
+			data, err := json.Marshal(x)
+			y := new(Complex)
+			return err == nil && json.Unmarshal(data, y) == nil && y.Equals(x)
+		},
+		func(x *Infra) bool {
+			// t.Logf("x = %v", x)
+			data, err := json.Marshal(x)
+			y := new(Infra)
+			return err == nil && json.Unmarshal(data, y) == nil && y.Equals(x)
+		},
+		func(x *Perplex) bool {
+			// t.Logf("x = %v", x)
+			data, err := json.Marshal(x)
+			y := new(Perplex)
+			return err == nil && json.Unmarshal(data, y) == nil && y.Equals(x)
+		},
+		func(x *BiComplex) bool {
+			// t.Logf("x = %v", x)
+			data, err := json.Marshal(x)
+			y := new(BiComplex)
+			return err == nil && json.Unmarshal(data, y) == nil && y.Equals(x)
+		},
+		func(x *BiPerplex) bool {
+			// t.Logf("x = %v", x)
+			data, err := json.Marshal(x)
+			y := new(BiPerplex)
+			return err == nil && json.Unmarshal(data, y) == nil && y.Equals(x)
+		},
+		func(x *Cockle) bool {
+			// t.Logf("x = %v", x)
+			data, err := json.Marshal(x)
+			y := new(Cockle)
+			return err == nil && json.Unmarshal(data, y) == nil && y.Equals(x)
+		},
+		func(x *DualComplex) bool {
+			// t.Logf("x = %v", x)
+			data, err := json.Marshal(x)
+			y := new(DualComplex)
+			return err == nil && json.Unmarshal(data, y) == nil && y.Equals(x)
+		},
+		func(x *DualPerplex) bool {
+			// t.Logf("x = %v", x)
+			data, err := json.Marshal(x)
+			y := new(DualPerplex)
+			return err == nil && json.Unmarshal(data, y) == nil && y.Equals(x)
+		},
+		func(x *Hamilton) bool {
+			// t.Logf("x = %v", x)
+			data, err := json.Marshal(x)
+			y := new(Hamilton)
+			return err == nil && json.Unmarshal(data, y) == nil && y.Equals(x)
+		},
+		func(x *Hyper) bool {
+			// t.Logf("x = %v", x)
+			data, err := json.Marshal(x)
+			y := new(Hyper)
+			return err == nil && json.Unmarshal(data, y) == nil && y.Equals(x)
+		},
+		func(x *InfraComplex) bool {
+			// t.Logf("x = %v", x)
+			data, err := json.Marshal(x)
+			y := new(InfraComplex)
+			return err == nil && json.Unmarshal(data, y) == nil && y.Equals(x)
+		},
+		func(x *InfraPerplex) bool {
+			// t.Logf("x = %v", x)
+			data, err := json.Marshal(x)
+			y := new(InfraPerplex)
+			return err == nil && json.Unmarshal(data, y) == nil && y.Equals(x)
+		},
+		func(x *Supra) bool {
+			// t.Logf("x = %v", x)
+			data, err := json.Marshal(x)
+			y := new(Supra)
+			return err == nil && json.Unmarshal(data, y) == nil && y.Equals(x)
+		},
+		func(x *BiCockle) bool {
+			// t.Logf("x = %v", x)
+			data, err := json.Marshal(x)
+			y := new(BiCockle)
+			return err == nil && json.Unmarshal(data, y) == nil && y.Equals(x)
+		},
+		func(x *BiHamilton) bool {
+			// t.Logf("x = %v", x)
+			data, err := json.Marshal(x)
+			y := new(BiHamilton)
+			return err == nil && json.Unmarshal(data, y) == nil && y.Equals(x)
+		},
+		func(x *Cayley) bool {
+			// t.Logf("x = %v", x)
+			data, err := json.Marshal(x)
+			y := new(Cayley)
+			return err == nil && json.Unmarshal(data, y) == nil && y.Equals(x)
+		},
+		func(x *InfraCockle) bool {
+			// t.Logf("x = %v", x)
+			data, err := json.Marshal(x)
+			y := new(InfraCockle)
+			return err == nil && json.Unmarshal(data, y) == nil && y.Equals(x)
+		},
+		func(x *InfraHamilton) bool {
+			// t.Logf("x = %v", x)
+			data, err := json.Marshal(x)
+			y := new(InfraHamilton)
+			return err == nil && json.Unmarshal(data, y) == nil && y.Equals(x)
+		},
+		func(x *SupraComplex) bool {
+			// t.Logf("x = %v", x)
+			data, err := json.Marshal(x)
+			y := new(SupraComplex)
+			return err == nil && json.Unmarshal(data, y) == nil && y.Equals(x)
+		},
+		func(x *SupraPerplex) bool {
+			// t.Logf("x = %v", x)
+			data, err := json.Marshal(x)
+			y := new(SupraPerplex)
+			return err == nil && json.Unmarshal(data, y) == nil && y.Equals(x)
+		},
+		func(x *TriComplex) bool {
+			// t.Logf("x = %v", x)
+			data, err := json.Marshal(x)
+			y := new(TriComplex)
+			return err == nil && json.Unmarshal(data, y) == nil && y.Equals(x)
+		},
+		func(x *TriNilplex) bool {
+			// t.Logf("x = %v", x)
+			data, err := json.Marshal(x)
+			y := new(TriNilplex)
+			return err == nil && json.Unmarshal(data, y) == nil && y.Equals(x)
+		},
+		func(x *TriPerplex) bool {
+			// t.Logf("x = %v", x)
+			data, err := json.Marshal(x)
+			y := new(TriPerplex)
+			return err == nil && json.Unmarshal(data, y) == nil && y.Equals(x)
+		},
+		func(x *Ultra) bool {
+			// t.Logf("x = %v", x)
+			data, err := json.Marshal(x)
+			y := new(Ultra)
+			return err == nil && json.Unmarshal(data, y) == nil && y.Equals(x)
+		},
+		func(x *Zorn) bool {
+			// t.Logf("x = %v", x)
+			data, err := json.Marshal(x)
+			y := new(Zorn)
+			return err == nil && json.Unmarshal(data, y) == nil && y.Equals(x)
+		},
+	}
+	for _, f := range tests {
+		if err := quick.Check(f, nil); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestJSONHamilton(t *testing.T) {
+	z := NewHamilton(big.NewRat(3, 4), big.NewRat(-1, 1), new(big.Rat), big.NewRat(5, 2))
+	data, err := json.Marshal(z)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `["3/4","-1","0","5/2"]`; string(data) != want {
+		t.Errorf("json.Marshal = %s, want %s", data, want)
+	}
+	// nested in a struct, with a null field
+	var v struct {
+		A, B *Hamilton
+	}
+	if err := json.Unmarshal([]byte(`{"A": ["6/8", "-2/2", "0", "2.5"], "B": null}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if !v.A.Equals(z) || v.B != nil {
+		t.Errorf("json.Unmarshal = %v, %v, want %v, nil", v.A, v.B, z)
+	}
+	for _, s := range []string{`["1", "2", "3"]`, `["1", "2", "3", "x"]`, `{"a": 1}`, `[1, 2, 3, 4]`} {
+		if err := json.Unmarshal([]byte(s), new(Hamilton)); err == nil {
+			t.Errorf("json.Unmarshal(%s) succeeded", s)
+		}
+	}
+}
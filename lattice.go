@@ -0,0 +1,513 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"math/rand"
+	"reflect"
+)
+
+// A ComplexInt represents a Gaussian integer, a complex number with integer
+// components. The arithmetic is exact integer arithmetic; use Rat to divide.
+type ComplexInt struct {
+	l, r big.Int
+}
+
+// NewComplexInt returns a pointer to the ComplexInt value a+bi.
+func NewComplexInt(a, b *big.Int) *ComplexInt {
+	z := new(ComplexInt)
+	z.l.Set(a)
+	z.r.Set(b)
+	return z
+}
+
+// Components returns the two integer components of z as a slice. The results
+// alias z.
+func (z *ComplexInt) Components() []*big.Int {
+	return []*big.Int{&z.l, &z.r}
+}
+
+// String returns the string version of a ComplexInt value, in the format of
+// Complex.
+func (z *ComplexInt) String() string {
+	return z.Rat().String()
+}
+
+// Equals returns true if y and z are equal.
+func (z *ComplexInt) Equals(y *ComplexInt) bool {
+	return z.l.Cmp(&y.l) == 0 && z.r.Cmp(&y.r) == 0
+}
+
+// Set sets z equal to y, and returns z.
+func (z *ComplexInt) Set(y *ComplexInt) *ComplexInt {
+	z.l.Set(&y.l)
+	z.r.Set(&y.r)
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *ComplexInt) Neg(y *ComplexInt) *ComplexInt {
+	z.l.Neg(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Conj sets z equal to the conjugate of y, and returns z.
+func (z *ComplexInt) Conj(y *ComplexInt) *ComplexInt {
+	z.l.Set(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *ComplexInt) Add(x, y *ComplexInt) *ComplexInt {
+	z.l.Add(&x.l, &y.l)
+	z.r.Add(&x.r, &y.r)
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *ComplexInt) Sub(x, y *ComplexInt) *ComplexInt {
+	z.l.Sub(&x.l, &y.l)
+	z.r.Sub(&x.r, &y.r)
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z. The product
+// follows Complex.Mul.
+func (z *ComplexInt) Mul(x, y *ComplexInt) *ComplexInt {
+	l, temp := new(big.Int), new(big.Int)
+	l.Sub(l.Mul(&x.l, &y.l), temp.Mul(&x.r, &y.r))
+	r := new(big.Int).Mul(&x.l, &y.r)
+	r.Add(r, temp.Mul(&x.r, &y.l))
+	z.l.Set(l)
+	z.r.Set(r)
+	return z
+}
+
+// Quad returns the quadrance of z. If z = a+bi, then the quadrance is
+// 		a² + b²
+// This is always non-negative.
+func (z *ComplexInt) Quad() *big.Int {
+	q := new(big.Int).Mul(&z.l, &z.l)
+	return q.Add(q, new(big.Int).Mul(&z.r, &z.r))
+}
+
+// IsUnit returns true if z is one of the four units ±1 and ±i.
+func (z *ComplexInt) IsUnit() bool {
+	return z.Quad().Cmp(big.NewInt(1)) == 0
+}
+
+// Rat returns z as a Complex value.
+func (z *ComplexInt) Rat() *Complex {
+	return new(Complex).Gauss(&z.l, &z.r)
+}
+
+// SetRat sets z equal to y, and returns true. If y is not a Gaussian integer,
+// then z is left unchanged and SetRat returns false.
+func (z *ComplexInt) SetRat(y *Complex) bool {
+	if !y.l.IsInt() || !y.r.IsInt() {
+		return false
+	}
+	z.l.Set(y.l.Num())
+	z.r.Set(y.r.Num())
+	return true
+}
+
+// Generate returns a random ComplexInt value for quick.Check testing.
+func (z *ComplexInt) Generate(rand *rand.Rand, size int) reflect.Value {
+	randomComplexInt := &ComplexInt{
+		*big.NewInt(rand.Int63n(201) - 100),
+		*big.NewInt(rand.Int63n(201) - 100),
+	}
+	return reflect.ValueOf(randomComplexInt)
+}
+
+// A HamiltonInt represents a Lipschitz integer, a Hamilton quaternion with
+// integer components.
+type HamiltonInt struct {
+	l, r ComplexInt
+}
+
+// NewHamiltonInt returns a pointer to the HamiltonInt value a+bi+cj+dk.
+func NewHamiltonInt(a, b, c, d *big.Int) *HamiltonInt {
+	z := new(HamiltonInt)
+	z.l.l.Set(a)
+	z.l.r.Set(b)
+	z.r.l.Set(c)
+	z.r.r.Set(d)
+	return z
+}
+
+// Components returns the four integer components of z as a slice. The
+// results alias z.
+func (z *HamiltonInt) Components() []*big.Int {
+	return append(z.l.Components(), z.r.Components()...)
+}
+
+// String returns the string version of a HamiltonInt value, in the format of
+// Hamilton.
+func (z *HamiltonInt) String() string {
+	return z.Rat().String()
+}
+
+// Equals returns true if y and z are equal.
+func (z *HamiltonInt) Equals(y *HamiltonInt) bool {
+	return z.l.Equals(&y.l) && z.r.Equals(&y.r)
+}
+
+// Set sets z equal to y, and returns z.
+func (z *HamiltonInt) Set(y *HamiltonInt) *HamiltonInt {
+	z.l.Set(&y.l)
+	z.r.Set(&y.r)
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *HamiltonInt) Neg(y *HamiltonInt) *HamiltonInt {
+	z.l.Neg(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Conj sets z equal to the conjugate of y, and returns z.
+func (z *HamiltonInt) Conj(y *HamiltonInt) *HamiltonInt {
+	z.l.Conj(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *HamiltonInt) Add(x, y *HamiltonInt) *HamiltonInt {
+	z.l.Add(&x.l, &y.l)
+	z.r.Add(&x.r, &y.r)
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *HamiltonInt) Sub(x, y *HamiltonInt) *HamiltonInt {
+	z.l.Sub(&x.l, &y.l)
+	z.r.Sub(&x.r, &y.r)
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z. The product
+// follows Hamilton.Mul.
+func (z *HamiltonInt) Mul(x, y *HamiltonInt) *HamiltonInt {
+	a := new(ComplexInt).Set(&x.l)
+	b := new(ComplexInt).Set(&x.r)
+	c := new(ComplexInt).Set(&y.l)
+	d := new(ComplexInt).Set(&y.r)
+	temp := new(ComplexInt)
+	z.l.Sub(
+		z.l.Mul(a, c),
+		temp.Mul(temp.Conj(d), b),
+	)
+	z.r.Add(
+		z.r.Mul(d, a),
+		temp.Mul(b, temp.Conj(c)),
+	)
+	return z
+}
+
+// Quad returns the quadrance of z. If z = a+bi+cj+dk, then the quadrance is
+// 		a² + b² + c² + d²
+// This is always non-negative.
+func (z *HamiltonInt) Quad() *big.Int {
+	q := z.l.Quad()
+	return q.Add(q, z.r.Quad())
+}
+
+// IsUnit returns true if z is one of the eight units ±1, ±i, ±j, and ±k.
+func (z *HamiltonInt) IsUnit() bool {
+	return z.Quad().Cmp(big.NewInt(1)) == 0
+}
+
+// Rat returns z as a Hamilton value.
+func (z *HamiltonInt) Rat() *Hamilton {
+	return new(Hamilton).Lipschitz(&z.l.l, &z.l.r, &z.r.l, &z.r.r)
+}
+
+// SetRat sets z equal to y, and returns true. If y is not a Lipschitz
+// integer, then z is left unchanged and SetRat returns false.
+func (z *HamiltonInt) SetRat(y *Hamilton) bool {
+	l, r := new(ComplexInt), new(ComplexInt)
+	if !l.SetRat(&y.l) || !r.SetRat(&y.r) {
+		return false
+	}
+	z.l.Set(l)
+	z.r.Set(r)
+	return true
+}
+
+// Generate returns a random HamiltonInt value for quick.Check testing.
+func (z *HamiltonInt) Generate(rand *rand.Rand, size int) reflect.Value {
+	randomHamiltonInt := &HamiltonInt{
+		*new(ComplexInt).Generate(rand, size).Interface().(*ComplexInt),
+		*new(ComplexInt).Generate(rand, size).Interface().(*ComplexInt),
+	}
+	return reflect.ValueOf(randomHamiltonInt)
+}
+
+// A HurwitzInt represents a Hurwitz integer, a Hamilton quaternion whose
+// components are either all integers or all halves of odd integers. The
+// Hurwitz integers are closed under multiplication, and unlike the Lipschitz
+// integers they have a Euclidean division. A HurwitzInt stores twice its
+// value, whose components are integers of the same parity.
+type HurwitzInt struct {
+	d HamiltonInt
+}
+
+// NewHurwitzInt returns a pointer to the HurwitzInt value
+// (a+½)+(b+½)i+(c+½)j+(d+½)k, as set by Hamilton.Hurwitz.
+func NewHurwitzInt(a, b, c, d *big.Int) *HurwitzInt {
+	z := new(HurwitzInt)
+	for i, x := range []*big.Int{a, b, c, d} {
+		v := z.d.Components()[i]
+		v.Add(v.Lsh(x, 1), big.NewInt(1))
+	}
+	return z
+}
+
+// SetHamiltonInt sets z equal to the Lipschitz integer y, and returns z.
+func (z *HurwitzInt) SetHamiltonInt(y *HamiltonInt) *HurwitzInt {
+	for i, x := range y.Components() {
+		z.d.Components()[i].Lsh(x, 1)
+	}
+	return z
+}
+
+// IsLipschitz returns true if the components of z are integers.
+func (z *HurwitzInt) IsLipschitz() bool {
+	return z.d.l.l.Bit(0) == 0
+}
+
+// String returns the string version of a HurwitzInt value, in the format of
+// Hamilton.
+func (z *HurwitzInt) String() string {
+	return z.Rat().String()
+}
+
+// Equals returns true if y and z are equal.
+func (z *HurwitzInt) Equals(y *HurwitzInt) bool {
+	return z.d.Equals(&y.d)
+}
+
+// Set sets z equal to y, and returns z.
+func (z *HurwitzInt) Set(y *HurwitzInt) *HurwitzInt {
+	z.d.Set(&y.d)
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *HurwitzInt) Neg(y *HurwitzInt) *HurwitzInt {
+	z.d.Neg(&y.d)
+	return z
+}
+
+// Conj sets z equal to the conjugate of y, and returns z.
+func (z *HurwitzInt) Conj(y *HurwitzInt) *HurwitzInt {
+	z.d.Conj(&y.d)
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *HurwitzInt) Add(x, y *HurwitzInt) *HurwitzInt {
+	z.d.Add(&x.d, &y.d)
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *HurwitzInt) Sub(x, y *HurwitzInt) *HurwitzInt {
+	z.d.Sub(&x.d, &y.d)
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z. The product
+// follows Hamilton.Mul.
+func (z *HurwitzInt) Mul(x, y *HurwitzInt) *HurwitzInt {
+	// (2x)(2y) = 2(2xy), and the components of 2xy are integers.
+	z.d.Mul(&x.d, &y.d)
+	two := big.NewInt(2)
+	for _, v := range z.d.Components() {
+		v.Quo(v, two)
+	}
+	return z
+}
+
+// Quad returns the quadrance of z, which is an integer. If
+// z = a+bi+cj+dk, then the quadrance is
+// 		a² + b² + c² + d²
+// This is always non-negative.
+func (z *HurwitzInt) Quad() *big.Int {
+	q := z.d.Quad()
+	return q.Rsh(q, 2)
+}
+
+// IsUnit returns true if z is one of the 24 Hurwitz units: ±1, ±i, ±j, ±k,
+// and (±1±i±j±k)/2.
+func (z *HurwitzInt) IsUnit() bool {
+	return z.Quad().Cmp(big.NewInt(1)) == 0
+}
+
+// Rat returns z as a Hamilton value.
+func (z *HurwitzInt) Rat() *Hamilton {
+	return new(Hamilton).Scal(z.d.Rat(), big.NewRat(1, 2))
+}
+
+// SetRat sets z equal to y, and returns true. If y is not a Hurwitz integer,
+// then z is left unchanged and SetRat returns false.
+func (z *HurwitzInt) SetRat(y *Hamilton) bool {
+	d := new(HamiltonInt)
+	if !d.SetRat(new(Hamilton).Scal(y, big.NewRat(2, 1))) {
+		return false
+	}
+	p := d.l.l.Bit(0)
+	for _, v := range d.Components() {
+		if v.Bit(0) != p {
+			return false
+		}
+	}
+	z.d.Set(d)
+	return true
+}
+
+// Generate returns a random HurwitzInt value for quick.Check testing.
+func (z *HurwitzInt) Generate(rand *rand.Rand, size int) reflect.Value {
+	x := new(HamiltonInt).Generate(rand, size).Interface().(*HamiltonInt)
+	if rand.Intn(2) == 0 {
+		return reflect.ValueOf(new(HurwitzInt).SetHamiltonInt(x))
+	}
+	v := x.Components()
+	return reflect.ValueOf(NewHurwitzInt(v[0], v[1], v[2], v[3]))
+}
+
+// A CayleyInt represents a Gravesian integer, a Cayley octonion with integer
+// components.
+type CayleyInt struct {
+	l, r HamiltonInt
+}
+
+// NewCayleyInt returns a pointer to the CayleyInt value
+// a+bi+cj+dk+em+fn+gp+hq.
+func NewCayleyInt(a, b, c, d, e, f, g, h *big.Int) *CayleyInt {
+	z := new(CayleyInt)
+	z.l.Set(NewHamiltonInt(a, b, c, d))
+	z.r.Set(NewHamiltonInt(e, f, g, h))
+	return z
+}
+
+// Components returns the eight integer components of z as a slice. The
+// results alias z.
+func (z *CayleyInt) Components() []*big.Int {
+	return append(z.l.Components(), z.r.Components()...)
+}
+
+// String returns the string version of a CayleyInt value, in the format of
+// Cayley.
+func (z *CayleyInt) String() string {
+	return z.Rat().String()
+}
+
+// Equals returns true if y and z are equal.
+func (z *CayleyInt) Equals(y *CayleyInt) bool {
+	return z.l.Equals(&y.l) && z.r.Equals(&y.r)
+}
+
+// Set sets z equal to y, and returns z.
+func (z *CayleyInt) Set(y *CayleyInt) *CayleyInt {
+	z.l.Set(&y.l)
+	z.r.Set(&y.r)
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *CayleyInt) Neg(y *CayleyInt) *CayleyInt {
+	z.l.Neg(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Conj sets z equal to the conjugate of y, and returns z.
+func (z *CayleyInt) Conj(y *CayleyInt) *CayleyInt {
+	z.l.Conj(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *CayleyInt) Add(x, y *CayleyInt) *CayleyInt {
+	z.l.Add(&x.l, &y.l)
+	z.r.Add(&x.r, &y.r)
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *CayleyInt) Sub(x, y *CayleyInt) *CayleyInt {
+	z.l.Sub(&x.l, &y.l)
+	z.r.Sub(&x.r, &y.r)
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z. The product
+// follows Cayley.Mul.
+func (z *CayleyInt) Mul(x, y *CayleyInt) *CayleyInt {
+	a := new(HamiltonInt).Set(&x.l)
+	b := new(HamiltonInt).Set(&x.r)
+	c := new(HamiltonInt).Set(&y.l)
+	d := new(HamiltonInt).Set(&y.r)
+	temp := new(HamiltonInt)
+	z.l.Sub(
+		z.l.Mul(a, c),
+		temp.Mul(temp.Conj(d), b),
+	)
+	z.r.Add(
+		z.r.Mul(d, a),
+		temp.Mul(b, temp.Conj(c)),
+	)
+	return z
+}
+
+// Quad returns the quadrance of z. If z = a+bi+cj+dk+em+fn+gp+hq, then the
+// quadrance is
+// 		a² + b² + c² + d² + e² + f² + g² + h²
+// This is always non-negative.
+func (z *CayleyInt) Quad() *big.Int {
+	q := z.l.Quad()
+	return q.Add(q, z.r.Quad())
+}
+
+// IsUnit returns true if z is one of the sixteen units ±1, ±i, ±j, ±k, ±m,
+// ±n, ±p, and ±q.
+func (z *CayleyInt) IsUnit() bool {
+	return z.Quad().Cmp(big.NewInt(1)) == 0
+}
+
+// Rat returns z as a Cayley value.
+func (z *CayleyInt) Rat() *Cayley {
+	v := z.Components()
+	return new(Cayley).Graves(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+}
+
+// SetRat sets z equal to y, and returns true. If y is not a Gravesian
+// integer, then z is left unchanged and SetRat returns false.
+func (z *CayleyInt) SetRat(y *Cayley) bool {
+	l, r := new(HamiltonInt), new(HamiltonInt)
+	if !l.SetRat(&y.l) || !r.SetRat(&y.r) {
+		return false
+	}
+	z.l.Set(l)
+	z.r.Set(r)
+	return true
+}
+
+// Generate returns a random CayleyInt value for quick.Check testing.
+func (z *CayleyInt) Generate(rand *rand.Rand, size int) reflect.Value {
+	randomCayleyInt := &CayleyInt{
+		*new(HamiltonInt).Generate(rand, size).Interface().(*HamiltonInt),
+		*new(HamiltonInt).Generate(rand, size).Interface().(*HamiltonInt),
+	}
+	return reflect.ValueOf(randomCayleyInt)
+}
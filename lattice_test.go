@@ -0,0 +1,110 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestComplexIntMul(t *testing.T) {
+	f := func(x, y *ComplexInt) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return new(ComplexInt).Mul(x, y).Rat().Equals(new(Complex).Mul(x.Rat(), y.Rat()))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonIntMul(t *testing.T) {
+	f := func(x, y *HamiltonInt) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		z := new(HamiltonInt).Set(x)
+		z.Mul(z, y)
+		return z.Rat().Equals(new(Hamilton).Mul(x.Rat(), y.Rat()))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHurwitzIntMul(t *testing.T) {
+	f := func(x, y *HurwitzInt) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		p := new(Hamilton).Mul(x.Rat(), y.Rat())
+		z := new(HurwitzInt)
+		return z.SetRat(p) && z.Equals(new(HurwitzInt).Mul(x, y)) &&
+			z.Quad().Cmp(new(big.Int).Mul(x.Quad(), y.Quad())) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyIntMul(t *testing.T) {
+	f := func(x, y *CayleyInt) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return new(CayleyInt).Mul(x, y).Rat().Equals(new(Cayley).Mul(x.Rat(), y.Rat()))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestLatticeUnits(t *testing.T) {
+	// count the units among values with components in {-1, 0, 1}, or in
+	// {-½, ½} for the Hurwitz integers
+	var nc, nh, nw int
+	for i := 0; i < 81; i++ {
+		v := make([]*big.Int, 4)
+		for j, k := 0, i; j < 4; j, k = j+1, k/3 {
+			v[j] = big.NewInt(int64(k%3 - 1))
+		}
+		if v[2].Sign() == 0 && v[3].Sign() == 0 && NewComplexInt(v[0], v[1]).IsUnit() {
+			nc++
+		}
+		x := NewHamiltonInt(v[0], v[1], v[2], v[3])
+		if x.IsUnit() {
+			nh++
+		}
+		if new(HurwitzInt).SetHamiltonInt(x).IsUnit() {
+			nw++
+		}
+	}
+	for i := 0; i < 16; i++ {
+		v := make([]*big.Int, 4)
+		for j := range v {
+			v[j] = big.NewInt(-int64(i >> uint(j) & 1))
+		}
+		if NewHurwitzInt(v[0], v[1], v[2], v[3]).IsUnit() {
+			nw++
+		}
+	}
+	if nc != 4 || nh != 8 || nw != 24 {
+		t.Errorf("unit counts = %d, %d, %d, want 4, 8, 24", nc, nh, nw)
+	}
+}
+
+func TestLatticeSetRat(t *testing.T) {
+	half := big.NewRat(1, 2)
+	one := big.NewRat(1, 1)
+	if new(HamiltonInt).SetRat(NewHamilton(half, half, half, half)) {
+		t.Error("HamiltonInt.SetRat accepted a half-integer")
+	}
+	z := new(HurwitzInt)
+	if !z.SetRat(NewHamilton(half, half, half, half)) || z.IsLipschitz() || !z.IsUnit() {
+		t.Errorf("HurwitzInt.SetRat((1+i+j+k)/2) = %v", z)
+	}
+	if z.SetRat(NewHamilton(half, half, one, half)) {
+		t.Error("HurwitzInt.SetRat accepted mixed components")
+	}
+	if w := new(CayleyInt); w.SetRat(new(Cayley).Klein(
+		big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0),
+		big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0),
+	)) {
+		t.Errorf("CayleyInt.SetRat accepted a Kleinian integer")
+	}
+}
@@ -0,0 +1,315 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "strings"
+
+// A Scalar is an Elem whose inverse can be attempted without panicking. Every
+// type of this package is a Scalar, and so can serve as the entries of a
+// Mat2.
+type Scalar[S any] interface {
+	Elem[S]
+	InvErr(y *S) (*S, error)
+}
+
+// mul returns the product xy as a new value.
+func mul[S any, T Elem[S]](x, y T) T {
+	z := T(new(S))
+	z.Mul(x, y)
+	return z
+}
+
+// inverse returns the inverse of x, and whether it exists.
+func inverse[S any, T Scalar[S]](x T) (T, bool) {
+	inv, err := T(new(S)).InvErr(x)
+	return inv, err == nil
+}
+
+// A Mat2 represents a 2×2 matrix with entries in one of the types S of this
+// package, with pointer type T, such as Mat2[Hamilton, *Hamilton]. The
+// products of entries keep their order, so the matrices are useful even when
+// T is not commutative. The zero value is the zero matrix.
+type Mat2[S any, T Scalar[S]] struct {
+	m [2][2]T
+}
+
+// NewMat2 returns a pointer to the Mat2 value
+// 		[ a b ]
+// 		[ c d ]
+// whose entries are copies of a, b, c, and d.
+func NewMat2[S any, T Scalar[S]](a, b, c, d T) *Mat2[S, T] {
+	z := new(Mat2[S, T])
+	for k, x := range []T{a, b, c, d} {
+		z.m[k/2][k%2] = T(new(S)).Set(x)
+	}
+	return z
+}
+
+// use allocates the entries of z as zeros, if it has none, and returns z.
+func (z *Mat2[S, T]) use() *Mat2[S, T] {
+	if z.m[0][0] == nil {
+		for i := range z.m {
+			for j := range z.m[i] {
+				z.m[i][j] = T(new(S))
+			}
+		}
+	}
+	return z
+}
+
+// at returns the entry of z in row i and column j, or a new zero if z has no
+// entries.
+func (z *Mat2[S, T]) at(i, j int) T {
+	if x := z.m[i][j]; x != nil {
+		return x
+	}
+	return T(new(S))
+}
+
+// identity returns the identity matrix of the type of the entries of y.
+func (y *Mat2[S, T]) identity() *Mat2[S, T] {
+	z := new(Mat2[S, T]).use()
+	z.m[0][0].Real().SetInt64(1)
+	z.m[1][1].Real().SetInt64(1)
+	return z
+}
+
+// At returns the entry of z in row i and column j. The result aliases z.
+func (z *Mat2[S, T]) At(i, j int) T {
+	return z.m[i][j]
+}
+
+// String returns the string version of a Mat2 value, in the format of
+// RatMatrix, such as "[[(1+2i) (0+0i)] [(0+0i) (1+0i)]]".
+func (z *Mat2[S, T]) String() string {
+	rows := make([]string, 2)
+	for i := range rows {
+		rows[i] = "[" + z.at(i, 0).String() + " " + z.at(i, 1).String() + "]"
+	}
+	return "[" + strings.Join(rows, " ") + "]"
+}
+
+// Equals returns true if y and z have equal entries.
+func (z *Mat2[S, T]) Equals(y *Mat2[S, T]) bool {
+	for i := range z.m {
+		for j := range z.m[i] {
+			if !z.at(i, j).Equals(y.at(i, j)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Set sets z equal to y, and returns z.
+func (z *Mat2[S, T]) Set(y *Mat2[S, T]) *Mat2[S, T] {
+	z.use()
+	for i := range z.m {
+		for j := range z.m[i] {
+			z.m[i][j].Set(y.at(i, j))
+		}
+	}
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *Mat2[S, T]) Add(x, y *Mat2[S, T]) *Mat2[S, T] {
+	z.use()
+	for i := range z.m {
+		for j := range z.m[i] {
+			z.m[i][j].Add(x.at(i, j), y.at(i, j))
+		}
+	}
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *Mat2[S, T]) Sub(x, y *Mat2[S, T]) *Mat2[S, T] {
+	z.use()
+	for i := range z.m {
+		for j := range z.m[i] {
+			z.m[i][j].Sub(x.at(i, j), y.at(i, j))
+		}
+	}
+	return z
+}
+
+// Mul sets z equal to the product xy, and returns z. Each entry is
+// 		x[i][0]y[0][j] + x[i][1]y[1][j]
+// with the entries of x on the left.
+func (z *Mat2[S, T]) Mul(x, y *Mat2[S, T]) *Mat2[S, T] {
+	var p [2][2]T
+	for i := range p {
+		for j := range p[i] {
+			p[i][j] = mul(x.at(i, 0), y.at(0, j))
+			p[i][j].Add(p[i][j], mul(x.at(i, 1), y.at(1, j)))
+		}
+	}
+	z.use()
+	for i := range z.m {
+		for j := range z.m[i] {
+			z.m[i][j].Set(p[i][j])
+		}
+	}
+	return z
+}
+
+// Apply returns the product of z and the column vector v.
+func (z *Mat2[S, T]) Apply(v [2]T) [2]T {
+	var w [2]T
+	for i := range w {
+		w[i] = mul(z.at(i, 0), v[0])
+		w[i].Add(w[i], mul(z.at(i, 1), v[1]))
+	}
+	return w
+}
+
+// Det returns the determinant of z. If z = [a b; c d] with a invertible, then
+// the determinant is the Dieudonné determinant
+// 		a(d - (ca⁻¹)b)
+// and if a is not invertible but c is, then it is
+// 		-c(b - (ac⁻¹)d)
+// which both reduce to ad - bc when T is commutative. Otherwise it is ad - bc.
+// When T is not commutative, only the class of the result modulo commutators
+// is meaningful. For Hamilton this class is given by the quadrance, which is
+// multiplicative: Quad(Det(xy)) = Quad(Det(x))Quad(Det(y)).
+func (z *Mat2[S, T]) Det() T {
+	a, b, c, d := z.at(0, 0), z.at(0, 1), z.at(1, 0), z.at(1, 1)
+	schur := func(p, q, r, s T) T {
+		// p(s - (rp⁻¹)q), for invertible p
+		inv, _ := inverse(p)
+		t := mul(mul(r, inv), q)
+		t.Sub(s, t)
+		return mul(p, t)
+	}
+	if _, ok := inverse(a); ok {
+		return schur(a, b, c, d)
+	}
+	if _, ok := inverse(c); ok {
+		t := schur(c, d, a, b)
+		t.Neg(t)
+		return t
+	}
+	t := mul(a, d)
+	t.Sub(t, mul(b, c))
+	return t
+}
+
+// schurInverse returns the inverse of y by block elimination on its entry
+// y[0][0], and whether the elimination succeeds. With y = [a b; c d] and the
+// Schur complement s = d - (ca⁻¹)b, the inverse is
+// 		[ a⁻¹ + a⁻¹b s⁻¹ca⁻¹   -a⁻¹b s⁻¹ ]
+// 		[ -s⁻¹ca⁻¹             s⁻¹       ]
+func schurInverse[S any, T Scalar[S]](y *Mat2[S, T]) (*Mat2[S, T], bool) {
+	a, b, c, d := y.at(0, 0), y.at(0, 1), y.at(1, 0), y.at(1, 1)
+	ai, ok := inverse(a)
+	if !ok {
+		return nil, false
+	}
+	ca := mul(c, ai)
+	ab := mul(ai, b)
+	s := mul(ca, b)
+	s.Sub(d, s)
+	si, ok := inverse(s)
+	if !ok {
+		return nil, false
+	}
+	z := new(Mat2[S, T]).use()
+	z.m[0][1].Neg(z.m[0][1].Mul(ab, si))
+	z.m[1][0].Neg(z.m[1][0].Mul(si, ca))
+	z.m[1][1].Set(si)
+	z.m[0][0].Sub(ai, mul(ab, z.m[1][0]))
+	return z, true
+}
+
+// swapRows sets z equal to y with its rows exchanged, and returns z;
+// swapCols does the same with the columns.
+func (z *Mat2[S, T]) swapRows(y *Mat2[S, T]) *Mat2[S, T] {
+	z.Set(y)
+	z.m[0], z.m[1] = z.m[1], z.m[0]
+	return z
+}
+
+func (z *Mat2[S, T]) swapCols(y *Mat2[S, T]) *Mat2[S, T] {
+	z.Set(y)
+	for i := range z.m {
+		z.m[i][0], z.m[i][1] = z.m[i][1], z.m[i][0]
+	}
+	return z
+}
+
+// inverseMat2 returns the two-sided inverse of y, and whether it was found.
+// It tries block elimination on each entry of the first column and row, and
+// then the adjugate over the determinant ad - bc, and checks each candidate.
+func inverseMat2[S any, T Scalar[S]](y *Mat2[S, T]) (*Mat2[S, T], bool) {
+	var candidates []*Mat2[S, T]
+	if z, ok := schurInverse(y); ok {
+		candidates = append(candidates, z)
+	}
+	// y is P times y with swapped rows, so its inverse has swapped columns
+	if z, ok := schurInverse(new(Mat2[S, T]).swapRows(y)); ok {
+		candidates = append(candidates, z.swapCols(z))
+	}
+	if z, ok := schurInverse(new(Mat2[S, T]).swapCols(y)); ok {
+		candidates = append(candidates, z.swapRows(z))
+	}
+	a, b, c, d := y.at(0, 0), y.at(0, 1), y.at(1, 0), y.at(1, 1)
+	det := mul(a, d)
+	det.Sub(det, mul(b, c))
+	if di, ok := inverse(det); ok {
+		z := new(Mat2[S, T]).use()
+		z.m[0][0].Mul(d, di)
+		z.m[0][1].Neg(z.m[0][1].Mul(b, di))
+		z.m[1][0].Neg(z.m[1][0].Mul(c, di))
+		z.m[1][1].Mul(a, di)
+		candidates = append(candidates, z)
+	}
+	one := y.identity()
+	for _, z := range candidates {
+		if new(Mat2[S, T]).Mul(y, z).Equals(one) && new(Mat2[S, T]).Mul(z, y).Equals(one) {
+			return z, true
+		}
+	}
+	return nil, false
+}
+
+// Inv sets z equal to the inverse of y, and returns z. The inverse is
+// two-sided, and is found by block elimination or, when T is commutative,
+// from the adjugate. If y is not invertible, then Inv panics.
+func (z *Mat2[S, T]) Inv(y *Mat2[S, T]) *Mat2[S, T] {
+	inv, ok := inverseMat2(y)
+	if !ok {
+		panic(divisorError("inverse of singular matrix"))
+	}
+	return z.Set(inv)
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y is not
+// invertible, then z is left unchanged and InvErr returns a *DivisionError
+// instead of panicking.
+func (z *Mat2[S, T]) InvErr(y *Mat2[S, T]) (*Mat2[S, T], error) {
+	inv, ok := inverseMat2(y)
+	if !ok {
+		return nil, &DivisionError{"Mat2", "Inv", new(Mat2[S, T]).Set(y)}
+	}
+	return z.Set(inv), nil
+}
+
+// Mobius returns the image of w under the Möbius transformation of z. If z is
+// [a b; c d], then the image is
+// 		(aw + b)(cw + d)⁻¹
+// If cw + d is not invertible, then Mobius returns a *DivisionError. This
+// extends the Mobius method of BiHamilton and BiCockle to any T.
+func (z *Mat2[S, T]) Mobius(w T) (T, error) {
+	num := mul(z.at(0, 0), w)
+	num.Add(num, z.at(0, 1))
+	den := mul(z.at(1, 0), w)
+	den.Add(den, z.at(1, 1))
+	inv, ok := inverse(den)
+	if !ok {
+		var zero T
+		return zero, &DivisionError{"Mat2", "Mobius", den}
+	}
+	return num.Mul(num, inv), nil
+}
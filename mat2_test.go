@@ -0,0 +1,118 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestMat2Hamilton(t *testing.T) {
+	f := func(a, b, c, d, e, g, h, k, w *Hamilton) bool {
+		// t.Logf("a = %v, b = %v, c = %v, d = %v, e = %v, g = %v, h = %v, k = %v, w = %v", a, b, c, d, e, g, h, k, w)
+		x, y := NewMat2(a, b, c, d), NewMat2(e, g, h, k)
+		xy := new(Mat2[Hamilton, *Hamilton]).Mul(x, y)
+		q := new(big.Rat).Mul(x.Det().Quad(), y.Det().Quad())
+		if xy.Det().Quad().Cmp(q) != 0 {
+			return false
+		}
+		inv, err := new(Mat2[Hamilton, *Hamilton]).InvErr(x)
+		if err != nil {
+			return x.Det().Quad().Sign() == 0
+		}
+		one := NewMat2(new(Hamilton), new(Hamilton), new(Hamilton), new(Hamilton))
+		one.At(0, 0).Real().SetInt64(1)
+		one.At(1, 1).Real().SetInt64(1)
+		if !new(Mat2[Hamilton, *Hamilton]).Mul(x, inv).Equals(one) {
+			return false
+		}
+		// the Möbius transformation of xy is that of y followed by that of x
+		v, err := y.Mobius(w)
+		if err != nil {
+			return true
+		}
+		got, err := xy.Mobius(w)
+		want, werr := x.Mobius(v)
+		if err != nil || werr != nil {
+			return (err != nil) == (werr != nil)
+		}
+		return got.Equals(want)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+	// the inverse of [0 i; j 0] is [0 -j; -i 0], found with a zero corner
+	zero := new(Hamilton)
+	i := NewHamilton(big.NewRat(0, 1), big.NewRat(1, 1), big.NewRat(0, 1), big.NewRat(0, 1))
+	j := NewHamilton(big.NewRat(0, 1), big.NewRat(0, 1), big.NewRat(1, 1), big.NewRat(0, 1))
+	x := NewMat2(zero, i, j, zero)
+	want := NewMat2(zero, new(Hamilton).Neg(j), new(Hamilton).Neg(i), zero)
+	if inv, err := new(Mat2[Hamilton, *Hamilton]).InvErr(x); err != nil || !inv.Equals(want) {
+		t.Errorf("InvErr(%v) = %v, %v", x, inv, err)
+	}
+}
+
+func TestMat2Zero(t *testing.T) {
+	var zero Mat2[Complex, *Complex]
+	one := NewComplex(big.NewRat(1, 1), big.NewRat(0, 1))
+	x := NewMat2(one, one, new(Complex), one)
+	if z := new(Mat2[Complex, *Complex]).Mul(&zero, x); !z.Equals(&zero) || !zero.Equals(z) {
+		t.Errorf("0 %v = %v", x, z)
+	}
+	if z := new(Mat2[Complex, *Complex]).Add(x, &zero); !z.Equals(x) {
+		t.Errorf("%v + 0 = %v", x, z)
+	}
+	if w := zero.Apply([2]*Complex{one, one}); !w[0].Equals(new(Complex)) || !w[1].Equals(new(Complex)) {
+		t.Errorf("0 (1, 1) = %v", w)
+	}
+	if d := zero.Det(); !d.Equals(new(Complex)) {
+		t.Errorf("Det(0) = %v", d)
+	}
+}
+
+func TestMat2Perplex(t *testing.T) {
+	f := func(a, b, c, d, e, g, h, k *Perplex) bool {
+		// t.Logf("a = %v, b = %v, c = %v, d = %v, e = %v, g = %v, h = %v, k = %v", a, b, c, d, e, g, h, k)
+		x, y := NewMat2(a, b, c, d), NewMat2(e, g, h, k)
+		det := new(Perplex).Sub(new(Perplex).Mul(a, d), new(Perplex).Mul(b, c))
+		if !x.Det().Equals(det) {
+			return false
+		}
+		if !new(Mat2[Perplex, *Perplex]).Mul(x, y).Det().Equals(new(Perplex).Mul(det, y.Det())) {
+			return false
+		}
+		_, err := new(Mat2[Perplex, *Perplex]).InvErr(x)
+		return (err == nil) == !det.IsZeroDivisor()
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+	// a singular matrix whose entries are all invertible
+	one := NewPerplex(big.NewRat(1, 1), big.NewRat(0, 1))
+	x := NewMat2(one, one, one, one)
+	if _, err := new(Mat2[Perplex, *Perplex]).InvErr(x); err == nil {
+		t.Errorf("InvErr(%v) did not fail", x)
+	}
+}
+
+func TestMat2BiCockle(t *testing.T) {
+	f := func(x *BiCockle, w *Complex) bool {
+		// t.Logf("x = %v, w = %v", x, w)
+		m := x.Matrix()
+		y := NewMat2(m[0][0], m[0][1], m[1][0], m[1][1])
+		if !y.Det().Equals(x.Det()) {
+			return false
+		}
+		got, err := y.Mobius(w)
+		want, werr := x.Mobius(w)
+		if err != nil || werr != nil {
+			return (err != nil) == (werr != nil)
+		}
+		return got.Equals(want)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,330 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// fromMatrix returns the first column of m if m is a square matrix of
+// dimension n equal to the matrix of left multiplication by that column, as
+// computed by toMatrix. Otherwise it returns nil.
+func fromMatrix(m *RatMatrix, n int, toMatrix func([]*big.Rat) *RatMatrix) []*big.Rat {
+	if rows, cols := m.Dims(); rows != n || cols != n {
+		return nil
+	}
+	v := make([]*big.Rat, n)
+	for i := range v {
+		v[i] = new(big.Rat).Set(m.At(i, 0))
+	}
+	if !toMatrix(v).Equals(m) {
+		return nil
+	}
+	return v
+}
+
+// ToMatrix returns the real matrix representation of z. If z = a+bi, then
+// the matrix is
+// 		⎡ a -b ⎤
+// 		⎣ b  a ⎦
+// This is the matrix of left multiplication by z, so ToMatrix sends products
+// to matrix products.
+func (z *Complex) ToMatrix() *RatMatrix {
+	return RatMatrixOf(z.LeftMul().Matrix())
+}
+
+// FromMatrix sets z equal to the value whose matrix is m, and returns true.
+// If m is not the ToMatrix of any Complex value, then FromMatrix returns false
+// and leaves z unchanged.
+func (z *Complex) FromMatrix(m *RatMatrix) bool {
+	r := fromMatrix(m, 2, func(v []*big.Rat) *RatMatrix {
+		return NewComplex(v[0], v[1]).ToMatrix()
+	})
+	if r == nil {
+		return false
+	}
+	z.Set(NewComplex(r[0], r[1]))
+	return true
+}
+
+// ToMatrix returns the real matrix representation of z. If z = a+bs, then
+// the matrix is
+// 		⎡ a b ⎤
+// 		⎣ b a ⎦
+// This is the matrix of left multiplication by z, so ToMatrix sends products
+// to matrix products.
+func (z *Perplex) ToMatrix() *RatMatrix {
+	return RatMatrixOf(z.LeftMul().Matrix())
+}
+
+// FromMatrix sets z equal to the value whose matrix is m, and returns true.
+// If m is not the ToMatrix of any Perplex value, then FromMatrix returns false
+// and leaves z unchanged.
+func (z *Perplex) FromMatrix(m *RatMatrix) bool {
+	r := fromMatrix(m, 2, func(v []*big.Rat) *RatMatrix {
+		return NewPerplex(v[0], v[1]).ToMatrix()
+	})
+	if r == nil {
+		return false
+	}
+	z.Set(NewPerplex(r[0], r[1]))
+	return true
+}
+
+// ToMatrix returns the 4×4 real matrix representation of z, which is the
+// matrix of left multiplication by z. ToMatrix sends products to matrix
+// products.
+func (z *Cockle) ToMatrix() *RatMatrix {
+	return RatMatrixOf(z.LeftMul().Matrix())
+}
+
+// FromMatrix sets z equal to the value whose matrix is m, and returns true.
+// If m is not the ToMatrix of any Cockle value, then FromMatrix returns false
+// and leaves z unchanged.
+func (z *Cockle) FromMatrix(m *RatMatrix) bool {
+	r := fromMatrix(m, 4, func(v []*big.Rat) *RatMatrix {
+		return NewCockle(v[0], v[1], v[2], v[3]).ToMatrix()
+	})
+	if r == nil {
+		return false
+	}
+	z.Set(NewCockle(r[0], r[1], r[2], r[3]))
+	return true
+}
+
+// ToMatrix returns the 4×4 real matrix representation of z, which is the
+// matrix of left multiplication by z. ToMatrix sends products to matrix
+// products.
+func (z *Hamilton) ToMatrix() *RatMatrix {
+	return RatMatrixOf(z.LeftMul().Matrix())
+}
+
+// FromMatrix sets z equal to the value whose matrix is m, and returns true.
+// If m is not the ToMatrix of any Hamilton value, then FromMatrix returns false
+// and leaves z unchanged.
+func (z *Hamilton) FromMatrix(m *RatMatrix) bool {
+	r := fromMatrix(m, 4, func(v []*big.Rat) *RatMatrix {
+		return NewHamilton(v[0], v[1], v[2], v[3]).ToMatrix()
+	})
+	if r == nil {
+		return false
+	}
+	z.Set(NewHamilton(r[0], r[1], r[2], r[3]))
+	return true
+}
+
+// ToMatrix returns the 8×8 matrix of left multiplication by z. Since Cayley
+// multiplication is not associative, ToMatrix does not send products to
+// matrix products, but it is still linear and injective.
+func (z *Cayley) ToMatrix() *RatMatrix {
+	return RatMatrixOf(z.LeftMul().Matrix())
+}
+
+// FromMatrix sets z equal to the value whose matrix is m, and returns true.
+// If m is not the ToMatrix of any Cayley value, then FromMatrix returns false
+// and leaves z unchanged.
+func (z *Cayley) FromMatrix(m *RatMatrix) bool {
+	r := fromMatrix(m, 8, func(v []*big.Rat) *RatMatrix {
+		return NewCayley(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7]).ToMatrix()
+	})
+	if r == nil {
+		return false
+	}
+	z.Set(NewCayley(r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7]))
+	return true
+}
+
+// Lmat returns the 4×4 matrix of left multiplication by z, which sends the
+// components of x to those of zx. Solving zx = b is the linear system with
+// this matrix.
+func (z *Cockle) Lmat() *RatMatrix {
+	return RatMatrixOf(z.LeftMul().Matrix())
+}
+
+// Rmat returns the 4×4 matrix of right multiplication by z, which sends the
+// components of x to those of xz. Solving xz = b is the linear system with
+// this matrix.
+func (z *Cockle) Rmat() *RatMatrix {
+	return RatMatrixOf(z.RightMul().Matrix())
+}
+
+// Lmat returns the 4×4 matrix of left multiplication by z, which sends the
+// components of x to those of zx. Solving zx = b is the linear system with
+// this matrix.
+func (z *Hamilton) Lmat() *RatMatrix {
+	return RatMatrixOf(z.LeftMul().Matrix())
+}
+
+// Rmat returns the 4×4 matrix of right multiplication by z, which sends the
+// components of x to those of xz. Solving xz = b is the linear system with
+// this matrix.
+func (z *Hamilton) Rmat() *RatMatrix {
+	return RatMatrixOf(z.RightMul().Matrix())
+}
+
+// Lmat returns the 4×4 matrix of left multiplication by z, which sends the
+// components of x to those of zx. Solving zx = b is the linear system with
+// this matrix.
+func (z *InfraComplex) Lmat() *RatMatrix {
+	return RatMatrixOf(z.LeftMul().Matrix())
+}
+
+// Rmat returns the 4×4 matrix of right multiplication by z, which sends the
+// components of x to those of xz. Solving xz = b is the linear system with
+// this matrix.
+func (z *InfraComplex) Rmat() *RatMatrix {
+	return RatMatrixOf(z.RightMul().Matrix())
+}
+
+// Lmat returns the 4×4 matrix of left multiplication by z, which sends the
+// components of x to those of zx. Solving zx = b is the linear system with
+// this matrix.
+func (z *InfraPerplex) Lmat() *RatMatrix {
+	return RatMatrixOf(z.LeftMul().Matrix())
+}
+
+// Rmat returns the 4×4 matrix of right multiplication by z, which sends the
+// components of x to those of xz. Solving xz = b is the linear system with
+// this matrix.
+func (z *InfraPerplex) Rmat() *RatMatrix {
+	return RatMatrixOf(z.RightMul().Matrix())
+}
+
+// Lmat returns the 4×4 matrix of left multiplication by z, which sends the
+// components of x to those of zx. Solving zx = b is the linear system with
+// this matrix.
+func (z *Supra) Lmat() *RatMatrix {
+	return RatMatrixOf(z.LeftMul().Matrix())
+}
+
+// Rmat returns the 4×4 matrix of right multiplication by z, which sends the
+// components of x to those of xz. Solving xz = b is the linear system with
+// this matrix.
+func (z *Supra) Rmat() *RatMatrix {
+	return RatMatrixOf(z.RightMul().Matrix())
+}
+
+// Lmat returns the 8×8 matrix of left multiplication by z, which sends the
+// components of x to those of zx. Solving zx = b is the linear system with
+// this matrix.
+func (z *BiCockle) Lmat() *RatMatrix {
+	return RatMatrixOf(z.LeftMul().Matrix())
+}
+
+// Rmat returns the 8×8 matrix of right multiplication by z, which sends the
+// components of x to those of xz. Solving xz = b is the linear system with
+// this matrix.
+func (z *BiCockle) Rmat() *RatMatrix {
+	return RatMatrixOf(z.RightMul().Matrix())
+}
+
+// Lmat returns the 8×8 matrix of left multiplication by z, which sends the
+// components of x to those of zx. Solving zx = b is the linear system with
+// this matrix.
+func (z *BiHamilton) Lmat() *RatMatrix {
+	return RatMatrixOf(z.LeftMul().Matrix())
+}
+
+// Rmat returns the 8×8 matrix of right multiplication by z, which sends the
+// components of x to those of xz. Solving xz = b is the linear system with
+// this matrix.
+func (z *BiHamilton) Rmat() *RatMatrix {
+	return RatMatrixOf(z.RightMul().Matrix())
+}
+
+// Lmat returns the 8×8 matrix of left multiplication by z, which sends the
+// components of x to those of zx. Solving zx = b is the linear system with
+// this matrix.
+func (z *Cayley) Lmat() *RatMatrix {
+	return RatMatrixOf(z.LeftMul().Matrix())
+}
+
+// Rmat returns the 8×8 matrix of right multiplication by z, which sends the
+// components of x to those of xz. Solving xz = b is the linear system with
+// this matrix.
+func (z *Cayley) Rmat() *RatMatrix {
+	return RatMatrixOf(z.RightMul().Matrix())
+}
+
+// Lmat returns the 8×8 matrix of left multiplication by z, which sends the
+// components of x to those of zx. Solving zx = b is the linear system with
+// this matrix.
+func (z *InfraCockle) Lmat() *RatMatrix {
+	return RatMatrixOf(z.LeftMul().Matrix())
+}
+
+// Rmat returns the 8×8 matrix of right multiplication by z, which sends the
+// components of x to those of xz. Solving xz = b is the linear system with
+// this matrix.
+func (z *InfraCockle) Rmat() *RatMatrix {
+	return RatMatrixOf(z.RightMul().Matrix())
+}
+
+// Lmat returns the 8×8 matrix of left multiplication by z, which sends the
+// components of x to those of zx. Solving zx = b is the linear system with
+// this matrix.
+func (z *InfraHamilton) Lmat() *RatMatrix {
+	return RatMatrixOf(z.LeftMul().Matrix())
+}
+
+// Rmat returns the 8×8 matrix of right multiplication by z, which sends the
+// components of x to those of xz. Solving xz = b is the linear system with
+// this matrix.
+func (z *InfraHamilton) Rmat() *RatMatrix {
+	return RatMatrixOf(z.RightMul().Matrix())
+}
+
+// Lmat returns the 8×8 matrix of left multiplication by z, which sends the
+// components of x to those of zx. Solving zx = b is the linear system with
+// this matrix.
+func (z *SupraComplex) Lmat() *RatMatrix {
+	return RatMatrixOf(z.LeftMul().Matrix())
+}
+
+// Rmat returns the 8×8 matrix of right multiplication by z, which sends the
+// components of x to those of xz. Solving xz = b is the linear system with
+// this matrix.
+func (z *SupraComplex) Rmat() *RatMatrix {
+	return RatMatrixOf(z.RightMul().Matrix())
+}
+
+// Lmat returns the 8×8 matrix of left multiplication by z, which sends the
+// components of x to those of zx. Solving zx = b is the linear system with
+// this matrix.
+func (z *SupraPerplex) Lmat() *RatMatrix {
+	return RatMatrixOf(z.LeftMul().Matrix())
+}
+
+// Rmat returns the 8×8 matrix of right multiplication by z, which sends the
+// components of x to those of xz. Solving xz = b is the linear system with
+// this matrix.
+func (z *SupraPerplex) Rmat() *RatMatrix {
+	return RatMatrixOf(z.RightMul().Matrix())
+}
+
+// Lmat returns the 8×8 matrix of left multiplication by z, which sends the
+// components of x to those of zx. Solving zx = b is the linear system with
+// this matrix.
+func (z *Ultra) Lmat() *RatMatrix {
+	return RatMatrixOf(z.LeftMul().Matrix())
+}
+
+// Rmat returns the 8×8 matrix of right multiplication by z, which sends the
+// components of x to those of xz. Solving xz = b is the linear system with
+// this matrix.
+func (z *Ultra) Rmat() *RatMatrix {
+	return RatMatrixOf(z.RightMul().Matrix())
+}
+
+// Lmat returns the 8×8 matrix of left multiplication by z, which sends the
+// components of x to those of zx. Solving zx = b is the linear system with
+// this matrix.
+func (z *Zorn) Lmat() *RatMatrix {
+	return RatMatrixOf(z.LeftMul().Matrix())
+}
+
+// Rmat returns the 8×8 matrix of right multiplication by z, which sends the
+// components of x to those of xz. Solving xz = b is the linear system with
+// this matrix.
+func (z *Zorn) Rmat() *RatMatrix {
+	return RatMatrixOf(z.RightMul().Matrix())
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestComplexToMatrix(t *testing.T) {
+	z := NewComplex(big.NewRat(3, 1), big.NewRat(-2, 5))
+	m := z.ToMatrix()
+	want := RatMatrixOf([][]*big.Rat{
+		{big.NewRat(3, 1), big.NewRat(2, 5)},
+		{big.NewRat(-2, 5), big.NewRat(3, 1)},
+	})
+	if !m.Equals(want) {
+		t.Errorf("ToMatrix = %v, want %v", m, want)
+	}
+}
+
+func TestHamiltonToMatrixMul(t *testing.T) {
+	f := func(x, y *Hamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return new(Hamilton).Mul(x, y).ToMatrix().Equals(new(RatMatrix).Mul(x.ToMatrix(), y.ToMatrix()))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCockleToMatrixMul(t *testing.T) {
+	f := func(x, y *Cockle) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return new(Cockle).Mul(x, y).ToMatrix().Equals(new(RatMatrix).Mul(x.ToMatrix(), y.ToMatrix()))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFromMatrix(t *testing.T) {
+	f := func(x *Cayley, y *Perplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		u, v := new(Cayley), new(Perplex)
+		return u.FromMatrix(x.ToMatrix()) && u.Equals(x) &&
+			v.FromMatrix(y.ToMatrix()) && v.Equals(y)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	z := NewComplex(big.NewRat(1, 1), big.NewRat(2, 1))
+	m := z.ToMatrix()
+	m.At(0, 1).SetInt64(2)
+	if new(Complex).FromMatrix(m) {
+		t.Errorf("FromMatrix accepted %v", m)
+	}
+	if new(Hamilton).FromMatrix(z.ToMatrix()) {
+		t.Error("FromMatrix accepted a matrix of the wrong size")
+	}
+}
+
+func TestLmatRmat(t *testing.T) {
+	f := func(x, y *Zorn) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		xy := new(Zorn).Mul(x, y).Components()
+		return equalRats(x.Lmat().Apply(y.Components()), xy) &&
+			equalRats(y.Rmat().Apply(x.Components()), xy)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	g := func(x, y *InfraHamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		xy := new(InfraHamilton).Mul(x, y).Components()
+		return equalRats(x.Lmat().Apply(y.Components()), xy) &&
+			equalRats(y.Rmat().Apply(x.Components()), xy)
+	}
+	if err := quick.Check(g, nil); err != nil {
+		t.Error(err)
+	}
+}
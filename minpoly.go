@@ -0,0 +1,39 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// quadPow returns the rationals p and q such that y^n = p*y + q, where poly is
+// the minimal polynomial of y. The degree of poly must be one or two.
+func quadPow(poly Laurent, n uint64) (p, q *big.Rat) {
+	// y² = t*y - s
+	t, s := new(big.Rat), new(big.Rat)
+	if len(poly) == 2 {
+		t.Neg(poly[0])
+	} else {
+		t.Neg(poly[1])
+		s.Set(poly[0])
+	}
+	p, q = new(big.Rat), big.NewRat(1, 1)
+	bp, bq := big.NewRat(1, 1), new(big.Rat)
+	// (p1*y + q1) * (p2*y + q2) = (p1*p2*t + p1*q2 + q1*p2)*y + (q1*q2 - p1*p2*s)
+	mul := func(p1, q1, p2, q2 *big.Rat) (*big.Rat, *big.Rat) {
+		pp := new(big.Rat).Mul(p1, p2)
+		temp := new(big.Rat)
+		np := new(big.Rat).Mul(pp, t)
+		np.Add(np, temp.Mul(p1, q2))
+		np.Add(np, temp.Mul(q1, p2))
+		nq := new(big.Rat).Mul(q1, q2)
+		nq.Sub(nq, temp.Mul(pp, s))
+		return np, nq
+	}
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			p, q = mul(p, q, bp, bq)
+		}
+		bp, bq = mul(bp, bq, bp, bq)
+	}
+	return
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestQuadPowReal(t *testing.T) {
+	x := NewComplex(big.NewRat(-2, 3), new(big.Rat))
+	if n := len(x.MinPoly()); n != 2 {
+		t.Fatalf("degree of minimal polynomial is %d, want 1", n-1)
+	}
+	l := new(Complex).PowViaMinPoly(x, 5)
+	r := NewComplex(big.NewRat(-32, 243), new(big.Rat))
+	if !l.Equals(r) {
+		t.Errorf("PowViaMinPoly(%v, 5) = %v, want %v", x, l, r)
+	}
+}
+
+func TestQuadPowZero(t *testing.T) {
+	x := NewHamilton(big.NewRat(1, 2), big.NewRat(3, 1), big.NewRat(-1, 5), big.NewRat(7, 3))
+	l := new(Hamilton).PowViaMinPoly(x, 0)
+	r := NewHamilton(big.NewRat(1, 1), new(big.Rat), new(big.Rat), new(big.Rat))
+	if !l.Equals(r) {
+		t.Errorf("PowViaMinPoly(%v, 0) = %v, want %v", x, l, r)
+	}
+}
+
+// checkMinPoly checks that random values of type T satisfy their minimal
+// polynomials, and that PowViaMinPoly agrees with repeated multiplication.
+func checkMinPoly[S any, T interface {
+	Elem[S]
+	EvaluateMinPoly() bool
+	PowViaMinPoly(y *S, n uint64) *S
+}](t *testing.T) {
+	f := func(x T) bool {
+		// t.Logf("x = %v", x)
+		if !x.EvaluateMinPoly() {
+			return false
+		}
+		l, r := T(new(S)), T(new(S))
+		l.PowViaMinPoly(x, 5)
+		r.Set(x)
+		for i := 1; i < 5; i++ {
+			r.Mul(r, x)
+		}
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Errorf("%T: %v", T(nil), err)
+	}
+}
+
+func TestMinPoly(t *testing.T) {
+	checkMinPoly[Cayley](t)
+	checkMinPoly[Cockle](t)
+	checkMinPoly[Complex](t)
+	checkMinPoly[Hamilton](t)
+	checkMinPoly[Infra](t)
+	checkMinPoly[InfraCockle](t)
+	checkMinPoly[InfraComplex](t)
+	checkMinPoly[InfraHamilton](t)
+	checkMinPoly[InfraPerplex](t)
+	checkMinPoly[Perplex](t)
+	checkMinPoly[Supra](t)
+	checkMinPoly[SupraComplex](t)
+	checkMinPoly[SupraPerplex](t)
+	checkMinPoly[Ultra](t)
+	checkMinPoly[Zorn](t)
+}
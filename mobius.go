@@ -0,0 +1,411 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// A MöbiusKind is the conjugacy type of an invertible Möbius transform.
+type MöbiusKind int
+
+const (
+	// MöbiusIdentity is the identity transform.
+	MöbiusIdentity MöbiusKind = iota
+	// MöbiusParabolic transforms have a single fixed point, and are
+	// conjugate to a translation.
+	MöbiusParabolic
+	// MöbiusElliptic transforms are conjugate to a rotation.
+	MöbiusElliptic
+	// MöbiusHyperbolic transforms are conjugate to a real scaling.
+	MöbiusHyperbolic
+	// MöbiusLoxodromic transforms are conjugate to a scaling composed with a
+	// rotation.
+	MöbiusLoxodromic
+)
+
+func (k MöbiusKind) String() string {
+	switch k {
+	case MöbiusIdentity:
+		return "identity"
+	case MöbiusParabolic:
+		return "parabolic"
+	case MöbiusElliptic:
+		return "elliptic"
+	case MöbiusHyperbolic:
+		return "hyperbolic"
+	case MöbiusLoxodromic:
+		return "loxodromic"
+	}
+	return fmt.Sprintf("MöbiusKind(%d)", int(k))
+}
+
+// A NormalFormError reports that the normal form of a Möbius transform could
+// not be computed exactly.
+type NormalFormError struct {
+	Op   string       // the failing function
+	Disc fmt.Stringer // the discriminant of the fixed-point equation
+	Msg  string
+}
+
+func (e *NormalFormError) Error() string {
+	return fmt.Sprintf("rational: %s: %s: %v", e.Op, e.Msg, e.Disc)
+}
+
+// complexSqrt returns a square root of y and true if y is the square of a
+// Complex with rational components. Otherwise it returns nil and false.
+func complexSqrt(y *Complex) (*Complex, bool) {
+	u, v := y.Rats()
+	n, ok := ratSqrt(y.Quad())
+	if !ok {
+		return nil, false
+	}
+	// x² - w² = u, 2xw = v
+	x2 := new(big.Rat).Add(u, n)
+	x2.Quo(x2, big.NewRat(2, 1))
+	x, ok := ratSqrt(x2)
+	if !ok {
+		return nil, false
+	}
+	if x.Sign() == 0 {
+		w, ok := ratSqrt(new(big.Rat).Neg(u))
+		if !ok {
+			return nil, false
+		}
+		return NewComplex(x, w), true
+	}
+	w := new(big.Rat).Quo(v, x)
+	w.Quo(w, big.NewRat(2, 1))
+	return NewComplex(x, w), true
+}
+
+// perplexSqrt returns a square root of y and true if y is the square of a
+// Perplex with rational components. Otherwise it returns nil and false.
+func perplexSqrt(y *Perplex) (*Perplex, bool) {
+	u, v := y.Rats()
+	// null coordinates u+v and u-v are squared independently
+	p, ok := ratSqrt(new(big.Rat).Add(u, v))
+	if !ok {
+		return nil, false
+	}
+	q, ok := ratSqrt(new(big.Rat).Sub(u, v))
+	if !ok {
+		return nil, false
+	}
+	half := big.NewRat(1, 2)
+	a := new(big.Rat).Add(p, q)
+	b := new(big.Rat).Sub(p, q)
+	return NewPerplex(a.Mul(a, half), b.Mul(b, half)), true
+}
+
+// ComplexNormalForm returns the conjugacy type of the invertible Möbius
+// transform
+// 		f(y) = (a*y + b) * Inv(c*y + d)
+// together with the coefficients n of its normal form g and the coefficients
+// s of a conjugating transform, so that f∘s = s∘g. The normal form is y + 1
+// for a parabolic transform, and ky otherwise, with k the ratio of the
+// eigenvalues of the coefficient matrix. The eigenvalues are
+// 		(a + d ± √Δ)/2
+// with discriminant
+// 		Δ = (a - d)² + 4bc
+// If Δ has no rational square root, then ComplexNormalForm returns a
+// *NormalFormError. If ad - bc is zero, then ComplexNormalForm panics.
+func ComplexNormalForm(a, b, c, d *Complex) (kind MöbiusKind, n, s [4]*Complex, err error) {
+	zero := new(Complex)
+	one := NewComplex(big.NewRat(1, 1), new(big.Rat))
+	det := new(Complex).Mul(a, d)
+	det.Sub(det, new(Complex).Mul(b, c))
+	if det.Equals(zero) {
+		panic("singular Möbius transform")
+	}
+	id := [4]*Complex{one, zero, zero, one}
+	if b.Equals(zero) && c.Equals(zero) && a.Equals(d) {
+		return MöbiusIdentity, id, id, nil
+	}
+	tr := new(Complex).Add(a, d)
+	disc := new(Complex).Sub(a, d)
+	disc.Mul(disc, disc)
+	disc.Add(disc, new(Complex).Scal(new(Complex).Mul(b, c), big.NewRat(4, 1)))
+	half := big.NewRat(1, 2)
+	if disc.Equals(zero) {
+		// Jordan basis (M - λ)e, λe
+		l := new(Complex).Scal(tr, half)
+		if !b.Equals(zero) {
+			s = [4]*Complex{b, zero, new(Complex).Sub(d, l), l}
+		} else {
+			s = [4]*Complex{new(Complex).Sub(a, l), l, c, zero}
+		}
+		return MöbiusParabolic, [4]*Complex{one, one, zero, one}, s, nil
+	}
+	r, ok := complexSqrt(disc)
+	if !ok {
+		return kind, n, s, &NormalFormError{"ComplexNormalForm", disc, "discriminant is not a square"}
+	}
+	l1 := new(Complex).Add(tr, r)
+	l1.Scal(l1, half)
+	l2 := new(Complex).Sub(tr, r)
+	l2.Scal(l2, half)
+	// eigenbasis (M - λ2)e, (M - λ1)e
+	switch {
+	case !c.Equals(zero):
+		s = [4]*Complex{new(Complex).Sub(a, l2), new(Complex).Sub(a, l1), c, c}
+	case !b.Equals(zero):
+		s = [4]*Complex{b, b, new(Complex).Sub(d, l2), new(Complex).Sub(d, l1)}
+	default:
+		s = id
+		l1, l2 = a, d
+	}
+	k := new(Complex).Quo(l1, l2)
+	switch {
+	case k.Quad().Cmp(big.NewRat(1, 1)) == 0:
+		kind = MöbiusElliptic
+	case k.IsReal():
+		kind = MöbiusHyperbolic
+	default:
+		kind = MöbiusLoxodromic
+	}
+	return kind, [4]*Complex{k, zero, zero, one}, s, nil
+}
+
+// PerplexNormalForm returns the conjugacy type of the invertible Möbius
+// transform
+// 		f(y) = (a*y + b) * Inv(c*y + d)
+// together with the coefficients n of its normal form g and the coefficients
+// s of a conjugating transform, so that f∘s = s∘g. The normal form is y + 1
+// for a parabolic transform, and ky otherwise, as for ComplexNormalForm.
+// Perplex Möbius transforms act independently on the null coordinates a+b and
+// a-b, so the eigenvalues can be swapped in each of them separately. The
+// multiplier k is chosen with both null coordinates of absolute value at least
+// one; in particular, hyperbolic rotations are conjugate to real scalings. If
+// the discriminant has no rational square root, or if the fixed points of f
+// are not separated by an invertible transform, then PerplexNormalForm
+// returns a *NormalFormError. If ad - bc is a zero divisor, then
+// PerplexNormalForm panics.
+func PerplexNormalForm(a, b, c, d *Perplex) (kind MöbiusKind, n, s [4]*Perplex, err error) {
+	zero := new(Perplex)
+	one := NewPerplex(big.NewRat(1, 1), new(big.Rat))
+	det := new(Perplex).Mul(a, d)
+	det.Sub(det, new(Perplex).Mul(b, c))
+	if det.IsZeroDivisor() {
+		panic("singular Möbius transform")
+	}
+	id := [4]*Perplex{one, zero, zero, one}
+	if b.Equals(zero) && c.Equals(zero) && a.Equals(d) {
+		return MöbiusIdentity, id, id, nil
+	}
+	tr := new(Perplex).Add(a, d)
+	disc := new(Perplex).Sub(a, d)
+	disc.Mul(disc, disc)
+	disc.Add(disc, new(Perplex).Scal(new(Perplex).Mul(b, c), big.NewRat(4, 1)))
+	half := big.NewRat(1, 2)
+	separated := func(s [4]*Perplex) bool {
+		t := new(Perplex).Mul(s[0], s[3])
+		return !t.Sub(t, new(Perplex).Mul(s[1], s[2])).IsZeroDivisor()
+	}
+	if disc.Equals(zero) {
+		// Jordan basis (M - λ)e, λe
+		l := new(Perplex).Scal(tr, half)
+		s = [4]*Perplex{b, zero, new(Perplex).Sub(d, l), l}
+		if !separated(s) {
+			s = [4]*Perplex{new(Perplex).Sub(a, l), l, c, zero}
+		}
+		if !separated(s) {
+			return kind, n, s, &NormalFormError{"PerplexNormalForm", disc, "no invertible Jordan basis"}
+		}
+		return MöbiusParabolic, [4]*Perplex{one, one, zero, one}, s, nil
+	}
+	r, ok := perplexSqrt(disc)
+	if !ok {
+		return kind, n, s, &NormalFormError{"PerplexNormalForm", disc, "discriminant is not a square"}
+	}
+	// In each null coordinate, take the root with the sign of the trace, so
+	// that λ1 is the eigenvalue of larger absolute value.
+	t0, t1 := tr.Rats()
+	r0, r1 := r.Rats()
+	p, q := new(big.Rat).Add(r0, r1), new(big.Rat).Sub(r0, r1)
+	if new(big.Rat).Add(t0, t1).Sign() < 0 {
+		p.Neg(p)
+	}
+	if new(big.Rat).Sub(t0, t1).Sign() < 0 {
+		q.Neg(q)
+	}
+	r = NewPerplex(new(big.Rat).Add(p, q), new(big.Rat).Sub(p, q))
+	r.Scal(r, half)
+	l1 := new(Perplex).Add(tr, r)
+	l1.Scal(l1, half)
+	l2 := new(Perplex).Sub(tr, r)
+	l2.Scal(l2, half)
+	// eigenbasis (M - λ2)e, (M - λ1)e, for e = (1, 0), (0, 1), or (1, 1)
+	for _, e := range [3][2]*Perplex{{one, zero}, {zero, one}, {one, one}} {
+		col := func(l *Perplex) (*Perplex, *Perplex) {
+			x := new(Perplex).Mul(new(Perplex).Sub(a, l), e[0])
+			x.Add(x, new(Perplex).Mul(b, e[1]))
+			y := new(Perplex).Mul(c, e[0])
+			y.Add(y, new(Perplex).Mul(new(Perplex).Sub(d, l), e[1]))
+			return x, y
+		}
+		s[0], s[2] = col(l2)
+		s[1], s[3] = col(l1)
+		if separated(s) {
+			break
+		}
+	}
+	if !separated(s) {
+		return kind, n, s, &NormalFormError{"PerplexNormalForm", disc, "fixed points are not separated"}
+	}
+	k := new(Perplex).Quo(l1, l2)
+	switch {
+	case k.Quad().Cmp(big.NewRat(1, 1)) == 0:
+		kind = MöbiusElliptic
+	case k.IsReal():
+		kind = MöbiusHyperbolic
+	default:
+		kind = MöbiusLoxodromic
+	}
+	return kind, [4]*Perplex{k, zero, zero, one}, s, nil
+}
+
+// hamiltonPair returns the column with entries given by the eight components
+// of v.
+func hamiltonPair(v []*big.Rat) [2]*Hamilton {
+	return [2]*Hamilton{
+		NewHamilton(v[0], v[1], v[2], v[3]),
+		NewHamilton(v[4], v[5], v[6], v[7]),
+	}
+}
+
+// hamiltonKernel returns a rational basis of the columns v with
+// 		Mv - vλ = w
+// for the Hamilton matrix m, with the last entry of each basis vector standing
+// for the coefficient of w.
+func hamiltonKernel(m [2][2]*Hamilton, l *Hamilton, w [2]*Hamilton) [][]*big.Rat {
+	cols := make([][]*big.Rat, 9)
+	for k := 0; k < 8; k++ {
+		e := make([]*big.Rat, 8)
+		for i := range e {
+			e[i] = new(big.Rat)
+		}
+		e[k].SetInt64(1)
+		v := hamiltonPair(e)
+		var img []*big.Rat
+		for i := 0; i < 2; i++ {
+			u := new(Hamilton).Mul(m[i][0], v[0])
+			u.Add(u, new(Hamilton).Mul(m[i][1], v[1]))
+			u.Sub(u, new(Hamilton).Mul(v[i], l))
+			img = append(img, rats(u.Rats())...)
+		}
+		cols[k] = img
+	}
+	cols[8] = append(rats(new(Hamilton).Neg(w[0]).Rats()), rats(new(Hamilton).Neg(w[1]).Rats())...)
+	mat := make([][]*big.Rat, 8)
+	for i := range mat {
+		mat[i] = make([]*big.Rat, 9)
+		for j := range mat[i] {
+			mat[i][j] = cols[j][i]
+		}
+	}
+	return RatMatrixOf(mat).Kernel()
+}
+
+// hamiltonInvertible returns true if the Hamilton matrix with columns u and v
+// is invertible.
+func hamiltonInvertible(u, v [2]*Hamilton) bool {
+	zero := new(Hamilton)
+	if u[0].Equals(zero) {
+		return !u[1].Equals(zero) && !v[0].Equals(zero)
+	}
+	// eliminate u[1] with a right column operation
+	f := new(Hamilton).Mul(new(Hamilton).Inv(u[0]), v[0])
+	t := new(Hamilton).Mul(u[1], f)
+	return !t.Sub(v[1], t).Equals(zero)
+}
+
+// HamiltonNormalForm returns the conjugacy type of the invertible right
+// Möbius transform
+// 		f(y) = (a*y + b) * Inv(c*y + d)
+// together with the coefficients n of its normal form g and the coefficients
+// s of a conjugating transform, so that f∘s = s∘g. The normal form is
+// 		λ1 * y * Inv(λ2)
+// where λ1 and λ2 are the standard representatives of the classes of right
+// eigenvalues returned by EigenR, or
+// 		(λ*y + 1) * Inv(λ)
+// for a parabolic transform. The columns of s are eigenvectors, found exactly
+// by solving a rational linear system. If EigenR fails, then
+// HamiltonNormalForm returns its *EigenError. If the coefficient matrix is
+// singular, then HamiltonNormalForm panics.
+func HamiltonNormalForm(a, b, c, d *Hamilton) (kind MöbiusKind, n, s [4]*Hamilton, err error) {
+	zero := new(Hamilton)
+	one := NewHamilton(big.NewRat(1, 1), new(big.Rat), new(big.Rat), new(big.Rat))
+	m := [2][2]*Hamilton{{a, b}, {c, d}}
+	if b.Equals(zero) && c.Equals(zero) && a.Equals(d) && a.IsReal() {
+		if a.Equals(zero) {
+			panic("singular Möbius transform")
+		}
+		id := [4]*Hamilton{one, zero, zero, one}
+		return MöbiusIdentity, id, id, nil
+	}
+	classes, err := EigenR(a, b, c, d)
+	if err != nil {
+		return kind, n, s, err
+	}
+	none := [2]*Hamilton{zero, zero}
+	eigen := func(l *Hamilton) [][2]*Hamilton {
+		var vs [][2]*Hamilton
+		for _, v := range hamiltonKernel(m, l, none) {
+			if v[8].Sign() == 0 {
+				vs = append(vs, hamiltonPair(v))
+			}
+		}
+		return vs
+	}
+	v1 := eigen(classes[0])
+	if len(v1) == 0 {
+		panic("singular Möbius transform")
+	}
+	if len(classes) == 2 {
+		v2 := eigen(classes[1])
+		for _, u := range v1 {
+			for _, v := range v2 {
+				if hamiltonInvertible(u, v) {
+					l1, l2 := classes[0], classes[1]
+					switch {
+					case l1.Quad().Cmp(l2.Quad()) == 0:
+						kind = MöbiusElliptic
+					case l1.IsReal() && l2.IsReal():
+						kind = MöbiusHyperbolic
+					default:
+						kind = MöbiusLoxodromic
+					}
+					return kind, [4]*Hamilton{l1, zero, zero, l2}, [4]*Hamilton{u[0], v[0], u[1], v[1]}, nil
+				}
+			}
+		}
+		panic("singular Möbius transform")
+	}
+	l := classes[0]
+	for i, u := range v1 {
+		for _, v := range v1[i+1:] {
+			if hamiltonInvertible(u, v) {
+				return MöbiusElliptic, [4]*Hamilton{l, zero, zero, l}, [4]*Hamilton{u[0], v[0], u[1], v[1]}, nil
+			}
+		}
+	}
+	// Jordan chain Mv = u + vλ
+	for _, u := range v1 {
+		for _, v := range hamiltonKernel(m, l, u) {
+			if v[8].Sign() == 0 {
+				continue
+			}
+			for k := range v[:8] {
+				v[k].Quo(v[k], v[8])
+			}
+			w := hamiltonPair(v)
+			if hamiltonInvertible(u, w) {
+				return MöbiusParabolic, [4]*Hamilton{l, one, zero, l}, [4]*Hamilton{u[0], w[0], u[1], w[1]}, nil
+			}
+		}
+	}
+	panic("singular Möbius transform")
+}
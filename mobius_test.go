@@ -0,0 +1,202 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// complexMat returns the product of the 2×2 Complex matrices x and y, with
+// entries in row-major order.
+func complexMat(x, y [4]*Complex) [4]*Complex {
+	var z [4]*Complex
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			z[2*i+j] = new(Complex).Mul(x[2*i], y[j])
+			z[2*i+j].Add(z[2*i+j], new(Complex).Mul(x[2*i+1], y[2+j]))
+		}
+	}
+	return z
+}
+
+// complexConjugated returns s g adj(s), the coefficients of a Möbius
+// transform with normal form g.
+func complexConjugated(s, g [4]*Complex) [4]*Complex {
+	adj := [4]*Complex{s[3], new(Complex).Neg(s[1]), new(Complex).Neg(s[2]), s[0]}
+	return complexMat(complexMat(s, g), adj)
+}
+
+// complexProportional returns true if the matrices x and y are proportional.
+func complexProportional(x, y [4]*Complex) bool {
+	for i := range x {
+		for j := range y {
+			if !new(Complex).Mul(x[i], y[j]).Equals(new(Complex).Mul(x[j], y[i])) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestComplexNormalForm(t *testing.T) {
+	zero := new(Complex)
+	one := NewComplex(big.NewRat(1, 1), new(big.Rat))
+	f := func(s0, s1, s2, s3, k *Complex) bool {
+		// t.Logf("s = %v, %v, %v, %v, k = %v", s0, s1, s2, s3, k)
+		s := [4]*Complex{s0, s1, s2, s3}
+		det := new(Complex).Mul(s0, s3)
+		if det.Sub(det, new(Complex).Mul(s1, s2)).Equals(zero) || k.Equals(zero) {
+			return true
+		}
+		for _, g := range [][4]*Complex{{k, zero, zero, one}, {one, one, zero, one}} {
+			m := complexConjugated(s, g)
+			kind, n, c, err := ComplexNormalForm(m[0], m[1], m[2], m[3])
+			if err != nil {
+				t.Log(err)
+				return false
+			}
+			if kind == MöbiusIdentity {
+				if !k.Equals(one) || g[1].Equals(one) {
+					return false
+				}
+				continue
+			}
+			if !complexProportional(complexMat(m, c), complexMat(c, n)) {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexNormalFormKind(t *testing.T) {
+	zero := new(Complex)
+	one := NewComplex(big.NewRat(1, 1), new(big.Rat))
+	s := [4]*Complex{
+		NewComplex(big.NewRat(1, 1), big.NewRat(2, 1)), one,
+		NewComplex(big.NewRat(-3, 1), new(big.Rat)), NewComplex(new(big.Rat), big.NewRat(1, 2)),
+	}
+	for _, test := range []struct {
+		k    *Complex
+		kind MöbiusKind
+	}{
+		{NewComplex(big.NewRat(3, 5), big.NewRat(4, 5)), MöbiusElliptic},
+		{NewComplex(big.NewRat(-7, 2), new(big.Rat)), MöbiusHyperbolic},
+		{NewComplex(big.NewRat(1, 1), big.NewRat(1, 1)), MöbiusLoxodromic},
+	} {
+		m := complexConjugated(s, [4]*Complex{test.k, zero, zero, one})
+		if kind, _, _, err := ComplexNormalForm(m[0], m[1], m[2], m[3]); err != nil || kind != test.kind {
+			t.Errorf("ComplexNormalForm(%v) = %v, %v, want %v", m, kind, err, test.kind)
+		}
+	}
+	two := NewComplex(big.NewRat(2, 1), new(big.Rat))
+	_, _, _, err := ComplexNormalForm(zero, one, two, zero)
+	if _, ok := err.(*NormalFormError); !ok {
+		t.Errorf("ComplexNormalForm returned %v, want *NormalFormError", err)
+	}
+}
+
+func TestPerplexNormalForm(t *testing.T) {
+	zero := new(Perplex)
+	one := NewPerplex(big.NewRat(1, 1), new(big.Rat))
+	mul := func(x, y [4]*Perplex) [4]*Perplex {
+		var z [4]*Perplex
+		for i := 0; i < 2; i++ {
+			for j := 0; j < 2; j++ {
+				z[2*i+j] = new(Perplex).Mul(x[2*i], y[j])
+				z[2*i+j].Add(z[2*i+j], new(Perplex).Mul(x[2*i+1], y[2+j]))
+			}
+		}
+		return z
+	}
+	s := [4]*Perplex{
+		NewPerplex(big.NewRat(2, 1), big.NewRat(1, 1)), one,
+		NewPerplex(big.NewRat(-1, 1), big.NewRat(3, 1)), NewPerplex(new(big.Rat), big.NewRat(1, 2)),
+	}
+	adj := [4]*Perplex{s[3], new(Perplex).Neg(s[1]), new(Perplex).Neg(s[2]), s[0]}
+	for _, test := range []struct {
+		g    [4]*Perplex
+		kind MöbiusKind
+	}{
+		{[4]*Perplex{NewPerplex(big.NewRat(5, 4), big.NewRat(3, 4)), zero, zero, one}, MöbiusHyperbolic},
+		{[4]*Perplex{NewPerplex(big.NewRat(3, 1), new(big.Rat)), zero, zero, one}, MöbiusHyperbolic},
+		{[4]*Perplex{NewPerplex(big.NewRat(-1, 1), new(big.Rat)), zero, zero, one}, MöbiusElliptic},
+		{[4]*Perplex{NewPerplex(big.NewRat(1, 1), big.NewRat(2, 1)), zero, zero, one}, MöbiusLoxodromic},
+		{[4]*Perplex{NewPerplex(big.NewRat(2, 1), new(big.Rat)), zero, zero, NewPerplex(big.NewRat(1, 1), big.NewRat(2, 1))}, MöbiusLoxodromic},
+		{[4]*Perplex{one, one, zero, one}, MöbiusParabolic},
+	} {
+		m := mul(mul(s, test.g), adj)
+		kind, n, c, err := PerplexNormalForm(m[0], m[1], m[2], m[3])
+		if err != nil || kind != test.kind {
+			t.Errorf("PerplexNormalForm(%v) = %v, %v, want %v", m, kind, err, test.kind)
+			continue
+		}
+		x, y := mul(m, c), mul(c, n)
+		for i := range x {
+			for j := range y {
+				if !new(Perplex).Mul(x[i], y[j]).Equals(new(Perplex).Mul(x[j], y[i])) {
+					t.Errorf("PerplexNormalForm(%v): %v does not conjugate to %v", m, c, n)
+				}
+			}
+		}
+	}
+}
+
+// hamiltonMat returns the product of the 2×2 Hamilton matrices x and y, with
+// entries in row-major order.
+func hamiltonMat(x, y [4]*Hamilton) [4]*Hamilton {
+	var z [4]*Hamilton
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			z[2*i+j] = new(Hamilton).Mul(x[2*i], y[j])
+			z[2*i+j].Add(z[2*i+j], new(Hamilton).Mul(x[2*i+1], y[2+j]))
+		}
+	}
+	return z
+}
+
+func TestHamiltonNormalForm(t *testing.T) {
+	zero := new(Hamilton)
+	one := NewHamilton(big.NewRat(1, 1), new(big.Rat), new(big.Rat), new(big.Rat))
+	// unipotent conjugation: s = [[1, u], [0, 1]] [[1, 0], [v, 1]]
+	u := NewHamilton(big.NewRat(1, 1), big.NewRat(2, 1), new(big.Rat), big.NewRat(-1, 1))
+	v := NewHamilton(new(big.Rat), big.NewRat(1, 1), big.NewRat(1, 2), new(big.Rat))
+	s := hamiltonMat([4]*Hamilton{one, u, zero, one}, [4]*Hamilton{one, zero, v, one})
+	inv := hamiltonMat([4]*Hamilton{one, zero, new(Hamilton).Neg(v), one}, [4]*Hamilton{one, new(Hamilton).Neg(u), zero, one})
+	i := NewHamilton(new(big.Rat), big.NewRat(1, 1), new(big.Rat), new(big.Rat))
+	j := NewHamilton(new(big.Rat), new(big.Rat), big.NewRat(1, 1), new(big.Rat))
+	for _, test := range []struct {
+		g    [4]*Hamilton
+		kind MöbiusKind
+	}{
+		{[4]*Hamilton{NewHamilton(big.NewRat(3, 1), big.NewRat(4, 1), new(big.Rat), new(big.Rat)), zero, zero, j}, MöbiusLoxodromic},
+		{[4]*Hamilton{NewHamilton(big.NewRat(3, 1), new(big.Rat), new(big.Rat), new(big.Rat)), zero, zero, one}, MöbiusHyperbolic},
+		{[4]*Hamilton{i, zero, zero, j}, MöbiusElliptic},
+		{[4]*Hamilton{one, one, zero, one}, MöbiusParabolic},
+		{[4]*Hamilton{i, one, zero, i}, MöbiusParabolic},
+	} {
+		m := hamiltonMat(hamiltonMat(s, test.g), inv)
+		kind, n, c, err := HamiltonNormalForm(m[0], m[1], m[2], m[3])
+		if err != nil || kind != test.kind {
+			t.Errorf("HamiltonNormalForm(%v) = %v, %v, want %v", m, kind, err, test.kind)
+			continue
+		}
+		x, y := hamiltonMat(m, c), hamiltonMat(c, n)
+		for k := range x {
+			if !x[k].Equals(y[k]) {
+				t.Errorf("HamiltonNormalForm(%v): %v does not conjugate to %v", m, c, n)
+				break
+			}
+		}
+	}
+	two := NewHamilton(big.NewRat(2, 1), new(big.Rat), new(big.Rat), new(big.Rat))
+	if _, _, _, err := HamiltonNormalForm(zero, one, two, zero); err == nil {
+		t.Error("HamiltonNormalForm found rational eigenvalues ±√2")
+	}
+}
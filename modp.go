@@ -0,0 +1,517 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"fmt"
+	"iter"
+	"math/big"
+)
+
+// A ModError reports a value that cannot be reduced modulo p.
+type ModError struct {
+	P   *big.Int
+	Msg string
+}
+
+func (e *ModError) Error() string {
+	return fmt.Sprintf("rational: mod %v: %s", e.P, e.Msg)
+}
+
+// A ModAlgebra is a construct of this package over the finite field Z/p, with
+// the same basis units and the same structure constants reduced modulo p.
+type ModAlgebra struct {
+	Name  string
+	P     *big.Int
+	basis []string
+	// e_i e_j = Σ table[i][j][k] e_k
+	table [][][]*big.Int
+}
+
+// newModAlgebra returns the type with the given name over Z/p. If p is not
+// prime, then newModAlgebra returns a *ModError.
+func newModAlgebra(name string, p *big.Int) (*ModAlgebra, error) {
+	if !p.ProbablyPrime(20) {
+		return nil, &ModError{new(big.Int).Set(p), "not a prime"}
+	}
+	for _, a := range algebras {
+		impl := a.impl()
+		if impl.Name != name {
+			continue
+		}
+		c := &ModAlgebra{name, new(big.Int).Set(p), a.basis, make([][][]*big.Int, impl.Dim)}
+		for i := range c.table {
+			c.table[i] = make([][]*big.Int, impl.Dim)
+			for j := range c.table[i] {
+				e := impl.Ops["Mul"](unit(impl.Dim, i), unit(impl.Dim, j))
+				c.table[i][j] = make([]*big.Int, impl.Dim)
+				for k, x := range e {
+					// the structure constants are integers
+					c.table[i][j][k] = new(big.Int).Mod(x.Num(), p)
+				}
+			}
+		}
+		return c, nil
+	}
+	panic("unknown type " + name)
+}
+
+// Dim returns the dimension of c over Z/p.
+func (c *ModAlgebra) Dim() int {
+	return len(c.table)
+}
+
+// zero returns the zero value of c.
+func (c *ModAlgebra) zero() *ModP {
+	z := &ModP{c, make([]*big.Int, c.Dim())}
+	for k := range z.v {
+		z.v[k] = new(big.Int)
+	}
+	return z
+}
+
+// All returns an iterator over the p^Dim elements of c, in increasing order of
+// their components read as digits in base p, with the last component most
+// significant. The iterator reuses no values, so they may be kept.
+func (c *ModAlgebra) All() iter.Seq[*ModP] {
+	return func(yield func(*ModP) bool) {
+		x := c.zero()
+		for {
+			if !yield(new(ModP).Set(x)) {
+				return
+			}
+			k := 0
+			for ; k < len(x.v); k++ {
+				if x.v[k].Add(x.v[k], big.NewInt(1)).Cmp(c.P) < 0 {
+					break
+				}
+				x.v[k].SetInt64(0)
+			}
+			if k == len(x.v) {
+				return
+			}
+		}
+	}
+}
+
+// A ModP represents a value of a ModAlgebra, with components in Z/p given by
+// their representatives 0, 1, ..., p-1. The zero value has no algebra, and
+// takes the algebra of the first value it is combined with.
+type ModP struct {
+	alg *ModAlgebra
+	v   []*big.Int
+}
+
+// reduceMod returns the value with components v of the type with the given
+// name, reduced modulo p. A component whose denominator is divisible by p
+// has no reduction, and then reduceMod returns a *ModError, as it does if p
+// is not prime.
+func reduceMod(name string, v []*big.Rat, p *big.Int) (*ModP, error) {
+	c, err := newModAlgebra(name, p)
+	if err != nil {
+		return nil, err
+	}
+	z := c.zero()
+	for k, x := range v {
+		inv := new(big.Int).ModInverse(x.Denom(), p)
+		if inv == nil {
+			return nil, &ModError{c.P, fmt.Sprintf("denominator of %v is divisible by p", x.RatString())}
+		}
+		z.v[k].Mul(x.Num(), inv)
+		z.v[k].Mod(z.v[k], p)
+	}
+	return z, nil
+}
+
+// Algebra returns the algebra of z.
+func (z *ModP) Algebra() *ModAlgebra {
+	return z.alg
+}
+
+// Components returns the components of z, along the basis units of its
+// algebra. The results alias z.
+func (z *ModP) Components() []*big.Int {
+	return z.v
+}
+
+// String returns the string version of a ModP value, with the basis units of
+// its type and the modulus, such as "(1+2i mod 3)".
+func (z *ModP) String() string {
+	v := make([]*big.Rat, len(z.v))
+	for k, x := range z.v {
+		v[k] = new(big.Rat).SetInt(x)
+	}
+	br := brackets()
+	return fmt.Sprintf("%s%s mod %v%s", br[0], formatBasis(v, z.alg.basis), z.alg.P, br[1])
+}
+
+// sameModAlgebra returns true if c and d are the same type over the same
+// field.
+func sameModAlgebra(c, d *ModAlgebra) bool {
+	return c == d || c.Name == d.Name && c.P.Cmp(d.P) == 0
+}
+
+// Equals returns true if y and z are equal values of the same algebra.
+func (z *ModP) Equals(y *ModP) bool {
+	if !sameModAlgebra(z.alg, y.alg) {
+		return false
+	}
+	for k := range z.v {
+		if z.v[k].Cmp(y.v[k]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// use sets the algebra of z to c, and returns z. If z already has another
+// algebra, then use panics.
+func (z *ModP) use(c *ModAlgebra) *ModP {
+	switch {
+	case z.alg == nil:
+		*z = *c.zero()
+	case !sameModAlgebra(z.alg, c):
+		panic("different algebras")
+	}
+	return z
+}
+
+// Set sets z equal to y, and returns z.
+func (z *ModP) Set(y *ModP) *ModP {
+	z.use(y.alg)
+	for k := range z.v {
+		z.v[k].Set(y.v[k])
+	}
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *ModP) Add(x, y *ModP) *ModP {
+	z.use(x.alg).use(y.alg)
+	for k := range z.v {
+		z.v[k].Add(x.v[k], y.v[k])
+		z.v[k].Mod(z.v[k], z.alg.P)
+	}
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *ModP) Sub(x, y *ModP) *ModP {
+	z.use(x.alg).use(y.alg)
+	for k := range z.v {
+		z.v[k].Sub(x.v[k], y.v[k])
+		z.v[k].Mod(z.v[k], z.alg.P)
+	}
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *ModP) Neg(y *ModP) *ModP {
+	z.use(y.alg)
+	for k := range z.v {
+		z.v[k].Neg(y.v[k])
+		z.v[k].Mod(z.v[k], z.alg.P)
+	}
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *ModP) Scal(y *ModP, a *big.Int) *ModP {
+	z.use(y.alg)
+	for k := range z.v {
+		z.v[k].Mul(y.v[k], a)
+		z.v[k].Mod(z.v[k], z.alg.P)
+	}
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z. The product
+// follows the Mul of the type, through its structure constants.
+func (z *ModP) Mul(x, y *ModP) *ModP {
+	c := z.use(x.alg).use(y.alg).alg
+	p := make([]*big.Int, c.Dim())
+	for k := range p {
+		p[k] = new(big.Int)
+	}
+	temp := new(big.Int)
+	for i, a := range x.v {
+		if a.Sign() == 0 {
+			continue
+		}
+		for j, b := range y.v {
+			if b.Sign() == 0 {
+				continue
+			}
+			ab := new(big.Int).Mul(a, b)
+			for k, t := range c.table[i][j] {
+				p[k].Add(p[k], temp.Mul(ab, t))
+			}
+		}
+	}
+	for k := range z.v {
+		z.v[k].Mod(p[k], c.P)
+	}
+	return z
+}
+
+// leftInverse returns the solution x of yx = 1, found by Gauss-Jordan
+// elimination modulo p on the matrix of left multiplication by y, and whether
+// it exists.
+func leftInverse(y *ModP) (*ModP, bool) {
+	c, n := y.alg, y.alg.Dim()
+	// the augmented matrix [L | e_0], with L[k][j] the k-th component of ye_j
+	m := make([][]*big.Int, n)
+	for k := range m {
+		m[k] = make([]*big.Int, n+1)
+		for j := range m[k] {
+			m[k][j] = new(big.Int)
+		}
+		if k == 0 {
+			m[k][n].SetInt64(1)
+		}
+	}
+	temp := new(big.Int)
+	for i, a := range y.v {
+		for j := 0; j < n; j++ {
+			for k, t := range c.table[i][j] {
+				m[k][j].Add(m[k][j], temp.Mul(a, t))
+			}
+		}
+	}
+	for col := 0; col < n; col++ {
+		row := col
+		for row < n && new(big.Int).Mod(m[row][col], c.P).Sign() == 0 {
+			row++
+		}
+		if row == n {
+			return nil, false
+		}
+		m[col], m[row] = m[row], m[col]
+		inv := new(big.Int).ModInverse(new(big.Int).Mod(m[col][col], c.P), c.P)
+		for j := range m[col] {
+			m[col][j].Mul(m[col][j], inv)
+			m[col][j].Mod(m[col][j], c.P)
+		}
+		for r := range m {
+			if r == col || m[r][col].Sign() == 0 {
+				continue
+			}
+			f := new(big.Int).Set(m[r][col])
+			for j := range m[r] {
+				m[r][j].Sub(m[r][j], temp.Mul(f, m[col][j]))
+				m[r][j].Mod(m[r][j], c.P)
+			}
+		}
+	}
+	x := c.zero()
+	for k := range x.v {
+		x.v[k].Set(m[k][n])
+	}
+	return x, true
+}
+
+// Inv sets z equal to the inverse of y, the solution x of yx = 1, and returns
+// z. In the associative and alternative types this is also the solution of
+// xy = 1. If y has no inverse, which happens for more values than over the
+// rationals since p may split the quadratic form, then Inv panics.
+func (z *ModP) Inv(y *ModP) *ModP {
+	x, ok := leftInverse(y)
+	if !ok {
+		panic(divisorError("inverse of zero divisor"))
+	}
+	return z.Set(x)
+}
+
+// InvErr sets z equal to the inverse of y, and returns z. If y has no inverse,
+// then z is left unchanged and InvErr returns a *DivisionError instead of
+// panicking.
+func (z *ModP) InvErr(y *ModP) (*ModP, error) {
+	x, ok := leftInverse(y)
+	if !ok {
+		return nil, &DivisionError{"ModP", "Inv", new(ModP).Set(y)}
+	}
+	return z.Set(x), nil
+}
+
+// ReduceMod returns z reduced modulo the prime p, a Complex value over Z/p. If
+// p is not prime, or if p divides the denominator of a component of z, then
+// ReduceMod returns a *ModError.
+func (z *Complex) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("Complex", z.Components(), p)
+}
+
+// ReduceMod returns z reduced modulo the prime p, an Infra value over Z/p. If p
+// is not prime, or if p divides the denominator of a component of z, then
+// ReduceMod returns a *ModError.
+func (z *Infra) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("Infra", z.Components(), p)
+}
+
+// ReduceMod returns z reduced modulo the prime p, a Perplex value over Z/p. If
+// p is not prime, or if p divides the denominator of a component of z, then
+// ReduceMod returns a *ModError.
+func (z *Perplex) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("Perplex", z.Components(), p)
+}
+
+// ReduceMod returns z reduced modulo the prime p, a BiComplex value over Z/p.
+// If p is not prime, or if p divides the denominator of a component of z, then
+// ReduceMod returns a *ModError.
+func (z *BiComplex) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("BiComplex", z.Components(), p)
+}
+
+// ReduceMod returns z reduced modulo the prime p, a BiPerplex value over Z/p.
+// If p is not prime, or if p divides the denominator of a component of z, then
+// ReduceMod returns a *ModError.
+func (z *BiPerplex) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("BiPerplex", z.Components(), p)
+}
+
+// ReduceMod returns z reduced modulo the prime p, a Cockle value over Z/p. If
+// p is not prime, or if p divides the denominator of a component of z, then
+// ReduceMod returns a *ModError.
+func (z *Cockle) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("Cockle", z.Components(), p)
+}
+
+// ReduceMod returns z reduced modulo the prime p, a DualComplex value over
+// Z/p. If p is not prime, or if p divides the denominator of a component of z,
+// then ReduceMod returns a *ModError.
+func (z *DualComplex) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("DualComplex", z.Components(), p)
+}
+
+// ReduceMod returns z reduced modulo the prime p, a DualPerplex value over
+// Z/p. If p is not prime, or if p divides the denominator of a component of z,
+// then ReduceMod returns a *ModError.
+func (z *DualPerplex) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("DualPerplex", z.Components(), p)
+}
+
+// ReduceMod returns z reduced modulo the prime p, a Hamilton value over Z/p.
+// If p is not prime, or if p divides the denominator of a component of z, then
+// ReduceMod returns a *ModError.
+func (z *Hamilton) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("Hamilton", z.Components(), p)
+}
+
+// ReduceMod returns z reduced modulo the prime p, a Hyper value over Z/p. If p
+// is not prime, or if p divides the denominator of a component of z, then
+// ReduceMod returns a *ModError.
+func (z *Hyper) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("Hyper", z.Components(), p)
+}
+
+// ReduceMod returns z reduced modulo the prime p, an InfraComplex value over
+// Z/p. If p is not prime, or if p divides the denominator of a component of z,
+// then ReduceMod returns a *ModError.
+func (z *InfraComplex) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("InfraComplex", z.Components(), p)
+}
+
+// ReduceMod returns z reduced modulo the prime p, an InfraPerplex value over
+// Z/p. If p is not prime, or if p divides the denominator of a component of z,
+// then ReduceMod returns a *ModError.
+func (z *InfraPerplex) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("InfraPerplex", z.Components(), p)
+}
+
+// ReduceMod returns z reduced modulo the prime p, a Supra value over Z/p. If p
+// is not prime, or if p divides the denominator of a component of z, then
+// ReduceMod returns a *ModError.
+func (z *Supra) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("Supra", z.Components(), p)
+}
+
+// ReduceMod returns z reduced modulo the prime p, a BiCockle value over Z/p.
+// If p is not prime, or if p divides the denominator of a component of z, then
+// ReduceMod returns a *ModError.
+func (z *BiCockle) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("BiCockle", z.Components(), p)
+}
+
+// ReduceMod returns z reduced modulo the prime p, a BiHamilton value over Z/p.
+// If p is not prime, or if p divides the denominator of a component of z, then
+// ReduceMod returns a *ModError.
+func (z *BiHamilton) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("BiHamilton", z.Components(), p)
+}
+
+// ReduceMod returns z reduced modulo the prime p, a Cayley value over Z/p. If
+// p is not prime, or if p divides the denominator of a component of z, then
+// ReduceMod returns a *ModError.
+func (z *Cayley) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("Cayley", z.Components(), p)
+}
+
+// ReduceMod returns z reduced modulo the prime p, a DualHamilton value over
+// Z/p. If p is not prime, or if p divides the denominator of a component of z,
+// then ReduceMod returns a *ModError.
+func (z *DualHamilton) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("DualHamilton", z.Components(), p)
+}
+
+// ReduceMod returns z reduced modulo the prime p, an InfraCockle value over
+// Z/p. If p is not prime, or if p divides the denominator of a component of z,
+// then ReduceMod returns a *ModError.
+func (z *InfraCockle) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("InfraCockle", z.Components(), p)
+}
+
+// ReduceMod returns z reduced modulo the prime p, an InfraHamilton value over
+// Z/p. If p is not prime, or if p divides the denominator of a component of z,
+// then ReduceMod returns a *ModError.
+func (z *InfraHamilton) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("InfraHamilton", z.Components(), p)
+}
+
+// ReduceMod returns z reduced modulo the prime p, a SupraComplex value over
+// Z/p. If p is not prime, or if p divides the denominator of a component of z,
+// then ReduceMod returns a *ModError.
+func (z *SupraComplex) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("SupraComplex", z.Components(), p)
+}
+
+// ReduceMod returns z reduced modulo the prime p, a SupraPerplex value over
+// Z/p. If p is not prime, or if p divides the denominator of a component of z,
+// then ReduceMod returns a *ModError.
+func (z *SupraPerplex) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("SupraPerplex", z.Components(), p)
+}
+
+// ReduceMod returns z reduced modulo the prime p, a TriComplex value over Z/p.
+// If p is not prime, or if p divides the denominator of a component of z, then
+// ReduceMod returns a *ModError.
+func (z *TriComplex) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("TriComplex", z.Components(), p)
+}
+
+// ReduceMod returns z reduced modulo the prime p, a TriNilplex value over Z/p.
+// If p is not prime, or if p divides the denominator of a component of z, then
+// ReduceMod returns a *ModError.
+func (z *TriNilplex) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("TriNilplex", z.Components(), p)
+}
+
+// ReduceMod returns z reduced modulo the prime p, a TriPerplex value over Z/p.
+// If p is not prime, or if p divides the denominator of a component of z, then
+// ReduceMod returns a *ModError.
+func (z *TriPerplex) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("TriPerplex", z.Components(), p)
+}
+
+// ReduceMod returns z reduced modulo the prime p, an Ultra value over Z/p. If p
+// is not prime, or if p divides the denominator of a component of z, then
+// ReduceMod returns a *ModError.
+func (z *Ultra) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("Ultra", z.Components(), p)
+}
+
+// ReduceMod returns z reduced modulo the prime p, a Zorn value over Z/p. If p
+// is not prime, or if p divides the denominator of a component of z, then
+// ReduceMod returns a *ModError.
+func (z *Zorn) ReduceMod(p *big.Int) (*ModP, error) {
+	return reduceMod("Zorn", z.Components(), p)
+}
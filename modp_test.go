@@ -0,0 +1,108 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// integral replaces each component of v with its numerator.
+func integral(v []*big.Rat) {
+	for _, c := range v {
+		c.SetInt(c.Num())
+	}
+}
+
+func TestReduceModMul(t *testing.T) {
+	p := big.NewInt(7)
+	f := func(x, y *Cayley) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		integral(x.Components())
+		integral(y.Components())
+		a, _ := x.ReduceMod(p)
+		b, _ := y.ReduceMod(p)
+		xy, _ := new(Cayley).Mul(x, y).ReduceMod(p)
+		if !new(ModP).Mul(a, b).Equals(xy) {
+			return false
+		}
+		// a is invertible exactly when p does not divide the norm of x
+		inv, err := new(ModP).InvErr(a)
+		if err != nil {
+			return new(big.Int).Mod(x.Quad().Num(), p).Sign() == 0
+		}
+		one := must(NewCayley(big.NewRat(1, 1), new(big.Rat), new(big.Rat), new(big.Rat),
+			new(big.Rat), new(big.Rat), new(big.Rat), new(big.Rat)).ReduceMod(p))
+		return new(ModP).Mul(a, inv).Equals(one) && new(ModP).Mul(inv, a).Equals(one)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+	g := func(x, y *Ultra) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a, err := x.ReduceMod(p)
+		if err != nil {
+			return true
+		}
+		b, err := y.ReduceMod(p)
+		if err != nil {
+			return true
+		}
+		xy, _ := new(Ultra).Mul(x, y).ReduceMod(p)
+		return new(ModP).Mul(a, b).Equals(xy) &&
+			new(ModP).Add(a, b).Equals(must(new(Ultra).Add(x, y).ReduceMod(p)))
+	}
+	if err := quick.Check(g, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func must(x *ModP, err error) *ModP {
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+func TestReduceModUnits(t *testing.T) {
+	// Complex is a field modulo primes equal to 3 modulo 4, while Perplex has
+	// the 2p-1 non-invertible values with a² = b², and Hamilton modulo p is
+	// the 2×2 matrices over Z/p, with p⁴ - (p²-1)(p²-p) singular ones
+	for _, test := range []struct {
+		x        interface{ ReduceMod(*big.Int) (*ModP, error) }
+		p        int64
+		singular int
+	}{
+		{new(Complex), 3, 1},
+		{new(Complex), 5, 9},
+		{new(Perplex), 5, 9},
+		{new(Infra), 5, 5},
+		{new(Hamilton), 3, 33},
+	} {
+		z, err := test.x.ReduceMod(big.NewInt(test.p))
+		if err != nil {
+			t.Fatal(err)
+		}
+		n := 0
+		for x := range z.Algebra().All() {
+			if _, err := new(ModP).InvErr(x); err != nil {
+				n++
+			}
+		}
+		if n != test.singular {
+			t.Errorf("%s mod %d: %d non-invertible values, want %d", z.Algebra().Name, test.p, n, test.singular)
+		}
+	}
+	x := NewComplex(big.NewRat(1, 3), big.NewRat(0, 1))
+	if _, err := x.ReduceMod(big.NewInt(3)); err == nil {
+		t.Errorf("ReduceMod(%v, 3) did not fail", x)
+	}
+	if v, err := x.ReduceMod(big.NewInt(5)); err != nil || v.Components()[0].Int64() != 2 {
+		t.Errorf("ReduceMod(%v, 5) = %v, %v", x, v, err)
+	}
+	if _, err := x.ReduceMod(big.NewInt(6)); err == nil {
+		t.Errorf("ReduceMod(%v, 6) did not fail", x)
+	}
+}
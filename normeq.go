@@ -0,0 +1,179 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+)
+
+// A NormEquationError reports that the integral solutions of a norm equation
+// could not be listed.
+type NormEquationError struct {
+	Op  string       // the failing function
+	N   fmt.Stringer // the right-hand side
+	Msg string
+}
+
+func (e *NormEquationError) Error() string {
+	return fmt.Sprintf("rational: %s: %s: %v", e.Op, e.Msg, e.N)
+}
+
+// intDivisors returns the positive and negative divisors of the non-zero
+// integer n, found by trial division up to √|n|.
+func intDivisors(n *big.Int) []*big.Int {
+	a := new(big.Int).Abs(n)
+	var small, large []*big.Int
+	d, q, r := big.NewInt(1), new(big.Int), new(big.Int)
+	root := new(big.Int).Sqrt(a)
+	for ; d.Cmp(root) <= 0; d.Add(d, big.NewInt(1)) {
+		if q.QuoRem(a, d, r); r.Sign() == 0 {
+			small = append(small, new(big.Int).Set(d))
+			if q.Cmp(d) != 0 {
+				large = append(large, new(big.Int).Set(q))
+			}
+		}
+	}
+	slices.Reverse(large)
+	pos := append(small, large...)
+	all := make([]*big.Int, 0, 2*len(pos))
+	for _, d := range pos {
+		all = append(all, new(big.Int).Neg(d), d)
+	}
+	return all
+}
+
+// halfInt returns (x + y)/2 if it is an integer.
+func halfInt(x, y *big.Int) (*big.Int, bool) {
+	s := new(big.Int).Add(x, y)
+	if s.Bit(0) != 0 {
+		return nil, false
+	}
+	return s.Rsh(s, 1), true
+}
+
+// factorPairs calls f with every pair of integers (d, n/d) with d ≡ n/d
+// modulo 2, together with (d + n/d)/2 and (n/d - d)/2. These are the
+// solutions of the null coordinates p = a+b and q = a-b of a² - b² = n. If n
+// is zero, then factorPairs panics.
+func factorPairs(n *big.Int, f func(a, b *big.Int)) {
+	for _, d := range intDivisors(n) {
+		e := new(big.Int).Quo(n, d)
+		a, ok := halfInt(e, d)
+		if !ok {
+			continue
+		}
+		b, _ := halfInt(e, new(big.Int).Neg(d))
+		f(a, b)
+	}
+}
+
+// fundamental returns one solution of each orbit of sols under
+// multiplication by units, choosing the largest one by cmp. The solutions
+// are sorted by cmp.
+func fundamental[T any](sols, units []T, mul func(x, y T) T, cmp func(x, y T) int) []T {
+	slices.SortFunc(sols, cmp)
+	var fund []T
+	seen := make([]bool, len(sols))
+	for i := len(sols) - 1; i >= 0; i-- {
+		if seen[i] {
+			continue
+		}
+		fund = append(fund, sols[i])
+		for _, u := range units {
+			ux := mul(u, sols[i])
+			if j, ok := slices.BinarySearchFunc(sols, ux, cmp); ok {
+				seen[j] = true
+			}
+		}
+	}
+	slices.Reverse(fund)
+	return fund
+}
+
+// perplexNormSolutions returns every Perplex integer x with x Conj(x) = n.
+func perplexNormSolutions(n *big.Int) []*Perplex {
+	var sols []*Perplex
+	factorPairs(n, func(a, b *big.Int) {
+		sols = append(sols, NewPerplex(new(big.Rat).SetInt(a), new(big.Rat).SetInt(b)))
+	})
+	return sols
+}
+
+// SolveNormPerplex solves the norm equation
+// 		x Conj(x) = a² - b² = n
+// over the Perplex integers x = a+bs, with a and b integers. The equation
+// factors as (a+b)(a-b) = n, so every solution comes from a pair of divisors
+// of n of the same parity, and there are finitely many. The units of norm 1
+// are ±1, and SolveNormPerplex returns them with the fundamental solutions,
+// one from each pair ±x, in increasing order: every solution is a unit times
+// a fundamental solution. The divisors are found by trial division, so n
+// should be of moderate size. If n is zero, then the solutions are the
+// integer multiples of 1+s and 1-s, and SolveNormPerplex returns a
+// *NormEquationError.
+func SolveNormPerplex(n *big.Int) (fund, units []*Perplex, err error) {
+	if n.Sign() == 0 {
+		return nil, nil, &NormEquationError{"SolveNormPerplex", n,
+			"infinitely many solutions, the integer multiples of 1+s and 1-s"}
+	}
+	units = perplexNormSolutions(big.NewInt(1))
+	slices.SortFunc(units, (*Perplex).Cmp)
+	mul := func(x, y *Perplex) *Perplex {
+		return new(Perplex).Mul(x, y)
+	}
+	return fundamental(perplexNormSolutions(n), units, mul, (*Perplex).Cmp), units, nil
+}
+
+// biPerplexNormSolutions returns every BiPerplex integer x with
+// x Conj(x) = n, whose null coordinates n₊ and n₋ are non-zero integers.
+func biPerplexNormSolutions(np, nm *big.Int) []*BiPerplex {
+	var sols []*BiPerplex
+	// l₊ and r₊ solve l₊² - r₊² = n₊, and l₋ and r₋ solve l₋² - r₋² = n₋
+	factorPairs(np, func(lp, rp *big.Int) {
+		factorPairs(nm, func(lm, rm *big.Int) {
+			// l = a+bs with a+b = l₊ and a-b = l₋, and r likewise
+			a, ok1 := halfInt(lp, lm)
+			b, ok2 := halfInt(lp, new(big.Int).Neg(lm))
+			c, ok3 := halfInt(rp, rm)
+			d, ok4 := halfInt(rp, new(big.Int).Neg(rm))
+			if ok1 && ok2 && ok3 && ok4 {
+				sols = append(sols, NewBiPerplex(new(big.Rat).SetInt(a), new(big.Rat).SetInt(b),
+					new(big.Rat).SetInt(c), new(big.Rat).SetInt(d)))
+			}
+		})
+	})
+	return sols
+}
+
+// SolveNormBiPerplex solves the norm equation
+// 		x Conj(x) = n
+// over the BiPerplex integers x, with integer components, where n = a+bs is a
+// Perplex integer. Along the null coordinates a+b and a-b of Perplex, the
+// equation splits into two equations of the kind solved by
+// SolveNormPerplex, whose solutions are combined when the result has integer
+// components. There are finitely many solutions, and the units of norm 1 are
+// ±1 and ±s. SolveNormBiPerplex returns the units with the
+// fundamental solutions, one from each orbit under the units, in increasing
+// order: every solution is a unit times a fundamental solution. If n is not a
+// Perplex integer, then there are no solutions. If n is a zero divisor, then
+// there are infinitely many, and SolveNormBiPerplex returns a
+// *NormEquationError.
+func SolveNormBiPerplex(n *Perplex) (fund, units []*BiPerplex, err error) {
+	if n.IsZeroDivisor() {
+		return nil, nil, &NormEquationError{"SolveNormBiPerplex", new(Perplex).Set(n),
+			"infinitely many solutions"}
+	}
+	if !n.l.IsInt() || !n.r.IsInt() {
+		return nil, nil, nil
+	}
+	np := new(big.Int).Add(n.l.Num(), n.r.Num())
+	nm := new(big.Int).Sub(n.l.Num(), n.r.Num())
+	units = biPerplexNormSolutions(big.NewInt(1), big.NewInt(1))
+	slices.SortFunc(units, (*BiPerplex).Cmp)
+	mul := func(x, y *BiPerplex) *BiPerplex {
+		return new(BiPerplex).Mul(x, y)
+	}
+	return fundamental(biPerplexNormSolutions(np, nm), units, mul, (*BiPerplex).Cmp), units, nil
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestSolveNormPerplex(t *testing.T) {
+	// a² - b² = n has a solution exactly when n is not 2 modulo 4
+	for n := int64(-30); n <= 30; n++ {
+		if n == 0 {
+			continue
+		}
+		fund, units, err := SolveNormPerplex(big.NewInt(n))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(units) != 2 {
+			t.Fatalf("units = %v", units)
+		}
+		if (len(fund) == 0) != ((n%4+4)%4 == 2) {
+			t.Errorf("SolveNormPerplex(%d) = %v", n, fund)
+		}
+		count := 0
+		// |a| and |b| are at most (|n| + 1)/2
+		m := max(n, -n)
+		for a := -m; a <= m; a++ {
+			for b := -m; b <= m; b++ {
+				if a*a-b*b == n {
+					count++
+				}
+			}
+		}
+		if count != len(fund)*len(units) {
+			t.Errorf("SolveNormPerplex(%d): %d solutions, want %d", n, len(fund)*len(units), count)
+		}
+		for _, x := range fund {
+			if x.Quad().Cmp(new(big.Rat).SetInt64(n)) != 0 {
+				t.Errorf("SolveNormPerplex(%d): Quad(%v) = %v", n, x, x.Quad())
+			}
+		}
+	}
+	if _, _, err := SolveNormPerplex(new(big.Int)); err == nil {
+		t.Error("SolveNormPerplex(0) did not fail")
+	}
+}
+
+func TestSolveNormBiPerplex(t *testing.T) {
+	for a := int64(-6); a <= 6; a++ {
+		for b := int64(-6); b <= 6; b++ {
+			n := NewPerplex(big.NewRat(a, 1), big.NewRat(b, 1))
+			fund, units, err := SolveNormBiPerplex(n)
+			if n.IsZeroDivisor() {
+				if err == nil {
+					t.Errorf("SolveNormBiPerplex(%v) did not fail", n)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(units) != 4 {
+				t.Fatalf("units = %v", units)
+			}
+			seen := make(map[string]bool)
+			for _, x := range fund {
+				if !x.Quad().Equals(n) {
+					t.Errorf("SolveNormBiPerplex(%v): Quad(%v) = %v", n, x, x.Quad())
+				}
+				for _, u := range units {
+					ux := new(BiPerplex).Mul(u, x)
+					if seen[ux.String()] {
+						t.Errorf("SolveNormBiPerplex(%v): %v is not fundamental", n, x)
+					}
+					seen[ux.String()] = true
+				}
+			}
+		}
+	}
+	// count every solution with small components
+	for _, n := range []*Perplex{
+		NewPerplex(big.NewRat(1, 1), big.NewRat(0, 1)),
+		NewPerplex(big.NewRat(2, 1), big.NewRat(1, 1)),
+		NewPerplex(big.NewRat(-3, 1), big.NewRat(0, 1)),
+		NewPerplex(big.NewRat(4, 1), big.NewRat(1, 1)),
+	} {
+		fund, units, _ := SolveNormBiPerplex(n)
+		count := 0
+		for a := int64(-5); a <= 5; a++ {
+			for b := int64(-5); b <= 5; b++ {
+				for c := int64(-5); c <= 5; c++ {
+					for d := int64(-5); d <= 5; d++ {
+						x := NewBiPerplex(big.NewRat(a, 1), big.NewRat(b, 1), big.NewRat(c, 1), big.NewRat(d, 1))
+						if x.Quad().Equals(n) {
+							count++
+						}
+					}
+				}
+			}
+		}
+		if count != len(fund)*len(units) {
+			t.Errorf("SolveNormBiPerplex(%v): %d solutions, want %d", n, len(fund)*len(units), count)
+		}
+	}
+	if fund, _, err := SolveNormBiPerplex(NewPerplex(big.NewRat(1, 2), big.NewRat(0, 1))); fund != nil || err != nil {
+		t.Errorf("SolveNormBiPerplex(1/2) = %v, %v", fund, err)
+	}
+}
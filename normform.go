@@ -0,0 +1,519 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"slices"
+	"strings"
+)
+
+// A poly is a polynomial in several variables with rational coefficients,
+// keyed by the exponents of its monomials, one byte per variable.
+type poly map[string]*big.Rat
+
+// add adds c times the monomial with exponents e to p.
+func (p poly) add(e string, c *big.Rat) {
+	if c.Sign() == 0 {
+		return
+	}
+	if x, ok := p[e]; ok {
+		if x.Add(x, c); x.Sign() == 0 {
+			delete(p, e)
+		}
+		return
+	}
+	p[e] = new(big.Rat).Set(c)
+}
+
+// mul returns the product of p and q.
+func (p poly) mul(q poly) poly {
+	r := make(poly)
+	temp := new(big.Rat)
+	for e, x := range p {
+		for f, y := range q {
+			g := []byte(e)
+			for i := range g {
+				g[i] += f[i]
+			}
+			r.add(string(g), temp.Mul(x, y))
+		}
+	}
+	return r
+}
+
+// constPoly returns the constant polynomial 1 in n variables.
+func constPoly(n int) poly {
+	return poly{string(make([]byte, n)): big.NewRat(1, 1)}
+}
+
+// quadraticForms returns the components of the quadratic map f on n
+// variables as polynomials, found by polarization:
+// 		f(eᵢ + eⱼ) - f(eᵢ) - f(eⱼ)
+// is the coefficient of the monomial xᵢxⱼ.
+func quadraticForms(n int, f func(v []*big.Rat) []*big.Rat) []poly {
+	basis := func(i, j int) []*big.Rat {
+		v := make([]*big.Rat, n)
+		for k := range v {
+			v[k] = new(big.Rat)
+		}
+		v[i].SetInt64(1)
+		v[j].SetInt64(1)
+		return v
+	}
+	var forms []poly
+	diag := make([][]*big.Rat, n)
+	for i := 0; i < n; i++ {
+		diag[i] = f(basis(i, i))
+		if forms == nil {
+			forms = make([]poly, len(diag[i]))
+			for k := range forms {
+				forms[k] = make(poly)
+			}
+		}
+		e := make([]byte, n)
+		e[i] = 2
+		for k, c := range diag[i] {
+			forms[k].add(string(e), c)
+		}
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			e := make([]byte, n)
+			e[i], e[j] = 1, 1
+			for k, c := range f(basis(i, j)) {
+				c.Sub(c, diag[i][k])
+				forms[k].add(string(e), c.Sub(c, diag[j][k]))
+			}
+		}
+	}
+	return forms
+}
+
+// A NormForm is the norm of a type written as a homogeneous polynomial in
+// the components of its values. For types with a rational quadrance, the
+// norm form is the quadrance, a quadratic form. For types whose quadrance is
+// itself hypercomplex, such as BiComplex, it is the quartic or octic form of
+// Norm.
+type NormForm struct {
+	vars int
+	p    poly
+}
+
+// A NormTerm is a term of a NormForm: a coefficient times the product of the
+// variables raised to the exponents.
+type NormTerm struct {
+	Coeff *big.Rat
+	Exps  []int
+}
+
+// compose returns the norm form f with the polynomials q substituted for its
+// variables.
+func (f *NormForm) compose(vars int, q []poly) *NormForm {
+	g := &NormForm{vars, make(poly)}
+	for e, c := range f.p {
+		r := constPoly(vars)
+		for i, k := range []byte(e) {
+			for ; k > 0; k-- {
+				r = r.mul(q[i])
+			}
+		}
+		temp := new(big.Rat)
+		for m, x := range r {
+			g.p.add(m, temp.Mul(x, c))
+		}
+	}
+	return g
+}
+
+// Vars returns the number of variables of f, the dimension of its type.
+func (f *NormForm) Vars() int {
+	return f.vars
+}
+
+// Degree returns the degree of f.
+func (f *NormForm) Degree() int {
+	for e := range f.p {
+		d := 0
+		for _, k := range []byte(e) {
+			d += int(k)
+		}
+		return d
+	}
+	return 0
+}
+
+// Terms returns the non-zero terms of f, in decreasing lexicographic order of
+// their exponents.
+func (f *NormForm) Terms() []NormTerm {
+	keys := make([]string, 0, len(f.p))
+	for e := range f.p {
+		keys = append(keys, e)
+	}
+	slices.Sort(keys)
+	slices.Reverse(keys)
+	terms := make([]NormTerm, len(keys))
+	for i, e := range keys {
+		exps := make([]int, f.vars)
+		for j, k := range []byte(e) {
+			exps[j] = int(k)
+		}
+		terms[i] = NormTerm{new(big.Rat).Set(f.p[e]), exps}
+	}
+	return terms
+}
+
+// Coeff returns the coefficient of the monomial with exponents exps in f. If
+// len(exps) differs from the number of variables, then Coeff panics.
+func (f *NormForm) Coeff(exps ...int) *big.Rat {
+	if len(exps) != f.vars {
+		panic("wrong number of exponents")
+	}
+	e := make([]byte, f.vars)
+	for i, k := range exps {
+		if k < 0 || k > 255 {
+			return new(big.Rat)
+		}
+		e[i] = byte(k)
+	}
+	if c, ok := f.p[string(e)]; ok {
+		return new(big.Rat).Set(c)
+	}
+	return new(big.Rat)
+}
+
+// Evaluate returns the value of f at v. Evaluating the norm form at the
+// components of a value gives its norm, and evaluating it at integers tells
+// which integers the norm form represents. If len(v) differs from the number
+// of variables, then Evaluate panics.
+func (f *NormForm) Evaluate(v ...*big.Rat) *big.Rat {
+	if len(v) != f.vars {
+		panic("wrong number of values")
+	}
+	sum, term := new(big.Rat), new(big.Rat)
+	for e, c := range f.p {
+		term.Set(c)
+		for i, k := range []byte(e) {
+			for ; k > 0; k-- {
+				term.Mul(term, v[i])
+			}
+		}
+		sum.Add(sum, term)
+	}
+	return sum
+}
+
+// String returns the string representation of f, with the variables named
+// a, b, c, and so on, as in the documentation of each type.
+func (f *NormForm) String() string {
+	terms := f.Terms()
+	if len(terms) == 0 {
+		return "0"
+	}
+	sup := []string{"", "", "²", "³", "⁴", "⁵", "⁶", "⁷", "⁸"}
+	var b strings.Builder
+	for i, t := range terms {
+		c := new(big.Rat).Set(t.Coeff)
+		switch {
+		case c.Sign() < 0 && i == 0:
+			b.WriteString("-")
+			c.Neg(c)
+		case c.Sign() < 0:
+			b.WriteString(" - ")
+			c.Neg(c)
+		case i > 0:
+			b.WriteString(" + ")
+		}
+		if !c.IsInt() || c.Num().Cmp(big.NewInt(1)) != 0 {
+			b.WriteString(c.RatString())
+		}
+		for j, k := range t.Exps {
+			if k == 0 {
+				continue
+			}
+			b.WriteByte(byte('a' + j))
+			if k < len(sup) {
+				b.WriteString(sup[k])
+			} else {
+				b.WriteString("^" + big.NewInt(int64(k)).String())
+			}
+		}
+	}
+	return b.String()
+}
+
+// NormForm returns the norm form of Complex, the quadratic form of Quad in the
+// components. The value of z is not used.
+func (z *Complex) NormForm() *NormForm {
+	q := quadraticForms(2, func(v []*big.Rat) []*big.Rat {
+		x := new(Complex)
+		setComponents(x.Components(), v)
+		return []*big.Rat{x.Quad()}
+	})
+	return &NormForm{2, q[0]}
+}
+
+// NormForm returns the norm form of Infra, the quadratic form of Quad in the
+// components. The value of z is not used.
+func (z *Infra) NormForm() *NormForm {
+	q := quadraticForms(2, func(v []*big.Rat) []*big.Rat {
+		x := new(Infra)
+		setComponents(x.Components(), v)
+		return []*big.Rat{x.Quad()}
+	})
+	return &NormForm{2, q[0]}
+}
+
+// NormForm returns the norm form of Perplex, the quadratic form of Quad in the
+// components. The value of z is not used.
+func (z *Perplex) NormForm() *NormForm {
+	q := quadraticForms(2, func(v []*big.Rat) []*big.Rat {
+		x := new(Perplex)
+		setComponents(x.Components(), v)
+		return []*big.Rat{x.Quad()}
+	})
+	return &NormForm{2, q[0]}
+}
+
+// NormForm returns the norm form of BiComplex, the polynomial of Norm in the
+// components. The value of z is not used.
+func (z *BiComplex) NormForm() *NormForm {
+	q := quadraticForms(4, func(v []*big.Rat) []*big.Rat {
+		x := new(BiComplex)
+		setComponents(x.Components(), v)
+		return x.Quad().Components()
+	})
+	return new(Complex).NormForm().compose(4, q)
+}
+
+// NormForm returns the norm form of BiPerplex, the polynomial of Norm in the
+// components. The value of z is not used.
+func (z *BiPerplex) NormForm() *NormForm {
+	q := quadraticForms(4, func(v []*big.Rat) []*big.Rat {
+		x := new(BiPerplex)
+		setComponents(x.Components(), v)
+		return x.Quad().Components()
+	})
+	return new(Perplex).NormForm().compose(4, q)
+}
+
+// NormForm returns the norm form of Cockle, the quadratic form of Quad in the
+// components. The value of z is not used.
+func (z *Cockle) NormForm() *NormForm {
+	q := quadraticForms(4, func(v []*big.Rat) []*big.Rat {
+		x := new(Cockle)
+		setComponents(x.Components(), v)
+		return []*big.Rat{x.Quad()}
+	})
+	return &NormForm{4, q[0]}
+}
+
+// NormForm returns the norm form of DualComplex, the polynomial of Norm in the
+// components. The value of z is not used.
+func (z *DualComplex) NormForm() *NormForm {
+	q := quadraticForms(4, func(v []*big.Rat) []*big.Rat {
+		x := new(DualComplex)
+		setComponents(x.Components(), v)
+		return x.Quad().Components()
+	})
+	return new(Complex).NormForm().compose(4, q)
+}
+
+// NormForm returns the norm form of DualPerplex, the polynomial of Norm in the
+// components. The value of z is not used.
+func (z *DualPerplex) NormForm() *NormForm {
+	q := quadraticForms(4, func(v []*big.Rat) []*big.Rat {
+		x := new(DualPerplex)
+		setComponents(x.Components(), v)
+		return x.Quad().Components()
+	})
+	return new(Perplex).NormForm().compose(4, q)
+}
+
+// NormForm returns the norm form of Hamilton, the quadratic form of Quad in the
+// components. The value of z is not used.
+func (z *Hamilton) NormForm() *NormForm {
+	q := quadraticForms(4, func(v []*big.Rat) []*big.Rat {
+		x := new(Hamilton)
+		setComponents(x.Components(), v)
+		return []*big.Rat{x.Quad()}
+	})
+	return &NormForm{4, q[0]}
+}
+
+// NormForm returns the norm form of Hyper, the polynomial of Norm in the
+// components. The value of z is not used.
+func (z *Hyper) NormForm() *NormForm {
+	q := quadraticForms(4, func(v []*big.Rat) []*big.Rat {
+		x := new(Hyper)
+		setComponents(x.Components(), v)
+		return x.Quad().Components()
+	})
+	return new(Infra).NormForm().compose(4, q)
+}
+
+// NormForm returns the norm form of InfraComplex, the quadratic form of Quad in the
+// components. The value of z is not used.
+func (z *InfraComplex) NormForm() *NormForm {
+	q := quadraticForms(4, func(v []*big.Rat) []*big.Rat {
+		x := new(InfraComplex)
+		setComponents(x.Components(), v)
+		return []*big.Rat{x.Quad()}
+	})
+	return &NormForm{4, q[0]}
+}
+
+// NormForm returns the norm form of InfraPerplex, the quadratic form of Quad in the
+// components. The value of z is not used.
+func (z *InfraPerplex) NormForm() *NormForm {
+	q := quadraticForms(4, func(v []*big.Rat) []*big.Rat {
+		x := new(InfraPerplex)
+		setComponents(x.Components(), v)
+		return []*big.Rat{x.Quad()}
+	})
+	return &NormForm{4, q[0]}
+}
+
+// NormForm returns the norm form of Supra, the quadratic form of Quad in the
+// components. The value of z is not used.
+func (z *Supra) NormForm() *NormForm {
+	q := quadraticForms(4, func(v []*big.Rat) []*big.Rat {
+		x := new(Supra)
+		setComponents(x.Components(), v)
+		return []*big.Rat{x.Quad()}
+	})
+	return &NormForm{4, q[0]}
+}
+
+// NormForm returns the norm form of BiCockle, the polynomial of Norm in the
+// components. The value of z is not used.
+func (z *BiCockle) NormForm() *NormForm {
+	q := quadraticForms(8, func(v []*big.Rat) []*big.Rat {
+		x := new(BiCockle)
+		setComponents(x.Components(), v)
+		return x.quad().Components()
+	})
+	return new(Complex).NormForm().compose(8, q)
+}
+
+// NormForm returns the norm form of BiHamilton, the polynomial of Norm in the
+// components. The value of z is not used.
+func (z *BiHamilton) NormForm() *NormForm {
+	q := quadraticForms(8, func(v []*big.Rat) []*big.Rat {
+		x := new(BiHamilton)
+		setComponents(x.Components(), v)
+		return x.quad().Components()
+	})
+	return new(Complex).NormForm().compose(8, q)
+}
+
+// NormForm returns the norm form of Cayley, the quadratic form of Quad in the
+// components. The value of z is not used.
+func (z *Cayley) NormForm() *NormForm {
+	q := quadraticForms(8, func(v []*big.Rat) []*big.Rat {
+		x := new(Cayley)
+		setComponents(x.Components(), v)
+		return []*big.Rat{x.Quad()}
+	})
+	return &NormForm{8, q[0]}
+}
+
+// NormForm returns the norm form of InfraCockle, the quadratic form of Quad in the
+// components. The value of z is not used.
+func (z *InfraCockle) NormForm() *NormForm {
+	q := quadraticForms(8, func(v []*big.Rat) []*big.Rat {
+		x := new(InfraCockle)
+		setComponents(x.Components(), v)
+		return []*big.Rat{x.Quad()}
+	})
+	return &NormForm{8, q[0]}
+}
+
+// NormForm returns the norm form of InfraHamilton, the quadratic form of Quad in the
+// components. The value of z is not used.
+func (z *InfraHamilton) NormForm() *NormForm {
+	q := quadraticForms(8, func(v []*big.Rat) []*big.Rat {
+		x := new(InfraHamilton)
+		setComponents(x.Components(), v)
+		return []*big.Rat{x.Quad()}
+	})
+	return &NormForm{8, q[0]}
+}
+
+// NormForm returns the norm form of SupraComplex, the quadratic form of Quad in the
+// components. The value of z is not used.
+func (z *SupraComplex) NormForm() *NormForm {
+	q := quadraticForms(8, func(v []*big.Rat) []*big.Rat {
+		x := new(SupraComplex)
+		setComponents(x.Components(), v)
+		return []*big.Rat{x.Quad()}
+	})
+	return &NormForm{8, q[0]}
+}
+
+// NormForm returns the norm form of SupraPerplex, the quadratic form of Quad in the
+// components. The value of z is not used.
+func (z *SupraPerplex) NormForm() *NormForm {
+	q := quadraticForms(8, func(v []*big.Rat) []*big.Rat {
+		x := new(SupraPerplex)
+		setComponents(x.Components(), v)
+		return []*big.Rat{x.Quad()}
+	})
+	return &NormForm{8, q[0]}
+}
+
+// NormForm returns the norm form of TriComplex, the polynomial of Norm in the
+// components. The value of z is not used.
+func (z *TriComplex) NormForm() *NormForm {
+	q := quadraticForms(8, func(v []*big.Rat) []*big.Rat {
+		x := new(TriComplex)
+		setComponents(x.Components(), v)
+		return x.Quad().Components()
+	})
+	return new(BiComplex).NormForm().compose(8, q)
+}
+
+// NormForm returns the norm form of TriNilplex, the polynomial of Norm in the
+// components. The value of z is not used.
+func (z *TriNilplex) NormForm() *NormForm {
+	q := quadraticForms(8, func(v []*big.Rat) []*big.Rat {
+		x := new(TriNilplex)
+		setComponents(x.Components(), v)
+		return x.Quad().Components()
+	})
+	return new(Hyper).NormForm().compose(8, q)
+}
+
+// NormForm returns the norm form of TriPerplex, the polynomial of Norm in the
+// components. The value of z is not used.
+func (z *TriPerplex) NormForm() *NormForm {
+	q := quadraticForms(8, func(v []*big.Rat) []*big.Rat {
+		x := new(TriPerplex)
+		setComponents(x.Components(), v)
+		return x.Quad().Components()
+	})
+	return new(BiPerplex).NormForm().compose(8, q)
+}
+
+// NormForm returns the norm form of Ultra, the quadratic form of Quad in the
+// components. The value of z is not used.
+func (z *Ultra) NormForm() *NormForm {
+	q := quadraticForms(8, func(v []*big.Rat) []*big.Rat {
+		x := new(Ultra)
+		setComponents(x.Components(), v)
+		return []*big.Rat{x.Quad()}
+	})
+	return &NormForm{8, q[0]}
+}
+
+// NormForm returns the norm form of Zorn, the quadratic form of Quad in the
+// components. The value of z is not used.
+func (z *Zorn) NormForm() *NormForm {
+	q := quadraticForms(8, func(v []*big.Rat) []*big.Rat {
+		x := new(Zorn)
+		setComponents(x.Components(), v)
+		return []*big.Rat{x.Quad()}
+	})
+	return &NormForm{8, q[0]}
+}
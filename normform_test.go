@@ -0,0 +1,66 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestNormFormString(t *testing.T) {
+	for _, test := range []struct {
+		f    *NormForm
+		want string
+	}{
+		{new(Complex).NormForm(), "a² + b²"},
+		{new(Perplex).NormForm(), "a² - b²"},
+		{new(Infra).NormForm(), "a²"},
+		{new(Cockle).NormForm(), "a² + b² - c² - d²"},
+		{new(Hyper).NormForm(), "a⁴"},
+	} {
+		if got := test.f.String(); got != test.want {
+			t.Errorf("String = %q, want %q", got, test.want)
+		}
+	}
+}
+
+func TestBiComplexNormForm(t *testing.T) {
+	f := new(BiComplex).NormForm()
+	if f.Vars() != 4 || f.Degree() != 4 {
+		t.Fatalf("Vars, Degree = %d, %d, want 4, 4", f.Vars(), f.Degree())
+	}
+	// (a² - b² + c² - d²)² + 4(ab + cd)²
+	if c := f.Coeff(2, 2, 0, 0); c.Cmp(big.NewRat(2, 1)) != 0 {
+		t.Errorf("coefficient of a²b² = %v, want 2", c)
+	}
+	if c := f.Coeff(1, 1, 1, 1); c.Cmp(big.NewRat(8, 1)) != 0 {
+		t.Errorf("coefficient of abcd = %v, want 8", c)
+	}
+	if c := f.Coeff(2, 0, 2, 0); c.Cmp(big.NewRat(2, 1)) != 0 {
+		t.Errorf("coefficient of a²c² = %v, want 2", c)
+	}
+}
+
+func TestNormFormEvaluate(t *testing.T) {
+	f := func(x *Cockle, y *BiPerplex, w *BiHamilton, v *TriComplex) bool {
+		// t.Logf("x = %v, y = %v, w = %v, v = %v", x, y, w, v)
+		return new(Cockle).NormForm().Evaluate(x.Components()...).Cmp(x.Quad()) == 0 &&
+			new(BiPerplex).NormForm().Evaluate(y.Components()...).Cmp(y.Norm()) == 0 &&
+			new(BiHamilton).NormForm().Evaluate(w.Components()...).Cmp(w.Norm()) == 0 &&
+			new(TriComplex).NormForm().Evaluate(v.Components()...).Cmp(v.Norm()) == 0
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 10}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNormFormRepresents(t *testing.T) {
+	// The Cockle norm form is indefinite, so it represents negative integers.
+	f := new(Cockle).NormForm()
+	v := []*big.Rat{big.NewRat(0, 1), big.NewRat(0, 1), big.NewRat(1, 1), big.NewRat(0, 1)}
+	if n := f.Evaluate(v...); n.Cmp(big.NewRat(-1, 1)) != 0 {
+		t.Errorf("Evaluate(%v) = %v, want -1", v, n)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// A Number is the method set shared by every type of this package, with T
+// the pointer type itself: *Complex implements Number[*Complex], *Cayley
+// implements Number[*Cayley], and so on. Generic code can use it as a
+// constraint,
+// 		func f[T Number[T]](x, y T) T
+// to operate on any construct without reflection or type switches.
+type Number[T any] interface {
+	fmt.Stringer
+	Real() *big.Rat
+	Components() []*big.Rat
+	Equals(y T) bool
+	IsReal() bool
+	IsPure() bool
+	Set(y T) T
+	Scal(y T, a *big.Rat) T
+	Neg(y T) T
+	Conj(y T) T
+	Add(x, y T) T
+	Sub(x, y T) T
+	Mul(x, y T) T
+	Inv(y T) T
+}
+
+// Sum sets z equal to the sum of the values v, and returns z. The sum of no
+// values is zero. The result z must not alias any of the values.
+func Sum[T Number[T]](z T, v ...T) T {
+	z.Sub(z, z)
+	for _, x := range v {
+		z.Add(z, x)
+	}
+	return z
+}
+
+// Product sets z equal to the product of the values v, multiplied from left
+// to right, and returns z:
+// 		(((v[0] * v[1]) * v[2]) * ...)
+// The product of no values is one. The result z must not alias any of the
+// values.
+func Product[T Number[T]](z T, v ...T) T {
+	z.Sub(z, z)
+	z.Real().SetInt64(1)
+	for _, x := range v {
+		z.Mul(z, x)
+	}
+	return z
+}
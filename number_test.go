@@ -0,0 +1,97 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+var (
+	_ Number[*Complex]       = new(Complex)
+	_ Number[*Infra]         = new(Infra)
+	_ Number[*Perplex]       = new(Perplex)
+	_ Number[*BiComplex]     = new(BiComplex)
+	_ Number[*BiPerplex]     = new(BiPerplex)
+	_ Number[*Cockle]        = new(Cockle)
+	_ Number[*DualComplex]   = new(DualComplex)
+	_ Number[*DualPerplex]   = new(DualPerplex)
+	_ Number[*Hamilton]      = new(Hamilton)
+	_ Number[*Hyper]         = new(Hyper)
+	_ Number[*InfraComplex]  = new(InfraComplex)
+	_ Number[*InfraPerplex]  = new(InfraPerplex)
+	_ Number[*Supra]         = new(Supra)
+	_ Number[*BiCockle]      = new(BiCockle)
+	_ Number[*BiHamilton]    = new(BiHamilton)
+	_ Number[*Cayley]        = new(Cayley)
+	_ Number[*InfraCockle]   = new(InfraCockle)
+	_ Number[*InfraHamilton] = new(InfraHamilton)
+	_ Number[*SupraComplex]  = new(SupraComplex)
+	_ Number[*SupraPerplex]  = new(SupraPerplex)
+	_ Number[*TriComplex]    = new(TriComplex)
+	_ Number[*TriNilplex]    = new(TriNilplex)
+	_ Number[*TriPerplex]    = new(TriPerplex)
+	_ Number[*Ultra]         = new(Ultra)
+	_ Number[*Zorn]          = new(Zorn)
+)
+
+// distributes returns true if x(y + w) = xy + xw, using only the methods of
+// Number.
+func distributes[T Number[T]](x, y, w, l, r, temp T) bool {
+	l.Mul(x, l.Add(y, w))
+	r.Add(r.Mul(x, y), temp.Mul(x, w))
+	return l.Equals(r)
+}
+
+func TestNumberDistributive(t *testing.T) {
+	f := func(x, y, w *Cayley) bool {
+		// t.Logf("x = %v, y = %v, w = %v", x, y, w)
+		return distributes(x, y, w, new(Cayley), new(Cayley), new(Cayley))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	g := func(x, y, w *TriNilplex) bool {
+		// t.Logf("x = %v, y = %v, w = %v", x, y, w)
+		return distributes(x, y, w, new(TriNilplex), new(TriNilplex), new(TriNilplex))
+	}
+	if err := quick.Check(g, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSumProduct(t *testing.T) {
+	f := func(x, y, w *Hamilton) bool {
+		// t.Logf("x = %v, y = %v, w = %v", x, y, w)
+		s := new(Hamilton).Add(x, y)
+		s.Add(s, w)
+		p := new(Hamilton).Mul(x, y)
+		p.Mul(p, w)
+		return Sum(new(Hamilton), x, y, w).Equals(s) &&
+			Product(new(Hamilton), x, y, w).Equals(p)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	one := NewComplex(big.NewRat(1, 1), new(big.Rat))
+	if !Sum(NewComplex(big.NewRat(3, 1), big.NewRat(2, 1))).Equals(new(Complex)) ||
+		!Product(NewComplex(big.NewRat(3, 1), big.NewRat(2, 1))).Equals(one) {
+		t.Error("empty Sum or Product is not the identity")
+	}
+}
+
+func TestComponents(t *testing.T) {
+	z := NewCayley(big.NewRat(1, 1), big.NewRat(2, 1), big.NewRat(3, 1), big.NewRat(4, 1),
+		big.NewRat(5, 1), big.NewRat(6, 1), big.NewRat(7, 1), big.NewRat(8, 1))
+	v := z.Components()
+	if !equalRats(v, rats(z.Rats())) {
+		t.Errorf("Components = %v, want %v", v, rats(z.Rats()))
+	}
+	v[7].SetInt64(0)
+	if z.Equals(NewCayley(big.NewRat(1, 1), big.NewRat(2, 1), big.NewRat(3, 1), big.NewRat(4, 1),
+		big.NewRat(5, 1), big.NewRat(6, 1), big.NewRat(7, 1), big.NewRat(8, 1))) {
+		t.Error("Components does not alias z")
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// An Operator represents a linear operator acting on the rational components
+// of a value.
+type Operator struct {
+	dim int
+	f   func([]*big.Rat) []*big.Rat
+}
+
+// rats collects rational components into a slice.
+func rats(v ...*big.Rat) []*big.Rat {
+	return v
+}
+
+// equalRats returns true if the two slices have equal entries.
+func equalRats(v, w []*big.Rat) bool {
+	if len(v) != len(w) {
+		return false
+	}
+	for i := range v {
+		if v[i].Cmp(w[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Dim returns the dimension of the component space on which op acts.
+func (op *Operator) Dim() int {
+	return op.dim
+}
+
+// Apply returns the image of the component vector v under op. If the length
+// of v is not equal to the dimension of op, then Apply panics.
+func (op *Operator) Apply(v []*big.Rat) []*big.Rat {
+	if len(v) != op.dim {
+		panic("dimension mismatch")
+	}
+	return op.f(v)
+}
+
+// Matrix returns the matrix of op with respect to the standard basis. The
+// j-th column is the image of the j-th basis element.
+func (op *Operator) Matrix() [][]*big.Rat {
+	m := make([][]*big.Rat, op.dim)
+	for i := range m {
+		m[i] = make([]*big.Rat, op.dim)
+	}
+	e := make([]*big.Rat, op.dim)
+	for j := 0; j < op.dim; j++ {
+		for i := range e {
+			e[i] = new(big.Rat)
+		}
+		e[j].SetInt64(1)
+		col := op.f(e)
+		for i := range col {
+			m[i][j] = new(big.Rat).Set(col[i])
+		}
+	}
+	return m
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// matVec returns the product of the matrix m and the vector v.
+func matVec(m [][]*big.Rat, v []*big.Rat) []*big.Rat {
+	w := make([]*big.Rat, len(m))
+	temp := new(big.Rat)
+	for i := range m {
+		w[i] = new(big.Rat)
+		for j := range v {
+			w[i].Add(w[i], temp.Mul(m[i][j], v[j]))
+		}
+	}
+	return w
+}
+
+func TestHamiltonLeftMulApply(t *testing.T) {
+	f := func(x, y *Hamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := x.LeftMul().Apply(rats(y.Rats()))
+		r := rats(new(Hamilton).Mul(x, y).Rats())
+		return equalRats(l, r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyRightMulMatrix(t *testing.T) {
+	f := func(x, y *Cayley) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		op := x.RightMul()
+		v := rats(y.Rats())
+		return equalRats(matVec(op.Matrix(), v), op.Apply(v))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestZornLeftRightMulMatrix(t *testing.T) {
+	f := func(x, y *Zorn) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		v := rats(y.Rats())
+		l := matVec(x.LeftMul().Matrix(), v)
+		r := rats(new(Zorn).Mul(x, y).Rats())
+		return equalRats(l, r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+// möbiusElem is a type parameter constraint for the types with a Möbius
+// method.
+type möbiusElem[S any] interface {
+	Elem[S]
+	Möbius(y, a, b, c, d *S) *S
+	Key() string
+}
+
+// MöbiusOrbit calls f with the first n iterates of the Möbius transform
+// 		x ↦ (a*x + b) * Inv(c*x + d)
+// starting with x₀ = z, and their indices. The iterates are computed lazily,
+// each after f returns for the previous one, and f may keep them. Iteration
+// stops early when f returns false, or after an iterate that is a pole, where
+// c*x + d is not invertible and the next iterate is at infinity.
+//
+// If an iterate x_k equals an earlier iterate x_j, then the orbit is
+// periodic from then on, and MöbiusOrbit returns the preperiod j, the period
+// k - j, and true, without calling f for x_k. Otherwise it returns false.
+// Iterates are matched by their Key values, so the check is exact.
+func MöbiusOrbit[S any, T möbiusElem[S]](z, a, b, c, d T, n int, f func(k int, x T) bool) (start, period int, ok bool) {
+	seen := make(map[string]int)
+	x := T(new(S))
+	x.Set(z)
+	for k := 0; k < n; k++ {
+		key := x.Key()
+		if j, found := seen[key]; found {
+			return j, k - j, true
+		}
+		seen[key] = k
+		if !f(k, x) {
+			return 0, 0, false
+		}
+		den := T(new(S))
+		den.Add(den.Mul(c, x), d)
+		if !isInvertible[S](den) {
+			return 0, 0, false
+		}
+		y := T(new(S))
+		y.Möbius(x, a, b, c, d)
+		x = y
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestMöbiusOrbit(t *testing.T) {
+	c := func(a int64) *Complex {
+		return NewComplex(big.NewRat(a, 1), new(big.Rat))
+	}
+	var tests = []struct {
+		z, a, b, c, d *Complex
+		n             int
+		start, period int
+		ok            bool
+		calls         int
+	}{
+		// x ↦ 1/(1-x) has order 3
+		{c(2), c(0), c(1), c(-1), c(1), 10, 0, 3, true, 3},
+		// x ↦ -1/x has order 2, with fixed point i
+		{c(2), c(0), c(-1), c(1), c(0), 10, 0, 2, true, 2},
+		{NewComplex(new(big.Rat), big.NewRat(1, 1)), c(0), c(-1), c(1), c(0), 10, 0, 1, true, 1},
+		// 1 is a pole of x ↦ 1/(1-x), and 0 reaches it
+		{c(0), c(0), c(1), c(-1), c(1), 10, 0, 0, false, 2},
+		// translations never repeat
+		{c(0), c(1), c(1), c(0), c(1), 5, 0, 0, false, 5},
+		// x ↦ 2x/(x+1) fixes 0
+		{c(0), c(2), c(0), c(1), c(1), 4, 0, 1, true, 1},
+	}
+	for _, test := range tests {
+		calls := 0
+		var last *Complex
+		start, period, ok := MöbiusOrbit(test.z, test.a, test.b, test.c, test.d, test.n,
+			func(k int, x *Complex) bool {
+				if k != calls {
+					t.Errorf("iterate %d passed as %d", calls, k)
+				}
+				calls++
+				last = x
+				return true
+			})
+		if start != test.start || period != test.period || ok != test.ok || calls != test.calls {
+			t.Errorf("MöbiusOrbit(%v, %v, %v, %v, %v, %d) = %d, %d, %v after %d calls, want %d, %d, %v after %d",
+				test.z, test.a, test.b, test.c, test.d, test.n, start, period, ok, calls,
+				test.start, test.period, test.ok, test.calls)
+		}
+		if last == test.z {
+			t.Errorf("MöbiusOrbit passed z itself")
+		}
+	}
+}
+
+func TestMöbiusOrbitPreperiod(t *testing.T) {
+	// x ↦ (x+1)/(x+1) is 1 away from -1, so 3 → 1 → 1
+	s := func(a int64) *Perplex {
+		return NewPerplex(big.NewRat(a, 1), new(big.Rat))
+	}
+	start, period, ok := MöbiusOrbit(s(3), s(1), s(1), s(1), s(1), 10,
+		func(int, *Perplex) bool { return true })
+	if start != 1 || period != 1 || !ok {
+		t.Errorf("MöbiusOrbit = %d, %d, %v, want 1, 1, true", start, period, ok)
+	}
+	// stopping early
+	calls := 0
+	_, _, ok = MöbiusOrbit(s(3), s(1), s(1), s(0), s(1), 10,
+		func(k int, _ *Perplex) bool { calls++; return k < 2 })
+	if ok || calls != 3 {
+		t.Errorf("MöbiusOrbit stopped after %d calls and %v, want 3 and false", calls, ok)
+	}
+}
@@ -0,0 +1,521 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// lexCmp compares the rationals in a and b in lexicographic order, and
+// returns -1, 0, or +1. Both slices must have the same length.
+func lexCmp(a, b []*big.Rat) int {
+	for i := range a {
+		if c := a[i].Cmp(b[i]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// lexLess returns true if the rationals in a precede those in b in
+// lexicographic order. Both slices must have the same length.
+func lexLess(a, b []*big.Rat) bool {
+	return lexCmp(a, b) < 0
+}
+
+// canonicalize puts each rational in v in lowest terms, with a positive
+// denominator.
+func canonicalize(v []*big.Rat) {
+	for _, c := range v {
+		c.SetFrac(new(big.Int).Set(c.Num()), new(big.Int).Set(c.Denom()))
+	}
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *Complex) Canonicalize() *Complex {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*Complex).Cmp) sorts
+// deterministically.
+func (z *Complex) Cmp(y *Complex) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *Infra) Canonicalize() *Infra {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*Infra).Cmp) sorts
+// deterministically.
+func (z *Infra) Cmp(y *Infra) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *Perplex) Canonicalize() *Perplex {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*Perplex).Cmp) sorts
+// deterministically. It differs from NullLess, which uses the null basis.
+func (z *Perplex) Cmp(y *Perplex) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *BiComplex) Canonicalize() *BiComplex {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*BiComplex).Cmp) sorts
+// deterministically.
+func (z *BiComplex) Cmp(y *BiComplex) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *BiPerplex) Canonicalize() *BiPerplex {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*BiPerplex).Cmp) sorts
+// deterministically.
+func (z *BiPerplex) Cmp(y *BiPerplex) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *Cockle) Canonicalize() *Cockle {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*Cockle).Cmp) sorts
+// deterministically.
+func (z *Cockle) Cmp(y *Cockle) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *DualComplex) Canonicalize() *DualComplex {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*DualComplex).Cmp) sorts
+// deterministically.
+func (z *DualComplex) Cmp(y *DualComplex) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *DualPerplex) Canonicalize() *DualPerplex {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*DualPerplex).Cmp) sorts
+// deterministically.
+func (z *DualPerplex) Cmp(y *DualPerplex) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *Hamilton) Canonicalize() *Hamilton {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*Hamilton).Cmp) sorts
+// deterministically.
+func (z *Hamilton) Cmp(y *Hamilton) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *Hyper) Canonicalize() *Hyper {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*Hyper).Cmp) sorts
+// deterministically.
+func (z *Hyper) Cmp(y *Hyper) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *InfraComplex) Canonicalize() *InfraComplex {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*InfraComplex).Cmp)
+// sorts deterministically.
+func (z *InfraComplex) Cmp(y *InfraComplex) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *InfraPerplex) Canonicalize() *InfraPerplex {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*InfraPerplex).Cmp)
+// sorts deterministically.
+func (z *InfraPerplex) Cmp(y *InfraPerplex) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *Supra) Canonicalize() *Supra {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*Supra).Cmp) sorts
+// deterministically.
+func (z *Supra) Cmp(y *Supra) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *BiCockle) Canonicalize() *BiCockle {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*BiCockle).Cmp) sorts
+// deterministically.
+func (z *BiCockle) Cmp(y *BiCockle) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *BiHamilton) Canonicalize() *BiHamilton {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*BiHamilton).Cmp) sorts
+// deterministically.
+func (z *BiHamilton) Cmp(y *BiHamilton) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *Cayley) Canonicalize() *Cayley {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*Cayley).Cmp) sorts
+// deterministically.
+func (z *Cayley) Cmp(y *Cayley) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *InfraCockle) Canonicalize() *InfraCockle {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*InfraCockle).Cmp) sorts
+// deterministically.
+func (z *InfraCockle) Cmp(y *InfraCockle) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *InfraHamilton) Canonicalize() *InfraHamilton {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*InfraHamilton).Cmp)
+// sorts deterministically.
+func (z *InfraHamilton) Cmp(y *InfraHamilton) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *SupraComplex) Canonicalize() *SupraComplex {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*SupraComplex).Cmp)
+// sorts deterministically.
+func (z *SupraComplex) Cmp(y *SupraComplex) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *SupraPerplex) Canonicalize() *SupraPerplex {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*SupraPerplex).Cmp)
+// sorts deterministically.
+func (z *SupraPerplex) Cmp(y *SupraPerplex) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *TriComplex) Canonicalize() *TriComplex {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*TriComplex).Cmp) sorts
+// deterministically.
+func (z *TriComplex) Cmp(y *TriComplex) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *TriNilplex) Canonicalize() *TriNilplex {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*TriNilplex).Cmp) sorts
+// deterministically.
+func (z *TriNilplex) Cmp(y *TriNilplex) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *TriPerplex) Canonicalize() *TriPerplex {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*TriPerplex).Cmp) sorts
+// deterministically.
+func (z *TriPerplex) Cmp(y *TriPerplex) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *Ultra) Canonicalize() *Ultra {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*Ultra).Cmp) sorts
+// deterministically.
+func (z *Ultra) Cmp(y *Ultra) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *Zorn) Canonicalize() *Zorn {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*Zorn).Cmp) sorts
+// deterministically.
+func (z *Zorn) Cmp(y *Zorn) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *DualHamilton) Canonicalize() *DualHamilton {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*DualHamilton).Cmp)
+// sorts deterministically.
+func (z *DualHamilton) Cmp(y *DualHamilton) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Canonicalize puts every component of z in lowest terms, with a positive
+// denominator and no negative zero, and returns z. The methods of big.Rat keep
+// this form, so Canonicalize only changes components whose Num or Denom was
+// modified in place. If such a denominator is zero, then Canonicalize panics.
+func (z *Double[S, T]) Canonicalize() *Double[S, T] {
+	canonicalize(z.Components())
+	return z
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*Sedenion).Cmp)
+// sorts deterministically.
+func (z *Double[S, T]) Cmp(y *Double[S, T]) int {
+	return lexCmp(z.Components(), y.Components())
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*ComplexInt).Cmp) sorts
+// deterministically.
+func (z *ComplexInt) Cmp(y *ComplexInt) int {
+	return lexCmp(intRats(z.Components()), intRats(y.Components()))
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*HamiltonInt).Cmp) sorts
+// deterministically.
+func (z *HamiltonInt) Cmp(y *HamiltonInt) int {
+	return lexCmp(intRats(z.Components()), intRats(y.Components()))
+}
+
+// Cmp compares z and y in the lexicographic order on the components, in the
+// order of Components, and returns -1, 0, or +1. This is a total order,
+// compatible with Equals, so that slices.SortFunc(v, (*CayleyInt).Cmp) sorts
+// deterministically.
+func (z *CayleyInt) Cmp(y *CayleyInt) int {
+	return lexCmp(intRats(z.Components()), intRats(y.Components()))
+}
+
+// Cmp compares z and y in the lexicographic order on the components of their
+// Rat values, and returns -1, 0, or +1. This is a total order, compatible with
+// Equals.
+func (z *HurwitzInt) Cmp(y *HurwitzInt) int {
+	return lexCmp(z.Rat().Components(), y.Rat().Components())
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"slices"
+	"testing"
+	"testing/quick"
+)
+
+func TestCanonicalize(t *testing.T) {
+	z := NewHamilton(big.NewRat(1, 2), big.NewRat(0, 1), big.NewRat(3, 1), big.NewRat(-1, 3))
+	// modifying the numerators in place leaves 4/2 and -0
+	z.Real().Num().SetInt64(4)
+	z.Components()[1].Num().Neg(z.Components()[1].Num())
+	z.Components()[3].Num().SetInt64(-6)
+	z.Canonicalize()
+	want := NewHamilton(big.NewRat(2, 1), big.NewRat(0, 1), big.NewRat(3, 1), big.NewRat(-2, 1))
+	// Key uses RatString, which shows unreduced fractions
+	if !z.Equals(want) || z.Key() != want.Key() {
+		t.Errorf("Canonicalize gave %v, want %v", z, want)
+	}
+}
+
+func TestBiComplexCmpLess(t *testing.T) {
+	f := func(x, y *BiComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		c := x.Cmp(y)
+		return c == -y.Cmp(x) && (c < 0) == x.Less(y) && (c == 0) == x.Equals(y) &&
+			x.Cmp(x) == 0
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyCmpSort(t *testing.T) {
+	f := func(x, y, z *Cayley) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		v := []*Cayley{x, y, z, x}
+		slices.SortFunc(v, (*Cayley).Cmp)
+		for i := 1; i < len(v); i++ {
+			if v[i-1].Cmp(v[i]) > 0 {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
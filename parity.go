@@ -0,0 +1,66 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+// The doubling of a Cayley value z = a + bm, or of a Zorn value z = a + br, by
+// its Hamilton halves is a Z₂ grading: the even part a lies in the subalgebra
+// Hamilton, the odd part multiplies even parts into odd ones, and two odd
+// parts multiply into an even one. Negating the odd part is then an
+// automorphism.
+
+// GradeInvolution sets z equal to the grade involution of y, and returns z. If
+// y = a + bm with a and b Hamilton values, then the grade involution is
+// 		a - bm
+// It is an automorphism, which fixes Even(y) and negates Odd(y).
+func (z *Cayley) GradeInvolution(y *Cayley) *Cayley {
+	z.l.Set(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Even sets z equal to the even part of y, and returns z. If y = a + bm with
+// a and b Hamilton values, then the even part is a. The even parts form the
+// subalgebra Hamilton, which is associative.
+func (z *Cayley) Even(y *Cayley) *Cayley {
+	z.l.Set(&y.l)
+	z.r.Set(new(Hamilton))
+	return z
+}
+
+// Odd sets z equal to the odd part of y, and returns z. If y = a + bm with a
+// and b Hamilton values, then the odd part is bm. The product of two odd
+// parts is even.
+func (z *Cayley) Odd(y *Cayley) *Cayley {
+	z.l.Set(new(Hamilton))
+	z.r.Set(&y.r)
+	return z
+}
+
+// GradeInvolution sets z equal to the grade involution of y, and returns z. If
+// y = a + br with a and b Hamilton values, then the grade involution is
+// 		a - br
+// It is an automorphism, which fixes Even(y) and negates Odd(y).
+func (z *Zorn) GradeInvolution(y *Zorn) *Zorn {
+	z.l.Set(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Even sets z equal to the even part of y, and returns z. If y = a + br with
+// a and b Hamilton values, then the even part is a. The even parts form the
+// subalgebra Hamilton, which is associative.
+func (z *Zorn) Even(y *Zorn) *Zorn {
+	z.l.Set(&y.l)
+	z.r.Set(new(Hamilton))
+	return z
+}
+
+// Odd sets z equal to the odd part of y, and returns z. If y = a + br with a
+// and b Hamilton values, then the odd part is br. The product of two odd
+// parts is even.
+func (z *Zorn) Odd(y *Zorn) *Zorn {
+	z.l.Set(new(Hamilton))
+	z.r.Set(&y.r)
+	return z
+}
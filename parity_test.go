@@ -0,0 +1,47 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"testing"
+	"testing/quick"
+)
+
+func TestCayleyGradeInvolution(t *testing.T) {
+	f := func(x, y *Cayley) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		gx, gy := new(Cayley).GradeInvolution(x), new(Cayley).GradeInvolution(y)
+		if !new(Cayley).GradeInvolution(new(Cayley).Mul(x, y)).Equals(new(Cayley).Mul(gx, gy)) {
+			return false
+		}
+		ex, ox := new(Cayley).Even(x), new(Cayley).Odd(x)
+		if !new(Cayley).Add(ex, ox).Equals(x) || !new(Cayley).Sub(ex, ox).Equals(gx) {
+			return false
+		}
+		oo := new(Cayley).Mul(ox, new(Cayley).Odd(y))
+		return new(Cayley).Even(oo).Equals(oo)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestZornGradeInvolution(t *testing.T) {
+	f := func(x, y *Zorn) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		gx, gy := new(Zorn).GradeInvolution(x), new(Zorn).GradeInvolution(y)
+		if !new(Zorn).GradeInvolution(new(Zorn).Mul(x, y)).Equals(new(Zorn).Mul(gx, gy)) {
+			return false
+		}
+		ex, ox := new(Zorn).Even(x), new(Zorn).Odd(x)
+		if !new(Zorn).Add(ex, ox).Equals(x) || !new(Zorn).Sub(ex, ox).Equals(gx) {
+			return false
+		}
+		oo := new(Zorn).Mul(ox, new(Zorn).Odd(y))
+		return new(Zorn).Even(oo).Equals(oo)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
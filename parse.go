@@ -0,0 +1,392 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// A ParseError records a failed parse.
+type ParseError struct {
+	Type  string // the type being parsed, such as "Hamilton"
+	Input string
+	Msg   string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("rational: parsing %q as %s: %s", e.Input, e.Type, e.Msg)
+}
+
+// parse reads the components of a value whose units have the symbols symb,
+// with the real unit first. The accepted syntax is that of String: a sum of
+// terms, each a signed rational followed by a unit symbol, between the
+// brackets ⦗ and ⦘. The brackets may also be those of SetBrackets, be
+// parentheses, or be omitted, and spaces are ignored. A missing rational
+// stands for 1, so "-i" is a valid term, and a missing term stands for 0. Each
+// unit may appear at most once.
+func parse(typ, s string, symb []string) ([]*big.Rat, error) {
+	fail := func(msg string) error {
+		return &ParseError{typ, s, msg}
+	}
+	t := strings.Join(strings.Fields(s), "")
+	br := brackets()
+	l, r := strings.Join(strings.Fields(br[0]), ""), strings.Join(strings.Fields(br[1]), "")
+	switch {
+	case l+r != "" && strings.HasPrefix(t, l) && strings.HasSuffix(t, r) && len(t) >= len(l)+len(r):
+		t = t[len(l) : len(t)-len(r)]
+	case strings.HasPrefix(t, leftBracket) && strings.HasSuffix(t, rightBracket):
+		t = t[len(leftBracket) : len(t)-len(rightBracket)]
+	case strings.HasPrefix(t, "(") && strings.HasSuffix(t, ")"):
+		t = t[1 : len(t)-1]
+	}
+	if t == "" {
+		return nil, fail("empty value")
+	}
+	v := make([]*big.Rat, len(symb))
+	for len(t) > 0 {
+		// split off the next term at the next sign
+		end := strings.IndexAny(t[1:], "+-") + 1
+		if end == 0 {
+			end = len(t)
+		}
+		term := t[:end]
+		t = t[end:]
+		num := strings.TrimLeft(term, "+-")
+		if len(term)-len(num) > 1 {
+			return nil, fail("repeated sign")
+		}
+		n := strings.IndexFunc(num, func(r rune) bool {
+			return !(r >= '0' && r <= '9' || r == '/' || r == '.')
+		})
+		if n < 0 {
+			n = len(num)
+		}
+		unit := -1
+		for i, u := range symb {
+			if u == num[n:] {
+				unit = i
+				break
+			}
+		}
+		if unit < 0 {
+			return nil, fail(fmt.Sprintf("unknown unit %q", num[n:]))
+		}
+		if v[unit] != nil {
+			return nil, fail(fmt.Sprintf("repeated unit %q", num[n:]))
+		}
+		x := big.NewRat(1, 1)
+		if n > 0 {
+			if _, ok := x.SetString(num[:n]); !ok {
+				return nil, fail(fmt.Sprintf("invalid rational %q", num[:n]))
+			}
+		} else if unit == 0 {
+			return nil, fail("missing rational")
+		}
+		if term[0] == '-' {
+			x.Neg(x)
+		}
+		v[unit] = x
+	}
+	for i := range v {
+		if v[i] == nil {
+			v[i] = new(big.Rat)
+		}
+	}
+	return v, nil
+}
+
+// ParseComplex returns the Complex value represented by s. It accepts the
+// output of String, as well as sums of terms such as "3/4-i" with missing
+// terms taken as zero. If s is not such a value, then ParseComplex returns a
+// *ParseError.
+func ParseComplex(s string) (*Complex, error) {
+	r, err := parse("Complex", s, symbComplex[:])
+	if err != nil {
+		return nil, err
+	}
+	return NewComplex(r[0], r[1]), nil
+}
+
+// ParseInfra returns the Infra value represented by s. It accepts the output
+// of String, as well as sums of terms such as "3/4-α" with missing terms taken
+// as zero. If s is not such a value, then ParseInfra returns a *ParseError.
+func ParseInfra(s string) (*Infra, error) {
+	r, err := parse("Infra", s, []string{"", "α"})
+	if err != nil {
+		return nil, err
+	}
+	return NewInfra(r[0], r[1]), nil
+}
+
+// ParsePerplex returns the Perplex value represented by s. It accepts the
+// output of String, as well as sums of terms such as "3/4-s" with missing
+// terms taken as zero. If s is not such a value, then ParsePerplex returns a
+// *ParseError.
+func ParsePerplex(s string) (*Perplex, error) {
+	r, err := parse("Perplex", s, []string{"", "s"})
+	if err != nil {
+		return nil, err
+	}
+	return NewPerplex(r[0], r[1]), nil
+}
+
+// ParseBiComplex returns the BiComplex value represented by s. It accepts the
+// output of String, as well as sums of terms such as "3/4-i" with missing
+// terms taken as zero. If s is not such a value, then ParseBiComplex returns a
+// *ParseError.
+func ParseBiComplex(s string) (*BiComplex, error) {
+	r, err := parse("BiComplex", s, symbBiComplex[:])
+	if err != nil {
+		return nil, err
+	}
+	return NewBiComplex(r[0], r[1], r[2], r[3]), nil
+}
+
+// ParseBiPerplex returns the BiPerplex value represented by s. It accepts the
+// output of String, as well as sums of terms such as "3/4-s" with missing
+// terms taken as zero. If s is not such a value, then ParseBiPerplex returns a
+// *ParseError.
+func ParseBiPerplex(s string) (*BiPerplex, error) {
+	r, err := parse("BiPerplex", s, symbBiPerplex[:])
+	if err != nil {
+		return nil, err
+	}
+	return NewBiPerplex(r[0], r[1], r[2], r[3]), nil
+}
+
+// ParseCockle returns the Cockle value represented by s. It accepts the output
+// of String, as well as sums of terms such as "3/4-i" with missing terms taken
+// as zero. If s is not such a value, then ParseCockle returns a *ParseError.
+func ParseCockle(s string) (*Cockle, error) {
+	r, err := parse("Cockle", s, symbCockle[:])
+	if err != nil {
+		return nil, err
+	}
+	return NewCockle(r[0], r[1], r[2], r[3]), nil
+}
+
+// ParseDualComplex returns the DualComplex value represented by s. It accepts
+// the output of String, as well as sums of terms such as "3/4-i" with missing
+// terms taken as zero. If s is not such a value, then ParseDualComplex returns
+// a *ParseError.
+func ParseDualComplex(s string) (*DualComplex, error) {
+	r, err := parse("DualComplex", s, symbDualComplex[:])
+	if err != nil {
+		return nil, err
+	}
+	return NewDualComplex(r[0], r[1], r[2], r[3]), nil
+}
+
+// ParseDualPerplex returns the DualPerplex value represented by s. It accepts
+// the output of String, as well as sums of terms such as "3/4-s" with missing
+// terms taken as zero. If s is not such a value, then ParseDualPerplex returns
+// a *ParseError.
+func ParseDualPerplex(s string) (*DualPerplex, error) {
+	r, err := parse("DualPerplex", s, symbDualPerplex[:])
+	if err != nil {
+		return nil, err
+	}
+	return NewDualPerplex(r[0], r[1], r[2], r[3]), nil
+}
+
+// ParseHamilton returns the Hamilton value represented by s. It accepts the
+// output of String, as well as sums of terms such as "3/4-i" with missing
+// terms taken as zero. If s is not such a value, then ParseHamilton returns a
+// *ParseError.
+func ParseHamilton(s string) (*Hamilton, error) {
+	r, err := parse("Hamilton", s, symbHamilton[:])
+	if err != nil {
+		return nil, err
+	}
+	return NewHamilton(r[0], r[1], r[2], r[3]), nil
+}
+
+// ParseHyper returns the Hyper value represented by s. It accepts the output
+// of String, as well as sums of terms such as "3/4-α" with missing terms taken
+// as zero. If s is not such a value, then ParseHyper returns a *ParseError.
+func ParseHyper(s string) (*Hyper, error) {
+	r, err := parse("Hyper", s, symbHyper[:])
+	if err != nil {
+		return nil, err
+	}
+	return NewHyper(r[0], r[1], r[2], r[3]), nil
+}
+
+// ParseInfraComplex returns the InfraComplex value represented by s. It
+// accepts the output of String, as well as sums of terms such as "3/4-i" with
+// missing terms taken as zero. If s is not such a value, then
+// ParseInfraComplex returns a *ParseError.
+func ParseInfraComplex(s string) (*InfraComplex, error) {
+	r, err := parse("InfraComplex", s, symbInfraComplex[:])
+	if err != nil {
+		return nil, err
+	}
+	return NewInfraComplex(r[0], r[1], r[2], r[3]), nil
+}
+
+// ParseInfraPerplex returns the InfraPerplex value represented by s. It
+// accepts the output of String, as well as sums of terms such as "3/4-s" with
+// missing terms taken as zero. If s is not such a value, then
+// ParseInfraPerplex returns a *ParseError.
+func ParseInfraPerplex(s string) (*InfraPerplex, error) {
+	r, err := parse("InfraPerplex", s, symbInfraPerplex[:])
+	if err != nil {
+		return nil, err
+	}
+	return NewInfraPerplex(r[0], r[1], r[2], r[3]), nil
+}
+
+// ParseSupra returns the Supra value represented by s. It accepts the output
+// of String, as well as sums of terms such as "3/4-α" with missing terms taken
+// as zero. If s is not such a value, then ParseSupra returns a *ParseError.
+func ParseSupra(s string) (*Supra, error) {
+	r, err := parse("Supra", s, symbSupra[:])
+	if err != nil {
+		return nil, err
+	}
+	return NewSupra(r[0], r[1], r[2], r[3]), nil
+}
+
+// ParseBiCockle returns the BiCockle value represented by s. It accepts the
+// output of String, as well as sums of terms such as "3/4-i" with missing
+// terms taken as zero. If s is not such a value, then ParseBiCockle returns a
+// *ParseError.
+func ParseBiCockle(s string) (*BiCockle, error) {
+	r, err := parse("BiCockle", s, symbBiCockle[:])
+	if err != nil {
+		return nil, err
+	}
+	return NewBiCockle(r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7]), nil
+}
+
+// ParseBiHamilton returns the BiHamilton value represented by s. It accepts
+// the output of String, as well as sums of terms such as "3/4-i" with missing
+// terms taken as zero. If s is not such a value, then ParseBiHamilton returns
+// a *ParseError.
+func ParseBiHamilton(s string) (*BiHamilton, error) {
+	r, err := parse("BiHamilton", s, symbBiHamilton[:])
+	if err != nil {
+		return nil, err
+	}
+	return NewBiHamilton(r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7]), nil
+}
+
+// ParseCayley returns the Cayley value represented by s. It accepts the output
+// of String, as well as sums of terms such as "3/4-i" with missing terms taken
+// as zero. If s is not such a value, then ParseCayley returns a *ParseError.
+func ParseCayley(s string) (*Cayley, error) {
+	r, err := parse("Cayley", s, symbCayley[:])
+	if err != nil {
+		return nil, err
+	}
+	return NewCayley(r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7]), nil
+}
+
+// ParseInfraCockle returns the InfraCockle value represented by s. It accepts
+// the output of String, as well as sums of terms such as "3/4-i" with missing
+// terms taken as zero. If s is not such a value, then ParseInfraCockle returns
+// a *ParseError.
+func ParseInfraCockle(s string) (*InfraCockle, error) {
+	r, err := parse("InfraCockle", s, symbInfraCockle[:])
+	if err != nil {
+		return nil, err
+	}
+	return NewInfraCockle(r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7]), nil
+}
+
+// ParseInfraHamilton returns the InfraHamilton value represented by s. It
+// accepts the output of String, as well as sums of terms such as "3/4-i" with
+// missing terms taken as zero. If s is not such a value, then
+// ParseInfraHamilton returns a *ParseError.
+func ParseInfraHamilton(s string) (*InfraHamilton, error) {
+	r, err := parse("InfraHamilton", s, symbInfraHamilton[:])
+	if err != nil {
+		return nil, err
+	}
+	return NewInfraHamilton(r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7]), nil
+}
+
+// ParseSupraComplex returns the SupraComplex value represented by s. It
+// accepts the output of String, as well as sums of terms such as "3/4-i" with
+// missing terms taken as zero. If s is not such a value, then
+// ParseSupraComplex returns a *ParseError.
+func ParseSupraComplex(s string) (*SupraComplex, error) {
+	r, err := parse("SupraComplex", s, symbSupraComplex[:])
+	if err != nil {
+		return nil, err
+	}
+	return NewSupraComplex(r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7]), nil
+}
+
+// ParseSupraPerplex returns the SupraPerplex value represented by s. It
+// accepts the output of String, as well as sums of terms such as "3/4-s" with
+// missing terms taken as zero. If s is not such a value, then
+// ParseSupraPerplex returns a *ParseError.
+func ParseSupraPerplex(s string) (*SupraPerplex, error) {
+	r, err := parse("SupraPerplex", s, symbSupraPerplex[:])
+	if err != nil {
+		return nil, err
+	}
+	return NewSupraPerplex(r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7]), nil
+}
+
+// ParseTriComplex returns the TriComplex value represented by s. It accepts
+// the output of String, as well as sums of terms such as "3/4-i" with missing
+// terms taken as zero. If s is not such a value, then ParseTriComplex returns
+// a *ParseError.
+func ParseTriComplex(s string) (*TriComplex, error) {
+	r, err := parse("TriComplex", s, symbTriComplex[:])
+	if err != nil {
+		return nil, err
+	}
+	return NewTriComplex(r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7]), nil
+}
+
+// ParseTriNilplex returns the TriNilplex value represented by s. It accepts
+// the output of String, as well as sums of terms such as "3/4-α" with missing
+// terms taken as zero. If s is not such a value, then ParseTriNilplex returns
+// a *ParseError.
+func ParseTriNilplex(s string) (*TriNilplex, error) {
+	r, err := parse("TriNilplex", s, symbTriNilplex[:])
+	if err != nil {
+		return nil, err
+	}
+	return NewTriNilplex(r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7]), nil
+}
+
+// ParseTriPerplex returns the TriPerplex value represented by s. It accepts
+// the output of String, as well as sums of terms such as "3/4-s" with missing
+// terms taken as zero. If s is not such a value, then ParseTriPerplex returns
+// a *ParseError.
+func ParseTriPerplex(s string) (*TriPerplex, error) {
+	r, err := parse("TriPerplex", s, symbTriPerplex[:])
+	if err != nil {
+		return nil, err
+	}
+	return NewTriPerplex(r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7]), nil
+}
+
+// ParseUltra returns the Ultra value represented by s. It accepts the output
+// of String, as well as sums of terms such as "3/4-α" with missing terms taken
+// as zero. If s is not such a value, then ParseUltra returns a *ParseError.
+func ParseUltra(s string) (*Ultra, error) {
+	r, err := parse("Ultra", s, symbUltra[:])
+	if err != nil {
+		return nil, err
+	}
+	return NewUltra(r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7]), nil
+}
+
+// ParseZorn returns the Zorn value represented by s. It accepts the output of
+// String, as well as sums of terms such as "3/4-i" with missing terms taken as
+// zero. If s is not such a value, then ParseZorn returns a *ParseError.
+func ParseZorn(s string) (*Zorn, error) {
+	r, err := parse("Zorn", s, symbZorn[:])
+	if err != nil {
+		return nil, err
+	}
+	return NewZorn(r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7]), nil
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestParseRoundTrip(t *testing.T) {
+	tests := []interface{}{
+		func(x *Complex) bool {
+			// t.Logf("x = %v", x)
+			y, err := ParseComplex(x.String())
+			return err == nil && y.Equals(x)
+		},
+		func(x *Infra) bool {
+			// t.Logf("x = %v", x)
+			y, err := ParseInfra(x.String())
+			return err == nil && y.Equals(x)
+		},
+		func(x *Perplex) bool {
+			// t.Logf("x = %v", x)
+			y, err := ParsePerplex(x.String())
+			return err == nil && y.Equals(x)
+		},
+		func(x *BiComplex) bool {
+			// t.Logf("x = %v", x)
+			y, err := ParseBiComplex(x.String())
+			return err == nil && y.Equals(x)
+		},
+		func(x *BiPerplex) bool {
+			// t.Logf("x = %v", x)
+			y, err := ParseBiPerplex(x.String())
+			return err == nil && y.Equals(x)
+		},
+		func(x *Cockle) bool {
+			// t.Logf("x = %v", x)
+			y, err := ParseCockle(x.String())
+			return err == nil && y.Equals(x)
+		},
+		func(x *DualComplex) bool {
+			// t.Logf("x = %v", x)
+			y, err := ParseDualComplex(x.String())
+			return err == nil && y.Equals(x)
+		},
+		func(x *DualPerplex) bool {
+			// t.Logf("x = %v", x)
+			y, err := ParseDualPerplex(x.String())
+			return err == nil && y.Equals(x)
+		},
+		func(x *Hamilton) bool {
+			// t.Logf("x = %v", x)
+			y, err := ParseHamilton(x.String())
+			return err == nil && y.Equals(x)
+		},
+		func(x *Hyper) bool {
+			// t.Logf("x = %v", x)
+			y, err := ParseHyper(x.String())
+			return err == nil && y.Equals(x)
+		},
+		func(x *InfraComplex) bool {
+			// t.Logf("x = %v", x)
+			y, err := ParseInfraComplex(x.String())
+			return err == nil && y.Equals(x)
+		},
+		func(x *InfraPerplex) bool {
+			// t.Logf("x = %v", x)
+			y, err := ParseInfraPerplex(x.String())
+			return err == nil && y.Equals(x)
+		},
+		func(x *Supra) bool {
+			// t.Logf("x = %v", x)
+			y, err := ParseSupra(x.String())
+			return err == nil && y.Equals(x)
+		},
+		func(x *BiCockle) bool {
+			// t.Logf("x = %v", x)
+			y, err := ParseBiCockle(x.String())
+			return err == nil && y.Equals(x)
+		},
+		func(x *BiHamilton) bool {
+			// t.Logf("x = %v", x)
+			y, err := ParseBiHamilton(x.String())
+			return err == nil && y.Equals(x)
+		},
+		func(x *Cayley) bool {
+			// t.Logf("x = %v", x)
+			y, err := ParseCayley(x.String())
+			return err == nil && y.Equals(x)
+		},
+		func(x *InfraCockle) bool {
+			// t.Logf("x = %v", x)
+			y, err := ParseInfraCockle(x.String())
+			return err == nil && y.Equals(x)
+		},
+		func(x *InfraHamilton) bool {
+			// t.Logf("x = %v", x)
+			y, err := ParseInfraHamilton(x.String())
+			return err == nil && y.Equals(x)
+		},
+		func(x *SupraComplex) bool {
+			// t.Logf("x = %v", x)
+			y, err := ParseSupraComplex(x.String())
+			return err == nil && y.Equals(x)
+		},
+		func(x *SupraPerplex) bool {
+			// t.Logf("x = %v", x)
+			y, err := ParseSupraPerplex(x.String())
+			return err == nil && y.Equals(x)
+		},
+		func(x *TriComplex) bool {
+			// t.Logf("x = %v", x)
+			y, err := ParseTriComplex(x.String())
+			return err == nil && y.Equals(x)
+		},
+		func(x *TriNilplex) bool {
+			// t.Logf("x = %v", x)
+			y, err := ParseTriNilplex(x.String())
+			return err == nil && y.Equals(x)
+		},
+		func(x *TriPerplex) bool {
+			// t.Logf("x = %v", x)
+			y, err := ParseTriPerplex(x.String())
+			return err == nil && y.Equals(x)
+		},
+		func(x *Ultra) bool {
+			// t.Logf("x = %v", x)
+			y, err := ParseUltra(x.String())
+			return err == nil && y.Equals(x)
+		},
+		func(x *Zorn) bool {
+			// t.Logf("x = %v", x)
+			y, err := ParseZorn(x.String())
+			return err == nil && y.Equals(x)
+		},
+	}
+	for _, f := range tests {
+		if err := quick.Check(f, nil); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestParseHamilton(t *testing.T) {
+	want := NewHamilton(big.NewRat(3, 4), big.NewRat(-1, 1), new(big.Rat), big.NewRat(5, 2))
+	for _, s := range []string{
+		"⦗3/4-1i+0j+5/2k⦘",
+		"3/4 - i + 5/2k",
+		"(5/2k+3/4-i)",
+		"-i+0.75+2.5k",
+	} {
+		z, err := ParseHamilton(s)
+		if err != nil {
+			t.Errorf("ParseHamilton(%q): %v", s, err)
+			continue
+		}
+		if !z.Equals(want) {
+			t.Errorf("ParseHamilton(%q) = %v, want %v", s, z, want)
+		}
+	}
+	for _, s := range []string{"", "⦗⦘", "1+2x", "1+i+i", "1/0", "1+-i", "+"} {
+		if _, err := ParseHamilton(s); err == nil {
+			t.Errorf("ParseHamilton(%q) succeeded", s)
+		} else if _, ok := err.(*ParseError); !ok {
+			t.Errorf("ParseHamilton(%q) returned %T, want *ParseError", s, err)
+		}
+	}
+}
+
+func TestParseUnicode(t *testing.T) {
+	z, err := ParseTriNilplex("1/2-αΓΛ+3ΓΛ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := NewTriNilplex(big.NewRat(1, 2), new(big.Rat), new(big.Rat), new(big.Rat),
+		new(big.Rat), new(big.Rat), big.NewRat(3, 1), big.NewRat(-1, 1))
+	if !z.Equals(want) {
+		t.Errorf("ParseTriNilplex = %v, want %v", z, want)
+	}
+}
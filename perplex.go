@@ -5,6 +5,7 @@ package rational
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"reflect"
@@ -16,23 +17,37 @@ type Perplex struct {
 	l, r big.Rat
 }
 
-// Real returns the (rational) real part of z.
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
 func (z *Perplex) Real() *big.Rat {
 	return &z.l
 }
 
-// Rats returns the two rational components of z.
+// Rats returns the two rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
 func (z *Perplex) Rats() (*big.Rat, *big.Rat) {
 	return &z.l, &z.r
 }
 
+// RatsCopy returns copies of the two rational components of z.
+func (z *Perplex) RatsCopy() (*big.Rat, *big.Rat) {
+	return new(Perplex).Set(z).Rats()
+}
+
+// Components returns the two rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *Perplex) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
 // String returns the string version of a Perplex value.
 //
 // If z corresponds to a + bs, then the string is "(a+bs)", similar to
 // complex128 values.
 func (z *Perplex) String() string {
 	a := make([]string, 5)
-	a[0] = leftBracket
+	br := brackets()
+	a[0] = br[0]
 	a[1] = fmt.Sprintf("%v", z.l.RatString())
 	if z.r.Sign() == -1 {
 		a[2] = fmt.Sprintf("%v", z.r.RatString())
@@ -40,7 +55,7 @@ func (z *Perplex) String() string {
 		a[2] = fmt.Sprintf("+%v", z.r.RatString())
 	}
 	a[3] = "s"
-	a[4] = rightBracket
+	a[4] = br[1]
 	return strings.Join(a, "")
 }
 
@@ -52,6 +67,16 @@ func (z *Perplex) Equals(y *Perplex) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *Perplex) IsReal() bool {
+	return z.r.Sign() == 0
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *Perplex) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *Perplex) Set(y *Perplex) *Perplex {
 	z.l.Set(&y.l)
@@ -107,20 +132,24 @@ func (z *Perplex) Sub(x, y *Perplex) *Perplex {
 // The multiplication rule is:
 // 		Mul(s, s) = +1
 // This binary operation is commutative and associative.
-func (z *Perplex) Mul(x, y *Perplex) *Perplex {
-	a := new(big.Rat).Set(&x.l)
-	b := new(big.Rat).Set(&x.r)
-	c := new(big.Rat).Set(&y.l)
-	d := new(big.Rat).Set(&y.r)
-	temp := new(big.Rat)
-	z.l.Add(
-		z.l.Mul(a, c),
-		temp.Mul(d, b),
-	)
-	z.r.Add(
-		z.r.Mul(d, a),
-		temp.Mul(b, c),
-	)
+func (z *Perplex) Mul(x, y *Perplex) (product *Perplex) {
+	if h := currentHook(); h != nil {
+		x, y := new(Perplex).Set(x), new(Perplex).Set(y)
+		defer func() { traceMul(h, "Perplex.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	// Each component is normalized once, and z.l is only written after z.r
+	// is computed, so z may alias x or y.
+	l := getRat()
+	defer putRat(l)
+	dot2(l, &x.l, &y.l, &y.r, &x.r, 1)
+	dot2(&z.r, &y.r, &x.l, &x.r, &y.l, 1)
+	z.l.Set(l)
 	return z
 }
 
@@ -128,11 +157,7 @@ func (z *Perplex) Mul(x, y *Perplex) *Perplex {
 // 		a² - b²
 // This can be positive, negative, or zero.
 func (z *Perplex) Quad() *big.Rat {
-	quad := new(big.Rat)
-	return quad.Sub(
-		quad.Mul(&z.l, &z.l),
-		new(big.Rat).Mul(&z.r, &z.r),
-	)
+	return dot2(getRat(), &z.l, &z.l, &z.r, &z.r, -1)
 }
 
 // IsZeroDivisor returns true if z is a zero divisor.
@@ -148,11 +173,16 @@ func (z *Perplex) IsZeroDivisor() bool {
 
 // Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
 // then Inv panics.
-func (z *Perplex) Inv(y *Perplex) *Perplex {
+func (z *Perplex) Inv(y *Perplex) (inverse *Perplex) {
+	if h := currentHook(); h != nil {
+		y := new(Perplex).Set(y)
+		defer func() { traceInv(h, "Perplex.Inv", inverse != nil, z, y) }()
+	}
 	if y.IsZeroDivisor() {
-		panic("inverse of zero divisor")
+		panic(divisorError("inverse of zero divisor"))
 	}
 	a := y.Quad()
+	defer putRat(a)
 	a.Inv(a)
 	return z.Scal(z.Conj(y), a)
 }
@@ -161,7 +191,7 @@ func (z *Perplex) Inv(y *Perplex) *Perplex {
 // divisor, then Quo panics.
 func (z *Perplex) Quo(x, y *Perplex) *Perplex {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(x, z.Inv(y))
 }
@@ -261,14 +291,252 @@ func (z *Perplex) Dot(y *Perplex) *big.Rat {
 	dot := new(big.Rat)
 	temp := new(big.Rat)
 	dot.Mul(&z.l, &y.l)
-	return dot.Sub(dot, temp.Mul(&z.r, &z.r))
+	return dot.Sub(dot, temp.Mul(&z.r, &y.r))
+}
+
+// LeftMul returns the linear operator that sends y to Mul(z, y), acting on
+// the rational components of y.
+func (z *Perplex) LeftMul() *Operator {
+	a := new(Perplex).Set(z)
+	return &Operator{2, func(v []*big.Rat) []*big.Rat {
+		y := NewPerplex(v[0], v[1])
+		return rats(y.Mul(a, y).Rats())
+	}}
+}
+
+// RightMul returns the linear operator that sends y to Mul(y, z), acting on
+// the rational components of y.
+func (z *Perplex) RightMul() *Operator {
+	a := new(Perplex).Set(z)
+	return &Operator{2, func(v []*big.Rat) []*big.Rat {
+		y := NewPerplex(v[0], v[1])
+		return rats(y.Mul(y, a).Rats())
+	}}
+}
+
+// MinPoly returns the minimal polynomial of z. If z is real, then the
+// minimal polynomial is
+// 		t - Real(z)
+// Otherwise, it is
+// 		t² - 2Real(z)t + Quad(z)
+func (z *Perplex) MinPoly() Laurent {
+	re := new(big.Rat).Set(z.Real())
+	pure := new(Perplex).Set(z)
+	pure.Real().SetInt64(0)
+	if pure.Equals(new(Perplex)) {
+		return Laurent{0: re.Neg(re), 1: big.NewRat(1, 1)}
+	}
+	return Laurent{
+		0: z.Quad(),
+		1: re.Mul(re, big.NewRat(-2, 1)),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// EvaluateMinPoly returns true if z satisfies its minimal polynomial.
+func (z *Perplex) EvaluateMinPoly() bool {
+	return satisfies(z, z.MinPoly())
+}
+
+// PowViaMinPoly sets z equal to y raised to the power n, and returns z. High
+// powers are reduced with the minimal polynomial of y, so only O(log n)
+// rational multiplications are needed.
+func (z *Perplex) PowViaMinPoly(y *Perplex, n uint64) *Perplex {
+	p, q := quadPow(y.MinPoly(), n)
+	z.Scal(y, p)
+	z.Real().Add(z.Real(), q)
+	return z
+}
+
+// WriteTo writes a canonical encoding of z to w, and returns the number of
+// bytes written. Equal values produce equal encodings, so any hash.Hash can be
+// used as w to hash z without building an intermediate byte slice.
+func (z *Perplex) WriteTo(w io.Writer) (int64, error) {
+	return writeRats(w, rats(z.Rats()))
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// the rational parameter t, and returns z. The curve is evaluated exactly with
+// the De Casteljau algorithm. If p is empty, then Bezier panics.
+func (z *Perplex) Bezier(t *big.Rat, p ...*Perplex) *Perplex {
+	if len(p) == 0 {
+		panic("no control points")
+	}
+	s := new(big.Rat).Sub(big.NewRat(1, 1), t)
+	b := make([]*Perplex, len(p))
+	for i := range p {
+		b[i] = new(Perplex).Set(p[i])
+	}
+	temp := new(Perplex)
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			b[i].Add(b[i].Scal(b[i], s), temp.Scal(b[i+1], t))
+		}
+	}
+	return z.Set(b[0])
+}
+
+// NullLess returns true if z precedes y in the null-basis order. If z = a+bs,
+// then its null-basis coordinates are a+b and a-b, and values are compared
+// lexicographically on these coordinates. This is a total order, compatible
+// with Equals, but it is not the component order of Cmp.
+func (z *Perplex) NullLess(y *Perplex) bool {
+	null := func(x *Perplex) []*big.Rat {
+		return rats(
+			new(big.Rat).Add(&x.l, &x.r),
+			new(big.Rat).Sub(&x.l, &x.r),
+		)
+	}
+	return lexLess(null(z), null(y))
+}
+
+// Barycentric sets z equal to the barycentric combination of the points p
+// with rational weights w:
+// 		(w[0]*p[0] + w[1]*p[1] + ...) / (w[0] + w[1] + ...)
+// Then it returns z. If the lengths of w and p differ, or if the weights sum
+// to zero, then Barycentric panics.
+func (z *Perplex) Barycentric(w []*big.Rat, p []*Perplex) *Perplex {
+	if len(w) != len(p) {
+		panic("length mismatch")
+	}
+	sum := new(big.Rat)
+	acc, temp := new(Perplex), new(Perplex)
+	for i := range p {
+		sum.Add(sum, w[i])
+		acc.Add(acc, temp.Scal(p[i], w[i]))
+	}
+	if sum.Sign() == 0 {
+		panic("weights sum to zero")
+	}
+	return z.Scal(acc, sum.Inv(sum))
+}
+
+// Dehomogenize sets z equal to the affine representative x/y of the point
+// [x : y] of the projective line, and returns true. If y is a zero divisor,
+// then [x : y] has no affine representative, z is left unchanged, and
+// Dehomogenize returns false.
+func (z *Perplex) Dehomogenize(x, y *Perplex) bool {
+	if y.IsZeroDivisor() {
+		return false
+	}
+	z.Quo(x, y)
+	return true
+}
+
+// Collinear returns true if z, x, and y lie on a common line of the plane.
+func (z *Perplex) Collinear(x, y *Perplex) bool {
+	a := new(Perplex).Sub(x, z)
+	b := new(Perplex).Sub(y, z)
+	det := new(big.Rat).Mul(&a.l, &b.r)
+	return det.Cmp(new(big.Rat).Mul(&a.r, &b.l)) == 0
+}
+
+// Concyclic returns true if the cross-ratio of z, w, x, and y is real, that
+// is, if the four points lie on a common generalized hyperbola. The
+// cross-ratio is real exactly when
+// 		(z - x)(w - y) Conj((w - x)(z - y))
+// is real, which needs no inverses, so Concyclic also accepts points whose
+// differences are zero divisors. If two of the points coincide, then
+// Concyclic returns true.
+func (z *Perplex) Concyclic(w, x, y *Perplex) bool {
+	a := new(Perplex).Mul(new(Perplex).Sub(z, x), new(Perplex).Sub(w, y))
+	b := new(Perplex).Mul(new(Perplex).Sub(w, x), new(Perplex).Sub(z, y))
+	return a.Mul(a, b.Conj(b)).IsReal()
+}
+
+// Round sets z equal to y with each component rounded to a multiple of 1/den
+// in the direction given by mode, and returns z. Directed modes round every
+// component the same way, so interval bounds stay rigorous. If den is not
+// positive, then Round panics.
+func (z *Perplex) Round(y *Perplex, den *big.Int, mode big.RoundingMode) *Perplex {
+	z.Set(y)
+	for _, c := range rats(z.Rats()) {
+		roundRat(c, c, den, mode)
+	}
+	return z
+}
+
+// Act returns the left action of z on the pair (a, b) of rationals, which is
+// identified with the Perplex value a + bs and multiplied on the left by z. If
+// z = x+ys, then
+// 		(a, b) ↦ (xa + yb, ya + xb)
+// which for a unit z is a Lorentz boost of the plane. Perplex is associative,
+// so Act is a representation of Perplex on pairs of rationals.
+func (z *Perplex) Act(a, b *big.Rat) (*big.Rat, *big.Rat) {
+	v := new(Perplex)
+	v.l.Set(a)
+	v.r.Set(b)
+	v.Mul(z, v)
+	return &v.l, &v.r
+}
+
+// SolveCrossRatio sets z equal to the point y such that the cross-ratio of v,
+// w, x, and y is c, and returns z. With A = Inv(w - x) * (v - x) and
+// B = Inv(A) * c, the point is
+// 		(v*B - w) * Inv(B - 1)
+// If the point is not uniquely determined, then SolveCrossRatio panics.
+func (z *Perplex) SolveCrossRatio(v, w, x, c *Perplex) *Perplex {
+	one := new(Perplex)
+	one.Real().SetInt64(1)
+	b := new(Perplex).Sub(v, x)
+	b.Mul(new(Perplex).Inv(new(Perplex).Sub(w, x)), b)
+	b.Mul(b.Inv(b), c)
+	temp := new(Perplex).Sub(b, one)
+	temp.Inv(temp)
+	z.Mul(v, b)
+	z.Sub(z, w)
+	return z.Mul(z, temp)
+}
+
+// Boost sets z equal to the unit Perplex with Doppler factor k, and returns
+// z. If k = exp(φ) for the rapidity φ, then
+// 		z = cosh(φ) + sinh(φ)s = (k + 1/k)/2 + (k - 1/k)s/2
+// If k is not positive, then Boost panics.
+func (z *Perplex) Boost(k *big.Rat) *Perplex {
+	if k.Sign() <= 0 {
+		panic("non-positive Doppler factor")
+	}
+	inv := new(big.Rat).Inv(k)
+	half := big.NewRat(1, 2)
+	z.l.Add(k, inv)
+	z.l.Mul(&z.l, half)
+	z.r.Sub(k, inv)
+	z.r.Mul(&z.r, half)
+	return z
+}
+
+// Doppler returns the Doppler factor a+b of z = a+bs. For a unit Perplex with
+// positive real part this is exp(φ), with φ the rapidity, so Doppler turns
+// AngleAdd into multiplication. The rapidity itself is irrational except at
+// zero, so it is not returned directly.
+func (z *Perplex) Doppler() *big.Rat {
+	return new(big.Rat).Add(&z.l, &z.r)
+}
+
+// Velocity returns the velocity b/a of z = a+bs, which is tanh(φ) for a unit
+// Perplex with rapidity φ. Under AngleAdd, velocities u and v combine as
+// 		(u + v)/(1 + uv)
+// If the real part of z is zero, then Velocity panics.
+func (z *Perplex) Velocity() *big.Rat {
+	if z.l.Sign() == 0 {
+		panic("velocity of zero real part")
+	}
+	return new(big.Rat).Quo(&z.r, &z.l)
+}
+
+// AngleAdd sets z equal to the composition of the hyperbolic rotations x and
+// y, and returns z. This is the product xy, whose rapidity is the sum of the
+// rapidities of x and y. If x or y is not a unit Perplex, then AngleAdd
+// panics.
+func (z *Perplex) AngleAdd(x, y *Perplex) *Perplex {
+	one := big.NewRat(1, 1)
+	if x.Quad().Cmp(one) != 0 || y.Quad().Cmp(one) != 0 {
+		panic("angle of non-unit")
+	}
+	return z.Mul(x, y)
 }
 
 // Generate returns a random Perplex value for quick.Check testing.
 func (z *Perplex) Generate(rand *rand.Rand, size int) reflect.Value {
-	randomPerplex := &Perplex{
-		*big.NewRat(rand.Int63(), rand.Int63()),
-		*big.NewRat(rand.Int63(), rand.Int63()),
-	}
-	return reflect.ValueOf(randomPerplex)
+	return reflect.ValueOf(new(Perplex).Random(rand, generateOptions))
 }
@@ -327,3 +327,154 @@ func TestPerplexComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Dot product
+
+func TestPerplexDot(t *testing.T) {
+	x := NewPerplex(big.NewRat(1, 1), big.NewRat(2, 1))
+	y := NewPerplex(big.NewRat(3, 1), big.NewRat(5, 1))
+	// 1·3 - 2·5
+	if d := x.Dot(y); d.Cmp(big.NewRat(-7, 1)) != 0 {
+		t.Errorf("Dot(%v, %v) = %v, want -7", x, y, d)
+	}
+	f := func(x, y *Perplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return x.Dot(y).Cmp(y.Dot(x)) == 0 && x.Dot(x).Cmp(x.Quad()) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Scalar operands
+
+func TestPerplexMulReal(t *testing.T) {
+	f := func(x, y *Perplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(big.Rat).Set(y.Real())
+		s := new(Perplex)
+		s.Real().Set(a)
+		if !s.IsReal() || x.IsReal() {
+			return false
+		}
+		l, r := new(Perplex), new(Perplex)
+		l.Mul(s, x)
+		r.Mul(x, s)
+		// an independent product: s x = (s + x)x - x x, with no real factor
+		xx := new(Perplex).Mul(x, x)
+		sx := new(Perplex).Add(s, x)
+		return l.Equals(new(Perplex).Sub(new(Perplex).Mul(sx, x), xx)) &&
+			r.Equals(new(Perplex).Sub(new(Perplex).Mul(x, sx), xx))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPerplexIsPure(t *testing.T) {
+	f := func(x *Perplex) bool {
+		// t.Logf("x = %v", x)
+		p := new(Perplex).Set(x)
+		p.Real().SetInt64(0)
+		return p.IsPure() && !x.IsPure()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Ordering
+
+func TestPerplexNullLessTrichotomy(t *testing.T) {
+	f := func(x, y *Perplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		n := 0
+		for _, b := range []bool{x.NullLess(y), y.NullLess(x), x.Equals(y)} {
+			if b {
+				n++
+			}
+		}
+		return n == 1 && !x.NullLess(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPerplexNullLessTransitive(t *testing.T) {
+	f := func(x, y, z *Perplex) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		if x.NullLess(y) && y.NullLess(z) {
+			return x.NullLess(z)
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Planar geometry
+
+func TestPerplexBarycentricCollinear(t *testing.T) {
+	f := func(x, y *Perplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		w := []*big.Rat{big.NewRat(-1, 4), big.NewRat(9, 2)}
+		m := new(Perplex).Barycentric(w, []*Perplex{x, y})
+		return m.Collinear(x, y) && !m.Collinear(x, new(Perplex).Add(y, NewPerplex(big.NewRat(1, 1), new(big.Rat))))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPerplexConcyclic(t *testing.T) {
+	// points on the unit hyperbola a² - b² = 1
+	h := func(x, y int64) *Perplex {
+		// ((t + 1/t)/2, (t - 1/t)/2) with t = x/y
+		return NewPerplex(big.NewRat(x*x+y*y, 2*x*y), big.NewRat(x*x-y*y, 2*x*y))
+	}
+	v, w, x, y := h(2, 1), h(3, 1), h(1, 2), h(5, 3)
+	if !v.Concyclic(w, x, y) {
+		t.Errorf("%v, %v, %v, %v are not concyclic", v, w, x, y)
+	}
+	// coincident points leave at most three distinct points
+	for _, p := range [][4]*Perplex{{v, v, x, y}, {v, w, v, y}, {v, w, x, v}, {v, w, w, y}, {v, w, x, w}, {v, w, x, x}, {v, v, v, v}} {
+		if !p[0].Concyclic(p[1], p[2], p[3]) {
+			t.Errorf("%v are not concyclic", p)
+		}
+	}
+	// light-like separations: points on the null line a = b lie on a common
+	// line, but a parallelogram with null sides does not lie on a hyperbola
+	n := func(a, b int64) *Perplex {
+		return NewPerplex(big.NewRat(a, 1), big.NewRat(b, 1))
+	}
+	if p := [4]*Perplex{n(0, 0), n(1, 1), n(2, 2), n(3, 3)}; !p[0].Concyclic(p[1], p[2], p[3]) {
+		t.Errorf("%v are not concyclic", p)
+	}
+	if p := [4]*Perplex{n(0, 0), n(1, 1), n(1, 0), n(2, 1)}; p[0].Concyclic(p[1], p[2], p[3]) {
+		t.Errorf("%v are concyclic", p)
+	}
+}
+
+// Hyperbolic rotations
+
+func TestPerplexAngleAdd(t *testing.T) {
+	f := func(m, n, p, q uint16) bool {
+		// t.Logf("m = %v, n = %v, p = %v, q = %v", m, n, p, q)
+		k := big.NewRat(int64(m)+1, int64(n)+1)
+		l := big.NewRat(int64(p)+1, int64(q)+1)
+		x, y := new(Perplex).Boost(k), new(Perplex).Boost(l)
+		z := new(Perplex).AngleAdd(x, y)
+		// velocity addition
+		u, v := x.Velocity(), y.Velocity()
+		w := new(big.Rat).Add(u, v)
+		w.Quo(w, new(big.Rat).Add(big.NewRat(1, 1), new(big.Rat).Mul(u, v)))
+		return z.Equals(new(Perplex).Boost(new(big.Rat).Mul(k, l))) &&
+			z.Doppler().Cmp(new(big.Rat).Mul(k, l)) == 0 &&
+			z.Velocity().Cmp(w) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// intRoot returns the n-th root of the non-negative integer x and true if x
+// is the n-th power of an integer. Otherwise it returns nil and false. The
+// root is found by Newton's method, which decreases from above to the floor
+// of the root.
+func intRoot(x *big.Int, n int) (*big.Int, bool) {
+	if x.Cmp(big.NewInt(1)) <= 0 {
+		return new(big.Int).Set(x), true
+	}
+	if n >= x.BitLen() {
+		// 1 < x < 2ⁿ
+		return nil, false
+	}
+	bn := big.NewInt(int64(n))
+	bm := big.NewInt(int64(n - 1))
+	r := new(big.Int).Lsh(big.NewInt(1), uint((x.BitLen()+n-1)/n))
+	s, temp := new(big.Int), new(big.Int)
+	for {
+		// s = ((n-1)r + x/r^(n-1))/n
+		temp.Exp(r, bm, nil)
+		s.Quo(x, temp)
+		s.Add(s, temp.Mul(bm, r))
+		s.Quo(s, bn)
+		if s.Cmp(r) >= 0 {
+			break
+		}
+		r, s = s, r
+	}
+	if temp.Exp(r, bn, nil).Cmp(x) != 0 {
+		return nil, false
+	}
+	return r, true
+}
+
+// ratRoot returns the largest rational n-th root of x and true if x is the
+// n-th power of a rational. Otherwise it returns nil and false.
+func ratRoot(x *big.Rat, n int) (*big.Rat, bool) {
+	if x.Sign() < 0 && n%2 == 0 {
+		return nil, false
+	}
+	num, ok := intRoot(new(big.Int).Abs(x.Num()), n)
+	if !ok {
+		return nil, false
+	}
+	den, ok := intRoot(x.Denom(), n)
+	if !ok {
+		return nil, false
+	}
+	if x.Sign() < 0 {
+		num.Neg(num)
+	}
+	return new(big.Rat).SetFrac(num, den), true
+}
+
+// rootDegree returns the numerator and denominator of p, with the
+// denominator as an int. If the denominator of p does not fit in an int, then
+// rootDegree returns false.
+func rootDegree(p *big.Rat) (*big.Int, int, bool) {
+	q := p.Denom()
+	if !q.IsInt64() || q.Int64() != int64(int(q.Int64())) {
+		return nil, 0, false
+	}
+	return new(big.Int).Set(p.Num()), int(q.Int64()), true
+}
+
+// Polar returns the square root r of the quadrance of z and the unimodular
+// direction u = z/r, with z = ru, and true. If the quadrance of z is not the
+// square of a rational, or if z is zero, then Polar returns false.
+func (z *Complex) Polar() (r *big.Rat, u *Complex, ok bool) {
+	r, ok = ratSqrt(z.Quad())
+	if !ok || r.Sign() == 0 {
+		return nil, nil, false
+	}
+	return r, new(Complex).Scal(z, new(big.Rat).Inv(r)), true
+}
+
+// Argument returns the unimodular value u = z/z*, so that
+// 		z² = Quad(z)u
+// Where z = r exp(iθ), u = exp(2iθ): Argument always exists exactly, at the
+// cost of doubling the angle. If z is zero, then Argument panics.
+func (z *Complex) Argument() *Complex {
+	u := new(Complex).Conj(z)
+	return u.Quo(z, u)
+}
+
+// complexRoot returns the rational n-th root of y with the largest real part,
+// and then the largest imaginary part, and true. If y has no such root, then
+// it returns nil and false.
+//
+// If D is the least common denominator of y, then a rational root w of y has
+// D w = h, a Gaussian integer with hⁿ = Dⁿ y = g. Writing
+// 		h = (1 + i)ᵗ c p
+// with c a positive odd integer and p a Gaussian integer divisible neither by
+// 1 + i nor by an integer other than ±1, g is (1 + i)ᵗⁿ cⁿ pⁿ, with pⁿ of the
+// same kind as p. So t and c follow from the factors 1 + i and the content of
+// g, and p is the greatest common divisor of pⁿ and its quadrance
+// 		Quad(p) = p Conj(p)
+// up to a unit, since p and Conj(p) have no common factor. Each candidate is
+// checked exactly with Pow.
+func complexRoot(y *Complex, n int) (*Complex, bool) {
+	if y.Equals(new(Complex)) {
+		return new(Complex), true
+	}
+	d := new(big.Int).GCD(nil, nil, y.l.Denom(), y.r.Denom())
+	d.Mul(d.Quo(y.l.Denom(), d), y.r.Denom())
+	// If D > 1, then the denominator ideal of w, of norm at least 2, has its
+	// n-th power dividing D, so that D ≥ 2^(n/2).
+	if d.Cmp(big.NewInt(1)) > 0 && n > 2*d.BitLen() {
+		return nil, false
+	}
+	dn := new(big.Int).Exp(d, big.NewInt(int64(n)), nil)
+	g := NewComplexInt(
+		new(big.Int).Quo(new(big.Int).Mul(y.l.Num(), dn), y.l.Denom()),
+		new(big.Int).Quo(new(big.Int).Mul(y.r.Num(), dn), y.r.Denom()),
+	)
+	// g/(1 + i) = ((a + b) + (b - a)i)/2
+	v := 0
+	for new(big.Int).Add(&g.l, &g.r).Bit(0) == 0 {
+		a := new(big.Int).Add(&g.l, &g.r)
+		g.r.Sub(&g.r, &g.l)
+		g.l.Set(a)
+		g.l.Rsh(&g.l, 1)
+		g.r.Rsh(&g.r, 1)
+		v++
+	}
+	if v%n != 0 {
+		return nil, false
+	}
+	content := new(big.Int).GCD(nil, nil, new(big.Int).Abs(&g.l), new(big.Int).Abs(&g.r))
+	c, ok := intRoot(content, n)
+	if !ok {
+		return nil, false
+	}
+	g.l.Quo(&g.l, content)
+	g.r.Quo(&g.r, content)
+	q, ok := intRoot(g.Quad(), n)
+	if !ok {
+		return nil, false
+	}
+	p := new(ComplexInt).GCD(g, NewComplexInt(q, new(big.Int)))
+	h := NewComplexInt(c, new(big.Int))
+	for k := 0; k < v/n; k++ {
+		h.Mul(h, NewComplexInt(big.NewInt(1), big.NewInt(1)))
+	}
+	w := h.Mul(h, p).Rat()
+	w.Scal(w, new(big.Rat).SetFrac(big.NewInt(1), d))
+	var root *Complex
+	e := big.NewInt(int64(n))
+	i := NewComplex(new(big.Rat), big.NewRat(1, 1))
+	for k := 0; k < 4; k++ {
+		if new(Complex).Pow(w, e).Equals(y) && (root == nil || lexLess(rats(root.Rats()), rats(w.Rats()))) {
+			root = new(Complex).Set(w)
+		}
+		w.Mul(w, i)
+	}
+	return root, root != nil
+}
+
+// PowRat sets z equal to y raised to the rational power p, and returns true.
+// If p = m/n in lowest terms, then the result is the m-th power of the
+// rational n-th root of y with the largest real part, and then the largest
+// imaginary part, so that non-negative reals have non-negative real powers.
+// If y has no rational n-th root, or if p is negative and y is zero, then z
+// is left unchanged and PowRat returns false.
+func (z *Complex) PowRat(y *Complex, p *big.Rat) bool {
+	m, n, ok := rootDegree(p)
+	if !ok {
+		return false
+	}
+	w, ok := complexRoot(y, n)
+	if !ok || (m.Sign() < 0 && w.Equals(new(Complex))) {
+		return false
+	}
+	z.Pow(w, m)
+	return true
+}
+
+// Polar returns the square root r of the absolute value of the quadrance of
+// z and the direction u = z/r, with z = ru and Quad(u) = ±1, and true. If the
+// absolute value of the quadrance of z is not the square of a rational, or
+// if z is a zero divisor, then Polar returns false.
+func (z *Perplex) Polar() (r *big.Rat, u *Perplex, ok bool) {
+	q := z.Quad()
+	r, ok = ratSqrt(q.Abs(q))
+	if !ok || r.Sign() == 0 {
+		return nil, nil, false
+	}
+	return r, new(Perplex).Scal(z, new(big.Rat).Inv(r)), true
+}
+
+// Argument returns the unit value u = z/z*, so that
+// 		z² = Quad(z)u
+// If z = r exp(sφ) for a rapidity φ, then u = exp(2sφ) is the hyperbolic
+// rotation by twice the rapidity of z. If z is a zero divisor, then Argument
+// panics.
+func (z *Perplex) Argument() *Perplex {
+	u := new(Perplex).Conj(z)
+	return u.Quo(z, u)
+}
+
+// PowRat sets z equal to y raised to the rational power p, and returns true.
+// If p = m/n in lowest terms, then the result is the m-th power of the
+// rational n-th root of y whose null coordinates a+b and a-b are the largest
+// real n-th roots of those of y. If y has no rational n-th root, or if p is
+// negative and y is a zero divisor, then z is left unchanged and PowRat
+// returns false.
+func (z *Perplex) PowRat(y *Perplex, p *big.Rat) bool {
+	m, n, ok := rootDegree(p)
+	if !ok {
+		return false
+	}
+	u, ok := ratRoot(new(big.Rat).Add(&y.l, &y.r), n)
+	if !ok {
+		return false
+	}
+	v, ok := ratRoot(new(big.Rat).Sub(&y.l, &y.r), n)
+	if !ok || (m.Sign() < 0 && (u.Sign() == 0 || v.Sign() == 0)) {
+		return false
+	}
+	half := big.NewRat(1, 2)
+	a := new(big.Rat).Add(u, v)
+	b := new(big.Rat).Sub(u, v)
+	z.Pow(NewPerplex(a.Mul(a, half), b.Mul(b, half)), m)
+	return true
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestComplexPolar(t *testing.T) {
+	z := NewComplex(big.NewRat(3, 1), big.NewRat(4, 1))
+	r, u, ok := z.Polar()
+	if !ok || r.Cmp(big.NewRat(5, 1)) != 0 {
+		t.Fatalf("Polar(%v) = %v, %v, %v", z, r, u, ok)
+	}
+	if !new(Complex).Scal(u, r).Equals(z) || u.Quad().Cmp(big.NewRat(1, 1)) != 0 {
+		t.Errorf("Polar(%v) = %v, %v", z, r, u)
+	}
+	if _, _, ok := NewComplex(big.NewRat(1, 1), big.NewRat(1, 1)).Polar(); ok {
+		t.Error("Polar of 1+i succeeded")
+	}
+}
+
+func TestComplexArgument(t *testing.T) {
+	f := func(x *Complex) bool {
+		// t.Logf("x = %v", x)
+		u := x.Argument()
+		sq := new(Complex).Mul(x, x)
+		return u.Quad().Cmp(big.NewRat(1, 1)) == 0 &&
+			sq.Equals(new(Complex).Scal(u, x.Quad()))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPerplexArgument(t *testing.T) {
+	f := func(x *Perplex) bool {
+		// t.Logf("x = %v", x)
+		if x.Quad().Sign() == 0 {
+			return true
+		}
+		u := x.Argument()
+		sq := new(Perplex).Mul(x, x)
+		return u.Quad().Cmp(big.NewRat(1, 1)) == 0 &&
+			sq.Equals(new(Perplex).Scal(u, x.Quad()))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexPowRat(t *testing.T) {
+	f := func(a, b, c int8, m int8, n uint8) bool {
+		// t.Logf("a = %v, b = %v, c = %v, m = %v, n = %v", a, b, c, m, n)
+		x := NewComplex(big.NewRat(int64(a), int64(c)|1), big.NewRat(int64(b), 3))
+		if x.Equals(new(Complex)) {
+			return true
+		}
+		k := int64(n%3) + 2
+		y := new(Complex).Pow(x, big.NewInt(k))
+		z := new(Complex)
+		if !z.PowRat(y, big.NewRat(1, k)) || !new(Complex).Pow(z, big.NewInt(k)).Equals(y) {
+			return false
+		}
+		// the root with the largest real part
+		for _, u := range []*Complex{
+			NewComplex(big.NewRat(-1, 1), big.NewRat(0, 1)),
+			NewComplex(big.NewRat(0, 1), big.NewRat(1, 1)),
+			NewComplex(big.NewRat(0, 1), big.NewRat(-1, 1)),
+		} {
+			v := new(Complex).Mul(u, x)
+			if new(Complex).Pow(v, big.NewInt(k)).Equals(y) && z.l.Cmp(&v.l) < 0 {
+				return false
+			}
+		}
+		p := big.NewRat(int64(m%5), k)
+		w := new(Complex)
+		if !w.PowRat(y, p) {
+			return false
+		}
+		return new(Complex).Pow(w, p.Denom()).Equals(new(Complex).Pow(y, p.Num()))
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+	z := new(Complex)
+	if z.PowRat(NewComplex(big.NewRat(2, 1), big.NewRat(0, 1)), big.NewRat(1, 2)) {
+		t.Errorf("PowRat(2, 1/2) = %v", z)
+	}
+	if !z.PowRat(NewComplex(big.NewRat(-4, 1), big.NewRat(0, 1)), big.NewRat(1, 2)) ||
+		!z.Equals(NewComplex(big.NewRat(0, 1), big.NewRat(2, 1))) {
+		t.Errorf("PowRat(-4, 1/2) = %v, want 2i", z)
+	}
+	if !z.PowRat(NewComplex(big.NewRat(0, 1), big.NewRat(8, 1)), big.NewRat(-1, 3)) ||
+		!z.Equals(NewComplex(big.NewRat(0, 1), big.NewRat(1, 2))) {
+		t.Errorf("PowRat(8i, -1/3) = %v, want i/2", z)
+	}
+	// roots of high degree
+	y := new(Complex).Pow(NewComplex(big.NewRat(3, 1), big.NewRat(4, 1)), big.NewInt(64))
+	if !z.PowRat(y, big.NewRat(1, 64)) || !z.Equals(NewComplex(big.NewRat(4, 1), big.NewRat(-3, 1))) {
+		t.Errorf("PowRat((3+4i)⁶⁴, 1/64) = %v, want 4-3i", z)
+	}
+	y = NewComplex(big.NewRat(3, 5), big.NewRat(4, 5))
+	if z.PowRat(y, big.NewRat(1, 1000000007)) {
+		t.Errorf("PowRat(%v, 1/1000000007) = %v", y, z)
+	}
+}
+
+func TestPerplexPowRat(t *testing.T) {
+	k := big.NewRat(4, 1)
+	x := new(Perplex).Boost(k)
+	z := new(Perplex)
+	if !z.PowRat(x, big.NewRat(3, 2)) || !z.Equals(new(Perplex).Boost(big.NewRat(8, 1))) {
+		t.Errorf("PowRat(%v, 3/2) = %v", x, z)
+	}
+	if z.PowRat(NewPerplex(big.NewRat(0, 1), big.NewRat(1, 1)), big.NewRat(1, 2)) {
+		t.Errorf("PowRat(s, 1/2) = %v", z)
+	}
+}
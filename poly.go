@@ -0,0 +1,254 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"fmt"
+	"strings"
+)
+
+// An Elem is a pointer type *S that implements Number, so that generic code
+// can allocate S values. Every type of this package satisfies it.
+type Elem[S any] interface {
+	*S
+	Number[*S]
+}
+
+// A Poly represents a polynomial in a central variable x with coefficients
+// in S:
+// 		c₀ + c₁x + c₂x² + ... + cₙxⁿ
+// The variable commutes with the coefficients, but the coefficients need not
+// commute with each other or with the values at which the polynomial is
+// evaluated, so there are left and right evaluations. A Poly never stores
+// zero leading coefficients.
+type Poly[S any, T Elem[S]] struct {
+	c []S
+}
+
+// Polynomials over each type of this package.
+type (
+	ComplexPoly       = Poly[Complex, *Complex]
+	InfraPoly         = Poly[Infra, *Infra]
+	PerplexPoly       = Poly[Perplex, *Perplex]
+	BiComplexPoly     = Poly[BiComplex, *BiComplex]
+	BiPerplexPoly     = Poly[BiPerplex, *BiPerplex]
+	CocklePoly        = Poly[Cockle, *Cockle]
+	DualComplexPoly   = Poly[DualComplex, *DualComplex]
+	DualPerplexPoly   = Poly[DualPerplex, *DualPerplex]
+	HamiltonPoly      = Poly[Hamilton, *Hamilton]
+	HyperPoly         = Poly[Hyper, *Hyper]
+	InfraComplexPoly  = Poly[InfraComplex, *InfraComplex]
+	InfraPerplexPoly  = Poly[InfraPerplex, *InfraPerplex]
+	SupraPoly         = Poly[Supra, *Supra]
+	BiCocklePoly      = Poly[BiCockle, *BiCockle]
+	BiHamiltonPoly    = Poly[BiHamilton, *BiHamilton]
+	CayleyPoly        = Poly[Cayley, *Cayley]
+	InfraCocklePoly   = Poly[InfraCockle, *InfraCockle]
+	InfraHamiltonPoly = Poly[InfraHamilton, *InfraHamilton]
+	SupraComplexPoly  = Poly[SupraComplex, *SupraComplex]
+	SupraPerplexPoly  = Poly[SupraPerplex, *SupraPerplex]
+	TriComplexPoly    = Poly[TriComplex, *TriComplex]
+	TriNilplexPoly    = Poly[TriNilplex, *TriNilplex]
+	TriPerplexPoly    = Poly[TriPerplex, *TriPerplex]
+	UltraPoly         = Poly[Ultra, *Ultra]
+	ZornPoly          = Poly[Zorn, *Zorn]
+)
+
+// NewPoly returns a pointer to the Poly value with coefficients c, in
+// increasing order of degree.
+func NewPoly[S any, T Elem[S]](c ...T) *Poly[S, T] {
+	p := &Poly[S, T]{make([]S, len(c))}
+	for i, a := range c {
+		T(&p.c[i]).Set(a)
+	}
+	return p.trim()
+}
+
+// trim removes zero leading coefficients from p, and returns p.
+func (p *Poly[S, T]) trim() *Poly[S, T] {
+	zero := T(new(S))
+	n := len(p.c)
+	for n > 0 && T(&p.c[n-1]).Equals(zero) {
+		n--
+	}
+	p.c = p.c[:n]
+	return p
+}
+
+// Degree returns the degree of p. The zero polynomial has degree -1.
+func (p *Poly[S, T]) Degree() int {
+	return len(p.c) - 1
+}
+
+// Coeff returns a copy of the coefficient of xⁱ in p. If i is negative or
+// larger than the degree of p, then the coefficient is zero.
+func (p *Poly[S, T]) Coeff(i int) T {
+	if i < 0 || i >= len(p.c) {
+		return T(new(S))
+	}
+	return T(new(S)).Set(&p.c[i])
+}
+
+// SetCoeff sets the coefficient of xⁱ in p to a, and returns p. The degree of
+// p grows or shrinks as needed. If i is negative, then SetCoeff panics.
+func (p *Poly[S, T]) SetCoeff(i int, a T) *Poly[S, T] {
+	if i < 0 {
+		panic("negative degree")
+	}
+	for len(p.c) <= i {
+		var s S
+		p.c = append(p.c, s)
+	}
+	T(&p.c[i]).Set(a)
+	return p.trim()
+}
+
+// String returns the string representation of p, with the coefficients in
+// increasing order of degree:
+// 		c₀ + c₁x + c₂x^2 + ...
+func (p *Poly[S, T]) String() string {
+	if len(p.c) == 0 {
+		return "0"
+	}
+	a := make([]string, len(p.c))
+	for i := range p.c {
+		switch i {
+		case 0:
+			a[i] = T(&p.c[i]).String()
+		case 1:
+			a[i] = fmt.Sprintf("%vx", T(&p.c[i]))
+		default:
+			a[i] = fmt.Sprintf("%vx^%d", T(&p.c[i]), i)
+		}
+	}
+	return strings.Join(a, " + ")
+}
+
+// Equals returns true if p and q are equal.
+func (p *Poly[S, T]) Equals(q *Poly[S, T]) bool {
+	if len(p.c) != len(q.c) {
+		return false
+	}
+	for i := range p.c {
+		if !T(&p.c[i]).Equals(&q.c[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Set sets p equal to q, and returns p.
+func (p *Poly[S, T]) Set(q *Poly[S, T]) *Poly[S, T] {
+	c := make([]S, len(q.c))
+	for i := range c {
+		T(&c[i]).Set(&q.c[i])
+	}
+	p.c = c
+	return p
+}
+
+// Neg sets p equal to the negative of q, and returns p.
+func (p *Poly[S, T]) Neg(q *Poly[S, T]) *Poly[S, T] {
+	c := make([]S, len(q.c))
+	for i := range c {
+		T(&c[i]).Neg(&q.c[i])
+	}
+	p.c = c
+	return p
+}
+
+// Add sets p equal to q+r, and returns p.
+func (p *Poly[S, T]) Add(q, r *Poly[S, T]) *Poly[S, T] {
+	c := make([]S, max(len(q.c), len(r.c)))
+	for i := range c {
+		T(&c[i]).Add(q.Coeff(i), r.Coeff(i))
+	}
+	p.c = c
+	return p.trim()
+}
+
+// Sub sets p equal to q-r, and returns p.
+func (p *Poly[S, T]) Sub(q, r *Poly[S, T]) *Poly[S, T] {
+	c := make([]S, max(len(q.c), len(r.c)))
+	for i := range c {
+		T(&c[i]).Sub(q.Coeff(i), r.Coeff(i))
+	}
+	p.c = c
+	return p.trim()
+}
+
+// Mul sets p equal to the product of q and r, and returns p. Since x is
+// central, the coefficient of xᵏ is the sum of the products qᵢrⱼ with
+// i+j = k, each taken in that order.
+func (p *Poly[S, T]) Mul(q, r *Poly[S, T]) *Poly[S, T] {
+	if len(q.c) == 0 || len(r.c) == 0 {
+		p.c = nil
+		return p
+	}
+	c := make([]S, len(q.c)+len(r.c)-1)
+	temp := T(new(S))
+	for i := range q.c {
+		for j := range r.c {
+			T(&c[i+j]).Add(&c[i+j], temp.Mul(&q.c[i], &r.c[j]))
+		}
+	}
+	p.c = c
+	return p.trim()
+}
+
+// EvalL returns the left evaluation of p at y, with the coefficients on the
+// left of the powers:
+// 		c₀ + c₁y + c₂y² + ... + cₙyⁿ
+// The powers of y are taken by repeated multiplication on the right.
+func (p *Poly[S, T]) EvalL(y T) T {
+	sum, pow, temp := T(new(S)), T(new(S)), T(new(S))
+	pow.Real().SetInt64(1)
+	for i := range p.c {
+		sum.Add(sum, temp.Mul(&p.c[i], pow))
+		pow.Mul(pow, y)
+	}
+	return sum
+}
+
+// EvalR returns the right evaluation of p at y, with the coefficients on the
+// right of the powers:
+// 		c₀ + yc₁ + y²c₂ + ... + yⁿcₙ
+// The powers of y are taken by repeated multiplication on the right.
+func (p *Poly[S, T]) EvalR(y T) T {
+	sum, pow, temp := T(new(S)), T(new(S)), T(new(S))
+	pow.Real().SetInt64(1)
+	for i := range p.c {
+		sum.Add(sum, temp.Mul(pow, &p.c[i]))
+		pow.Mul(pow, y)
+	}
+	return sum
+}
+
+// HornerL returns the left evaluation of p at y by Horner's rule,
+// 		(...((cₙy + cₙ₋₁)y + cₙ₋₂)y + ...)y + c₀
+// with one multiplication per degree instead of the two of EvalL. The result
+// equals EvalL(y) whenever each coefficient and y generate an associative
+// subalgebra, as they do in every associative type, such as Hamilton and
+// Cockle, and, by Artin's theorem, in the alternative types Cayley and Zorn.
+func (p *Poly[S, T]) HornerL(y T) T {
+	sum, temp := T(new(S)), T(new(S))
+	for i := len(p.c) - 1; i >= 0; i-- {
+		temp.Mul(sum, y)
+		sum.Add(temp, &p.c[i])
+	}
+	return sum
+}
+
+// HornerR returns the right evaluation of p at y by Horner's rule,
+// 		c₀ + y(c₁ + y(c₂ + ... + y(cₙ₋₁ + ycₙ)...))
+// with one multiplication per degree instead of the two of EvalR. The result
+// equals EvalR(y) under the same conditions as for HornerL.
+func (p *Poly[S, T]) HornerR(y T) T {
+	sum, temp := T(new(S)), T(new(S))
+	for i := len(p.c) - 1; i >= 0; i-- {
+		temp.Mul(y, sum)
+		sum.Add(temp, &p.c[i])
+	}
+	return sum
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+	"testing/quick"
+)
+
+func TestComplexPolyMulEval(t *testing.T) {
+	f := func(a, b, c, d, y *Complex) bool {
+		// t.Logf("a = %v, b = %v, c = %v, d = %v, y = %v", a, b, c, d, y)
+		p := NewPoly(a, b)
+		q := NewPoly(c, d, a)
+		l := new(ComplexPoly).Mul(p, q).EvalL(y)
+		return l.Equals(new(Complex).Mul(p.EvalL(y), q.EvalL(y))) &&
+			l.Equals(new(ComplexPoly).Mul(q, p).EvalR(y))
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonPolyZeros(t *testing.T) {
+	one, zero := big.NewRat(1, 1), big.NewRat(0, 1)
+	// x² + 1 has every unit pure quaternion as a zero
+	p := NewPoly(NewHamilton(one, zero, zero, zero), new(Hamilton), NewHamilton(one, zero, zero, zero))
+	for _, y := range []*Hamilton{
+		NewHamilton(zero, one, zero, zero),
+		NewHamilton(zero, zero, one, zero),
+		NewHamilton(zero, big.NewRat(3, 5), big.NewRat(4, 5), zero),
+	} {
+		if v := p.EvalL(y); !v.Equals(new(Hamilton)) {
+			t.Errorf("EvalL(%v) = %v, want 0", y, v)
+		}
+	}
+	// ix - k: left zero j, since ij = k, but ji = -k
+	i := NewHamilton(zero, one, zero, zero)
+	j := NewHamilton(zero, zero, one, zero)
+	k := NewHamilton(zero, zero, zero, one)
+	q := NewPoly(new(Hamilton).Neg(k), i)
+	if v := q.EvalL(j); !v.Equals(new(Hamilton)) {
+		t.Errorf("EvalL(j) = %v, want 0", v)
+	}
+	if v := q.EvalR(j); !v.Equals(new(Hamilton).Scal(k, big.NewRat(-2, 1))) {
+		t.Errorf("EvalR(j) = %v, want -2k", v)
+	}
+}
+
+func TestPolyDegree(t *testing.T) {
+	one := big.NewRat(1, 1)
+	p := NewPoly(NewPerplex(one, one), NewPerplex(one, one))
+	q := new(PerplexPoly).Neg(p)
+	if d := new(PerplexPoly).Add(p, q).Degree(); d != -1 {
+		t.Errorf("Degree(p - p) = %d, want -1", d)
+	}
+	if d := p.SetCoeff(4, new(Perplex)).Degree(); d != 1 {
+		t.Errorf("Degree after SetCoeff(4, 0) = %d, want 1", d)
+	}
+	if d := p.SetCoeff(3, NewPerplex(one, one)).Degree(); d != 3 {
+		t.Errorf("Degree after SetCoeff(3, 1+s) = %d, want 3", d)
+	}
+	// (1+s)(1-s) = 0, so products can drop degree
+	r := NewPoly(NewPerplex(one, new(big.Rat).Neg(one)))
+	if d := new(PerplexPoly).Mul(p, r).Degree(); d != -1 {
+		t.Errorf("Degree((1+s)(1-s)p) = %d, want -1", d)
+	}
+	if got, want := NewPoly(NewComplex(one, one), new(Complex), NewComplex(one, one)).String(), "⦗1+1i⦘ + ⦗0+0i⦘x + ⦗1+1i⦘x^2"; got != want {
+		t.Errorf("String = %q, want %q", got, want)
+	}
+}
+
+func TestPolyHorner(t *testing.T) {
+	f := func(a, b, c, d, y *Hamilton) bool {
+		// t.Logf("a = %v, b = %v, c = %v, d = %v, y = %v", a, b, c, d, y)
+		p := NewPoly(a, b, c, d)
+		return p.HornerL(y).Equals(p.EvalL(y)) && p.HornerR(y).Equals(p.EvalR(y))
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+	g := func(a, b, c, y *Cockle) bool {
+		// t.Logf("a = %v, b = %v, c = %v, y = %v", a, b, c, y)
+		p := NewPoly(a, b, c)
+		return p.HornerL(y).Equals(p.EvalL(y)) && p.HornerR(y).Equals(p.EvalR(y))
+	}
+	if err := quick.Check(g, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+	h := func(a, b, c, y *Cayley) bool {
+		// t.Logf("a = %v, b = %v, c = %v, y = %v", a, b, c, y)
+		p := NewPoly(a, b, c)
+		return p.HornerL(y).Equals(p.EvalL(y)) && p.HornerR(y).Equals(p.EvalR(y))
+	}
+	if err := quick.Check(h, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+	if v := new(ZornPoly).HornerL(new(Zorn)); !v.Equals(new(Zorn)) {
+		t.Errorf("HornerL of the zero polynomial = %v", v)
+	}
+}
+
+// benchmarkCayleyPoly returns a Cayley polynomial of degree 8 with random
+// coefficients, and a random value at which to evaluate it.
+func benchmarkCayleyPoly() (*CayleyPoly, *Cayley) {
+	r := rand.New(rand.NewSource(1))
+	o := &RandomOptions{MaxNum: 1 << 20, MaxDen: 1 << 10}
+	c := make([]*Cayley, 9)
+	for i := range c {
+		c[i] = new(Cayley).Random(r, o)
+	}
+	return NewPoly(c...), new(Cayley).Random(r, o)
+}
+
+func BenchmarkCayleyPolyEvalL(b *testing.B) {
+	p, y := benchmarkCayleyPoly()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		p.EvalL(y)
+	}
+}
+
+func BenchmarkCayleyPolyHornerL(b *testing.B) {
+	p, y := benchmarkCayleyPoly()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		p.HornerL(y)
+	}
+}
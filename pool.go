@@ -0,0 +1,99 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"sync"
+)
+
+// ratPool holds scratch rationals. Most intermediate values in this package
+// are short-lived, so reusing them, together with the storage of their
+// numerators and denominators, saves most of the allocations of the
+// innermost arithmetic.
+var ratPool = sync.Pool{
+	New: func() interface{} { return new(big.Rat) },
+}
+
+// getRat returns a scratch rational with an unspecified value.
+func getRat() *big.Rat {
+	return ratPool.Get().(*big.Rat)
+}
+
+// putRat returns the scratch rationals v to the pool. They must not be used
+// afterwards.
+func putRat(v ...*big.Rat) {
+	for _, x := range v {
+		ratPool.Put(x)
+	}
+}
+
+// intPool holds scratch integers for the same purpose as ratPool.
+var intPool = sync.Pool{
+	New: func() interface{} { return new(big.Int) },
+}
+
+// dot2 sets z equal to ab + cd if sign is positive, or to ab - cd otherwise,
+// and returns z. The result is computed over a common denominator, so it is
+// normalized once, instead of once for each of the three operations that
+// big.Rat would need. Any of a, b, c, or d may alias z.
+func dot2(z, a, b, c, d *big.Rat, sign int) *big.Rat {
+	p, q := intPool.Get().(*big.Int), intPool.Get().(*big.Int)
+	r, s := intPool.Get().(*big.Int), intPool.Get().(*big.Int)
+	defer func() {
+		intPool.Put(p)
+		intPool.Put(q)
+		intPool.Put(r)
+		intPool.Put(s)
+	}()
+	// ab = p/r and cd = q/s
+	p.Mul(a.Num(), b.Num())
+	r.Mul(a.Denom(), b.Denom())
+	q.Mul(c.Num(), d.Num())
+	s.Mul(c.Denom(), d.Denom())
+	p.Mul(p, s)
+	q.Mul(q, r)
+	if sign > 0 {
+		p.Add(p, q)
+	} else {
+		p.Sub(p, q)
+	}
+	return z.SetFrac(p, r.Mul(r, s))
+}
+
+// An arena is a pool of scratch values of one type. The higher types take the
+// temporaries of their products, quadrances, and inverses from the arenas of
+// their halves, as the innermost arithmetic takes its rationals from ratPool.
+type arena[T any] struct {
+	pool sync.Pool
+}
+
+// get returns a scratch value with an unspecified value.
+func (a *arena[T]) get() *T {
+	if x, ok := a.pool.Get().(*T); ok {
+		return x
+	}
+	return new(T)
+}
+
+// put returns the scratch values v to a. They must not be used afterwards.
+func (a *arena[T]) put(v ...*T) {
+	for _, x := range v {
+		a.pool.Put(x)
+	}
+}
+
+var (
+	complexArena      arena[Complex]
+	infraArena        arena[Infra]
+	perplexArena      arena[Perplex]
+	biComplexArena    arena[BiComplex]
+	biPerplexArena    arena[BiPerplex]
+	cockleArena       arena[Cockle]
+	hamiltonArena     arena[Hamilton]
+	hyperArena        arena[Hyper]
+	infraComplexArena arena[InfraComplex]
+	infraPerplexArena arena[InfraPerplex]
+	supraArena        arena[Supra]
+)
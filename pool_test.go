@@ -0,0 +1,262 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+	"testing/quick"
+)
+
+func TestDot2(t *testing.T) {
+	f := func(a, b, c, d int64, p, q, r, s uint32) bool {
+		// t.Logf("a = %v, b = %v, c = %v, d = %v", a, b, c, d)
+		x := big.NewRat(a, int64(p)+1)
+		y := big.NewRat(b, int64(q)+1)
+		u := big.NewRat(c, int64(r)+1)
+		v := big.NewRat(d, int64(s)+1)
+		sum := new(big.Rat).Add(new(big.Rat).Mul(x, y), new(big.Rat).Mul(u, v))
+		diff := new(big.Rat).Sub(new(big.Rat).Mul(x, y), new(big.Rat).Mul(u, v))
+		if dot2(new(big.Rat), x, y, u, v, 1).Cmp(sum) != 0 {
+			return false
+		}
+		// aliased result
+		return dot2(x, x, y, u, v, -1).Cmp(diff) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Benchmarks of the hot paths: Mul, Inv, and Quad on random values with
+// 63-bit components.
+
+func BenchmarkComplexMul(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	x := new(Complex).Generate(r, 0).Interface().(*Complex)
+	y := new(Complex).Generate(r, 0).Interface().(*Complex)
+	z := new(Complex)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		z.Mul(x, y)
+	}
+}
+
+func BenchmarkComplexInv(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	x := new(Complex).Generate(r, 0).Interface().(*Complex)
+	z := new(Complex)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		z.Inv(x)
+	}
+}
+
+func BenchmarkComplexQuad(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	x := new(Complex).Generate(r, 0).Interface().(*Complex)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		x.Quad()
+	}
+}
+
+func BenchmarkPerplexMul(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	x := new(Perplex).Generate(r, 0).Interface().(*Perplex)
+	y := new(Perplex).Generate(r, 0).Interface().(*Perplex)
+	z := new(Perplex)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		z.Mul(x, y)
+	}
+}
+
+func BenchmarkPerplexInv(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	x := new(Perplex).Generate(r, 0).Interface().(*Perplex)
+	z := new(Perplex)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		z.Inv(x)
+	}
+}
+
+func BenchmarkPerplexQuad(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	x := new(Perplex).Generate(r, 0).Interface().(*Perplex)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		x.Quad()
+	}
+}
+
+func BenchmarkHamiltonMul(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	x := new(Hamilton).Generate(r, 0).Interface().(*Hamilton)
+	y := new(Hamilton).Generate(r, 0).Interface().(*Hamilton)
+	z := new(Hamilton)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		z.Mul(x, y)
+	}
+}
+
+// BenchmarkHamiltonMulReal multiplies by a real operand, which Mul
+// short-circuits to Scal.
+func BenchmarkHamiltonMulReal(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	x := new(Hamilton)
+	x.Real().Set(new(Hamilton).Generate(r, 0).Interface().(*Hamilton).Real())
+	y := new(Hamilton).Generate(r, 0).Interface().(*Hamilton)
+	z := new(Hamilton)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		z.Mul(x, y)
+	}
+}
+
+func BenchmarkHamiltonQuad(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	x := new(Hamilton).Generate(r, 0).Interface().(*Hamilton)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		x.Quad()
+	}
+}
+
+func BenchmarkCayleyMul(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	x := new(Cayley).Generate(r, 0).Interface().(*Cayley)
+	y := new(Cayley).Generate(r, 0).Interface().(*Cayley)
+	z := new(Cayley)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		z.Mul(x, y)
+	}
+}
+
+func BenchmarkCayleyMulReal(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	x := new(Cayley)
+	x.Real().Set(new(Cayley).Generate(r, 0).Interface().(*Cayley).Real())
+	y := new(Cayley).Generate(r, 0).Interface().(*Cayley)
+	z := new(Cayley)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		z.Mul(x, y)
+	}
+}
+
+func BenchmarkCayleyInv(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	x := new(Cayley).Generate(r, 0).Interface().(*Cayley)
+	z := new(Cayley)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		z.Inv(x)
+	}
+}
+
+func BenchmarkCayleyQuad(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	x := new(Cayley).Generate(r, 0).Interface().(*Cayley)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		x.Quad()
+	}
+}
+
+func BenchmarkBiComplexMul(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	x := new(BiComplex).Generate(r, 0).Interface().(*BiComplex)
+	y := new(BiComplex).Generate(r, 0).Interface().(*BiComplex)
+	z := new(BiComplex)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		z.Mul(x, y)
+	}
+}
+
+func BenchmarkBiComplexInv(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	x := new(BiComplex).Generate(r, 0).Interface().(*BiComplex)
+	z := new(BiComplex)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		z.Inv(x)
+	}
+}
+
+func BenchmarkTriComplexMul(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	x := new(TriComplex).Generate(r, 0).Interface().(*TriComplex)
+	y := new(TriComplex).Generate(r, 0).Interface().(*TriComplex)
+	z := new(TriComplex)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		z.Mul(x, y)
+	}
+}
+
+func BenchmarkTriComplexInv(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	x := new(TriComplex).Generate(r, 0).Interface().(*TriComplex)
+	z := new(TriComplex)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		z.Inv(x)
+	}
+}
+
+func BenchmarkZornInv(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	x := new(Zorn).Generate(r, 0).Interface().(*Zorn)
+	z := new(Zorn)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		z.Inv(x)
+	}
+}
+
+func BenchmarkBiComplexQuad(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	x := new(BiComplex).Generate(r, 0).Interface().(*BiComplex)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		x.Quad()
+	}
+}
+
+func BenchmarkUltraMul(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	x := new(Ultra).Generate(r, 0).Interface().(*Ultra)
+	y := new(Ultra).Generate(r, 0).Interface().(*Ultra)
+	z := new(Ultra)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		z.Mul(x, y)
+	}
+}
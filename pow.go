@@ -0,0 +1,251 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// pow sets z equal to y raised to the power n by binary exponentiation, and
+// returns z.
+func pow[S any, T Elem[S]](z, y T, n *big.Int) T {
+	x := T(new(S))
+	x.Set(y)
+	if n.Sign() < 0 {
+		x.Inv(x)
+	}
+	p := T(new(S))
+	p.Real().SetInt64(1)
+	e := new(big.Int).Abs(n)
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		p.Mul(p, p)
+		if e.Bit(i) == 1 {
+			p.Mul(p, x)
+		}
+	}
+	return z.Set(p)
+}
+
+// Pow sets z equal to y raised to the power n, and returns z. The power is
+// computed by binary exponentiation, which is valid because every type of
+// this package is power-associative. A negative power is a power of Inv(y),
+// and the zeroth power is one. If n is negative and y is a zero divisor, then
+// Pow panics.
+func (z *Complex) Pow(y *Complex, n *big.Int) *Complex {
+	return pow(z, y, n)
+}
+
+// Pow sets z equal to y raised to the power n, and returns z. The power is
+// computed by binary exponentiation, which is valid because every type of
+// this package is power-associative. A negative power is a power of Inv(y),
+// and the zeroth power is one. If n is negative and y is a zero divisor, then
+// Pow panics.
+func (z *Infra) Pow(y *Infra, n *big.Int) *Infra {
+	return pow(z, y, n)
+}
+
+// Pow sets z equal to y raised to the power n, and returns z. The power is
+// computed by binary exponentiation, which is valid because every type of
+// this package is power-associative. A negative power is a power of Inv(y),
+// and the zeroth power is one. If n is negative and y is a zero divisor, then
+// Pow panics.
+func (z *Perplex) Pow(y *Perplex, n *big.Int) *Perplex {
+	return pow(z, y, n)
+}
+
+// Pow sets z equal to y raised to the power n, and returns z. The power is
+// computed by binary exponentiation, which is valid because every type of
+// this package is power-associative. A negative power is a power of Inv(y),
+// and the zeroth power is one. If n is negative and y is a zero divisor, then
+// Pow panics.
+func (z *BiComplex) Pow(y *BiComplex, n *big.Int) *BiComplex {
+	return pow(z, y, n)
+}
+
+// Pow sets z equal to y raised to the power n, and returns z. The power is
+// computed by binary exponentiation, which is valid because every type of
+// this package is power-associative. A negative power is a power of Inv(y),
+// and the zeroth power is one. If n is negative and y is a zero divisor, then
+// Pow panics.
+func (z *BiPerplex) Pow(y *BiPerplex, n *big.Int) *BiPerplex {
+	return pow(z, y, n)
+}
+
+// Pow sets z equal to y raised to the power n, and returns z. The power is
+// computed by binary exponentiation, which is valid because every type of
+// this package is power-associative. A negative power is a power of Inv(y),
+// and the zeroth power is one. If n is negative and y is a zero divisor, then
+// Pow panics.
+func (z *Cockle) Pow(y *Cockle, n *big.Int) *Cockle {
+	return pow(z, y, n)
+}
+
+// Pow sets z equal to y raised to the power n, and returns z. The power is
+// computed by binary exponentiation, which is valid because every type of
+// this package is power-associative. A negative power is a power of Inv(y),
+// and the zeroth power is one. If n is negative and y is a zero divisor, then
+// Pow panics.
+func (z *DualComplex) Pow(y *DualComplex, n *big.Int) *DualComplex {
+	return pow(z, y, n)
+}
+
+// Pow sets z equal to y raised to the power n, and returns z. The power is
+// computed by binary exponentiation, which is valid because every type of
+// this package is power-associative. A negative power is a power of Inv(y),
+// and the zeroth power is one. If n is negative and y is a zero divisor, then
+// Pow panics.
+func (z *DualPerplex) Pow(y *DualPerplex, n *big.Int) *DualPerplex {
+	return pow(z, y, n)
+}
+
+// Pow sets z equal to y raised to the power n, and returns z. The power is
+// computed by binary exponentiation, which is valid because every type of
+// this package is power-associative. A negative power is a power of Inv(y),
+// and the zeroth power is one. If n is negative and y is a zero divisor, then
+// Pow panics.
+func (z *Hamilton) Pow(y *Hamilton, n *big.Int) *Hamilton {
+	return pow(z, y, n)
+}
+
+// Pow sets z equal to y raised to the power n, and returns z. The power is
+// computed by binary exponentiation, which is valid because every type of
+// this package is power-associative. A negative power is a power of Inv(y),
+// and the zeroth power is one. If n is negative and y is a zero divisor, then
+// Pow panics.
+func (z *Hyper) Pow(y *Hyper, n *big.Int) *Hyper {
+	return pow(z, y, n)
+}
+
+// Pow sets z equal to y raised to the power n, and returns z. The power is
+// computed by binary exponentiation, which is valid because every type of
+// this package is power-associative. A negative power is a power of Inv(y),
+// and the zeroth power is one. If n is negative and y is a zero divisor, then
+// Pow panics.
+func (z *InfraComplex) Pow(y *InfraComplex, n *big.Int) *InfraComplex {
+	return pow(z, y, n)
+}
+
+// Pow sets z equal to y raised to the power n, and returns z. The power is
+// computed by binary exponentiation, which is valid because every type of
+// this package is power-associative. A negative power is a power of Inv(y),
+// and the zeroth power is one. If n is negative and y is a zero divisor, then
+// Pow panics.
+func (z *InfraPerplex) Pow(y *InfraPerplex, n *big.Int) *InfraPerplex {
+	return pow(z, y, n)
+}
+
+// Pow sets z equal to y raised to the power n, and returns z. The power is
+// computed by binary exponentiation, which is valid because every type of
+// this package is power-associative. A negative power is a power of Inv(y),
+// and the zeroth power is one. If n is negative and y is a zero divisor, then
+// Pow panics.
+func (z *Supra) Pow(y *Supra, n *big.Int) *Supra {
+	return pow(z, y, n)
+}
+
+// Pow sets z equal to y raised to the power n, and returns z. The power is
+// computed by binary exponentiation, which is valid because every type of
+// this package is power-associative. A negative power is a power of Inv(y),
+// and the zeroth power is one. If n is negative and y is a zero divisor, then
+// Pow panics.
+func (z *BiCockle) Pow(y *BiCockle, n *big.Int) *BiCockle {
+	return pow(z, y, n)
+}
+
+// Pow sets z equal to y raised to the power n, and returns z. The power is
+// computed by binary exponentiation, which is valid because every type of
+// this package is power-associative. A negative power is a power of Inv(y),
+// and the zeroth power is one. If n is negative and y is a zero divisor, then
+// Pow panics.
+func (z *BiHamilton) Pow(y *BiHamilton, n *big.Int) *BiHamilton {
+	return pow(z, y, n)
+}
+
+// Pow sets z equal to y raised to the power n, and returns z. The power is
+// computed by binary exponentiation, which is valid because every type of
+// this package is power-associative. A negative power is a power of Inv(y),
+// and the zeroth power is one. If n is negative and y is a zero divisor, then
+// Pow panics.
+func (z *Cayley) Pow(y *Cayley, n *big.Int) *Cayley {
+	return pow(z, y, n)
+}
+
+// Pow sets z equal to y raised to the power n, and returns z. The power is
+// computed by binary exponentiation, which is valid because every type of
+// this package is power-associative. A negative power is a power of Inv(y),
+// and the zeroth power is one. If n is negative and y is a zero divisor, then
+// Pow panics.
+func (z *InfraCockle) Pow(y *InfraCockle, n *big.Int) *InfraCockle {
+	return pow(z, y, n)
+}
+
+// Pow sets z equal to y raised to the power n, and returns z. The power is
+// computed by binary exponentiation, which is valid because every type of
+// this package is power-associative. A negative power is a power of Inv(y),
+// and the zeroth power is one. If n is negative and y is a zero divisor, then
+// Pow panics.
+func (z *InfraHamilton) Pow(y *InfraHamilton, n *big.Int) *InfraHamilton {
+	return pow(z, y, n)
+}
+
+// Pow sets z equal to y raised to the power n, and returns z. The power is
+// computed by binary exponentiation, which is valid because every type of
+// this package is power-associative. A negative power is a power of Inv(y),
+// and the zeroth power is one. If n is negative and y is a zero divisor, then
+// Pow panics.
+func (z *SupraComplex) Pow(y *SupraComplex, n *big.Int) *SupraComplex {
+	return pow(z, y, n)
+}
+
+// Pow sets z equal to y raised to the power n, and returns z. The power is
+// computed by binary exponentiation, which is valid because every type of
+// this package is power-associative. A negative power is a power of Inv(y),
+// and the zeroth power is one. If n is negative and y is a zero divisor, then
+// Pow panics.
+func (z *SupraPerplex) Pow(y *SupraPerplex, n *big.Int) *SupraPerplex {
+	return pow(z, y, n)
+}
+
+// Pow sets z equal to y raised to the power n, and returns z. The power is
+// computed by binary exponentiation, which is valid because every type of
+// this package is power-associative. A negative power is a power of Inv(y),
+// and the zeroth power is one. If n is negative and y is a zero divisor, then
+// Pow panics.
+func (z *TriComplex) Pow(y *TriComplex, n *big.Int) *TriComplex {
+	return pow(z, y, n)
+}
+
+// Pow sets z equal to y raised to the power n, and returns z. The power is
+// computed by binary exponentiation, which is valid because every type of
+// this package is power-associative. A negative power is a power of Inv(y),
+// and the zeroth power is one. If n is negative and y is a zero divisor, then
+// Pow panics.
+func (z *TriNilplex) Pow(y *TriNilplex, n *big.Int) *TriNilplex {
+	return pow(z, y, n)
+}
+
+// Pow sets z equal to y raised to the power n, and returns z. The power is
+// computed by binary exponentiation, which is valid because every type of
+// this package is power-associative. A negative power is a power of Inv(y),
+// and the zeroth power is one. If n is negative and y is a zero divisor, then
+// Pow panics.
+func (z *TriPerplex) Pow(y *TriPerplex, n *big.Int) *TriPerplex {
+	return pow(z, y, n)
+}
+
+// Pow sets z equal to y raised to the power n, and returns z. The power is
+// computed by binary exponentiation, which is valid because every type of
+// this package is power-associative. A negative power is a power of Inv(y),
+// and the zeroth power is one. If n is negative and y is a zero divisor, then
+// Pow panics.
+func (z *Ultra) Pow(y *Ultra, n *big.Int) *Ultra {
+	return pow(z, y, n)
+}
+
+// Pow sets z equal to y raised to the power n, and returns z. The power is
+// computed by binary exponentiation, which is valid because every type of
+// this package is power-associative. A negative power is a power of Inv(y),
+// and the zeroth power is one. If n is negative and y is a zero divisor, then
+// Pow panics.
+func (z *Zorn) Pow(y *Zorn, n *big.Int) *Zorn {
+	return pow(z, y, n)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestHamiltonPow(t *testing.T) {
+	f := func(x *Hamilton, n uint8) bool {
+		// t.Logf("x = %v, n = %v", x, n)
+		n %= 12
+		p := NewHamilton(big.NewRat(1, 1), new(big.Rat), new(big.Rat), new(big.Rat))
+		for i := uint8(0); i < n; i++ {
+			p.Mul(p, x)
+		}
+		e := big.NewInt(int64(n))
+		if !new(Hamilton).Pow(x, e).Equals(p) {
+			return false
+		}
+		// x^n x^-n = 1
+		if x.Equals(new(Hamilton)) {
+			return true
+		}
+		q := new(Hamilton).Pow(x, new(big.Int).Neg(e))
+		return q.Mul(q, p).Equals(NewHamilton(big.NewRat(1, 1), new(big.Rat), new(big.Rat), new(big.Rat)))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyPow(t *testing.T) {
+	f := func(x *Cayley, n uint8) bool {
+		// t.Logf("x = %v, n = %v", x, n)
+		n %= 24
+		return new(Cayley).Pow(x, big.NewInt(int64(n))).Equals(new(Cayley).PowViaMinPoly(x, uint64(n)))
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraPowPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("negative power of a zero divisor did not panic")
+		}
+	}()
+	new(Infra).Pow(NewInfra(new(big.Rat), big.NewRat(1, 1)), big.NewInt(-1))
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"errors"
+	"math/big"
+)
+
+// IsPrime returns true if z is a Gaussian prime. These are the associates of
+// 1+i, of the rational primes p ≡ 3 (mod 4), and of the a+bi whose quadrance
+// a² + b² is a rational prime. The test on rational primes is probabilistic,
+// as in big.Int.ProbablyPrime(20).
+func (z *ComplexInt) IsPrime() bool {
+	if z.l.Sign() != 0 && z.r.Sign() != 0 {
+		return z.Quad().ProbablyPrime(20)
+	}
+	p := new(big.Int).Abs(&z.l)
+	if z.l.Sign() == 0 {
+		p.Abs(&z.r)
+	}
+	return p.Bit(0) == 1 && p.Bit(1) == 1 && p.ProbablyPrime(20)
+}
+
+// gaussianPrime returns the Gaussian prime above the rational prime p, with
+// positive real part and non-negative imaginary part. For p ≡ 1 (mod 4), it
+// is GCD(p, x+i) for a square root x of -1 modulo p, and its conjugate is the
+// other prime above p.
+func gaussianPrime(p *big.Int) *ComplexInt {
+	zero, one := big.NewInt(0), big.NewInt(1)
+	switch new(big.Int).And(p, big.NewInt(3)).Int64() {
+	case 2:
+		return NewComplexInt(one, one)
+	case 3:
+		return NewComplexInt(p, zero)
+	}
+	x := new(big.Int).ModSqrt(new(big.Int).Sub(p, one), p)
+	return new(ComplexInt).GCD(NewComplexInt(p, zero), NewComplexInt(x, one))
+}
+
+// Factor returns the Gaussian prime factors of z, with multiplicity, and the
+// unit u such that z is u times their product. Each factor has positive real
+// part and non-negative imaginary part, and the factors are ordered by the
+// rational primes they divide. The quadrance of z is factored by trial
+// division. If z is zero, then Factor panics.
+func (z *ComplexInt) Factor() (u *ComplexInt, factors []*ComplexInt) {
+	if z.Quad().Sign() == 0 {
+		panic("factorization of zero")
+	}
+	x := new(ComplexInt).Set(z)
+	q, m := new(ComplexInt), new(ComplexInt)
+	f := trialFactor(z.Quad())
+	for i := 0; i < len(f); i++ {
+		p := f[i]
+		if p.Bit(0) == 1 && p.Bit(1) == 1 {
+			// p ≡ 3 (mod 4) is a Gaussian prime of quadrance p²
+			i++
+		}
+		pi := gaussianPrime(p)
+		if q.DivMod(x, pi, m); m.Quad().Sign() != 0 {
+			// the other prime above p, rotated into the first quadrant
+			pi.Mul(pi.Conj(pi), NewComplexInt(big.NewInt(0), big.NewInt(1)))
+			q.DivMod(x, pi, m)
+		}
+		factors = append(factors, pi)
+		x.Set(q)
+	}
+	return x, factors
+}
+
+// LipschitzPrime returns a Lipschitz integer π with
+// 		p = ππ* = Quad(π)
+// for the rational prime p, found by Euler's descent. The four components of
+// π write p as a sum of four squares, and FourSquares multiplies these
+// together for composite integers. If p is not prime, then LipschitzPrime
+// returns an error.
+func LipschitzPrime(p *big.Int) (*HamiltonInt, error) {
+	if p.Sign() <= 0 || !p.ProbablyPrime(20) {
+		return nil, errors.New("rational: not a prime")
+	}
+	z := new(HamiltonInt)
+	z.SetRat(primeQuaternion(p))
+	return z, nil
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestComplexIntIsPrime(t *testing.T) {
+	for _, test := range []struct {
+		a, b int64
+		want bool
+	}{
+		{1, 1, true},
+		{2, 0, false},
+		{3, 0, true},
+		{0, -7, true},
+		{5, 0, false},
+		{2, 1, true},
+		{1, 0, false},
+		{3, 2, true},
+		{3, 3, false},
+	} {
+		z := NewComplexInt(big.NewInt(test.a), big.NewInt(test.b))
+		if got := z.IsPrime(); got != test.want {
+			t.Errorf("IsPrime(%v) = %v, want %v", z, got, test.want)
+		}
+	}
+}
+
+func TestComplexIntFactor(t *testing.T) {
+	f := func(x *ComplexInt) bool {
+		// t.Logf("x = %v", x)
+		if x.Quad().Sign() == 0 {
+			return true
+		}
+		u, factors := x.Factor()
+		p := new(ComplexInt).Set(u)
+		for _, pi := range factors {
+			if !pi.IsPrime() || pi.l.Sign() <= 0 || pi.r.Sign() < 0 {
+				return false
+			}
+			p.Mul(p, pi)
+		}
+		return u.IsUnit() && p.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestLipschitzPrime(t *testing.T) {
+	for _, p := range []int64{2, 3, 5, 7, 97, 65537, 999983} {
+		z, err := LipschitzPrime(big.NewInt(p))
+		if err != nil {
+			t.Errorf("LipschitzPrime(%d) returned error: %v", p, err)
+			continue
+		}
+		n := new(HamiltonInt).Mul(z, new(HamiltonInt).Conj(z))
+		if !n.Equals(NewHamiltonInt(big.NewInt(p), big.NewInt(0), big.NewInt(0), big.NewInt(0))) {
+			t.Errorf("ππ* = %v, want %d", n, p)
+		}
+	}
+	if _, err := LipschitzPrime(big.NewInt(91)); err == nil {
+		t.Error("LipschitzPrime(91) succeeded")
+	}
+}
@@ -0,0 +1,292 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// content returns the positive rational c such that the components v divided
+// by c are coprime integers: the greatest common divisor of the numerators
+// over the least common multiple of the denominators. If v is all zero, then
+// content returns zero. It sets the components w to v divided by c.
+func content(w, v []*big.Rat) *big.Rat {
+	num, den := new(big.Int), big.NewInt(1)
+	g := new(big.Int)
+	for _, c := range v {
+		num.GCD(nil, nil, num, new(big.Int).Abs(c.Num()))
+		g.GCD(nil, nil, den, c.Denom())
+		den.Mul(den, new(big.Int).Quo(c.Denom(), g))
+	}
+	if num.Sign() == 0 {
+		for _, c := range w {
+			c.SetInt64(0)
+		}
+		return new(big.Rat)
+	}
+	c := new(big.Rat).SetFrac(num, den)
+	inv := new(big.Rat).Inv(c)
+	for k := range w {
+		w[k].Mul(v[k], inv)
+	}
+	return c
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x a Complex value whose components are
+// coprime integers. The decomposition is unique, and z is left unchanged. If z
+// is zero, then c and x are zero.
+func (z *Complex) Primitive() (c *big.Rat, x *Complex) {
+	x = new(Complex)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x an Infra value whose components are
+// coprime integers. The decomposition is unique, and z is left unchanged. If z
+// is zero, then c and x are zero.
+func (z *Infra) Primitive() (c *big.Rat, x *Infra) {
+	x = new(Infra)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x a Perplex value whose components are
+// coprime integers. The decomposition is unique, and z is left unchanged. If z
+// is zero, then c and x are zero.
+func (z *Perplex) Primitive() (c *big.Rat, x *Perplex) {
+	x = new(Perplex)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x a BiComplex value whose components are
+// coprime integers. The decomposition is unique, and z is left unchanged. If z
+// is zero, then c and x are zero.
+func (z *BiComplex) Primitive() (c *big.Rat, x *BiComplex) {
+	x = new(BiComplex)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x a BiPerplex value whose components are
+// coprime integers. The decomposition is unique, and z is left unchanged. If z
+// is zero, then c and x are zero.
+func (z *BiPerplex) Primitive() (c *big.Rat, x *BiPerplex) {
+	x = new(BiPerplex)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x a Cockle value whose components are
+// coprime integers. The decomposition is unique, and z is left unchanged. If z
+// is zero, then c and x are zero.
+func (z *Cockle) Primitive() (c *big.Rat, x *Cockle) {
+	x = new(Cockle)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x a DualComplex value whose components are
+// coprime integers. The decomposition is unique, and z is left unchanged. If z
+// is zero, then c and x are zero.
+func (z *DualComplex) Primitive() (c *big.Rat, x *DualComplex) {
+	x = new(DualComplex)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x a DualPerplex value whose components are
+// coprime integers. The decomposition is unique, and z is left unchanged. If z
+// is zero, then c and x are zero.
+func (z *DualPerplex) Primitive() (c *big.Rat, x *DualPerplex) {
+	x = new(DualPerplex)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x a Hamilton value whose components are
+// coprime integers. The decomposition is unique, and z is left unchanged. If z
+// is zero, then c and x are zero.
+func (z *Hamilton) Primitive() (c *big.Rat, x *Hamilton) {
+	x = new(Hamilton)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x a Hyper value whose components are coprime
+// integers. The decomposition is unique, and z is left unchanged. If z is
+// zero, then c and x are zero.
+func (z *Hyper) Primitive() (c *big.Rat, x *Hyper) {
+	x = new(Hyper)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x an InfraComplex value whose components are
+// coprime integers. The decomposition is unique, and z is left unchanged. If z
+// is zero, then c and x are zero.
+func (z *InfraComplex) Primitive() (c *big.Rat, x *InfraComplex) {
+	x = new(InfraComplex)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x an InfraPerplex value whose components are
+// coprime integers. The decomposition is unique, and z is left unchanged. If z
+// is zero, then c and x are zero.
+func (z *InfraPerplex) Primitive() (c *big.Rat, x *InfraPerplex) {
+	x = new(InfraPerplex)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x a Supra value whose components are coprime
+// integers. The decomposition is unique, and z is left unchanged. If z is
+// zero, then c and x are zero.
+func (z *Supra) Primitive() (c *big.Rat, x *Supra) {
+	x = new(Supra)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x a BiCockle value whose components are
+// coprime integers. The decomposition is unique, and z is left unchanged. If z
+// is zero, then c and x are zero.
+func (z *BiCockle) Primitive() (c *big.Rat, x *BiCockle) {
+	x = new(BiCockle)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x a BiHamilton value whose components are
+// coprime integers. The decomposition is unique, and z is left unchanged. If z
+// is zero, then c and x are zero.
+func (z *BiHamilton) Primitive() (c *big.Rat, x *BiHamilton) {
+	x = new(BiHamilton)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x a Cayley value whose components are
+// coprime integers. The decomposition is unique, and z is left unchanged. If z
+// is zero, then c and x are zero.
+func (z *Cayley) Primitive() (c *big.Rat, x *Cayley) {
+	x = new(Cayley)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x a DualHamilton value whose components are
+// coprime integers. The decomposition is unique, and z is left unchanged. If z
+// is zero, then c and x are zero.
+func (z *DualHamilton) Primitive() (c *big.Rat, x *DualHamilton) {
+	x = new(DualHamilton)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x an InfraCockle value whose components are
+// coprime integers. The decomposition is unique, and z is left unchanged. If z
+// is zero, then c and x are zero.
+func (z *InfraCockle) Primitive() (c *big.Rat, x *InfraCockle) {
+	x = new(InfraCockle)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x an InfraHamilton value whose components
+// are coprime integers. The decomposition is unique, and z is left unchanged.
+// If z is zero, then c and x are zero.
+func (z *InfraHamilton) Primitive() (c *big.Rat, x *InfraHamilton) {
+	x = new(InfraHamilton)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x a SupraComplex value whose components are
+// coprime integers. The decomposition is unique, and z is left unchanged. If z
+// is zero, then c and x are zero.
+func (z *SupraComplex) Primitive() (c *big.Rat, x *SupraComplex) {
+	x = new(SupraComplex)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x a SupraPerplex value whose components are
+// coprime integers. The decomposition is unique, and z is left unchanged. If z
+// is zero, then c and x are zero.
+func (z *SupraPerplex) Primitive() (c *big.Rat, x *SupraPerplex) {
+	x = new(SupraPerplex)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x a TriComplex value whose components are
+// coprime integers. The decomposition is unique, and z is left unchanged. If z
+// is zero, then c and x are zero.
+func (z *TriComplex) Primitive() (c *big.Rat, x *TriComplex) {
+	x = new(TriComplex)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x a TriNilplex value whose components are
+// coprime integers. The decomposition is unique, and z is left unchanged. If z
+// is zero, then c and x are zero.
+func (z *TriNilplex) Primitive() (c *big.Rat, x *TriNilplex) {
+	x = new(TriNilplex)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x a TriPerplex value whose components are
+// coprime integers. The decomposition is unique, and z is left unchanged. If z
+// is zero, then c and x are zero.
+func (z *TriPerplex) Primitive() (c *big.Rat, x *TriPerplex) {
+	x = new(TriPerplex)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x an Ultra value whose components are
+// coprime integers. The decomposition is unique, and z is left unchanged. If z
+// is zero, then c and x are zero.
+func (z *Ultra) Primitive() (c *big.Rat, x *Ultra) {
+	x = new(Ultra)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
+
+// Primitive returns the decomposition z = c·x, with the content c a positive
+// rational and the primitive part x a Zorn value whose components are coprime
+// integers. The decomposition is unique, and z is left unchanged. If z is
+// zero, then c and x are zero.
+func (z *Zorn) Primitive() (c *big.Rat, x *Zorn) {
+	x = new(Zorn)
+	c = content(x.Components(), z.Components())
+	return c, x
+}
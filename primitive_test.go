@@ -0,0 +1,44 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestHamiltonPrimitive(t *testing.T) {
+	f := func(z *Hamilton) bool {
+		// t.Logf("z = %v", z)
+		c, x := z.Primitive()
+		if !new(Hamilton).Scal(x, c).Equals(z) {
+			return false
+		}
+		if z.Equals(new(Hamilton)) {
+			return c.Sign() == 0 && x.Equals(z)
+		}
+		if c.Sign() <= 0 {
+			return false
+		}
+		g := new(big.Int)
+		for _, v := range x.Components() {
+			if !v.IsInt() {
+				return false
+			}
+			g.GCD(nil, nil, g, new(big.Int).Abs(v.Num()))
+		}
+		// the primitive part of a multiple is the same
+		_, y := new(Hamilton).Scal(z, big.NewRat(-7, 4)).Primitive()
+		return g.Cmp(big.NewInt(1)) == 0 && y.Equals(new(Hamilton).Neg(x))
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+	z := NewComplex(big.NewRat(4, 3), big.NewRat(-2, 9))
+	c, x := z.Primitive()
+	if c.Cmp(big.NewRat(2, 9)) != 0 || !x.Equals(NewComplex(big.NewRat(6, 1), big.NewRat(-1, 1))) {
+		t.Errorf("Primitive(%v) = %v, %v", z, c, x)
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+)
+
+// A PromoteError reports that two values have no common algebra.
+type PromoteError struct {
+	X, Y string // the types, such as "Hamilton" and "Perplex"
+}
+
+func (e *PromoteError) Error() string {
+	return fmt.Sprintf("rational: Promote: no common algebra for %s and %s", e.X, e.Y)
+}
+
+// halves maps the name of each type to the name of its half, the type of the
+// values that its Embed method accepts, and to a function that embeds them.
+// The half of Complex, Infra, and Perplex is *big.Rat.
+var halves = map[string]struct {
+	half  string
+	embed func(y fmt.Stringer) fmt.Stringer
+}{
+	"Complex": {"Rat", func(y fmt.Stringer) fmt.Stringer {
+		z := new(Complex)
+		z.Real().Set(y.(*big.Rat))
+		return z
+	}},
+	"Infra": {"Rat", func(y fmt.Stringer) fmt.Stringer {
+		z := new(Infra)
+		z.Real().Set(y.(*big.Rat))
+		return z
+	}},
+	"Perplex": {"Rat", func(y fmt.Stringer) fmt.Stringer {
+		z := new(Perplex)
+		z.Real().Set(y.(*big.Rat))
+		return z
+	}},
+	"BiComplex": {"Complex", func(y fmt.Stringer) fmt.Stringer {
+		return new(BiComplex).Embed(y.(*Complex))
+	}},
+	"BiPerplex": {"Perplex", func(y fmt.Stringer) fmt.Stringer {
+		return new(BiPerplex).Embed(y.(*Perplex))
+	}},
+	"Cockle": {"Complex", func(y fmt.Stringer) fmt.Stringer {
+		return new(Cockle).Embed(y.(*Complex))
+	}},
+	"DualComplex": {"Complex", func(y fmt.Stringer) fmt.Stringer {
+		return new(DualComplex).Embed(y.(*Complex))
+	}},
+	"DualPerplex": {"Perplex", func(y fmt.Stringer) fmt.Stringer {
+		return new(DualPerplex).Embed(y.(*Perplex))
+	}},
+	"Hamilton": {"Complex", func(y fmt.Stringer) fmt.Stringer {
+		return new(Hamilton).Embed(y.(*Complex))
+	}},
+	"Hyper": {"Infra", func(y fmt.Stringer) fmt.Stringer {
+		return new(Hyper).Embed(y.(*Infra))
+	}},
+	"InfraComplex": {"Complex", func(y fmt.Stringer) fmt.Stringer {
+		return new(InfraComplex).Embed(y.(*Complex))
+	}},
+	"InfraPerplex": {"Perplex", func(y fmt.Stringer) fmt.Stringer {
+		return new(InfraPerplex).Embed(y.(*Perplex))
+	}},
+	"Supra": {"Infra", func(y fmt.Stringer) fmt.Stringer {
+		return new(Supra).Embed(y.(*Infra))
+	}},
+	"BiCockle": {"Cockle", func(y fmt.Stringer) fmt.Stringer {
+		return new(BiCockle).Embed(y.(*Cockle))
+	}},
+	"BiHamilton": {"Hamilton", func(y fmt.Stringer) fmt.Stringer {
+		return new(BiHamilton).Embed(y.(*Hamilton))
+	}},
+	"Cayley": {"Hamilton", func(y fmt.Stringer) fmt.Stringer {
+		return new(Cayley).Embed(y.(*Hamilton))
+	}},
+	"InfraCockle": {"Cockle", func(y fmt.Stringer) fmt.Stringer {
+		return new(InfraCockle).Embed(y.(*Cockle))
+	}},
+	"InfraHamilton": {"Hamilton", func(y fmt.Stringer) fmt.Stringer {
+		return new(InfraHamilton).Embed(y.(*Hamilton))
+	}},
+	"SupraComplex": {"InfraComplex", func(y fmt.Stringer) fmt.Stringer {
+		return new(SupraComplex).Embed(y.(*InfraComplex))
+	}},
+	"SupraPerplex": {"InfraPerplex", func(y fmt.Stringer) fmt.Stringer {
+		return new(SupraPerplex).Embed(y.(*InfraPerplex))
+	}},
+	"TriComplex": {"BiComplex", func(y fmt.Stringer) fmt.Stringer {
+		return new(TriComplex).Embed(y.(*BiComplex))
+	}},
+	"TriNilplex": {"Hyper", func(y fmt.Stringer) fmt.Stringer {
+		return new(TriNilplex).Embed(y.(*Hyper))
+	}},
+	"TriPerplex": {"BiPerplex", func(y fmt.Stringer) fmt.Stringer {
+		return new(TriPerplex).Embed(y.(*BiPerplex))
+	}},
+	"Ultra": {"Supra", func(y fmt.Stringer) fmt.Stringer {
+		return new(Ultra).Embed(y.(*Supra))
+	}},
+	"Zorn": {"Hamilton", func(y fmt.Stringer) fmt.Stringer {
+		return new(Zorn).Embed(y.(*Hamilton))
+	}},
+	"DualHamilton": {"Hamilton", func(y fmt.Stringer) fmt.Stringer {
+		return new(DualHamilton).Embed(y.(*Hamilton))
+	}},
+}
+
+// typeName returns the name of the type that v points to, such as "Hamilton"
+// or "Rat".
+func typeName(v fmt.Stringer) string {
+	t := reflect.TypeOf(v)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+// lift embeds v, whose type is at index i of the chain of halves down from
+// path[0], into path[0].
+func lift(v fmt.Stringer, path []string, i int) fmt.Stringer {
+	for k := i - 1; k >= 0; k-- {
+		v = halves[path[k]].embed(v)
+	}
+	return v
+}
+
+// chain returns the name t followed by the names of its half, the half of
+// its half, and so on, down to "Rat".
+func chain(t string) []string {
+	path := []string{t}
+	for {
+		h, ok := halves[path[len(path)-1]]
+		if !ok {
+			return path
+		}
+		path = append(path, h.half)
+	}
+}
+
+// Promote lifts x and y to a common algebra, so that they can be added or
+// multiplied, and returns them as values of the same type. Each type embeds in
+// the types that double it, by Embed, and *big.Rat values embed as real parts,
+// so the types form the trees
+// 		Rat ⊂ Complex ⊂ Hamilton ⊂ Cayley, Zorn, BiHamilton, DualHamilton, InfraHamilton
+// 		            Complex ⊂ Cockle ⊂ BiCockle, InfraCockle
+// 		            Complex ⊂ BiComplex ⊂ TriComplex
+// 		            Complex ⊂ DualComplex
+// 		            Complex ⊂ InfraComplex ⊂ SupraComplex
+// 		Rat ⊂ Perplex ⊂ BiPerplex ⊂ TriPerplex
+// 		            Perplex ⊂ DualPerplex
+// 		            Perplex ⊂ InfraPerplex ⊂ SupraPerplex
+// 		Rat ⊂ Infra ⊂ Supra ⊂ Ultra
+// 		            Infra ⊂ Hyper ⊂ TriNilplex
+// Two types are compatible if one lies below the other on the same branch,
+// and the common algebra is the higher one. The value of that type is
+// returned as is, and the other is a new value. Every type is compatible with
+// itself, including types outside the trees such as ComplexInt. Otherwise
+// Promote returns a *PromoteError: Hamilton and Perplex, for instance, have no
+// common algebra here, although both embed in other constructs.
+func Promote(x, y fmt.Stringer) (fmt.Stringer, fmt.Stringer, error) {
+	a, b := typeName(x), typeName(y)
+	if a == b {
+		return x, y, nil
+	}
+	path := chain(a)
+	for i, t := range path {
+		if t == b {
+			return x, lift(y, path, i), nil
+		}
+	}
+	path = chain(b)
+	for i, t := range path {
+		if t == a {
+			return lift(x, path, i), y, nil
+		}
+	}
+	return nil, nil, &PromoteError{a, b}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestPromote(t *testing.T) {
+	f := func(x *Complex, y *Hamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a, b, err := Promote(x, y)
+		if err != nil {
+			return false
+		}
+		p, ok := a.(*Hamilton)
+		if !ok || b != y {
+			return false
+		}
+		return p.ComplexPart().Equals(x) && new(Hamilton).Embed(x).Equals(p)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+	g := func(x *Infra, y *TriNilplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		b, a, err := Promote(y, x)
+		if err != nil || b != y {
+			return false
+		}
+		p := a.(*TriNilplex)
+		return p.HyperPart().InfraPart().Equals(x) && p.Real().Cmp(x.Real()) == 0
+	}
+	if err := quick.Check(g, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPromoteRat(t *testing.T) {
+	half := big.NewRat(1, 2)
+	for name := range halves {
+		path := chain(name)
+		y := lift(new(big.Rat), path, len(path)-1)
+		a, b, err := Promote(half, y)
+		if err != nil {
+			t.Errorf("Promote(1/2, %s): %v", name, err)
+			continue
+		}
+		if typeName(a) != name || b != y {
+			t.Errorf("Promote(1/2, %s) = %T, %T", name, a, b)
+			continue
+		}
+		if r := a.(interface{ Real() *big.Rat }).Real(); r.Cmp(half) != 0 {
+			t.Errorf("Promote(1/2, %s) has real part %v", name, r)
+		}
+	}
+}
+
+func TestPromoteError(t *testing.T) {
+	x := NewHamilton(big.NewRat(1, 1), big.NewRat(2, 1), big.NewRat(3, 1), big.NewRat(4, 1))
+	y := NewPerplex(big.NewRat(1, 1), big.NewRat(2, 1))
+	var e *PromoteError
+	if _, _, err := Promote(x, y); !errors.As(err, &e) || e.X != "Hamilton" || e.Y != "Perplex" {
+		t.Errorf("Promote(%v, %v) error = %v", x, y, err)
+	}
+	c := NewCockle(big.NewRat(1, 1), big.NewRat(2, 1), big.NewRat(3, 1), big.NewRat(4, 1))
+	if _, _, err := Promote(c, x); err == nil {
+		t.Errorf("Promote(%v, %v) succeeded", c, x)
+	}
+	u := NewComplexInt(big.NewInt(1), big.NewInt(2))
+	if a, b, err := Promote(u, u); err != nil || a != u || b != u {
+		t.Errorf("Promote(%v, %v) = %v, %v, %v", u, u, a, b, err)
+	}
+}
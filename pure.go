@@ -0,0 +1,326 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// A HamiltonPure represents a pure quaternion ai+bj+ck, a vector of rational
+// 3-space with the Euclidean dot product, and the cross product of vector
+// calculus. The HamiltonPure values are closed under sums and Cross, but not
+// under products, which have a real part; PureMul gives the full Hamilton
+// product.
+type HamiltonPure struct {
+	v Hamilton
+}
+
+// NewHamiltonPure returns a pointer to the HamiltonPure value ai+bj+ck.
+func NewHamiltonPure(a, b, c *big.Rat) *HamiltonPure {
+	z := new(HamiltonPure)
+	v := z.v.Components()
+	v[1].Set(a)
+	v[2].Set(b)
+	v[3].Set(c)
+	return z
+}
+
+// Components returns the three rational components of z, along i, j, and k.
+// The results alias z.
+func (z *HamiltonPure) Components() []*big.Rat {
+	return z.v.Components()[1:]
+}
+
+// String returns the string representation of z, as a Hamilton value with a
+// zero real part.
+func (z *HamiltonPure) String() string {
+	return z.v.String()
+}
+
+// Equals returns true if z and y are equal.
+func (z *HamiltonPure) Equals(y *HamiltonPure) bool {
+	return z.v.Equals(&y.v)
+}
+
+// Set sets z equal to y, and returns z.
+func (z *HamiltonPure) Set(y *HamiltonPure) *HamiltonPure {
+	z.v.Set(&y.v)
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *HamiltonPure) Scal(y *HamiltonPure, a *big.Rat) *HamiltonPure {
+	z.v.Scal(&y.v, a)
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *HamiltonPure) Neg(y *HamiltonPure) *HamiltonPure {
+	z.v.Neg(&y.v)
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *HamiltonPure) Add(x, y *HamiltonPure) *HamiltonPure {
+	z.v.Add(&x.v, &y.v)
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *HamiltonPure) Sub(x, y *HamiltonPure) *HamiltonPure {
+	z.v.Sub(&x.v, &y.v)
+	return z
+}
+
+// Dot returns the dot product of z and y, the negative of the real part of
+// their Hamilton product. Dot(z, z) is the quadrance of z.
+func (z *HamiltonPure) Dot(y *HamiltonPure) *big.Rat {
+	p := new(Hamilton).Mul(&z.v, &y.v)
+	return p.Real().Neg(p.Real())
+}
+
+// Cross sets z equal to the cross product of x and y, the pure part of their
+// Hamilton product, and returns z. It is anti-commutative, and orthogonal to x
+// and y under Dot.
+func (z *HamiltonPure) Cross(x, y *HamiltonPure) *HamiltonPure {
+	z.v.Mul(&x.v, &y.v)
+	z.v.Real().SetInt64(0)
+	return z
+}
+
+// Split returns the real part and the pure part of z as new values, so that z
+// is their sum.
+func (z *Hamilton) Split() (*big.Rat, *HamiltonPure) {
+	v := new(HamiltonPure)
+	v.v.Set(z)
+	v.v.Real().SetInt64(0)
+	return new(big.Rat).Set(z.Real()), v
+}
+
+// SetSplit sets z equal to a+v, and returns z. It undoes Split.
+func (z *Hamilton) SetSplit(a *big.Rat, v *HamiltonPure) *Hamilton {
+	z.Set(&v.v)
+	z.Real().Set(a)
+	return z
+}
+
+// PureMul sets z equal to the product of the pure values x and y, and returns
+// z. The product is
+// 		xy = -Dot(x, y) + Cross(x, y)
+func (z *Hamilton) PureMul(x, y *HamiltonPure) *Hamilton {
+	return z.Mul(&x.v, &y.v)
+}
+
+// A CocklePure represents a pure split quaternion ai+bt+cu, a vector of
+// rational 3-space with a Lorentzian dot product of signature (+, -, -), and
+// the matching cross product. The CocklePure values are closed under sums and
+// Cross, but not under products, which have a real part; PureMul gives the
+// full Cockle product.
+type CocklePure struct {
+	v Cockle
+}
+
+// NewCocklePure returns a pointer to the CocklePure value ai+bt+cu.
+func NewCocklePure(a, b, c *big.Rat) *CocklePure {
+	z := new(CocklePure)
+	v := z.v.Components()
+	v[1].Set(a)
+	v[2].Set(b)
+	v[3].Set(c)
+	return z
+}
+
+// Components returns the three rational components of z, along i, t, and u.
+// The results alias z.
+func (z *CocklePure) Components() []*big.Rat {
+	return z.v.Components()[1:]
+}
+
+// String returns the string representation of z, as a Cockle value with a zero
+// real part.
+func (z *CocklePure) String() string {
+	return z.v.String()
+}
+
+// Equals returns true if z and y are equal.
+func (z *CocklePure) Equals(y *CocklePure) bool {
+	return z.v.Equals(&y.v)
+}
+
+// Set sets z equal to y, and returns z.
+func (z *CocklePure) Set(y *CocklePure) *CocklePure {
+	z.v.Set(&y.v)
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *CocklePure) Scal(y *CocklePure, a *big.Rat) *CocklePure {
+	z.v.Scal(&y.v, a)
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *CocklePure) Neg(y *CocklePure) *CocklePure {
+	z.v.Neg(&y.v)
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *CocklePure) Add(x, y *CocklePure) *CocklePure {
+	z.v.Add(&x.v, &y.v)
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *CocklePure) Sub(x, y *CocklePure) *CocklePure {
+	z.v.Sub(&x.v, &y.v)
+	return z
+}
+
+// Dot returns the dot product of z and y, the negative of the real part of
+// their Cockle product. Dot(z, z) is the quadrance of z.
+func (z *CocklePure) Dot(y *CocklePure) *big.Rat {
+	p := new(Cockle).Mul(&z.v, &y.v)
+	return p.Real().Neg(p.Real())
+}
+
+// Cross sets z equal to the cross product of x and y, the pure part of their
+// Cockle product, and returns z. It is anti-commutative, and orthogonal to x
+// and y under Dot.
+func (z *CocklePure) Cross(x, y *CocklePure) *CocklePure {
+	z.v.Mul(&x.v, &y.v)
+	z.v.Real().SetInt64(0)
+	return z
+}
+
+// Split returns the real part and the pure part of z as new values, so that z
+// is their sum.
+func (z *Cockle) Split() (*big.Rat, *CocklePure) {
+	v := new(CocklePure)
+	v.v.Set(z)
+	v.v.Real().SetInt64(0)
+	return new(big.Rat).Set(z.Real()), v
+}
+
+// SetSplit sets z equal to a+v, and returns z. It undoes Split.
+func (z *Cockle) SetSplit(a *big.Rat, v *CocklePure) *Cockle {
+	z.Set(&v.v)
+	z.Real().Set(a)
+	return z
+}
+
+// PureMul sets z equal to the product of the pure values x and y, and returns
+// z. The product is
+// 		xy = -Dot(x, y) + Cross(x, y)
+func (z *Cockle) PureMul(x, y *CocklePure) *Cockle {
+	return z.Mul(&x.v, &y.v)
+}
+
+// A CayleyPure represents a pure octonion ai+bj+ck+dm+en+fp+gq, a vector of
+// rational 7-space with the Euclidean dot product, and the seven-dimensional
+// cross product. The CayleyPure values are closed under sums and Cross, but
+// not under products, which have a real part; PureMul gives the full Cayley
+// product.
+type CayleyPure struct {
+	v Cayley
+}
+
+// NewCayleyPure returns a pointer to the CayleyPure value
+// ai+bj+ck+dm+en+fp+gq.
+func NewCayleyPure(a, b, c, d, e, f, g *big.Rat) *CayleyPure {
+	z := new(CayleyPure)
+	v := z.v.Components()
+	v[1].Set(a)
+	v[2].Set(b)
+	v[3].Set(c)
+	v[4].Set(d)
+	v[5].Set(e)
+	v[6].Set(f)
+	v[7].Set(g)
+	return z
+}
+
+// Components returns the seven rational components of z, along i, j, k, m, n,
+// p, and q. The results alias z.
+func (z *CayleyPure) Components() []*big.Rat {
+	return z.v.Components()[1:]
+}
+
+// String returns the string representation of z, as a Cayley value with a zero
+// real part.
+func (z *CayleyPure) String() string {
+	return z.v.String()
+}
+
+// Equals returns true if z and y are equal.
+func (z *CayleyPure) Equals(y *CayleyPure) bool {
+	return z.v.Equals(&y.v)
+}
+
+// Set sets z equal to y, and returns z.
+func (z *CayleyPure) Set(y *CayleyPure) *CayleyPure {
+	z.v.Set(&y.v)
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *CayleyPure) Scal(y *CayleyPure, a *big.Rat) *CayleyPure {
+	z.v.Scal(&y.v, a)
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *CayleyPure) Neg(y *CayleyPure) *CayleyPure {
+	z.v.Neg(&y.v)
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *CayleyPure) Add(x, y *CayleyPure) *CayleyPure {
+	z.v.Add(&x.v, &y.v)
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *CayleyPure) Sub(x, y *CayleyPure) *CayleyPure {
+	z.v.Sub(&x.v, &y.v)
+	return z
+}
+
+// Dot returns the dot product of z and y, the negative of the real part of
+// their Cayley product. Dot(z, z) is the quadrance of z.
+func (z *CayleyPure) Dot(y *CayleyPure) *big.Rat {
+	p := new(Cayley).Mul(&z.v, &y.v)
+	return p.Real().Neg(p.Real())
+}
+
+// Cross sets z equal to the cross product of x and y, the pure part of their
+// Cayley product, and returns z. It is anti-commutative, and orthogonal to x
+// and y under Dot.
+func (z *CayleyPure) Cross(x, y *CayleyPure) *CayleyPure {
+	z.v.Mul(&x.v, &y.v)
+	z.v.Real().SetInt64(0)
+	return z
+}
+
+// Split returns the real part and the pure part of z as new values, so that z
+// is their sum.
+func (z *Cayley) Split() (*big.Rat, *CayleyPure) {
+	v := new(CayleyPure)
+	v.v.Set(z)
+	v.v.Real().SetInt64(0)
+	return new(big.Rat).Set(z.Real()), v
+}
+
+// SetSplit sets z equal to a+v, and returns z. It undoes Split.
+func (z *Cayley) SetSplit(a *big.Rat, v *CayleyPure) *Cayley {
+	z.Set(&v.v)
+	z.Real().Set(a)
+	return z
+}
+
+// PureMul sets z equal to the product of the pure values x and y, and returns
+// z. The product is
+// 		xy = -Dot(x, y) + Cross(x, y)
+func (z *Cayley) PureMul(x, y *CayleyPure) *Cayley {
+	return z.Mul(&x.v, &y.v)
+}
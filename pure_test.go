@@ -0,0 +1,82 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestHamiltonSplit(t *testing.T) {
+	f := func(x, y *Hamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a, u := x.Split()
+		_, v := y.Split()
+		if !new(Hamilton).SetSplit(a, u).Equals(x) || a.Cmp(x.Real()) != 0 {
+			return false
+		}
+		c := new(HamiltonPure).Cross(u, v)
+		p := new(Hamilton).PureMul(u, v)
+		if _, w := p.Split(); !w.Equals(c) || p.Real().Cmp(new(big.Rat).Neg(u.Dot(v))) != 0 {
+			return false
+		}
+		// the cross product of vector calculus
+		x1, y1 := u.Components(), v.Components()
+		for i := 0; i < 3; i++ {
+			j, k := (i+1)%3, (i+2)%3
+			w := new(big.Rat).Mul(x1[j], y1[k])
+			w.Sub(w, new(big.Rat).Mul(x1[k], y1[j]))
+			if w.Cmp(c.Components()[i]) != 0 {
+				return false
+			}
+		}
+		return u.Dot(v).Cmp(v.Dot(u)) == 0 && u.Dot(u).Cmp(x.Quad().Sub(x.Quad(), new(big.Rat).Mul(a, a))) == 0
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCocklePure(t *testing.T) {
+	f := func(x, y *Cockle) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		_, u := x.Split()
+		_, v := y.Split()
+		c := new(CocklePure).Cross(u, v)
+		if c.Dot(u).Sign() != 0 || c.Dot(v).Sign() != 0 {
+			return false
+		}
+		// xy = -Dot(x, y) + Cross(x, y)
+		p := new(Cockle).SetSplit(new(big.Rat).Neg(u.Dot(v)), c)
+		return p.Equals(new(Cockle).PureMul(u, v)) && new(CocklePure).Neg(c).Equals(new(CocklePure).Cross(v, u))
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+	u := NewCocklePure(big.NewRat(1, 1), big.NewRat(2, 1), big.NewRat(3, 1))
+	if d := u.Dot(u); d.Cmp(big.NewRat(-12, 1)) != 0 {
+		t.Errorf("Dot(%v, %v) = %v, want -12", u, u, d)
+	}
+}
+
+func TestCayleyPure(t *testing.T) {
+	f := func(x, y *Cayley) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		_, u := x.Split()
+		_, v := y.Split()
+		c := new(CayleyPure).Cross(u, v)
+		if c.Dot(u).Sign() != 0 || c.Dot(v).Sign() != 0 {
+			return false
+		}
+		// the Lagrange identity holds in seven dimensions
+		d := u.Dot(v)
+		l := new(big.Rat).Mul(u.Dot(u), v.Dot(v))
+		l.Sub(l, d.Mul(d, d))
+		return c.Dot(c).Cmp(l) == 0
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
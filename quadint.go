@@ -0,0 +1,249 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+// A DiscriminantError reports a discriminant that does not belong to an
+// imaginary quadratic order.
+type DiscriminantError struct {
+	D *big.Int
+}
+
+func (e *DiscriminantError) Error() string {
+	return fmt.Sprintf("rational: %v is not a negative integer equal to 0 or 1 modulo 4", e.D)
+}
+
+// A QuadInt represents an element a+bω of the imaginary quadratic order of
+// discriminant D, a negative integer equal to 0 or 1 modulo 4. With σ equal to
+// D modulo 2, the generator is
+// 		ω = (-σ + √D)/2
+// which satisfies
+// 		ω² = -σω - (σ - D)/4
+// so that ω = i for D = -4, giving the Gaussian integers, and ω is a primitive
+// cube root of unity for D = -3, giving the Eisenstein integers. Since √D is
+// irrational for most D, these values are not Complex values; the arithmetic
+// is exact integer arithmetic in the basis 1 and ω.
+type QuadInt struct {
+	d, l, r big.Int
+}
+
+// quadDisc returns σ and (σ - D)/4 for the discriminant d.
+func quadDisc(d *big.Int) (sigma, c *big.Int) {
+	sigma = big.NewInt(int64(d.Bit(0)))
+	c = new(big.Int).Sub(sigma, d)
+	return sigma, c.Rsh(c, 2)
+}
+
+// NewQuadInt returns a pointer to the QuadInt value a+bω in the order of
+// discriminant d. If d is not negative and equal to 0 or 1 modulo 4, then
+// NewQuadInt returns a *DiscriminantError.
+func NewQuadInt(d, a, b *big.Int) (*QuadInt, error) {
+	if m := new(big.Int).Mod(d, big.NewInt(4)).Int64(); d.Sign() >= 0 || m > 1 {
+		return nil, &DiscriminantError{new(big.Int).Set(d)}
+	}
+	z := new(QuadInt)
+	z.d.Set(d)
+	z.l.Set(a)
+	z.r.Set(b)
+	return z, nil
+}
+
+// NewQuadIntRoot returns a pointer to the QuadInt value a+b√-n in the order
+// Z[√-n] of discriminant -4n, in the manner of Kummer's integers. If n is not
+// positive, then NewQuadIntRoot returns a *DiscriminantError.
+func NewQuadIntRoot(n, a, b *big.Int) (*QuadInt, error) {
+	return NewQuadInt(new(big.Int).Mul(n, big.NewInt(-4)), a, b)
+}
+
+// Eisenstein returns a pointer to the Eisenstein integer a+bω, with
+// 		ω = (-1 + √-3)/2
+// a primitive cube root of unity.
+func Eisenstein(a, b *big.Int) *QuadInt {
+	z, _ := NewQuadInt(big.NewInt(-3), a, b)
+	return z
+}
+
+// Disc returns the discriminant of the order of z.
+func (z *QuadInt) Disc() *big.Int {
+	return new(big.Int).Set(&z.d)
+}
+
+// Components returns the two integer components of z as a slice, along 1 and
+// ω. The results alias z.
+func (z *QuadInt) Components() []*big.Int {
+	return []*big.Int{&z.l, &z.r}
+}
+
+// String returns the string version of a QuadInt value. If z = a+bω, then the
+// string is "(a+bω)", similar to Complex.
+func (z *QuadInt) String() string {
+	a := make([]string, 5)
+	br := brackets()
+	a[0] = br[0]
+	a[1] = z.l.String()
+	if z.r.Sign() < 0 {
+		a[2] = z.r.String()
+	} else {
+		a[2] = "+" + z.r.String()
+	}
+	a[3] = "ω"
+	a[4] = br[1]
+	return strings.Join(a, "")
+}
+
+// Equals returns true if y and z are equal elements of the same order.
+func (z *QuadInt) Equals(y *QuadInt) bool {
+	return z.d.Cmp(&y.d) == 0 && z.l.Cmp(&y.l) == 0 && z.r.Cmp(&y.r) == 0
+}
+
+// Set sets z equal to y, and returns z.
+func (z *QuadInt) Set(y *QuadInt) *QuadInt {
+	z.d.Set(&y.d)
+	z.l.Set(&y.l)
+	z.r.Set(&y.r)
+	return z
+}
+
+// sameOrder panics if x and y belong to different orders.
+func sameOrder(x, y *QuadInt) {
+	if x.d.Cmp(&y.d) != 0 {
+		panic("different discriminants")
+	}
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *QuadInt) Neg(y *QuadInt) *QuadInt {
+	z.d.Set(&y.d)
+	z.l.Neg(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Conj sets z equal to the conjugate of y, and returns z. Since the conjugate
+// of ω is -σ-ω, if y = a+bω, then the conjugate is
+// 		(a - σb) - bω
+func (z *QuadInt) Conj(y *QuadInt) *QuadInt {
+	sigma, _ := quadDisc(&y.d)
+	l := new(big.Int).Sub(&y.l, sigma.Mul(sigma, &y.r))
+	z.d.Set(&y.d)
+	z.r.Neg(&y.r)
+	z.l.Set(l)
+	return z
+}
+
+// Add sets z equal to x+y, and returns z. If x and y belong to different
+// orders, then Add panics.
+func (z *QuadInt) Add(x, y *QuadInt) *QuadInt {
+	sameOrder(x, y)
+	z.d.Set(&x.d)
+	z.l.Add(&x.l, &y.l)
+	z.r.Add(&x.r, &y.r)
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z. If x and y belong to different
+// orders, then Sub panics.
+func (z *QuadInt) Sub(x, y *QuadInt) *QuadInt {
+	sameOrder(x, y)
+	z.d.Set(&x.d)
+	z.l.Sub(&x.l, &y.l)
+	z.r.Sub(&x.r, &y.r)
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z. If x = a+bω and
+// y = c+dω, then the product is
+// 		(ac - (σ - D)bd/4) + (ad + bc - σbd)ω
+// If x and y belong to different orders, then Mul panics.
+func (z *QuadInt) Mul(x, y *QuadInt) *QuadInt {
+	sameOrder(x, y)
+	sigma, c := quadDisc(&x.d)
+	bd := new(big.Int).Mul(&x.r, &y.r)
+	l := new(big.Int).Mul(&x.l, &y.l)
+	l.Sub(l, c.Mul(c, bd))
+	r := new(big.Int).Mul(&x.l, &y.r)
+	r.Add(r, new(big.Int).Mul(&x.r, &y.l))
+	r.Sub(r, sigma.Mul(sigma, bd))
+	z.d.Set(&x.d)
+	z.l.Set(l)
+	z.r.Set(r)
+	return z
+}
+
+// Norm returns the norm z Conj(z) of z. If z = a+bω, then the norm is
+// 		a² - σab + (σ - D)b²/4
+// This is always non-negative, and it is zero only if z is zero.
+func (z *QuadInt) Norm() *big.Int {
+	sigma, c := quadDisc(&z.d)
+	n := new(big.Int).Mul(&z.l, &z.l)
+	n.Sub(n, sigma.Mul(sigma, new(big.Int).Mul(&z.l, &z.r)))
+	return n.Add(n, c.Mul(c, new(big.Int).Mul(&z.r, &z.r)))
+}
+
+// Trace returns z + Conj(z). If z = a+bω, then the trace is 2a - σb.
+func (z *QuadInt) Trace() *big.Int {
+	sigma, _ := quadDisc(&z.d)
+	t := new(big.Int).Lsh(&z.l, 1)
+	return t.Sub(t, sigma.Mul(sigma, &z.r))
+}
+
+// IsUnit returns true if the norm of z is one.
+func (z *QuadInt) IsUnit() bool {
+	return z.Norm().Cmp(big.NewInt(1)) == 0
+}
+
+// Units returns the units of the order of z: the six powers of -ω for the
+// Eisenstein integers, the four powers of i for the Gaussian integers, and ±1
+// otherwise.
+func (z *QuadInt) Units() []*QuadInt {
+	one, _ := NewQuadInt(&z.d, big.NewInt(1), big.NewInt(0))
+	w, _ := NewQuadInt(&z.d, big.NewInt(0), big.NewInt(1))
+	g := new(QuadInt).Neg(one)
+	switch {
+	case !z.d.IsInt64():
+	case z.d.Int64() == -3:
+		g = w.Neg(w)
+	case z.d.Int64() == -4:
+		g = w
+	}
+	units := []*QuadInt{one}
+	for u := new(QuadInt).Mul(one, g); !u.Equals(one); u = new(QuadInt).Mul(u, g) {
+		units = append(units, u)
+	}
+	return units
+}
+
+// Complex returns z as a Complex value, and true. This is only possible in the
+// orders Z[mi] of discriminant -4m², where a+bω is the Complex value a+bmi;
+// otherwise Complex returns nil and false.
+func (z *QuadInt) Complex() (*Complex, bool) {
+	if z.d.Bit(0) != 0 {
+		return nil, false
+	}
+	n := new(big.Int).Rsh(new(big.Int).Neg(&z.d), 2)
+	m := new(big.Int).Sqrt(n)
+	if new(big.Int).Mul(m, m).Cmp(n) != 0 {
+		return nil, false
+	}
+	return new(Complex).Gauss(&z.l, m.Mul(m, &z.r)), true
+}
+
+// Generate returns a random QuadInt value for quick.Check testing, in the
+// order of a small random discriminant.
+func (z *QuadInt) Generate(rand *rand.Rand, size int) reflect.Value {
+	discs := []int64{-3, -4, -7, -8, -11, -12, -15, -16, -20, -23}
+	randomQuadInt, _ := NewQuadInt(
+		big.NewInt(discs[rand.Intn(len(discs))]),
+		big.NewInt(rand.Int63n(201)-100),
+		big.NewInt(rand.Int63n(201)-100),
+	)
+	return reflect.ValueOf(randomQuadInt)
+}
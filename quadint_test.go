@@ -0,0 +1,89 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestQuadIntMul(t *testing.T) {
+	f := func(x, y *QuadInt) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		y, _ = NewQuadInt(x.Disc(), &y.l, &y.r)
+		xy := new(QuadInt).Mul(x, y)
+		if !xy.Equals(new(QuadInt).Mul(y, x)) {
+			return false
+		}
+		if xy.Norm().Cmp(new(big.Int).Mul(x.Norm(), y.Norm())) != 0 {
+			return false
+		}
+		n := new(QuadInt).Mul(x, new(QuadInt).Conj(x))
+		if n.r.Sign() != 0 || n.l.Cmp(x.Norm()) != 0 {
+			return false
+		}
+		if new(QuadInt).Add(x, new(QuadInt).Conj(x)).l.Cmp(x.Trace()) != 0 {
+			return false
+		}
+		if c, ok := x.Complex(); ok {
+			d, _ := y.Complex()
+			p, _ := xy.Complex()
+			return p.Equals(new(Complex).Mul(c, d))
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestQuadIntUnits(t *testing.T) {
+	for _, test := range []struct {
+		d     int64
+		units int
+	}{
+		{-3, 6},
+		{-4, 4},
+		{-7, 2},
+		{-8, 2},
+		{-16, 2},
+	} {
+		x, err := NewQuadInt(big.NewInt(test.d), big.NewInt(0), big.NewInt(0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		units := x.Units()
+		if len(units) != test.units {
+			t.Errorf("Units(%d) = %v", test.d, units)
+		}
+		// every unit has components in {-1, 0, 1}
+		n := 0
+		for a := int64(-1); a <= 1; a++ {
+			for b := int64(-1); b <= 1; b++ {
+				u, _ := NewQuadInt(big.NewInt(test.d), big.NewInt(a), big.NewInt(b))
+				if u.IsUnit() {
+					n++
+				}
+			}
+		}
+		if n != test.units {
+			t.Errorf("%d units of discriminant %d, want %d", n, test.d, test.units)
+		}
+	}
+	// ω³ = 1 in the Eisenstein integers
+	w := Eisenstein(big.NewInt(0), big.NewInt(1))
+	if w3 := new(QuadInt).Mul(w, new(QuadInt).Mul(w, w)); !w3.Equals(Eisenstein(big.NewInt(1), big.NewInt(0))) {
+		t.Errorf("ω³ = %v", w3)
+	}
+	// 1+√-5 in Z[√-5] has norm 6
+	if x, _ := NewQuadIntRoot(big.NewInt(5), big.NewInt(1), big.NewInt(1)); x.Norm().Cmp(big.NewInt(6)) != 0 {
+		t.Errorf("Norm(%v) = %v", x, x.Norm())
+	}
+	for _, d := range []int64{-1, -2, 0, 5} {
+		if _, err := NewQuadInt(big.NewInt(d), big.NewInt(1), big.NewInt(0)); err == nil {
+			t.Errorf("NewQuadInt(%d) did not fail", d)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// A QuadraticError reports that the roots of a quadratic equation could not
+// be listed exactly.
+type QuadraticError struct {
+	Op   string       // the failing function
+	Disc fmt.Stringer // the discriminant, or nil
+	Msg  string
+}
+
+func (e *QuadraticError) Error() string {
+	if e.Disc == nil {
+		return fmt.Sprintf("rational: %s: %s", e.Op, e.Msg)
+	}
+	return fmt.Sprintf("rational: %s: %s: %v", e.Op, e.Msg, e.Disc)
+}
+
+// SolveQuadraticComplex returns the distinct roots of
+// 		ax² + bx + c = 0
+// which are
+// 		(-b ± √Δ)/2a
+// with discriminant
+// 		Δ = b² - 4ac
+// If a is zero, then the equation is linear, with no roots if b is zero. If
+// Δ has no rational square root, or if a, b, and c are all zero, then
+// SolveQuadraticComplex returns a *QuadraticError.
+func SolveQuadraticComplex(a, b, c *Complex) ([]*Complex, error) {
+	const op = "SolveQuadraticComplex"
+	zero := new(Complex)
+	if a.Equals(zero) {
+		if b.Equals(zero) {
+			if c.Equals(zero) {
+				return nil, &QuadraticError{op, nil, "every value is a root"}
+			}
+			return nil, nil
+		}
+		x := new(Complex).Quo(c, b)
+		return []*Complex{x.Neg(x)}, nil
+	}
+	disc := new(Complex).Mul(b, b)
+	disc.Sub(disc, new(Complex).Scal(new(Complex).Mul(a, c), big.NewRat(4, 1)))
+	r, ok := complexSqrt(disc)
+	if !ok {
+		return nil, &QuadraticError{op, disc, "discriminant has no rational square root"}
+	}
+	den := new(Complex).Scal(a, big.NewRat(2, 1))
+	x := new(Complex).Quo(new(Complex).Sub(r, b), den)
+	if r.Equals(zero) {
+		return []*Complex{x}, nil
+	}
+	y := new(Complex).Add(r, b)
+	return []*Complex{x, new(Complex).Quo(y.Neg(y), den)}, nil
+}
+
+// solveQuadraticRat returns the distinct rational roots of ax² + bx + c = 0,
+// in increasing order. If a, b, and c are all zero, then every value is a
+// root, and solveQuadraticRat returns false. If the discriminant is a
+// positive non-square, it returns the discriminant and false.
+func solveQuadraticRat(a, b, c *big.Rat) (roots []*big.Rat, disc *big.Rat, ok bool) {
+	if a.Sign() == 0 {
+		if b.Sign() == 0 {
+			return nil, nil, c.Sign() != 0
+		}
+		x := new(big.Rat).Quo(c, b)
+		return []*big.Rat{x.Neg(x)}, nil, true
+	}
+	disc = new(big.Rat).Mul(b, b)
+	disc.Sub(disc, new(big.Rat).Mul(big.NewRat(4, 1), new(big.Rat).Mul(a, c)))
+	if disc.Sign() < 0 {
+		return nil, disc, true
+	}
+	r, ok := ratSqrt(disc)
+	if !ok {
+		return nil, disc, false
+	}
+	den := new(big.Rat).Mul(big.NewRat(2, 1), a)
+	x := new(big.Rat).Sub(new(big.Rat).Neg(b), r)
+	x.Quo(x, den)
+	if r.Sign() == 0 {
+		return []*big.Rat{x}, disc, true
+	}
+	y := new(big.Rat).Add(new(big.Rat).Neg(b), r)
+	y.Quo(y, den)
+	if x.Cmp(y) > 0 {
+		x, y = y, x
+	}
+	return []*big.Rat{x, y}, disc, true
+}
+
+// SolveQuadraticPerplex returns the distinct roots of
+// 		ax² + bx + c = 0
+// Along the idempotents e₊ and e₋ of IdempotentDecompose, the equation
+// splits into two rational quadratic equations, which are solved
+// separately. Every pair of a root along e₊ and a root along e₋ is a root, so
+// there can be up to four roots, and when a is a zero divisor one of the
+// equations may be linear. If either discriminant is positive but not the
+// square of a rational, or if one of the split equations is zero while the
+// other has roots, then there is no finite exact list and
+// SolveQuadraticPerplex returns a *QuadraticError. If either split equation
+// has no roots, then neither does the original one.
+func SolveQuadraticPerplex(a, b, c *Perplex) ([]*Perplex, error) {
+	const op = "SolveQuadraticPerplex"
+	ap, am := a.IdempotentDecompose()
+	bp, bm := b.IdempotentDecompose()
+	cp, cm := c.IdempotentDecompose()
+	p, dp, okp := solveQuadraticRat(ap, bp, cp)
+	m, dm, okm := solveQuadraticRat(am, bm, cm)
+	if (okp && len(p) == 0) || (okm && len(m) == 0) {
+		return nil, nil
+	}
+	if !okp && dp != nil {
+		return nil, &QuadraticError{op, dp, "discriminant along e₊ has no rational square root"}
+	}
+	if !okm && dm != nil {
+		return nil, &QuadraticError{op, dm, "discriminant along e₋ has no rational square root"}
+	}
+	if !okp || !okm {
+		return nil, &QuadraticError{op, nil, "infinitely many roots"}
+	}
+	var roots []*Perplex
+	for _, x := range p {
+		for _, y := range m {
+			roots = append(roots, NewPerplexIdempotent(x, y))
+		}
+	}
+	return roots, nil
+}
+
+// SolveSquareHamilton returns the distinct roots of
+// 		x² = q
+// If q = r+v, with r real and v pure, and v is not zero, then the roots are
+// ±(s + v/2s) with
+// 		s² = (r + √Quad(q))/2
+// and they exist exactly when both square roots are rational. If q is a
+// positive real, the roots are ±√q, and if q is zero, the only root is zero.
+// If q is a negative real, then every pure quaternion of quadrance -q is a
+// root, and SolveSquareHamilton returns a *QuadraticError, as it does when
+// the square roots are not rational.
+func SolveSquareHamilton(q *Hamilton) ([]*Hamilton, error) {
+	const op = "SolveSquareHamilton"
+	r := q.Real()
+	if q.IsReal() {
+		switch r.Sign() {
+		case 0:
+			return []*Hamilton{new(Hamilton)}, nil
+		case -1:
+			return nil, &QuadraticError{op, q, "every pure quaternion of quadrance -q is a root"}
+		}
+		s, ok := ratSqrt(r)
+		if !ok {
+			return nil, &QuadraticError{op, q, "no rational square root"}
+		}
+		x := new(Hamilton)
+		x.Real().Set(s)
+		return []*Hamilton{x, new(Hamilton).Neg(x)}, nil
+	}
+	n, ok := ratSqrt(q.Quad())
+	if !ok {
+		return nil, &QuadraticError{op, q, "no rational square root"}
+	}
+	s2 := new(big.Rat).Add(r, n)
+	s, ok := ratSqrt(s2.Quo(s2, big.NewRat(2, 1)))
+	if !ok {
+		return nil, &QuadraticError{op, q, "no rational square root"}
+	}
+	// v/2s, then add s
+	x := new(Hamilton).Set(q)
+	x.Real().SetInt64(0)
+	x.Scal(x, new(big.Rat).Inv(new(big.Rat).Mul(big.NewRat(2, 1), s)))
+	x.Real().Set(s)
+	return []*Hamilton{x, new(Hamilton).Neg(x)}, nil
+}
+
+// Sqrt sets z equal to the principal square root of y, and returns z. The
+// principal root has positive real part, or zero real part and non-negative
+// imaginary part. If y has no square root with rational components, then
+// Sqrt returns a *QuadraticError and leaves z unchanged.
+func (z *Complex) Sqrt(y *Complex) (*Complex, error) {
+	r, ok := complexSqrt(y)
+	if !ok {
+		return nil, &QuadraticError{"Complex.Sqrt", new(Complex).Set(y), "no rational square root"}
+	}
+	return z.Set(r), nil
+}
+
+// Sqrt sets z equal to the principal square root of y, and returns z. If
+// y = a+bs, then the roots square the null coordinates a+b and a-b
+// independently, and the principal root has non-negative null coordinates
+// √(a+b) and √(a-b). If either is negative or not the square of a rational,
+// then Sqrt returns a *QuadraticError and leaves z unchanged.
+func (z *Perplex) Sqrt(y *Perplex) (*Perplex, error) {
+	r, ok := perplexSqrt(y)
+	if !ok {
+		return nil, &QuadraticError{"Perplex.Sqrt", new(Perplex).Set(y), "no rational square root"}
+	}
+	return z.Set(r), nil
+}
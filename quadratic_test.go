@@ -0,0 +1,136 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestSolveQuadraticComplex(t *testing.T) {
+	f := func(a, x, y *Complex) bool {
+		// t.Logf("a = %v, x = %v, y = %v", a, x, y)
+		if a.Equals(new(Complex)) {
+			return true
+		}
+		// a(t - x)(t - y) = at² - a(x + y)t + axy
+		b := new(Complex).Mul(a, new(Complex).Add(x, y))
+		b.Neg(b)
+		c := new(Complex).Mul(a, new(Complex).Mul(x, y))
+		roots, err := SolveQuadraticComplex(a, b, c)
+		if err != nil || len(roots) != 2 {
+			return false
+		}
+		return (roots[0].Equals(x) && roots[1].Equals(y)) || (roots[0].Equals(y) && roots[1].Equals(x))
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+	one, zero := big.NewRat(1, 1), big.NewRat(0, 1)
+	// x² - 2 = 0 has no rational roots
+	_, err := SolveQuadraticComplex(NewComplex(one, zero), new(Complex), NewComplex(big.NewRat(-2, 1), zero))
+	if _, ok := err.(*QuadraticError); !ok {
+		t.Errorf("SolveQuadraticComplex(1, 0, -2) returned error %v", err)
+	}
+	// x² + 1 = 0 has roots ±i
+	roots, err := SolveQuadraticComplex(NewComplex(one, zero), new(Complex), NewComplex(one, zero))
+	if err != nil || len(roots) != 2 || !new(Complex).Add(roots[0], roots[1]).Equals(new(Complex)) || !roots[0].IsPure() {
+		t.Errorf("SolveQuadraticComplex(1, 0, 1) = %v, %v", roots, err)
+	}
+}
+
+func TestSolveQuadraticPerplex(t *testing.T) {
+	n := func(a, b int64) *Perplex {
+		return NewPerplex(big.NewRat(a, 1), big.NewRat(b, 1))
+	}
+	// x² = 1 has the four roots ±1 and ±s
+	roots, err := SolveQuadraticPerplex(n(1, 0), n(0, 0), n(-1, 0))
+	if err != nil || len(roots) != 4 {
+		t.Fatalf("SolveQuadraticPerplex(1, 0, -1) = %v, %v", roots, err)
+	}
+	for _, x := range roots {
+		if !new(Perplex).Mul(x, x).Equals(n(1, 0)) {
+			t.Errorf("root %v of x² = 1 squares to %v", x, new(Perplex).Mul(x, x))
+		}
+	}
+	// (1+s)x² + 2(1+s)x = 0 is 2x₊² + 4x₊ = 0 along e₊ and 0 = 0 along e₋
+	if roots, err := SolveQuadraticPerplex(n(1, 1), n(2, 2), n(0, 0)); err == nil {
+		t.Errorf("SolveQuadraticPerplex(1+s, 2+2s, 0) = %v", roots)
+	}
+	// x² + 1 = 0 has no roots
+	if roots, err := SolveQuadraticPerplex(n(1, 0), n(0, 0), n(1, 0)); err != nil || len(roots) != 0 {
+		t.Errorf("SolveQuadraticPerplex(1, 0, 1) = %v, %v", roots, err)
+	}
+	// (1+s)x = 1+s is x₊ = 1 along e₊ and 0 = 0 along e₋
+	if _, err := SolveQuadraticPerplex(n(0, 0), n(1, 1), n(-1, -1)); err == nil {
+		t.Error("SolveQuadraticPerplex(0, 1+s, -1-s) succeeded")
+	}
+	// x² = 2 has no rational roots
+	if _, err := SolveQuadraticPerplex(n(1, 0), n(0, 0), n(-2, 0)); err == nil {
+		t.Error("SolveQuadraticPerplex(1, 0, -2) succeeded")
+	}
+}
+
+func TestSolveSquareHamilton(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		q := new(Hamilton).Mul(x, x)
+		roots, err := SolveSquareHamilton(q)
+		if q.IsReal() && q.Real().Sign() < 0 {
+			return err != nil
+		}
+		if err != nil {
+			return false
+		}
+		for _, r := range roots {
+			if !new(Hamilton).Mul(r, r).Equals(q) {
+				return false
+			}
+		}
+		return roots[0].Equals(x) || new(Hamilton).Neg(roots[0]).Equals(x)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+	q := NewHamilton(big.NewRat(2, 1), big.NewRat(0, 1), big.NewRat(0, 1), big.NewRat(0, 1))
+	if _, err := SolveSquareHamilton(q); err == nil {
+		t.Errorf("SolveSquareHamilton(%v) succeeded", q)
+	}
+}
+
+func TestComplexSqrt(t *testing.T) {
+	f := func(x *Complex) bool {
+		// t.Logf("x = %v", x)
+		y := new(Complex).Mul(x, x)
+		r, err := new(Complex).Sqrt(y)
+		if err != nil || !new(Complex).Mul(r, r).Equals(y) {
+			return false
+		}
+		// the principal root is x or -x
+		return r.Equals(x) == (x.Real().Sign() > 0 || x.Real().Sign() == 0 && x.r.Sign() >= 0)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+	z := NewComplex(big.NewRat(1, 1), big.NewRat(1, 1))
+	if r, err := z.Sqrt(NewComplex(big.NewRat(2, 1), big.NewRat(0, 1))); err == nil || r != nil {
+		t.Errorf("Sqrt(2) = %v, %v, want an error", r, err)
+	}
+	if !z.Equals(NewComplex(big.NewRat(1, 1), big.NewRat(1, 1))) {
+		t.Errorf("failed Sqrt changed z to %v", z)
+	}
+}
+
+func TestPerplexSqrt(t *testing.T) {
+	// (2+s)² = 5+4s
+	r, err := new(Perplex).Sqrt(NewPerplex(big.NewRat(5, 1), big.NewRat(4, 1)))
+	if want := NewPerplex(big.NewRat(2, 1), big.NewRat(1, 1)); err != nil || !r.Equals(want) {
+		t.Errorf("Sqrt(5+4s) = %v, %v, want %v", r, err, want)
+	}
+	// the null coordinate 1-4 is negative
+	if _, err := new(Perplex).Sqrt(NewPerplex(big.NewRat(1, 1), big.NewRat(4, 1))); err == nil {
+		t.Error("Sqrt(1+4s) succeeded")
+	}
+}
@@ -0,0 +1,405 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+)
+
+// RandomOptions configure the values sampled by the Random methods.
+type RandomOptions struct {
+	// MaxNum bounds the absolute values of the numerators of the
+	// components, which are uniform in [-MaxNum, MaxNum].
+	MaxNum int64
+	// MaxDen bounds the denominators of the components, which are uniform
+	// in [1, MaxDen] before reduction.
+	MaxDen int64
+	// Integer restricts the components to integers, as if MaxDen were 1.
+	Integer bool
+	// Unit samples values u with u Conj(u) = 1, as the quotient x/Conj(x)
+	// of a sampled invertible x. The components of u are then no longer
+	// bounded by MaxNum and MaxDen, nor integers.
+	Unit bool
+	// Invertible rejects zero divisors, and zero itself, by resampling.
+	Invertible bool
+}
+
+// defaultRandomOptions are used by the Random methods for nil options.
+var defaultRandomOptions = &RandomOptions{MaxNum: 100, MaxDen: 100}
+
+// generateOptions are used by the Generate methods for quick.Check testing.
+// They give large components, but never zero denominators.
+var generateOptions = &RandomOptions{MaxNum: math.MaxInt64 - 1, MaxDen: math.MaxInt64 - 1}
+
+// randomRat returns a random rational with the numerator and denominator
+// bounds of o.
+func randomRat(r *rand.Rand, o *RandomOptions) *big.Rat {
+	num := r.Int63n(o.MaxNum + 1)
+	if r.Intn(2) == 0 {
+		num = -num
+	}
+	den := int64(1)
+	if !o.Integer && o.MaxDen > 1 {
+		den += r.Int63n(o.MaxDen)
+	}
+	return big.NewRat(num, den)
+}
+
+// isInvertible returns true if z is neither zero nor a zero divisor.
+func isInvertible[S any, T Elem[S]](z T) bool {
+	if d, ok := any(z).(interface{ IsZeroDivisor() bool }); ok {
+		return !d.IsZeroDivisor()
+	}
+	return !z.Equals(new(S))
+}
+
+// random sets z equal to a random value sampled as described by o, and
+// returns z.
+func random[S any, T Elem[S]](z T, r *rand.Rand, o *RandomOptions) T {
+	if o == nil {
+		o = defaultRandomOptions
+	}
+	if o.MaxNum < 0 || o.MaxDen < 0 || o.MaxNum == math.MaxInt64 {
+		panic("invalid random bounds")
+	}
+	x := T(new(S))
+	for tries := 0; ; tries++ {
+		for _, c := range x.Components() {
+			c.Set(randomRat(r, o))
+		}
+		if !o.Invertible && !o.Unit || isInvertible[S](x) {
+			break
+		}
+		if tries == 1000 {
+			panic("no invertible value found")
+		}
+	}
+	if o.Unit {
+		y, w := T(new(S)), T(new(S))
+		return z.Mul(x, w.Inv(y.Conj(x)))
+	}
+	return z.Set(x)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *Complex) Random(r *rand.Rand, opts *RandomOptions) *Complex {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *Infra) Random(r *rand.Rand, opts *RandomOptions) *Infra {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *Perplex) Random(r *rand.Rand, opts *RandomOptions) *Perplex {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *BiComplex) Random(r *rand.Rand, opts *RandomOptions) *BiComplex {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *BiPerplex) Random(r *rand.Rand, opts *RandomOptions) *BiPerplex {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *Cockle) Random(r *rand.Rand, opts *RandomOptions) *Cockle {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *DualComplex) Random(r *rand.Rand, opts *RandomOptions) *DualComplex {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *DualPerplex) Random(r *rand.Rand, opts *RandomOptions) *DualPerplex {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *Hamilton) Random(r *rand.Rand, opts *RandomOptions) *Hamilton {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *Hyper) Random(r *rand.Rand, opts *RandomOptions) *Hyper {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *InfraComplex) Random(r *rand.Rand, opts *RandomOptions) *InfraComplex {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *InfraPerplex) Random(r *rand.Rand, opts *RandomOptions) *InfraPerplex {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *Supra) Random(r *rand.Rand, opts *RandomOptions) *Supra {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *BiCockle) Random(r *rand.Rand, opts *RandomOptions) *BiCockle {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *BiHamilton) Random(r *rand.Rand, opts *RandomOptions) *BiHamilton {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *Cayley) Random(r *rand.Rand, opts *RandomOptions) *Cayley {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *InfraCockle) Random(r *rand.Rand, opts *RandomOptions) *InfraCockle {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *InfraHamilton) Random(r *rand.Rand, opts *RandomOptions) *InfraHamilton {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *SupraComplex) Random(r *rand.Rand, opts *RandomOptions) *SupraComplex {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *SupraPerplex) Random(r *rand.Rand, opts *RandomOptions) *SupraPerplex {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *TriComplex) Random(r *rand.Rand, opts *RandomOptions) *TriComplex {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *TriNilplex) Random(r *rand.Rand, opts *RandomOptions) *TriNilplex {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *TriPerplex) Random(r *rand.Rand, opts *RandomOptions) *TriPerplex {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *Ultra) Random(r *rand.Rand, opts *RandomOptions) *Ultra {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *Zorn) Random(r *rand.Rand, opts *RandomOptions) *Zorn {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *DualHamilton) Random(r *rand.Rand, opts *RandomOptions) *DualHamilton {
+	return random(z, r, opts)
+}
+
+// Random sets z equal to a random value sampled as described by opts, and
+// returns z. If opts is nil, then the components have numerators in
+// [-100, 100] and denominators in [1, 100]. If the bounds are negative, or if
+// opts asks for an invertible value and none is found in 1000 tries, then
+// Random panics.
+func (z *Double[S, T]) Random(r *rand.Rand, opts *RandomOptions) *Double[S, T] {
+	return random(z, r, opts)
+}
+
+// randomUnit sets z equal to the Cayley transform of a random pure value v
+// sampled as described by o, and returns z. Its quadrance is one. The Unit
+// and Invertible options are ignored.
+func randomUnit[S any, T interface {
+	Elem[S]
+	Quad() *big.Rat
+}](z T, r *rand.Rand, o *RandomOptions) T {
+	if o == nil {
+		o = defaultRandomOptions
+	}
+	if o.MaxNum < 0 || o.MaxDen < 0 || o.MaxNum == math.MaxInt64 {
+		panic("invalid random bounds")
+	}
+	v := T(new(S))
+	for tries := 0; ; tries++ {
+		for _, c := range v.Components()[1:] {
+			c.Set(randomRat(r, o))
+		}
+		// v is isotropic along a line through -1 when Quad(v) = -1
+		if v.Quad().Cmp(big.NewRat(-1, 1)) != 0 {
+			break
+		}
+		if tries == 1000 {
+			panic("no unit found")
+		}
+	}
+	return cayley(z, v)
+}
+
+// RandomUnit sets z equal to a random exact value of quadrance one, and
+// returns z. It is the Cayley transform
+// 		(1 + v)/(1 - v)
+// of a random pure value v whose components are sampled as described by opts,
+// so that every value of quadrance one, except those with real part -1, can
+// occur. If opts is nil, then the numerators are in [-100, 100] and the
+// denominators in [1, 100]. If the bounds are negative, then RandomUnit
+// panics.
+func (z *Complex) RandomUnit(r *rand.Rand, opts *RandomOptions) *Complex {
+	return randomUnit(z, r, opts)
+}
+
+// RandomUnit sets z equal to a random exact value of quadrance one, and
+// returns z. It is the Cayley transform
+// 		(1 + v)/(1 - v)
+// of a random pure value v whose components are sampled as described by opts,
+// so that every value of quadrance one, except those with real part -1, can
+// occur. If opts is nil, then the numerators are in [-100, 100] and the
+// denominators in [1, 100]. If the bounds are negative, then RandomUnit
+// panics.
+func (z *Perplex) RandomUnit(r *rand.Rand, opts *RandomOptions) *Perplex {
+	return randomUnit(z, r, opts)
+}
+
+// RandomUnit sets z equal to a random exact value of quadrance one, and
+// returns z. It is the Cayley transform
+// 		(1 + v)/(1 - v)
+// of a random pure value v whose components are sampled as described by opts,
+// so that every value of quadrance one, except those with real part -1, can
+// occur. If opts is nil, then the numerators are in [-100, 100] and the
+// denominators in [1, 100]. If the bounds are negative, then RandomUnit
+// panics.
+func (z *Hamilton) RandomUnit(r *rand.Rand, opts *RandomOptions) *Hamilton {
+	return randomUnit(z, r, opts)
+}
+
+// RandomUnit sets z equal to a random exact value of quadrance one, and
+// returns z. It is the Cayley transform
+// 		(1 + v)/(1 - v)
+// of a random pure value v whose components are sampled as described by opts,
+// so that every value of quadrance one, except those with real part -1, can
+// occur. If opts is nil, then the numerators are in [-100, 100] and the
+// denominators in [1, 100]. If the bounds are negative, then RandomUnit
+// panics.
+func (z *Cockle) RandomUnit(r *rand.Rand, opts *RandomOptions) *Cockle {
+	return randomUnit(z, r, opts)
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// checkRandom samples values of type T with several options and checks that
+// they are as described.
+func checkRandom[S any, T Elem[S]](t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	one := T(new(S))
+	one.Real().SetInt64(1)
+	for n := 0; n < 20; n++ {
+		z := T(new(S))
+		random(z, r, &RandomOptions{MaxNum: 5, MaxDen: 3})
+		for _, c := range z.Components() {
+			if new(big.Int).Abs(c.Num()).Int64() > 5 || c.Denom().Int64() > 3 {
+				t.Errorf("%T: component %v out of bounds", z, c)
+			}
+		}
+		random(z, r, &RandomOptions{MaxNum: 5, MaxDen: 3, Integer: true})
+		for _, c := range z.Components() {
+			if !c.IsInt() {
+				t.Errorf("%T: component %v is not an integer", z, c)
+			}
+		}
+		random(z, r, &RandomOptions{MaxNum: 1, Integer: true, Invertible: true})
+		if !isInvertible[S](z) {
+			t.Errorf("%T: %v is not invertible", z, z)
+		}
+		random(z, r, &RandomOptions{MaxNum: 5, MaxDen: 3, Unit: true})
+		q := T(new(S))
+		if q.Mul(z, q.Conj(z)); !q.Equals(one) {
+			t.Errorf("%T: %v times its conjugate is %v", z, z, q)
+		}
+	}
+}
+
+func TestRandom(t *testing.T) {
+	checkRandom[Complex](t)
+	checkRandom[Infra](t)
+	checkRandom[Perplex](t)
+	checkRandom[BiComplex](t)
+	checkRandom[BiPerplex](t)
+	checkRandom[Cockle](t)
+	checkRandom[DualComplex](t)
+	checkRandom[DualPerplex](t)
+	checkRandom[Hamilton](t)
+	checkRandom[Hyper](t)
+	checkRandom[InfraComplex](t)
+	checkRandom[InfraPerplex](t)
+	checkRandom[Supra](t)
+	checkRandom[BiCockle](t)
+	checkRandom[BiHamilton](t)
+	checkRandom[Cayley](t)
+	checkRandom[DualHamilton](t)
+	checkRandom[InfraCockle](t)
+	checkRandom[InfraHamilton](t)
+	checkRandom[SupraComplex](t)
+	checkRandom[SupraPerplex](t)
+	checkRandom[TriComplex](t)
+	checkRandom[TriNilplex](t)
+	checkRandom[TriPerplex](t)
+	checkRandom[Ultra](t)
+	checkRandom[Zorn](t)
+	checkRandom[Sedenion](t)
+}
+
+func TestRandomPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Random with MaxNum 0 and Invertible did not panic")
+		}
+	}()
+	new(Hamilton).Random(rand.New(rand.NewSource(1)), &RandomOptions{Invertible: true})
+}
+
+// checkRandomUnit checks that the values sampled by randomUnit have
+// quadrance one.
+func checkRandomUnit[S any, T interface {
+	Elem[S]
+	Quad() *big.Rat
+}](t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	one := big.NewRat(1, 1)
+	for _, o := range []*RandomOptions{nil, {MaxNum: 3, Integer: true}, {MaxNum: 1 << 40, MaxDen: 1 << 20}} {
+		for n := 0; n < 20; n++ {
+			z := randomUnit(T(new(S)), r, o)
+			if q := z.Quad(); q.Cmp(one) != 0 {
+				t.Errorf("%T: Quad(%v) = %v, want 1", z, z, q)
+			}
+		}
+	}
+}
+
+func TestRandomUnit(t *testing.T) {
+	checkRandomUnit[Complex](t)
+	checkRandomUnit[Perplex](t)
+	checkRandomUnit[Hamilton](t)
+	checkRandomUnit[Cockle](t)
+	// with v = i, the Cayley transform is (1+i)/(1-i) = i
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 50; n++ {
+		z := new(Complex).RandomUnit(r, &RandomOptions{MaxNum: 1, Integer: true})
+		if !z.IsReal() && z.Real().Sign() != 0 {
+			t.Errorf("RandomUnit with v in {-i, 0, i} gave %v", z)
+		}
+	}
+}
@@ -0,0 +1,314 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"strings"
+)
+
+// A RatMatrix represents a dense matrix of rationals.
+type RatMatrix struct {
+	rows, cols int
+	e          []big.Rat // row-major entries
+}
+
+// NewRatMatrix returns a pointer to the rows×cols zero matrix.
+func NewRatMatrix(rows, cols int) *RatMatrix {
+	if rows < 0 || cols < 0 {
+		panic("negative dimension")
+	}
+	return &RatMatrix{rows, cols, make([]big.Rat, rows*cols)}
+}
+
+// IdentityRatMatrix returns a pointer to the n×n identity matrix.
+func IdentityRatMatrix(n int) *RatMatrix {
+	z := NewRatMatrix(n, n)
+	for i := 0; i < n; i++ {
+		z.At(i, i).SetInt64(1)
+	}
+	return z
+}
+
+// RatMatrixOf returns a pointer to a matrix with copies of the entries of m,
+// given by rows. If the rows of m have different lengths, then RatMatrixOf
+// panics.
+func RatMatrixOf(m [][]*big.Rat) *RatMatrix {
+	cols := 0
+	if len(m) > 0 {
+		cols = len(m[0])
+	}
+	z := NewRatMatrix(len(m), cols)
+	for i := range m {
+		if len(m[i]) != cols {
+			panic("ragged rows")
+		}
+		for j, x := range m[i] {
+			z.At(i, j).Set(x)
+		}
+	}
+	return z
+}
+
+// Dims returns the number of rows and columns of z.
+func (z *RatMatrix) Dims() (rows, cols int) {
+	return z.rows, z.cols
+}
+
+// At returns the entry of z in row i and column j. The result aliases z, so
+// modifying it modifies z. If i or j is out of range, then At panics.
+func (z *RatMatrix) At(i, j int) *big.Rat {
+	if i < 0 || i >= z.rows || j < 0 || j >= z.cols {
+		panic("index out of range")
+	}
+	return &z.e[i*z.cols+j]
+}
+
+// Entries returns the entries of z by rows. The results alias z.
+func (z *RatMatrix) Entries() [][]*big.Rat {
+	m := make([][]*big.Rat, z.rows)
+	for i := range m {
+		m[i] = make([]*big.Rat, z.cols)
+		for j := range m[i] {
+			m[i][j] = z.At(i, j)
+		}
+	}
+	return m
+}
+
+// String returns the string version of a RatMatrix value. The rows are
+// listed between brackets, such as "[[1 -1/2] [0 3]]".
+func (z *RatMatrix) String() string {
+	rows := make([]string, z.rows)
+	for i := range rows {
+		row := make([]string, z.cols)
+		for j := range row {
+			row[j] = z.At(i, j).RatString()
+		}
+		rows[i] = "[" + strings.Join(row, " ") + "]"
+	}
+	return "[" + strings.Join(rows, " ") + "]"
+}
+
+// Equals returns true if y and z have equal dimensions and entries.
+func (z *RatMatrix) Equals(y *RatMatrix) bool {
+	if z.rows != y.rows || z.cols != y.cols {
+		return false
+	}
+	for k := range z.e {
+		if z.e[k].Cmp(&y.e[k]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Set sets z equal to y, and returns z.
+func (z *RatMatrix) Set(y *RatMatrix) *RatMatrix {
+	if z == y {
+		return z
+	}
+	if len(z.e) != len(y.e) {
+		z.e = make([]big.Rat, len(y.e))
+	}
+	z.rows, z.cols = y.rows, y.cols
+	for k := range y.e {
+		z.e[k].Set(&y.e[k])
+	}
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *RatMatrix) Scal(y *RatMatrix, a *big.Rat) *RatMatrix {
+	z.Set(y)
+	for k := range z.e {
+		z.e[k].Mul(&z.e[k], a)
+	}
+	return z
+}
+
+// Add sets z equal to x+y, and returns z. If the dimensions of x and y
+// differ, then Add panics.
+func (z *RatMatrix) Add(x, y *RatMatrix) *RatMatrix {
+	if x.rows != y.rows || x.cols != y.cols {
+		panic("dimension mismatch")
+	}
+	w := NewRatMatrix(x.rows, x.cols)
+	for k := range w.e {
+		w.e[k].Add(&x.e[k], &y.e[k])
+	}
+	return z.Set(w)
+}
+
+// Sub sets z equal to x-y, and returns z. If the dimensions of x and y
+// differ, then Sub panics.
+func (z *RatMatrix) Sub(x, y *RatMatrix) *RatMatrix {
+	if x.rows != y.rows || x.cols != y.cols {
+		panic("dimension mismatch")
+	}
+	w := NewRatMatrix(x.rows, x.cols)
+	for k := range w.e {
+		w.e[k].Sub(&x.e[k], &y.e[k])
+	}
+	return z.Set(w)
+}
+
+// Mul sets z equal to the matrix product of x and y, and returns z. If the
+// number of columns of x is not equal to the number of rows of y, then Mul
+// panics.
+func (z *RatMatrix) Mul(x, y *RatMatrix) *RatMatrix {
+	if x.cols != y.rows {
+		panic("dimension mismatch")
+	}
+	w := NewRatMatrix(x.rows, y.cols)
+	temp := new(big.Rat)
+	for i := 0; i < x.rows; i++ {
+		for j := 0; j < y.cols; j++ {
+			s := w.At(i, j)
+			for k := 0; k < x.cols; k++ {
+				s.Add(s, temp.Mul(x.At(i, k), y.At(k, j)))
+			}
+		}
+	}
+	return z.Set(w)
+}
+
+// Apply returns the product of z and the column vector v. If the length of v
+// is not equal to the number of columns of z, then Apply panics.
+func (z *RatMatrix) Apply(v []*big.Rat) []*big.Rat {
+	if len(v) != z.cols {
+		panic("dimension mismatch")
+	}
+	w := make([]*big.Rat, z.rows)
+	temp := new(big.Rat)
+	for i := range w {
+		w[i] = new(big.Rat)
+		for j, x := range v {
+			w[i].Add(w[i], temp.Mul(z.At(i, j), x))
+		}
+	}
+	return w
+}
+
+// Trace returns the sum of the diagonal entries of z. If z is not square,
+// then Trace panics.
+func (z *RatMatrix) Trace() *big.Rat {
+	if z.rows != z.cols {
+		panic("non-square matrix")
+	}
+	tr := new(big.Rat)
+	for i := 0; i < z.rows; i++ {
+		tr.Add(tr, z.At(i, i))
+	}
+	return tr
+}
+
+// reduce brings a copy of z to reduced row echelon form by Gauss–Jordan
+// elimination, applying the same row operations to a copy of w if w is not
+// nil. It returns both results, the pivot columns, and the determinant factor
+// of the row operations: the product of the pivots and the sign of the row
+// swaps.
+func (z *RatMatrix) reduce(w *RatMatrix) (a, b *RatMatrix, pivots []int, det *big.Rat) {
+	a = new(RatMatrix).Set(z)
+	if w != nil {
+		b = new(RatMatrix).Set(w)
+	}
+	det = big.NewRat(1, 1)
+	swap := func(m *RatMatrix, i, j int) {
+		for k := 0; k < m.cols; k++ {
+			m.e[i*m.cols+k], m.e[j*m.cols+k] = m.e[j*m.cols+k], m.e[i*m.cols+k]
+		}
+	}
+	temp := new(big.Rat)
+	for j, r := 0, 0; j < a.cols && r < a.rows; j++ {
+		p := r
+		for p < a.rows && a.At(p, j).Sign() == 0 {
+			p++
+		}
+		if p == a.rows {
+			continue
+		}
+		if p != r {
+			swap(a, p, r)
+			if b != nil {
+				swap(b, p, r)
+			}
+			det.Neg(det)
+		}
+		inv := new(big.Rat).Inv(a.At(r, j))
+		det.Quo(det, inv)
+		for _, m := range []*RatMatrix{a, b} {
+			if m == nil {
+				continue
+			}
+			for k := 0; k < m.cols; k++ {
+				m.At(r, k).Mul(m.At(r, k), inv)
+			}
+		}
+		for i := 0; i < a.rows; i++ {
+			if i == r || a.At(i, j).Sign() == 0 {
+				continue
+			}
+			f := new(big.Rat).Set(a.At(i, j))
+			for _, m := range []*RatMatrix{a, b} {
+				if m == nil {
+					continue
+				}
+				for k := 0; k < m.cols; k++ {
+					m.At(i, k).Sub(m.At(i, k), temp.Mul(f, m.At(r, k)))
+				}
+			}
+		}
+		pivots = append(pivots, j)
+		r++
+	}
+	return a, b, pivots, det
+}
+
+// Det returns the determinant of z. If z is not square, then Det panics.
+func (z *RatMatrix) Det() *big.Rat {
+	if z.rows != z.cols {
+		panic("non-square matrix")
+	}
+	_, _, pivots, det := z.reduce(nil)
+	if len(pivots) < z.rows {
+		return new(big.Rat)
+	}
+	return det
+}
+
+// Inv sets z equal to the inverse of y, and returns z. If y is not square or
+// is singular, then Inv panics.
+func (z *RatMatrix) Inv(y *RatMatrix) *RatMatrix {
+	if y.rows != y.cols {
+		panic("non-square matrix")
+	}
+	_, b, pivots, _ := y.reduce(IdentityRatMatrix(y.rows))
+	if len(pivots) < y.rows {
+		panic(divisorError("inverse of singular matrix"))
+	}
+	return z.Set(b)
+}
+
+// Kernel returns a basis of the kernel of z: column vectors v with zv = 0.
+func (z *RatMatrix) Kernel() [][]*big.Rat {
+	a, _, pivots, _ := z.reduce(nil)
+	var basis [][]*big.Rat
+	for j, p := 0, 0; j < z.cols; j++ {
+		if p < len(pivots) && pivots[p] == j {
+			p++
+			continue
+		}
+		v := make([]*big.Rat, z.cols)
+		for k := range v {
+			v[k] = new(big.Rat)
+		}
+		v[j].SetInt64(1)
+		for i, q := range pivots {
+			v[q].Neg(a.At(i, j))
+		}
+		basis = append(basis, v)
+	}
+	return basis
+}
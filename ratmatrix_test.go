@@ -0,0 +1,98 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestRatMatrixDetMul(t *testing.T) {
+	f := func(x, y *Hamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		m, n := x.ToMatrix(), y.ToMatrix()
+		l := new(big.Rat).Mul(m.Det(), n.Det())
+		return new(RatMatrix).Mul(m, n).Det().Cmp(l) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRatMatrixDetQuad(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		q := x.Quad()
+		return x.ToMatrix().Det().Cmp(q.Mul(q, q)) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRatMatrixInv(t *testing.T) {
+	f := func(x *Cockle) bool {
+		// t.Logf("x = %v", x)
+		m := x.ToMatrix()
+		if m.Det().Sign() == 0 {
+			return true
+		}
+		inv := new(RatMatrix).Inv(m)
+		return new(RatMatrix).Mul(m, inv).Equals(IdentityRatMatrix(4)) &&
+			inv.Equals(new(Cockle).Inv(x).ToMatrix())
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRatMatrixTraceAdd(t *testing.T) {
+	f := func(x, y *Cayley) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		m, n := x.ToMatrix(), y.ToMatrix()
+		l := new(big.Rat).Add(m.Trace(), n.Trace())
+		return new(RatMatrix).Add(m, n).Trace().Cmp(l) == 0 &&
+			m.Trace().Cmp(new(big.Rat).Mul(big.NewRat(8, 1), x.Real())) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRatMatrixKernel(t *testing.T) {
+	m := RatMatrixOf([][]*big.Rat{
+		{big.NewRat(1, 1), big.NewRat(2, 1), big.NewRat(3, 1)},
+		{big.NewRat(2, 1), big.NewRat(4, 1), big.NewRat(6, 1)},
+	})
+	if d := m.Kernel(); len(d) != 2 {
+		t.Fatalf("len(Kernel) = %d, want 2", len(d))
+	}
+	for _, v := range m.Kernel() {
+		for _, x := range m.Apply(v) {
+			if x.Sign() != 0 {
+				t.Errorf("Kernel vector %v not in kernel", v)
+			}
+		}
+	}
+	n := RatMatrixOf([][]*big.Rat{
+		{big.NewRat(1, 1), big.NewRat(2, 1)},
+		{big.NewRat(2, 1), big.NewRat(4, 1)},
+	})
+	if n.Det().Sign() != 0 {
+		t.Errorf("Det(%v) = %v, want 0", n, n.Det())
+	}
+	if got, want := n.String(), "[[1 2] [2 4]]"; got != want {
+		t.Errorf("String = %q, want %q", got, want)
+	}
+}
+
+func TestRatMatrixInvSingular(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Inv of singular matrix did not panic")
+		}
+	}()
+	new(RatMatrix).Inv(NewRatMatrix(2, 2))
+}
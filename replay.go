@@ -0,0 +1,154 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+)
+
+// replayMagic starts every replay file.
+const replayMagic = "rational replay\x01"
+
+// A Recorder applies operations of an implementation and records them, with
+// their operands and results, to a replay file.
+//
+// The file starts with a header holding the name and dimension of the
+// implementation. Each record then holds the length-prefixed name of the
+// operation, followed by the components of both operands and of the result,
+// encoded as by WriteTo.
+type Recorder struct {
+	impl *Impl
+	w    io.Writer
+	err  error
+}
+
+// NewRecorder writes the header of a replay file for impl to w, and returns
+// a Recorder that appends records to it.
+func NewRecorder(w io.Writer, impl *Impl) (*Recorder, error) {
+	var head [4]byte
+	binary.BigEndian.PutUint32(head[:], uint32(impl.Dim))
+	r := &Recorder{impl, w, nil}
+	r.write([]byte(replayMagic))
+	r.writeString(impl.Name)
+	r.write(head[:])
+	return r, r.err
+}
+
+func (r *Recorder) write(b []byte) {
+	if r.err == nil {
+		_, r.err = r.w.Write(b)
+	}
+}
+
+func (r *Recorder) writeString(s string) {
+	var head [4]byte
+	binary.BigEndian.PutUint32(head[:], uint32(len(s)))
+	r.write(head[:])
+	r.write([]byte(s))
+}
+
+// Do applies the operation op to x and y, records it, and returns the
+// result. Unary operations ignore y, but it is recorded all the same; a nil y
+// is recorded as zero. If x, or a non-nil y, does not have the dimension of
+// the implementation, then Do returns an error without recording anything.
+// If op is not an operation of the implementation, then Do panics.
+func (r *Recorder) Do(op string, x, y []*big.Rat) ([]*big.Rat, error) {
+	f, ok := r.impl.Ops[op]
+	if !ok {
+		panic("unknown operation " + op)
+	}
+	if y == nil {
+		y = make([]*big.Rat, r.impl.Dim)
+		for i := range y {
+			y[i] = new(big.Rat)
+		}
+	}
+	for _, v := range [][]*big.Rat{x, y} {
+		if len(v) != r.impl.Dim {
+			return nil, fmt.Errorf("rational: %s: got %d components, want %d", op, len(v), r.impl.Dim)
+		}
+	}
+	z := f(x, y)
+	r.writeString(op)
+	for _, v := range [][]*big.Rat{x, y, z} {
+		if r.err == nil {
+			_, r.err = writeRats(r.w, v)
+		}
+	}
+	return z, r.err
+}
+
+// Replay reads a replay file from rd, repeats each recorded operation with
+// impl, and returns a *Divergence for the first result that differs from the
+// recorded one. Other errors report malformed files, or a file recorded for a
+// different implementation.
+func Replay(rd io.Reader, impl *Impl) error {
+	br := bufio.NewReader(rd)
+	magic := make([]byte, len(replayMagic))
+	if _, err := io.ReadFull(br, magic); err != nil || string(magic) != replayMagic {
+		return errors.New("rational: not a replay file")
+	}
+	readString := func() (string, error) {
+		var head [4]byte
+		if _, err := io.ReadFull(br, head[:]); err != nil {
+			return "", err
+		}
+		// the length is not trusted, so the buffer grows only as bytes arrive
+		var b bytes.Buffer
+		if _, err := io.CopyN(&b, br, int64(binary.BigEndian.Uint32(head[:]))); err != nil {
+			return "", unexpected(err)
+		}
+		return b.String(), nil
+	}
+	name, err := readString()
+	if err != nil {
+		return unexpected(err)
+	}
+	var head [4]byte
+	if _, err := io.ReadFull(br, head[:]); err != nil {
+		return unexpected(err)
+	}
+	if dim := int(binary.BigEndian.Uint32(head[:])); name != impl.Name || dim != impl.Dim {
+		return fmt.Errorf("rational: replay file for %s (dimension %d), not %s", name, dim, impl.Name)
+	}
+	readVec := func() ([]*big.Rat, error) {
+		v := make([]*big.Rat, impl.Dim)
+		for i := range v {
+			x, err := readRat(br)
+			if err != nil {
+				return nil, unexpected(err)
+			}
+			v[i] = x
+		}
+		return v, nil
+	}
+	for step := 0; ; step++ {
+		op, err := readString()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return unexpected(err)
+		}
+		var v [3][]*big.Rat
+		for i := range v {
+			if v[i], err = readVec(); err != nil {
+				return err
+			}
+		}
+		f, ok := impl.Ops[op]
+		if !ok {
+			return fmt.Errorf("rational: step %d: unknown operation %s", step, op)
+		}
+		if got := f(v[0], v[1]); !equalRats(got, v[2]) {
+			return &Divergence{step, op, v[0], v[1], got, v[2]}
+		}
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+)
+
+// record returns a replay file of a few Cayley operations.
+func record(t *testing.T) []byte {
+	var buf bytes.Buffer
+	r, err := NewRecorder(&buf, CayleyImpl())
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := rats(NewCayley(big.NewRat(1, 2), big.NewRat(-3, 1), big.NewRat(2, 7), new(big.Rat),
+		big.NewRat(5, 1), new(big.Rat), big.NewRat(-1, 9), big.NewRat(4, 3)).Rats())
+	y := rats(NewCayley(new(big.Rat), big.NewRat(1, 1), big.NewRat(-2, 1), big.NewRat(3, 5),
+		new(big.Rat), big.NewRat(7, 2), big.NewRat(1, 1), big.NewRat(-6, 1)).Rats())
+	for _, op := range []string{"Mul", "Add", "Conj", "Mul", "Sub"} {
+		z, err := r.Do(op, x, y)
+		if err != nil {
+			t.Fatal(err)
+		}
+		x, y = y, z
+	}
+	return buf.Bytes()
+}
+
+func TestReplay(t *testing.T) {
+	if err := Replay(bytes.NewReader(record(t)), CayleyImpl()); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestReplayDivergence(t *testing.T) {
+	impl := CayleyImpl()
+	mul := impl.Ops["Mul"]
+	impl.Ops["Mul"] = func(x, y []*big.Rat) []*big.Rat {
+		return mul(y, x)
+	}
+	err := Replay(bytes.NewReader(record(t)), impl)
+	if d, ok := err.(*Divergence); !ok || d.Step != 0 || d.Op != "Mul" {
+		t.Errorf("Replay returned %v, want a divergence at step 0", err)
+	}
+}
+
+func TestReplayErrors(t *testing.T) {
+	data := record(t)
+	if err := Replay(bytes.NewReader(data), ZornImpl()); err == nil {
+		t.Error("Replay accepted a Cayley file for Zorn")
+	}
+	if err := Replay(bytes.NewReader(data[:len(data)-3]), CayleyImpl()); err == nil {
+		t.Error("Replay accepted a truncated file")
+	}
+	if err := Replay(bytes.NewReader([]byte("garbage")), CayleyImpl()); err == nil {
+		t.Error("Replay accepted garbage")
+	}
+	// a name length of 2 GiB with no bytes after it
+	data = append([]byte(replayMagic), 0x7f, 0xff, 0xff, 0xff)
+	if err := Replay(bytes.NewReader(data), CayleyImpl()); err == nil {
+		t.Error("Replay accepted an oversized name")
+	}
+}
+
+func TestRecorderUnary(t *testing.T) {
+	var buf bytes.Buffer
+	r, err := NewRecorder(&buf, CayleyImpl())
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := rats(NewCayley(big.NewRat(1, 2), big.NewRat(-3, 1), big.NewRat(2, 7), new(big.Rat),
+		big.NewRat(5, 1), new(big.Rat), big.NewRat(-1, 9), big.NewRat(4, 3)).Rats())
+	for _, op := range []string{"Conj", "Neg"} {
+		if _, err := r.Do(op, x, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := Replay(bytes.NewReader(buf.Bytes()), CayleyImpl()); err != nil {
+		t.Error(err)
+	}
+	if _, err := r.Do("Mul", x, x[:3]); err == nil {
+		t.Error("Do accepted an operand of dimension 3")
+	}
+}
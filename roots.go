@@ -0,0 +1,275 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// trimPoly removes the trailing zero coefficients of p.
+func trimPoly(p []*big.Rat) []*big.Rat {
+	n := len(p)
+	for n > 0 && p[n-1].Sign() == 0 {
+		n--
+	}
+	return p[:n]
+}
+
+// evalPoly returns the value of the polynomial p at x. The coefficient of xⁱ
+// is p[i].
+func evalPoly(p []*big.Rat, x *big.Rat) *big.Rat {
+	v := new(big.Rat)
+	for i := len(p) - 1; i >= 0; i-- {
+		v.Mul(v, x)
+		v.Add(v, p[i])
+	}
+	return v
+}
+
+// derivPoly returns the derivative of the polynomial p.
+func derivPoly(p []*big.Rat) []*big.Rat {
+	if len(p) < 2 {
+		return nil
+	}
+	d := make([]*big.Rat, len(p)-1)
+	for i := range d {
+		d[i] = new(big.Rat).Mul(p[i+1], big.NewRat(int64(i+1), 1))
+	}
+	return d
+}
+
+// divPoly returns the quotient and remainder of the polynomial division of p
+// by the non-zero polynomial q.
+func divPoly(p, q []*big.Rat) (quo, rem []*big.Rat) {
+	q = trimPoly(q)
+	rem = make([]*big.Rat, len(p))
+	for i := range p {
+		rem[i] = new(big.Rat).Set(p[i])
+	}
+	rem = trimPoly(rem)
+	if len(rem) < len(q) {
+		return nil, rem
+	}
+	quo = make([]*big.Rat, len(rem)-len(q)+1)
+	for i := range quo {
+		quo[i] = new(big.Rat)
+	}
+	lead := q[len(q)-1]
+	temp := new(big.Rat)
+	for len(rem) >= len(q) {
+		k := len(rem) - len(q)
+		c := new(big.Rat).Quo(rem[len(rem)-1], lead)
+		quo[k].Set(c)
+		for i := range q {
+			rem[k+i].Sub(rem[k+i], temp.Mul(c, q[i]))
+		}
+		rem = trimPoly(rem[:len(rem)-1])
+	}
+	return quo, rem
+}
+
+// gcdPoly returns a greatest common divisor of the polynomials p and q.
+func gcdPoly(p, q []*big.Rat) []*big.Rat {
+	p, q = trimPoly(p), trimPoly(q)
+	for len(q) > 0 {
+		_, r := divPoly(p, q)
+		p, q = q, r
+	}
+	return p
+}
+
+// signChanges returns the number of sign changes in the Sturm sequence s
+// evaluated at x, ignoring zeros.
+func signChanges(s [][]*big.Rat, x *big.Rat) int {
+	n, last := 0, 0
+	for _, p := range s {
+		sign := evalPoly(p, x).Sign()
+		if sign == 0 {
+			continue
+		}
+		if last != 0 && sign != last {
+			n++
+		}
+		last = sign
+	}
+	return n
+}
+
+// simplest returns the rational with the smallest denominator in the closed
+// interval [lo, hi].
+func simplest(lo, hi *big.Rat) *big.Rat {
+	if lo.Sign() <= 0 && hi.Sign() >= 0 {
+		return new(big.Rat)
+	}
+	if hi.Sign() < 0 {
+		s := simplest(new(big.Rat).Neg(hi), new(big.Rat).Neg(lo))
+		return s.Neg(s)
+	}
+	fl := new(big.Int).Quo(lo.Num(), lo.Denom())
+	if lo.IsInt() {
+		return new(big.Rat).Set(lo)
+	}
+	next := new(big.Rat).SetInt(fl)
+	next.Add(next, big.NewRat(1, 1))
+	if next.Cmp(hi) <= 0 {
+		return next
+	}
+	f := new(big.Rat).SetInt(fl)
+	a := new(big.Rat).Sub(hi, f)
+	b := new(big.Rat).Sub(lo, f)
+	s := simplest(a.Inv(a), b.Inv(b))
+	return s.Add(f, s.Inv(s))
+}
+
+// sturm returns the Sturm sequence of the non-constant polynomial p.
+func sturm(p []*big.Rat) [][]*big.Rat {
+	s := [][]*big.Rat{p, derivPoly(p)}
+	for {
+		_, r := divPoly(s[len(s)-2], s[len(s)-1])
+		if len(r) == 0 {
+			return s
+		}
+		for _, c := range r {
+			c.Neg(c)
+		}
+		s = append(s, r)
+	}
+}
+
+// rootBound returns the Cauchy bound of the non-constant polynomial p, which
+// exceeds the absolute value of every root.
+func rootBound(p []*big.Rat) *big.Rat {
+	bound := new(big.Rat)
+	ratio := new(big.Rat)
+	for _, c := range p[:len(p)-1] {
+		ratio.Quo(c, p[len(p)-1])
+		if ratio.Abs(ratio).Cmp(bound) > 0 {
+			bound.Set(ratio)
+		}
+	}
+	return bound.Add(bound, big.NewRat(1, 1))
+}
+
+// positiveRoots returns the number of distinct positive real roots of the
+// polynomial p.
+func positiveRoots(p []*big.Rat) int {
+	p = trimPoly(p)
+	if len(p) < 2 {
+		return 0
+	}
+	s := sturm(p)
+	return signChanges(s, new(big.Rat)) - signChanges(s, rootBound(p))
+}
+
+// ratRoots returns the distinct rational roots of the polynomial p in
+// increasing order. The coefficient of xⁱ is p[i].
+//
+// The real roots of the square-free part of p are isolated with a Sturm
+// sequence and bisected until each isolating interval is narrower than the
+// gap between any two rationals whose denominators divide the leading
+// coefficient of the integral form of p. The simplest rational in each such
+// interval is then the only candidate, and it is checked exactly.
+func ratRoots(p []*big.Rat) []*big.Rat {
+	p = trimPoly(p)
+	if len(p) < 2 {
+		return nil
+	}
+	if g := gcdPoly(p, derivPoly(p)); len(g) > 1 {
+		p, _ = divPoly(p, g)
+	}
+	// integral form
+	lcm := big.NewInt(1)
+	temp := new(big.Int)
+	for _, c := range p {
+		temp.GCD(nil, nil, lcm, c.Denom())
+		lcm.Mul(lcm, temp.Quo(c.Denom(), temp))
+	}
+	lead := new(big.Rat).Mul(p[len(p)-1], new(big.Rat).SetInt(lcm))
+	eps := new(big.Rat).Mul(lead, lead)
+	eps.Abs(eps)
+	eps.Inv(eps)
+	s := sturm(p)
+	bound := rootBound(p)
+	var roots []*big.Rat
+	var isolate func(lo, hi *big.Rat, vlo, vhi int)
+	isolate = func(lo, hi *big.Rat, vlo, vhi int) {
+		count := vlo - vhi
+		if count == 0 {
+			return
+		}
+		width := new(big.Rat).Sub(hi, lo)
+		if count == 1 && width.Cmp(eps) < 0 {
+			if c := simplest(lo, hi); evalPoly(p, c).Sign() == 0 {
+				if n := len(roots); n == 0 || roots[n-1].Cmp(c) != 0 {
+					roots = append(roots, c)
+				}
+			}
+			return
+		}
+		mid := new(big.Rat).Add(lo, hi)
+		mid.Quo(mid, big.NewRat(2, 1))
+		vmid := signChanges(s, mid)
+		isolate(lo, mid, vlo, vmid)
+		isolate(mid, hi, vmid, vhi)
+	}
+	lo := new(big.Rat).Neg(bound)
+	isolate(lo, bound, signChanges(s, lo), signChanges(s, bound))
+	return roots
+}
+
+// ratSqrt returns the rational square root of x and true if x is the square
+// of a rational. Otherwise it returns nil and false.
+func ratSqrt(x *big.Rat) (*big.Rat, bool) {
+	if x.Sign() < 0 {
+		return nil, false
+	}
+	num := new(big.Int).Sqrt(x.Num())
+	if new(big.Int).Mul(num, num).Cmp(x.Num()) != 0 {
+		return nil, false
+	}
+	den := new(big.Int).Sqrt(x.Denom())
+	if new(big.Int).Mul(den, den).Cmp(x.Denom()) != 0 {
+		return nil, false
+	}
+	return new(big.Rat).SetFrac(num, den), true
+}
+
+// squarefree returns true if the non-zero integer d is not divisible by the
+// square of a prime.
+func squarefree(d int64) bool {
+	if d < 0 {
+		d = -d
+	}
+	for p := int64(2); p*p <= d; p++ {
+		if d%(p*p) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// SquareIn decides whether the rational q is a square in a quadratic field
+// Q(√d), with d a squarefree integer of absolute value at most maxD. Since
+// 		(a + b√d)² = a² + db² + 2ab√d
+// is rational only if a or b vanishes, q is a square in Q(√d) exactly when q
+// or q/d is a rational square. SquareIn returns the first such d, ordered by
+// absolute value with positive values first, together with the rational r
+// such that q = dr², so r√d is a square root of q. If d is 1, then q is a
+// square in Q itself. If no such d exists, then SquareIn returns 0, nil, and
+// false.
+func SquareIn(q *big.Rat, maxD int64) (d int64, r *big.Rat, ok bool) {
+	if r, ok := ratSqrt(q); ok {
+		return 1, r, true
+	}
+	temp := new(big.Rat)
+	for n := int64(1); n <= maxD; n++ {
+		for _, d := range [2]int64{n, -n} {
+			if d == 1 || !squarefree(d) {
+				continue
+			}
+			if r, ok := ratSqrt(temp.Quo(q, big.NewRat(d, 1))); ok {
+				return d, r, true
+			}
+		}
+	}
+	return 0, nil, false
+}
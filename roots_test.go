@@ -0,0 +1,52 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestRatRoots(t *testing.T) {
+	// (2x - 1)(x + 3)²(x² - 2)
+	p := []*big.Rat{
+		big.NewRat(18, 1), big.NewRat(-24, 1), big.NewRat(-31, 1),
+		big.NewRat(8, 1), big.NewRat(11, 1), big.NewRat(2, 1),
+	}
+	roots := ratRoots(p)
+	want := []*big.Rat{big.NewRat(-3, 1), big.NewRat(1, 2)}
+	if len(roots) != len(want) {
+		t.Fatalf("ratRoots = %v, want %v", roots, want)
+	}
+	for i := range want {
+		if roots[i].Cmp(want[i]) != 0 {
+			t.Errorf("ratRoots = %v, want %v", roots, want)
+		}
+	}
+}
+
+func TestSquareIn(t *testing.T) {
+	tests := []struct {
+		z *Hamilton
+		d int64
+		r *big.Rat
+	}{
+		{NewHamilton(big.NewRat(1, 1), big.NewRat(2, 1), big.NewRat(2, 1), new(big.Rat)), 1, big.NewRat(3, 1)},
+		{NewHamilton(big.NewRat(1, 2), big.NewRat(1, 2), new(big.Rat), new(big.Rat)), 2, big.NewRat(1, 2)},
+		{NewHamilton(big.NewRat(2, 1), big.NewRat(2, 1), big.NewRat(2, 1), new(big.Rat)), 3, big.NewRat(2, 1)},
+	}
+	for _, test := range tests {
+		d, r, ok := SquareIn(test.z.Quad(), 10)
+		if !ok || d != test.d || r.Cmp(test.r) != 0 {
+			t.Errorf("SquareIn(%v) = %d, %v, %v, want %d, %v, true",
+				test.z.Quad(), d, r, ok, test.d, test.r)
+		}
+	}
+	if d, r, ok := SquareIn(big.NewRat(-8, 1), 5); !ok || d != -2 || r.Cmp(big.NewRat(2, 1)) != 0 {
+		t.Errorf("SquareIn(-8) = %d, %v, %v, want -2, 2, true", d, r, ok)
+	}
+	if _, _, ok := SquareIn(big.NewRat(7, 1), 5); ok {
+		t.Error("SquareIn(7) found d with |d| ≤ 5")
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// cayley sets z equal to the Cayley transform
+// 		(1 + v)/(1 - v) = ((1 - Quad(v)) + 2v)/(1 + Quad(v))
+// of the pure value v, and returns z. Its quadrance is one. If Quad(v) = -1,
+// then cayley panics.
+func cayley[S any, T interface {
+	Elem[S]
+	Quad() *big.Rat
+}](z, v T) T {
+	one := big.NewRat(1, 1)
+	n := v.Quad()
+	den := new(big.Rat).Add(one, n)
+	if den.Sign() == 0 {
+		panic("Cayley transform of a value with quadrance -1")
+	}
+	den.Inv(den)
+	z.Scal(v, den)
+	z.Scal(z, big.NewRat(2, 1))
+	z.Real().Mul(new(big.Rat).Sub(one, n), den)
+	return z
+}
+
+// Rotate sets z equal to the sandwich product
+// 		q v Inv(q)
+// and returns z. If v = bi+cj+dk is pure, then z is the vector (b, c, d)
+// rotated by q; the real part of v is left unchanged. Scaling q does not
+// change the rotation, so q need not have quadrance one. If q is zero, then
+// Rotate panics.
+func (z *Hamilton) Rotate(q, v *Hamilton) *Hamilton {
+	if q.Equals(new(Hamilton)) {
+		panic(denominatorError("rotation by zero"))
+	}
+	n := q.Quad()
+	w := new(Hamilton).Mul(q, v)
+	w.Mul(w, new(Hamilton).Conj(q))
+	return z.Scal(w, n.Inv(n))
+}
+
+// NewHamiltonRotation returns a pointer to the Hamilton value
+// 		(1 + w)/(1 - w) = (1 + w)²/(1 + Quad(w))
+// with w = ai+bj+ck. This is the Cayley transform of w, an exact unit
+// quaternion. As a rotation, it turns by the angle θ about the axis (a, b, c),
+// where
+// 		tan(θ/4)² = a² + b² + c²
+// so that NewHamiltonRotation(1, 0, 0) = i turns by π about the x-axis. Every
+// unit quaternion other than -1 arises this way, but not every rotation with
+// a rational matrix: 1+i turns by π/2 about the x-axis, and Rotate accepts
+// it, but its unit quaternion (1+i)/√2 is irrational.
+func NewHamiltonRotation(a, b, c *big.Rat) *Hamilton {
+	return cayley(new(Hamilton), NewHamilton(new(big.Rat), a, b, c))
+}
+
+// RotationMatrix returns the 3×3 rational matrix of the rotation
+// 		v ↦ z v Inv(z)
+// on the pure quaternions, in the basis (i, j, k). If z = a+bi+cj+dk, the
+// entries are quadratic in a, b, c, and d, divided by Quad(z). If z is zero,
+// then RotationMatrix panics.
+func (z *Hamilton) RotationMatrix() [3][3]*big.Rat {
+	if z.Equals(new(Hamilton)) {
+		panic(denominatorError("rotation by zero"))
+	}
+	v := z.Components()
+	n := z.Quad()
+	n.Inv(n)
+	// p(i, j) is the product of components i and j
+	p := func(i, j int) *big.Rat {
+		return new(big.Rat).Mul(v[i], v[j])
+	}
+	two := big.NewRat(2, 1)
+	diag := func(i int) *big.Rat {
+		x := new(big.Rat).Set(p(0, 0))
+		for k := 1; k < 4; k++ {
+			if k == i {
+				x.Add(x, p(k, k))
+			} else {
+				x.Sub(x, p(k, k))
+			}
+		}
+		return x
+	}
+	// off returns 2(p(i, j) + s p(0, k))
+	off := func(i, j, k int, s int64) *big.Rat {
+		x := p(0, k)
+		x.Mul(x, big.NewRat(s, 1))
+		x.Add(x, p(i, j))
+		return x.Mul(x, two)
+	}
+	m := [3][3]*big.Rat{
+		{diag(1), off(1, 2, 3, -1), off(1, 3, 2, 1)},
+		{off(1, 2, 3, 1), diag(2), off(2, 3, 1, -1)},
+		{off(1, 3, 2, -1), off(2, 3, 1, 1), diag(3)},
+	}
+	for i := range m {
+		for j := range m[i] {
+			m[i][j].Mul(m[i][j], n)
+		}
+	}
+	return m
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestRotateMatrix(t *testing.T) {
+	f := func(q, v *Hamilton) bool {
+		// t.Logf("q = %v, v = %v", q, v)
+		if q.Equals(new(Hamilton)) {
+			return true
+		}
+		v.Real().SetInt64(0)
+		w := new(Hamilton).Rotate(q, v)
+		m := q.RotationMatrix()
+		x := v.Components()[1:]
+		for i := 0; i < 3; i++ {
+			c := new(big.Rat)
+			for j := 0; j < 3; j++ {
+				c.Add(c, new(big.Rat).Mul(m[i][j], x[j]))
+			}
+			if c.Cmp(w.Components()[i+1]) != 0 || w.Real().Sign() != 0 {
+				return false
+			}
+		}
+		return w.Quad().Cmp(v.Quad()) == 0
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNewHamiltonRotation(t *testing.T) {
+	one := big.NewRat(1, 1)
+	zero := new(big.Rat)
+	if q := NewHamiltonRotation(one, zero, zero); !q.Equals(NewHamilton(zero, one, zero, zero)) {
+		t.Errorf("NewHamiltonRotation(1, 0, 0) = %v, want i", q)
+	}
+	// 1+i turns j into k
+	j := NewHamilton(zero, zero, one, zero)
+	k := NewHamilton(zero, zero, zero, one)
+	if z := new(Hamilton).Rotate(NewHamilton(one, one, zero, zero), j); !z.Equals(k) {
+		t.Errorf("Rotate(1+i, j) = %v, want k", z)
+	}
+	q := NewHamiltonRotation(big.NewRat(1, 2), big.NewRat(-2, 3), big.NewRat(3, 7))
+	if n := q.Quad(); n.Cmp(one) != 0 {
+		t.Errorf("Quad(%v) = %v, want 1", q, n)
+	}
+	// the matrix is orthogonal
+	m := q.RotationMatrix()
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			c := new(big.Rat)
+			for k := 0; k < 3; k++ {
+				c.Add(c, new(big.Rat).Mul(m[i][k], m[j][k]))
+			}
+			want := new(big.Rat)
+			if i == j {
+				want.SetInt64(1)
+			}
+			if c.Cmp(want) != 0 {
+				t.Errorf("row %d · row %d = %v, want %v", i, j, c, want)
+			}
+		}
+	}
+}
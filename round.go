@@ -0,0 +1,107 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// roundInt returns the integer nearest to the rational x in the direction given
+// by mode.
+func roundInt(x *big.Rat, mode big.RoundingMode) *big.Int {
+	// x = q + r/d, with 0 ≤ r < d
+	q, r := new(big.Int).DivMod(x.Num(), x.Denom(), new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	up := false
+	switch mode {
+	case big.ToNegativeInf:
+	case big.ToPositiveInf:
+		up = true
+	case big.ToZero:
+		up = x.Sign() < 0
+	case big.AwayFromZero:
+		up = x.Sign() > 0
+	case big.ToNearestEven, big.ToNearestAway:
+		c := new(big.Int).Lsh(r, 1).Cmp(x.Denom())
+		switch {
+		case c > 0:
+			up = true
+		case c == 0 && mode == big.ToNearestEven:
+			up = q.Bit(0) == 1
+		case c == 0:
+			up = x.Sign() > 0
+		}
+	default:
+		panic("invalid rounding mode")
+	}
+	if up {
+		q.Add(q, big.NewInt(1))
+	}
+	return q
+}
+
+// roundRat sets z equal to the multiple of 1/den nearest to x in the direction
+// given by mode, and returns z. If den is not positive, then roundRat panics.
+func roundRat(z, x *big.Rat, den *big.Int, mode big.RoundingMode) *big.Rat {
+	if den.Sign() <= 0 {
+		panic("non-positive denominator")
+	}
+	d := new(big.Rat).SetInt(den)
+	n := roundInt(new(big.Rat).Mul(x, d), mode)
+	return z.SetFrac(n, den)
+}
+
+// limitDenominator returns the rational nearest to x whose denominator is at
+// most maxDen. It walks the continued fraction of x until the next
+// convergent's denominator exceeds maxDen, and then picks the closer of the
+// last convergent and the best semiconvergent. If maxDen is not positive,
+// then limitDenominator panics.
+func limitDenominator(x *big.Rat, maxDen *big.Int) *big.Rat {
+	if maxDen.Sign() <= 0 {
+		panic("non-positive denominator")
+	}
+	if x.Denom().Cmp(maxDen) <= 0 {
+		return new(big.Rat).Set(x)
+	}
+	p0, q0 := big.NewInt(0), big.NewInt(1)
+	p1, q1 := big.NewInt(1), big.NewInt(0)
+	n, d := new(big.Int).Set(x.Num()), new(big.Int).Set(x.Denom())
+	a, temp := new(big.Int), new(big.Int)
+	for {
+		a.Div(n, d)
+		q2 := new(big.Int).Add(q0, temp.Mul(a, q1))
+		if q2.Cmp(maxDen) > 0 {
+			break
+		}
+		p2 := new(big.Int).Add(p0, temp.Mul(a, p1))
+		p0, q0, p1, q1 = p1, q1, p2, q2
+		n, d = d, n.Sub(n, temp.Mul(a, d))
+	}
+	k := new(big.Int).Sub(maxDen, q0)
+	k.Div(k, q1)
+	semi := new(big.Rat).SetFrac(
+		new(big.Int).Add(p0, temp.Mul(k, p1)),
+		new(big.Int).Add(q0, new(big.Int).Mul(k, q1)),
+	)
+	conv := new(big.Rat).SetFrac(p1, q1)
+	e1 := new(big.Rat).Sub(semi, x)
+	e2 := new(big.Rat).Sub(conv, x)
+	if e2.Abs(e2).Cmp(e1.Abs(e1)) <= 0 {
+		return conv
+	}
+	return semi
+}
+
+// RoundToDenominator sets z equal to y with each component replaced by the
+// nearest rational whose denominator is at most maxDen, and returns z. This
+// bounds the size of the components after long chains of operations, at the
+// cost of an error of at most 1/(2·maxDen) in each component. If maxDen is
+// not positive, then RoundToDenominator panics.
+func RoundToDenominator[T Number[T]](z, y T, maxDen *big.Int) T {
+	z.Set(y)
+	for _, c := range z.Components() {
+		c.Set(limitDenominator(c, maxDen))
+	}
+	return z
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestRoundInt(t *testing.T) {
+	modes := []big.RoundingMode{
+		big.ToNearestEven, big.ToNearestAway, big.ToZero,
+		big.AwayFromZero, big.ToNegativeInf, big.ToPositiveInf,
+	}
+	tests := []struct {
+		x    *big.Rat
+		want [6]int64
+	}{
+		{big.NewRat(5, 2), [6]int64{2, 3, 2, 3, 2, 3}},
+		{big.NewRat(-5, 2), [6]int64{-2, -3, -2, -3, -3, -2}},
+		{big.NewRat(7, 3), [6]int64{2, 2, 2, 3, 2, 3}},
+		{big.NewRat(-8, 3), [6]int64{-3, -3, -2, -3, -3, -2}},
+		{big.NewRat(4, 1), [6]int64{4, 4, 4, 4, 4, 4}},
+	}
+	for _, test := range tests {
+		for i, mode := range modes {
+			if got := roundInt(test.x, mode); got.Int64() != test.want[i] {
+				t.Errorf("roundInt(%v, %v) = %v, want %d", test.x, mode, got, test.want[i])
+			}
+		}
+	}
+}
+
+func TestHamiltonRoundBounds(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		den := big.NewInt(1000)
+		lo := rats(new(Hamilton).Round(x, den, big.ToNegativeInf).Rats())
+		hi := rats(new(Hamilton).Round(x, den, big.ToPositiveInf).Rats())
+		for i, c := range rats(x.Rats()) {
+			if lo[i].Cmp(c) > 0 || hi[i].Cmp(c) < 0 {
+				return false
+			}
+			w := new(big.Rat).Sub(hi[i], lo[i])
+			if w.Cmp(big.NewRat(1, 1000)) > 0 {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestLimitDenominator(t *testing.T) {
+	tests := []struct {
+		x      *big.Rat
+		maxDen int64
+		want   *big.Rat
+	}{
+		{big.NewRat(314159265358979, 100000000000000), 1000, big.NewRat(355, 113)},
+		{big.NewRat(314159265358979, 100000000000000), 100, big.NewRat(311, 99)},
+		{big.NewRat(-314159265358979, 100000000000000), 10, big.NewRat(-22, 7)},
+		{big.NewRat(1, 3), 3, big.NewRat(1, 3)},
+		{big.NewRat(1, 3), 2, big.NewRat(1, 2)},
+		{big.NewRat(7, 1), 1, big.NewRat(7, 1)},
+	}
+	for _, test := range tests {
+		if got := limitDenominator(test.x, big.NewInt(test.maxDen)); got.Cmp(test.want) != 0 {
+			t.Errorf("limitDenominator(%v, %d) = %v, want %v", test.x, test.maxDen, got, test.want)
+		}
+	}
+}
+
+func TestLimitDenominatorBest(t *testing.T) {
+	f := func(num int32, den uint16, max uint8) bool {
+		// t.Logf("num = %v, den = %v, max = %v", num, den, max)
+		x := big.NewRat(int64(num), int64(den)+1)
+		m := int64(max) + 1
+		got := limitDenominator(x, big.NewInt(m))
+		if got.Denom().Int64() > m {
+			return false
+		}
+		e := new(big.Rat).Sub(got, x)
+		e.Abs(e)
+		// no fraction with a denominator of at most m is closer
+		for q := int64(1); q <= m; q++ {
+			p := roundInt(new(big.Rat).Mul(x, big.NewRat(q, 1)), big.ToNearestEven)
+			d := new(big.Rat).Sub(new(big.Rat).SetFrac(p, big.NewInt(q)), x)
+			if d.Abs(d).Cmp(e) < 0 {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRoundToDenominator(t *testing.T) {
+	f := func(x *Cayley) bool {
+		// t.Logf("x = %v", x)
+		max := big.NewInt(1000)
+		z := RoundToDenominator(new(Cayley), x, max)
+		bound := big.NewRat(1, 2000)
+		for i, c := range z.Components() {
+			e := new(big.Rat).Sub(c, x.Components()[i])
+			if c.Denom().Cmp(max) > 0 || e.Abs(e).Cmp(bound) > 0 {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
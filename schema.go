@@ -0,0 +1,151 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"encoding/json"
+	"math/big"
+)
+
+// A Schema is a machine-readable description of an algebra.
+type Schema struct {
+	Name string `json:"name"`
+	Dim  int    `json:"dim"`
+	// Basis holds the symbols of the basis units, starting with "1".
+	Basis []string `json:"basis"`
+	// Signature holds the sign of the real part of Mul(e, Conj(e)) for each
+	// basis unit e: 1, -1, or 0.
+	Signature []int `json:"signature"`
+	// Table holds the structure constants: Table[i][j] lists the components
+	// of the product of the i-th and j-th basis units, as RatStrings.
+	Table       [][][]string `json:"table"`
+	Involutions []string     `json:"involutions"`
+	Commutative bool         `json:"commutative"`
+	Associative bool         `json:"associative"`
+}
+
+// An algebra registers one of the types of this package.
+type algebra struct {
+	impl        func() *Impl
+	basis       []string
+	involutions []string
+}
+
+var twoInvolutions = []string{"Neg", "Conj"}
+
+// basis returns the basis symbols in symb, with "1" for the real unit.
+func basis(symb []string) []string {
+	return append([]string{"1"}, symb[1:]...)
+}
+
+// algebras holds every type of this package, ordered by dimension and name.
+var algebras = []algebra{
+	{ComplexImpl, basis(symbComplex[:]), twoInvolutions},
+	{InfraImpl, []string{"1", "α"}, twoInvolutions},
+	{PerplexImpl, []string{"1", "s"}, twoInvolutions},
+	{BiComplexImpl, basis(symbBiComplex[:]), []string{"Neg", "Conj", "Star"}},
+	{BiPerplexImpl, basis(symbBiPerplex[:]), []string{"Neg", "Conj", "Star"}},
+	{CockleImpl, basis(symbCockle[:]), twoInvolutions},
+	{DualComplexImpl, basis(symbDualComplex[:]), []string{"Neg", "Conj", "Star"}},
+	{DualPerplexImpl, basis(symbDualPerplex[:]), []string{"Neg", "Conj", "Star"}},
+	{HamiltonImpl, basis(symbHamilton[:]), twoInvolutions},
+	{HyperImpl, basis(symbHyper[:]), []string{"Neg", "Conj", "Star"}},
+	{InfraComplexImpl, basis(symbInfraComplex[:]), twoInvolutions},
+	{InfraPerplexImpl, basis(symbInfraPerplex[:]), twoInvolutions},
+	{SupraImpl, basis(symbSupra[:]), twoInvolutions},
+	{BiCockleImpl, basis(symbBiCockle[:]), []string{"Neg", "Conj", "Star"}},
+	{BiHamiltonImpl, basis(symbBiHamilton[:]), []string{"Neg", "Conj", "Star"}},
+	{CayleyImpl, basis(symbCayley[:]), twoInvolutions},
+	{DualHamiltonImpl, basis(symbDualHamilton[:]), []string{"Neg", "Conj", "Star"}},
+	{InfraCockleImpl, basis(symbInfraCockle[:]), twoInvolutions},
+	{InfraHamiltonImpl, basis(symbInfraHamilton[:]), twoInvolutions},
+	{SupraComplexImpl, basis(symbSupraComplex[:]), twoInvolutions},
+	{SupraPerplexImpl, basis(symbSupraPerplex[:]), twoInvolutions},
+	{TriComplexImpl, basis(symbTriComplex[:]), []string{"Neg", "Conj", "Star"}},
+	{TriNilplexImpl, basis(symbTriNilplex[:]), []string{"Neg", "Conj", "Star"}},
+	{TriPerplexImpl, basis(symbTriPerplex[:]), []string{"Neg", "Conj", "Star"}},
+	{UltraImpl, basis(symbUltra[:]), twoInvolutions},
+	{ZornImpl, basis(symbZorn[:]), twoInvolutions},
+}
+
+// unit returns the i-th basis unit of dimension n as a component vector.
+func unit(n, i int) []*big.Rat {
+	e := make([]*big.Rat, n)
+	for k := range e {
+		e[k] = new(big.Rat)
+	}
+	e[i].SetInt64(1)
+	return e
+}
+
+// schema computes the schema of a.
+func (a algebra) schema() *Schema {
+	impl := a.impl()
+	n := impl.Dim
+	mul, conj := impl.Ops["Mul"], impl.Ops["Conj"]
+	s := &Schema{
+		Name:        impl.Name,
+		Dim:         n,
+		Basis:       a.basis,
+		Signature:   make([]int, n),
+		Table:       make([][][]string, n),
+		Involutions: a.involutions,
+		Commutative: true,
+		Associative: true,
+	}
+	prod := make([][][]*big.Rat, n)
+	for i := 0; i < n; i++ {
+		e := unit(n, i)
+		s.Signature[i] = mul(e, conj(e, nil))[0].Sign()
+		prod[i] = make([][]*big.Rat, n)
+		s.Table[i] = make([][]string, n)
+		for j := 0; j < n; j++ {
+			prod[i][j] = mul(e, unit(n, j))
+			s.Table[i][j] = make([]string, n)
+			for k, c := range prod[i][j] {
+				s.Table[i][j][k] = c.RatString()
+			}
+		}
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if !equalRats(prod[i][j], prod[j][i]) {
+				s.Commutative = false
+			}
+			for k := 0; k < n && s.Associative; k++ {
+				l := mul(prod[i][j], unit(n, k))
+				r := mul(unit(n, i), prod[j][k])
+				s.Associative = equalRats(l, r)
+			}
+		}
+	}
+	return s
+}
+
+// Schemas returns the schemas of every type of this package, ordered by
+// dimension and name.
+func Schemas() []*Schema {
+	v := make([]*Schema, len(algebras))
+	for i, a := range algebras {
+		v[i] = a.schema()
+	}
+	return v
+}
+
+// SchemaOf returns the schema of the type with the given name, such as
+// "Hamilton". The second result is false if there is no such type.
+func SchemaOf(name string) (*Schema, bool) {
+	for _, a := range algebras {
+		if impl := a.impl(); impl.Name == name {
+			return a.schema(), true
+		}
+	}
+	return nil, false
+}
+
+// SchemasJSON returns the schemas of every type of this package as a JSON
+// array.
+func SchemasJSON() ([]byte, error) {
+	return json.Marshal(Schemas())
+}
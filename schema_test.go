@@ -0,0 +1,75 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSchemaHamilton(t *testing.T) {
+	s, ok := SchemaOf("Hamilton")
+	if !ok {
+		t.Fatal("no schema for Hamilton")
+	}
+	if s.Dim != 4 || s.Commutative || !s.Associative {
+		t.Errorf("Hamilton schema = %+v", s)
+	}
+	// i*j = k
+	if got := s.Table[1][2]; got[3] != "1" || got[0] != "0" {
+		t.Errorf("i*j = %v, want k", got)
+	}
+	for i, sign := range s.Signature {
+		if sign != 1 {
+			t.Errorf("signature of %s is %d, want 1", s.Basis[i], sign)
+		}
+	}
+}
+
+func TestSchemaFlags(t *testing.T) {
+	tests := []struct {
+		name        string
+		commutative bool
+		associative bool
+	}{
+		{"Complex", true, true},
+		{"BiComplex", true, true},
+		{"Cockle", false, true},
+		{"Cayley", false, false},
+		{"Zorn", false, false},
+	}
+	for _, test := range tests {
+		s, ok := SchemaOf(test.name)
+		if !ok {
+			t.Errorf("no schema for %s", test.name)
+			continue
+		}
+		if s.Commutative != test.commutative || s.Associative != test.associative {
+			t.Errorf("%s: commutative = %v, associative = %v, want %v, %v",
+				test.name, s.Commutative, s.Associative, test.commutative, test.associative)
+		}
+	}
+	if _, ok := SchemaOf("Sedenion"); ok {
+		t.Error("found a schema for Sedenion")
+	}
+}
+
+func TestSchemasJSON(t *testing.T) {
+	data, err := SchemasJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var v []Schema
+	if err := json.Unmarshal(data, &v); err != nil {
+		t.Fatal(err)
+	}
+	if len(v) != len(algebras) {
+		t.Errorf("decoded %d schemas, want %d", len(v), len(algebras))
+	}
+	for _, s := range v {
+		if len(s.Basis) != s.Dim || len(s.Table) != s.Dim || len(s.Signature) != s.Dim {
+			t.Errorf("%s: inconsistent schema %+v", s.Name, s)
+		}
+	}
+}
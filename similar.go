@@ -0,0 +1,103 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// similarElem is a type parameter constraint for the types with an
+// AreSimilar method.
+type similarElem[S any] interface {
+	Elem[S]
+	Quad() *big.Rat
+	Unit(i int) *S
+	Dim() int
+}
+
+// similar returns a value q with q x = y q and a non-zero quadrance, and
+// true, if x and y have the same real part and quadrance and are both real or
+// both not real. Otherwise it returns false. With u and v the pure parts of x
+// and y, u² = v² is real, so every
+// 		q = v z + z u
+// satisfies q u = v q. The values of q for the basis units z and their
+// pairwise sums span the solutions, and one of them is invertible.
+func similar[S any, T similarElem[S]](x, y T) (T, bool) {
+	if x.Real().Cmp(y.Real()) != 0 || x.Quad().Cmp(y.Quad()) != 0 {
+		return nil, false
+	}
+	if x.IsReal() || y.IsReal() {
+		if !x.Equals(y) {
+			return nil, false
+		}
+		one := T(new(S))
+		one.Unit(0)
+		return one, true
+	}
+	u, v := T(new(S)), T(new(S))
+	u.Set(x)
+	u.Real().SetInt64(0)
+	v.Set(y)
+	v.Real().SetInt64(0)
+	n := u.Dim()
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			z, e := T(new(S)), T(new(S))
+			z.Unit(i)
+			if j != i {
+				z.Add(z, e.Unit(j))
+			}
+			q, zu := T(new(S)), T(new(S))
+			q.Mul(v, z)
+			q.Add(q, zu.Mul(z, u))
+			if q.Quad().Sign() != 0 {
+				return q, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// AreSimilar returns true if x and y are similar, or conjugate under an inner
+// automorphism, so that
+// 		q x Inv(q) = y
+// for some quaternion q, and sets z equal to such a q. Otherwise it returns
+// false and leaves z unchanged. Similar quaternions have the same minimal
+// polynomial, and by the Skolem-Noether theorem the converse holds, so x and
+// y are similar exactly when they have the same real part and quadrance.
+func (z *Hamilton) AreSimilar(x, y *Hamilton) bool {
+	q, ok := similar(x, y)
+	if ok {
+		z.Set(q)
+	}
+	return ok
+}
+
+// AreSimilar returns true if x and y are similar, so that
+// 		q x Inv(q) = y
+// for some invertible q, and sets z equal to such a q. Otherwise it returns
+// false and leaves z unchanged. As 2×2 matrices, x and y are similar exactly
+// when they have the same trace and determinant, that is, the same real part
+// and quadrance, unless one of them is real, in which case they must be
+// equal.
+func (z *Cockle) AreSimilar(x, y *Cockle) bool {
+	q, ok := similar(x, y)
+	if ok {
+		z.Set(q)
+	}
+	return ok
+}
+
+// AreSimilar returns true if x and y are similar, so that
+// 		(q x) Inv(q) = y
+// for some octonion q, and sets z equal to such a q. Otherwise it returns
+// false and leaves z unchanged. The product does not depend on the grouping,
+// since q, x, and Inv(q) lie in an associative subalgebra. As for Hamilton,
+// x and y are similar exactly when they have the same real part and
+// quadrance.
+func (z *Cayley) AreSimilar(x, y *Cayley) bool {
+	q, ok := similar(x, y)
+	if ok {
+		z.Set(q)
+	}
+	return ok
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestHamiltonAreSimilar(t *testing.T) {
+	f := func(x, p *Hamilton) bool {
+		// t.Logf("x = %v, p = %v", x, p)
+		if p.Quad().Sign() == 0 {
+			return true
+		}
+		y := new(Hamilton).Mul(new(Hamilton).Mul(p, x), new(Hamilton).Inv(p))
+		q := new(Hamilton)
+		if !q.AreSimilar(x, y) {
+			return false
+		}
+		return new(Hamilton).Mul(q, x).Equals(new(Hamilton).Mul(y, q))
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+	i := NewHamilton(big.NewRat(1, 1), big.NewRat(2, 1), big.NewRat(0, 1), big.NewRat(0, 1))
+	j := NewHamilton(big.NewRat(1, 1), big.NewRat(-2, 1), big.NewRat(0, 1), big.NewRat(0, 1))
+	q := new(Hamilton)
+	if !q.AreSimilar(i, j) || !new(Hamilton).Mul(q, i).Equals(new(Hamilton).Mul(j, q)) {
+		t.Errorf("AreSimilar(%v, %v) = %v", i, j, q)
+	}
+	k := NewHamilton(big.NewRat(1, 1), big.NewRat(0, 1), big.NewRat(1, 1), big.NewRat(1, 1))
+	if q.AreSimilar(i, k) {
+		t.Errorf("%v and %v are similar", i, k)
+	}
+}
+
+func TestCockleAreSimilar(t *testing.T) {
+	f := func(x, p *Cockle) bool {
+		// t.Logf("x = %v, p = %v", x, p)
+		if p.IsZeroDivisor() {
+			return true
+		}
+		y := new(Cockle).Mul(new(Cockle).Mul(p, x), new(Cockle).Inv(p))
+		q := new(Cockle)
+		if !q.AreSimilar(x, y) || q.IsZeroDivisor() {
+			return false
+		}
+		return new(Cockle).Mul(q, x).Equals(new(Cockle).Mul(y, q))
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+	// 1 and 1+t+u have the same trace and determinant, but only one of them
+	// is a scalar matrix
+	one := NewCockle(big.NewRat(1, 1), big.NewRat(0, 1), big.NewRat(0, 1), big.NewRat(0, 1))
+	n := NewCockle(big.NewRat(1, 1), big.NewRat(0, 1), big.NewRat(1, 1), big.NewRat(1, 1))
+	if new(Cockle).AreSimilar(one, n) {
+		t.Errorf("%v and %v are similar", one, n)
+	}
+	m := NewCockle(big.NewRat(1, 1), big.NewRat(0, 1), big.NewRat(1, 1), big.NewRat(-1, 1))
+	q := new(Cockle)
+	if !q.AreSimilar(n, m) || !new(Cockle).Mul(q, n).Equals(new(Cockle).Mul(m, q)) {
+		t.Errorf("AreSimilar(%v, %v) = %v", n, m, q)
+	}
+}
+
+func TestCayleyAreSimilar(t *testing.T) {
+	f := func(x, p *Cayley) bool {
+		// t.Logf("x = %v, p = %v", x, p)
+		if p.Quad().Sign() == 0 {
+			return true
+		}
+		y := new(Cayley).Mul(new(Cayley).Mul(p, x), new(Cayley).Inv(p))
+		q := new(Cayley)
+		if !q.AreSimilar(x, y) {
+			return false
+		}
+		return new(Cayley).Mul(q, x).Equals(new(Cayley).Mul(y, q))
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+	x := new(Cayley).Unit(5)
+	y := new(Cayley).Neg(x)
+	q := new(Cayley)
+	if !q.AreSimilar(x, y) || !new(Cayley).Mul(q, x).Equals(new(Cayley).Mul(y, q)) {
+		t.Errorf("AreSimilar(%v, %v) = %v", x, y, q)
+	}
+	if q.AreSimilar(x, new(Cayley).Scal(x, big.NewRat(2, 1))) {
+		t.Errorf("%v and %v are similar", x, new(Cayley).Scal(x, big.NewRat(2, 1)))
+	}
+}
@@ -0,0 +1,687 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// A SoA stores a sequence of values in struct-of-arrays form: one contiguous
+// slice of rationals per component. Bulk component-wise operations then walk
+// memory linearly instead of hopping between values.
+type SoA struct {
+	cols [][]big.Rat
+}
+
+// NewSoA returns a pointer to a zero SoA holding n values of dimension dim.
+func NewSoA(dim, n int) *SoA {
+	s := &SoA{make([][]big.Rat, dim)}
+	for i := range s.cols {
+		s.cols[i] = make([]big.Rat, n)
+	}
+	return s
+}
+
+// Dim returns the number of components of each value in s.
+func (s *SoA) Dim() int {
+	return len(s.cols)
+}
+
+// Len returns the number of values in s.
+func (s *SoA) Len() int {
+	if len(s.cols) == 0 {
+		return 0
+	}
+	return len(s.cols[0])
+}
+
+// Column returns the i-th component of every value in s. The result shares
+// storage with s.
+func (s *SoA) Column(i int) []big.Rat {
+	return s.cols[i]
+}
+
+// Row returns copies of the components of the j-th value in s.
+func (s *SoA) Row(j int) []*big.Rat {
+	v := make([]*big.Rat, len(s.cols))
+	for i := range s.cols {
+		v[i] = new(big.Rat).Set(&s.cols[i][j])
+	}
+	return v
+}
+
+// SetRow sets the components of the j-th value in s equal to v. If the length
+// of v is not equal to the dimension of s, then SetRow panics.
+func (s *SoA) SetRow(j int, v []*big.Rat) {
+	if len(v) != len(s.cols) {
+		panic("dimension mismatch")
+	}
+	for i := range s.cols {
+		s.cols[i][j].Set(v[i])
+	}
+}
+
+// check panics if x and y do not have the same shape as s.
+func (s *SoA) check(x, y *SoA) {
+	if x.Dim() != s.Dim() || y.Dim() != s.Dim() || x.Len() != s.Len() || y.Len() != s.Len() {
+		panic("shape mismatch")
+	}
+}
+
+// Add sets s equal to the component-wise sum x+y, and returns s.
+func (s *SoA) Add(x, y *SoA) *SoA {
+	s.check(x, y)
+	for i := range s.cols {
+		for j := range s.cols[i] {
+			s.cols[i][j].Add(&x.cols[i][j], &y.cols[i][j])
+		}
+	}
+	return s
+}
+
+// Sub sets s equal to the component-wise difference x-y, and returns s.
+func (s *SoA) Sub(x, y *SoA) *SoA {
+	s.check(x, y)
+	for i := range s.cols {
+		for j := range s.cols[i] {
+			s.cols[i][j].Sub(&x.cols[i][j], &y.cols[i][j])
+		}
+	}
+	return s
+}
+
+// Neg sets s equal to the negative of x, and returns s.
+func (s *SoA) Neg(x *SoA) *SoA {
+	s.check(x, x)
+	for i := range s.cols {
+		for j := range s.cols[i] {
+			s.cols[i][j].Neg(&x.cols[i][j])
+		}
+	}
+	return s
+}
+
+// Scal sets s equal to x scaled by a, and returns s.
+func (s *SoA) Scal(x *SoA, a *big.Rat) *SoA {
+	s.check(x, x)
+	for i := range s.cols {
+		for j := range s.cols[i] {
+			s.cols[i][j].Mul(&x.cols[i][j], a)
+		}
+	}
+	return s
+}
+
+// ComplexSoA returns the struct-of-arrays form of the Complex values in v.
+func ComplexSoA(v []*Complex) *SoA {
+	s := NewSoA(2, len(v))
+	for j, z := range v {
+		s.SetRow(j, rats(z.Rats()))
+	}
+	return s
+}
+
+// Complexes returns the Complex values stored in s. If the dimension of s is not
+// 2, then Complexes panics.
+func (s *SoA) Complexes() []*Complex {
+	if s.Dim() != 2 {
+		panic("dimension mismatch")
+	}
+	v := make([]*Complex, s.Len())
+	for j := range v {
+		r := s.Row(j)
+		v[j] = NewComplex(r[0], r[1])
+	}
+	return v
+}
+
+// InfraSoA returns the struct-of-arrays form of the Infra values in v.
+func InfraSoA(v []*Infra) *SoA {
+	s := NewSoA(2, len(v))
+	for j, z := range v {
+		s.SetRow(j, rats(z.Rats()))
+	}
+	return s
+}
+
+// Infras returns the Infra values stored in s. If the dimension of s is not
+// 2, then Infras panics.
+func (s *SoA) Infras() []*Infra {
+	if s.Dim() != 2 {
+		panic("dimension mismatch")
+	}
+	v := make([]*Infra, s.Len())
+	for j := range v {
+		r := s.Row(j)
+		v[j] = NewInfra(r[0], r[1])
+	}
+	return v
+}
+
+// PerplexSoA returns the struct-of-arrays form of the Perplex values in v.
+func PerplexSoA(v []*Perplex) *SoA {
+	s := NewSoA(2, len(v))
+	for j, z := range v {
+		s.SetRow(j, rats(z.Rats()))
+	}
+	return s
+}
+
+// Perplexes returns the Perplex values stored in s. If the dimension of s is not
+// 2, then Perplexes panics.
+func (s *SoA) Perplexes() []*Perplex {
+	if s.Dim() != 2 {
+		panic("dimension mismatch")
+	}
+	v := make([]*Perplex, s.Len())
+	for j := range v {
+		r := s.Row(j)
+		v[j] = NewPerplex(r[0], r[1])
+	}
+	return v
+}
+
+// BiComplexSoA returns the struct-of-arrays form of the BiComplex values in v.
+func BiComplexSoA(v []*BiComplex) *SoA {
+	s := NewSoA(4, len(v))
+	for j, z := range v {
+		s.SetRow(j, rats(z.Rats()))
+	}
+	return s
+}
+
+// BiComplexes returns the BiComplex values stored in s. If the dimension of s is not
+// 4, then BiComplexes panics.
+func (s *SoA) BiComplexes() []*BiComplex {
+	if s.Dim() != 4 {
+		panic("dimension mismatch")
+	}
+	v := make([]*BiComplex, s.Len())
+	for j := range v {
+		r := s.Row(j)
+		v[j] = NewBiComplex(r[0], r[1], r[2], r[3])
+	}
+	return v
+}
+
+// BiPerplexSoA returns the struct-of-arrays form of the BiPerplex values in v.
+func BiPerplexSoA(v []*BiPerplex) *SoA {
+	s := NewSoA(4, len(v))
+	for j, z := range v {
+		s.SetRow(j, rats(z.Rats()))
+	}
+	return s
+}
+
+// BiPerplexes returns the BiPerplex values stored in s. If the dimension of s is not
+// 4, then BiPerplexes panics.
+func (s *SoA) BiPerplexes() []*BiPerplex {
+	if s.Dim() != 4 {
+		panic("dimension mismatch")
+	}
+	v := make([]*BiPerplex, s.Len())
+	for j := range v {
+		r := s.Row(j)
+		v[j] = NewBiPerplex(r[0], r[1], r[2], r[3])
+	}
+	return v
+}
+
+// CockleSoA returns the struct-of-arrays form of the Cockle values in v.
+func CockleSoA(v []*Cockle) *SoA {
+	s := NewSoA(4, len(v))
+	for j, z := range v {
+		s.SetRow(j, rats(z.Rats()))
+	}
+	return s
+}
+
+// Cockles returns the Cockle values stored in s. If the dimension of s is not
+// 4, then Cockles panics.
+func (s *SoA) Cockles() []*Cockle {
+	if s.Dim() != 4 {
+		panic("dimension mismatch")
+	}
+	v := make([]*Cockle, s.Len())
+	for j := range v {
+		r := s.Row(j)
+		v[j] = NewCockle(r[0], r[1], r[2], r[3])
+	}
+	return v
+}
+
+// DualComplexSoA returns the struct-of-arrays form of the DualComplex values in v.
+func DualComplexSoA(v []*DualComplex) *SoA {
+	s := NewSoA(4, len(v))
+	for j, z := range v {
+		s.SetRow(j, rats(z.Rats()))
+	}
+	return s
+}
+
+// DualComplexes returns the DualComplex values stored in s. If the dimension of s is not
+// 4, then DualComplexes panics.
+func (s *SoA) DualComplexes() []*DualComplex {
+	if s.Dim() != 4 {
+		panic("dimension mismatch")
+	}
+	v := make([]*DualComplex, s.Len())
+	for j := range v {
+		r := s.Row(j)
+		v[j] = NewDualComplex(r[0], r[1], r[2], r[3])
+	}
+	return v
+}
+
+// DualPerplexSoA returns the struct-of-arrays form of the DualPerplex values in v.
+func DualPerplexSoA(v []*DualPerplex) *SoA {
+	s := NewSoA(4, len(v))
+	for j, z := range v {
+		s.SetRow(j, rats(z.Rats()))
+	}
+	return s
+}
+
+// DualPerplexes returns the DualPerplex values stored in s. If the dimension of s is not
+// 4, then DualPerplexes panics.
+func (s *SoA) DualPerplexes() []*DualPerplex {
+	if s.Dim() != 4 {
+		panic("dimension mismatch")
+	}
+	v := make([]*DualPerplex, s.Len())
+	for j := range v {
+		r := s.Row(j)
+		v[j] = NewDualPerplex(r[0], r[1], r[2], r[3])
+	}
+	return v
+}
+
+// HamiltonSoA returns the struct-of-arrays form of the Hamilton values in v.
+func HamiltonSoA(v []*Hamilton) *SoA {
+	s := NewSoA(4, len(v))
+	for j, z := range v {
+		s.SetRow(j, rats(z.Rats()))
+	}
+	return s
+}
+
+// Hamiltons returns the Hamilton values stored in s. If the dimension of s is not
+// 4, then Hamiltons panics.
+func (s *SoA) Hamiltons() []*Hamilton {
+	if s.Dim() != 4 {
+		panic("dimension mismatch")
+	}
+	v := make([]*Hamilton, s.Len())
+	for j := range v {
+		r := s.Row(j)
+		v[j] = NewHamilton(r[0], r[1], r[2], r[3])
+	}
+	return v
+}
+
+// HyperSoA returns the struct-of-arrays form of the Hyper values in v.
+func HyperSoA(v []*Hyper) *SoA {
+	s := NewSoA(4, len(v))
+	for j, z := range v {
+		s.SetRow(j, rats(z.Rats()))
+	}
+	return s
+}
+
+// Hypers returns the Hyper values stored in s. If the dimension of s is not
+// 4, then Hypers panics.
+func (s *SoA) Hypers() []*Hyper {
+	if s.Dim() != 4 {
+		panic("dimension mismatch")
+	}
+	v := make([]*Hyper, s.Len())
+	for j := range v {
+		r := s.Row(j)
+		v[j] = NewHyper(r[0], r[1], r[2], r[3])
+	}
+	return v
+}
+
+// InfraComplexSoA returns the struct-of-arrays form of the InfraComplex values in v.
+func InfraComplexSoA(v []*InfraComplex) *SoA {
+	s := NewSoA(4, len(v))
+	for j, z := range v {
+		s.SetRow(j, rats(z.Rats()))
+	}
+	return s
+}
+
+// InfraComplexes returns the InfraComplex values stored in s. If the dimension of s is not
+// 4, then InfraComplexes panics.
+func (s *SoA) InfraComplexes() []*InfraComplex {
+	if s.Dim() != 4 {
+		panic("dimension mismatch")
+	}
+	v := make([]*InfraComplex, s.Len())
+	for j := range v {
+		r := s.Row(j)
+		v[j] = NewInfraComplex(r[0], r[1], r[2], r[3])
+	}
+	return v
+}
+
+// InfraPerplexSoA returns the struct-of-arrays form of the InfraPerplex values in v.
+func InfraPerplexSoA(v []*InfraPerplex) *SoA {
+	s := NewSoA(4, len(v))
+	for j, z := range v {
+		s.SetRow(j, rats(z.Rats()))
+	}
+	return s
+}
+
+// InfraPerplexes returns the InfraPerplex values stored in s. If the dimension of s is not
+// 4, then InfraPerplexes panics.
+func (s *SoA) InfraPerplexes() []*InfraPerplex {
+	if s.Dim() != 4 {
+		panic("dimension mismatch")
+	}
+	v := make([]*InfraPerplex, s.Len())
+	for j := range v {
+		r := s.Row(j)
+		v[j] = NewInfraPerplex(r[0], r[1], r[2], r[3])
+	}
+	return v
+}
+
+// SupraSoA returns the struct-of-arrays form of the Supra values in v.
+func SupraSoA(v []*Supra) *SoA {
+	s := NewSoA(4, len(v))
+	for j, z := range v {
+		s.SetRow(j, rats(z.Rats()))
+	}
+	return s
+}
+
+// Supras returns the Supra values stored in s. If the dimension of s is not
+// 4, then Supras panics.
+func (s *SoA) Supras() []*Supra {
+	if s.Dim() != 4 {
+		panic("dimension mismatch")
+	}
+	v := make([]*Supra, s.Len())
+	for j := range v {
+		r := s.Row(j)
+		v[j] = NewSupra(r[0], r[1], r[2], r[3])
+	}
+	return v
+}
+
+// BiCockleSoA returns the struct-of-arrays form of the BiCockle values in v.
+func BiCockleSoA(v []*BiCockle) *SoA {
+	s := NewSoA(8, len(v))
+	for j, z := range v {
+		s.SetRow(j, rats(z.Rats()))
+	}
+	return s
+}
+
+// BiCockles returns the BiCockle values stored in s. If the dimension of s is not
+// 8, then BiCockles panics.
+func (s *SoA) BiCockles() []*BiCockle {
+	if s.Dim() != 8 {
+		panic("dimension mismatch")
+	}
+	v := make([]*BiCockle, s.Len())
+	for j := range v {
+		r := s.Row(j)
+		v[j] = NewBiCockle(r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7])
+	}
+	return v
+}
+
+// BiHamiltonSoA returns the struct-of-arrays form of the BiHamilton values in v.
+func BiHamiltonSoA(v []*BiHamilton) *SoA {
+	s := NewSoA(8, len(v))
+	for j, z := range v {
+		s.SetRow(j, rats(z.Rats()))
+	}
+	return s
+}
+
+// BiHamiltons returns the BiHamilton values stored in s. If the dimension of s is not
+// 8, then BiHamiltons panics.
+func (s *SoA) BiHamiltons() []*BiHamilton {
+	if s.Dim() != 8 {
+		panic("dimension mismatch")
+	}
+	v := make([]*BiHamilton, s.Len())
+	for j := range v {
+		r := s.Row(j)
+		v[j] = NewBiHamilton(r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7])
+	}
+	return v
+}
+
+// CayleySoA returns the struct-of-arrays form of the Cayley values in v.
+func CayleySoA(v []*Cayley) *SoA {
+	s := NewSoA(8, len(v))
+	for j, z := range v {
+		s.SetRow(j, rats(z.Rats()))
+	}
+	return s
+}
+
+// Cayleys returns the Cayley values stored in s. If the dimension of s is not
+// 8, then Cayleys panics.
+func (s *SoA) Cayleys() []*Cayley {
+	if s.Dim() != 8 {
+		panic("dimension mismatch")
+	}
+	v := make([]*Cayley, s.Len())
+	for j := range v {
+		r := s.Row(j)
+		v[j] = NewCayley(r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7])
+	}
+	return v
+}
+
+// InfraCockleSoA returns the struct-of-arrays form of the InfraCockle values in v.
+func InfraCockleSoA(v []*InfraCockle) *SoA {
+	s := NewSoA(8, len(v))
+	for j, z := range v {
+		s.SetRow(j, rats(z.Rats()))
+	}
+	return s
+}
+
+// InfraCockles returns the InfraCockle values stored in s. If the dimension of s is not
+// 8, then InfraCockles panics.
+func (s *SoA) InfraCockles() []*InfraCockle {
+	if s.Dim() != 8 {
+		panic("dimension mismatch")
+	}
+	v := make([]*InfraCockle, s.Len())
+	for j := range v {
+		r := s.Row(j)
+		v[j] = NewInfraCockle(r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7])
+	}
+	return v
+}
+
+// InfraHamiltonSoA returns the struct-of-arrays form of the InfraHamilton values in v.
+func InfraHamiltonSoA(v []*InfraHamilton) *SoA {
+	s := NewSoA(8, len(v))
+	for j, z := range v {
+		s.SetRow(j, rats(z.Rats()))
+	}
+	return s
+}
+
+// InfraHamiltons returns the InfraHamilton values stored in s. If the dimension of s is not
+// 8, then InfraHamiltons panics.
+func (s *SoA) InfraHamiltons() []*InfraHamilton {
+	if s.Dim() != 8 {
+		panic("dimension mismatch")
+	}
+	v := make([]*InfraHamilton, s.Len())
+	for j := range v {
+		r := s.Row(j)
+		v[j] = NewInfraHamilton(r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7])
+	}
+	return v
+}
+
+// SupraComplexSoA returns the struct-of-arrays form of the SupraComplex values in v.
+func SupraComplexSoA(v []*SupraComplex) *SoA {
+	s := NewSoA(8, len(v))
+	for j, z := range v {
+		s.SetRow(j, rats(z.Rats()))
+	}
+	return s
+}
+
+// SupraComplexes returns the SupraComplex values stored in s. If the dimension of s is not
+// 8, then SupraComplexes panics.
+func (s *SoA) SupraComplexes() []*SupraComplex {
+	if s.Dim() != 8 {
+		panic("dimension mismatch")
+	}
+	v := make([]*SupraComplex, s.Len())
+	for j := range v {
+		r := s.Row(j)
+		v[j] = NewSupraComplex(r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7])
+	}
+	return v
+}
+
+// SupraPerplexSoA returns the struct-of-arrays form of the SupraPerplex values in v.
+func SupraPerplexSoA(v []*SupraPerplex) *SoA {
+	s := NewSoA(8, len(v))
+	for j, z := range v {
+		s.SetRow(j, rats(z.Rats()))
+	}
+	return s
+}
+
+// SupraPerplexes returns the SupraPerplex values stored in s. If the dimension of s is not
+// 8, then SupraPerplexes panics.
+func (s *SoA) SupraPerplexes() []*SupraPerplex {
+	if s.Dim() != 8 {
+		panic("dimension mismatch")
+	}
+	v := make([]*SupraPerplex, s.Len())
+	for j := range v {
+		r := s.Row(j)
+		v[j] = NewSupraPerplex(r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7])
+	}
+	return v
+}
+
+// TriComplexSoA returns the struct-of-arrays form of the TriComplex values in v.
+func TriComplexSoA(v []*TriComplex) *SoA {
+	s := NewSoA(8, len(v))
+	for j, z := range v {
+		s.SetRow(j, rats(z.Rats()))
+	}
+	return s
+}
+
+// TriComplexes returns the TriComplex values stored in s. If the dimension of s is not
+// 8, then TriComplexes panics.
+func (s *SoA) TriComplexes() []*TriComplex {
+	if s.Dim() != 8 {
+		panic("dimension mismatch")
+	}
+	v := make([]*TriComplex, s.Len())
+	for j := range v {
+		r := s.Row(j)
+		v[j] = NewTriComplex(r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7])
+	}
+	return v
+}
+
+// TriNilplexSoA returns the struct-of-arrays form of the TriNilplex values in v.
+func TriNilplexSoA(v []*TriNilplex) *SoA {
+	s := NewSoA(8, len(v))
+	for j, z := range v {
+		s.SetRow(j, rats(z.Rats()))
+	}
+	return s
+}
+
+// TriNilplexes returns the TriNilplex values stored in s. If the dimension of s is not
+// 8, then TriNilplexes panics.
+func (s *SoA) TriNilplexes() []*TriNilplex {
+	if s.Dim() != 8 {
+		panic("dimension mismatch")
+	}
+	v := make([]*TriNilplex, s.Len())
+	for j := range v {
+		r := s.Row(j)
+		v[j] = NewTriNilplex(r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7])
+	}
+	return v
+}
+
+// TriPerplexSoA returns the struct-of-arrays form of the TriPerplex values in v.
+func TriPerplexSoA(v []*TriPerplex) *SoA {
+	s := NewSoA(8, len(v))
+	for j, z := range v {
+		s.SetRow(j, rats(z.Rats()))
+	}
+	return s
+}
+
+// TriPerplexes returns the TriPerplex values stored in s. If the dimension of s is not
+// 8, then TriPerplexes panics.
+func (s *SoA) TriPerplexes() []*TriPerplex {
+	if s.Dim() != 8 {
+		panic("dimension mismatch")
+	}
+	v := make([]*TriPerplex, s.Len())
+	for j := range v {
+		r := s.Row(j)
+		v[j] = NewTriPerplex(r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7])
+	}
+	return v
+}
+
+// UltraSoA returns the struct-of-arrays form of the Ultra values in v.
+func UltraSoA(v []*Ultra) *SoA {
+	s := NewSoA(8, len(v))
+	for j, z := range v {
+		s.SetRow(j, rats(z.Rats()))
+	}
+	return s
+}
+
+// Ultras returns the Ultra values stored in s. If the dimension of s is not
+// 8, then Ultras panics.
+func (s *SoA) Ultras() []*Ultra {
+	if s.Dim() != 8 {
+		panic("dimension mismatch")
+	}
+	v := make([]*Ultra, s.Len())
+	for j := range v {
+		r := s.Row(j)
+		v[j] = NewUltra(r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7])
+	}
+	return v
+}
+
+// ZornSoA returns the struct-of-arrays form of the Zorn values in v.
+func ZornSoA(v []*Zorn) *SoA {
+	s := NewSoA(8, len(v))
+	for j, z := range v {
+		s.SetRow(j, rats(z.Rats()))
+	}
+	return s
+}
+
+// Zorns returns the Zorn values stored in s. If the dimension of s is not
+// 8, then Zorns panics.
+func (s *SoA) Zorns() []*Zorn {
+	if s.Dim() != 8 {
+		panic("dimension mismatch")
+	}
+	v := make([]*Zorn, s.Len())
+	for j := range v {
+		r := s.Row(j)
+		v[j] = NewZorn(r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7])
+	}
+	return v
+}
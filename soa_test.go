@@ -0,0 +1,49 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestSoARoundTrip(t *testing.T) {
+	f := func(x, y, z *Cayley) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		v := CayleySoA([]*Cayley{x, y, z}).Cayleys()
+		return len(v) == 3 && v[0].Equals(x) && v[1].Equals(y) && v[2].Equals(z)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSoAAdd(t *testing.T) {
+	f := func(x, y, z, w *Hamilton) bool {
+		// t.Logf("x = %v, y = %v, z = %v, w = %v", x, y, z, w)
+		a := HamiltonSoA([]*Hamilton{x, y})
+		b := HamiltonSoA([]*Hamilton{z, w})
+		v := a.Add(a, b).Hamiltons()
+		return v[0].Equals(new(Hamilton).Add(x, z)) &&
+			v[1].Equals(new(Hamilton).Add(y, w))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSoAScal(t *testing.T) {
+	f := func(x, y *Complex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := big.NewRat(-3, 7)
+		s := ComplexSoA([]*Complex{x, y})
+		v := s.Scal(s, a).Complexes()
+		return v[0].Equals(new(Complex).Scal(x, a)) &&
+			v[1].Equals(new(Complex).Scal(y, a))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
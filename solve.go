@@ -0,0 +1,406 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// A SolveError reports that a linear equation ax = b or xa = b has no unique
+// solution, because a is a zero divisor.
+type SolveError struct {
+	Type string // the type, such as "Hamilton"
+	Op   string // "SolveL" or "SolveR"
+	A    fmt.Stringer
+}
+
+func (e *SolveError) Error() string {
+	return fmt.Sprintf("rational: %s.%s: %v is a zero divisor", e.Type, e.Op, e.A)
+}
+
+// Unwrap returns ErrZeroDivisor.
+func (e *SolveError) Unwrap() error {
+	return ErrZeroDivisor
+}
+
+// solve returns the unique solution of the linear system mx = b, or nil if m
+// is singular.
+func solve(m *RatMatrix, b []*big.Rat) []*big.Rat {
+	if m.Det().Sign() == 0 {
+		return nil
+	}
+	return new(RatMatrix).Inv(m).Apply(b)
+}
+
+// setComponents sets the components v of a value to those of w.
+func setComponents(v, w []*big.Rat) {
+	for i, x := range v {
+		x.Set(w[i])
+	}
+}
+
+// SolveL sets z equal to the solution x of ax = b, and returns z. The
+// equation is solved exactly with the matrix of left multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveL returns a
+// *SolveError.
+func (z *Cockle) SolveL(a, b *Cockle) (*Cockle, error) {
+	x := solve(a.Lmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"Cockle", "SolveL", new(Cockle).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveR sets z equal to the solution x of xa = b, and returns z. The
+// equation is solved exactly with the matrix of right multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveR returns a
+// *SolveError.
+func (z *Cockle) SolveR(a, b *Cockle) (*Cockle, error) {
+	x := solve(a.Rmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"Cockle", "SolveR", new(Cockle).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveL sets z equal to the solution x of ax = b, and returns z. The
+// equation is solved exactly with the matrix of left multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveL returns a
+// *SolveError.
+func (z *Hamilton) SolveL(a, b *Hamilton) (*Hamilton, error) {
+	x := solve(a.Lmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"Hamilton", "SolveL", new(Hamilton).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveR sets z equal to the solution x of xa = b, and returns z. The
+// equation is solved exactly with the matrix of right multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveR returns a
+// *SolveError.
+func (z *Hamilton) SolveR(a, b *Hamilton) (*Hamilton, error) {
+	x := solve(a.Rmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"Hamilton", "SolveR", new(Hamilton).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveL sets z equal to the solution x of ax = b, and returns z. The
+// equation is solved exactly with the matrix of left multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveL returns a
+// *SolveError.
+func (z *InfraComplex) SolveL(a, b *InfraComplex) (*InfraComplex, error) {
+	x := solve(a.Lmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"InfraComplex", "SolveL", new(InfraComplex).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveR sets z equal to the solution x of xa = b, and returns z. The
+// equation is solved exactly with the matrix of right multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveR returns a
+// *SolveError.
+func (z *InfraComplex) SolveR(a, b *InfraComplex) (*InfraComplex, error) {
+	x := solve(a.Rmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"InfraComplex", "SolveR", new(InfraComplex).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveL sets z equal to the solution x of ax = b, and returns z. The
+// equation is solved exactly with the matrix of left multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveL returns a
+// *SolveError.
+func (z *InfraPerplex) SolveL(a, b *InfraPerplex) (*InfraPerplex, error) {
+	x := solve(a.Lmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"InfraPerplex", "SolveL", new(InfraPerplex).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveR sets z equal to the solution x of xa = b, and returns z. The
+// equation is solved exactly with the matrix of right multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveR returns a
+// *SolveError.
+func (z *InfraPerplex) SolveR(a, b *InfraPerplex) (*InfraPerplex, error) {
+	x := solve(a.Rmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"InfraPerplex", "SolveR", new(InfraPerplex).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveL sets z equal to the solution x of ax = b, and returns z. The
+// equation is solved exactly with the matrix of left multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveL returns a
+// *SolveError.
+func (z *Supra) SolveL(a, b *Supra) (*Supra, error) {
+	x := solve(a.Lmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"Supra", "SolveL", new(Supra).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveR sets z equal to the solution x of xa = b, and returns z. The
+// equation is solved exactly with the matrix of right multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveR returns a
+// *SolveError.
+func (z *Supra) SolveR(a, b *Supra) (*Supra, error) {
+	x := solve(a.Rmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"Supra", "SolveR", new(Supra).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveL sets z equal to the solution x of ax = b, and returns z. The
+// equation is solved exactly with the matrix of left multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveL returns a
+// *SolveError.
+func (z *BiCockle) SolveL(a, b *BiCockle) (*BiCockle, error) {
+	x := solve(a.Lmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"BiCockle", "SolveL", new(BiCockle).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveR sets z equal to the solution x of xa = b, and returns z. The
+// equation is solved exactly with the matrix of right multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveR returns a
+// *SolveError.
+func (z *BiCockle) SolveR(a, b *BiCockle) (*BiCockle, error) {
+	x := solve(a.Rmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"BiCockle", "SolveR", new(BiCockle).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveL sets z equal to the solution x of ax = b, and returns z. The
+// equation is solved exactly with the matrix of left multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveL returns a
+// *SolveError.
+func (z *BiHamilton) SolveL(a, b *BiHamilton) (*BiHamilton, error) {
+	x := solve(a.Lmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"BiHamilton", "SolveL", new(BiHamilton).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveR sets z equal to the solution x of xa = b, and returns z. The
+// equation is solved exactly with the matrix of right multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveR returns a
+// *SolveError.
+func (z *BiHamilton) SolveR(a, b *BiHamilton) (*BiHamilton, error) {
+	x := solve(a.Rmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"BiHamilton", "SolveR", new(BiHamilton).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveL sets z equal to the solution x of ax = b, and returns z. The
+// equation is solved exactly with the matrix of left multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveL returns a
+// *SolveError.
+func (z *Cayley) SolveL(a, b *Cayley) (*Cayley, error) {
+	x := solve(a.Lmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"Cayley", "SolveL", new(Cayley).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveR sets z equal to the solution x of xa = b, and returns z. The
+// equation is solved exactly with the matrix of right multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveR returns a
+// *SolveError.
+func (z *Cayley) SolveR(a, b *Cayley) (*Cayley, error) {
+	x := solve(a.Rmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"Cayley", "SolveR", new(Cayley).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveL sets z equal to the solution x of ax = b, and returns z. The
+// equation is solved exactly with the matrix of left multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveL returns a
+// *SolveError.
+func (z *InfraCockle) SolveL(a, b *InfraCockle) (*InfraCockle, error) {
+	x := solve(a.Lmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"InfraCockle", "SolveL", new(InfraCockle).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveR sets z equal to the solution x of xa = b, and returns z. The
+// equation is solved exactly with the matrix of right multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveR returns a
+// *SolveError.
+func (z *InfraCockle) SolveR(a, b *InfraCockle) (*InfraCockle, error) {
+	x := solve(a.Rmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"InfraCockle", "SolveR", new(InfraCockle).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveL sets z equal to the solution x of ax = b, and returns z. The
+// equation is solved exactly with the matrix of left multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveL returns a
+// *SolveError.
+func (z *InfraHamilton) SolveL(a, b *InfraHamilton) (*InfraHamilton, error) {
+	x := solve(a.Lmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"InfraHamilton", "SolveL", new(InfraHamilton).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveR sets z equal to the solution x of xa = b, and returns z. The
+// equation is solved exactly with the matrix of right multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveR returns a
+// *SolveError.
+func (z *InfraHamilton) SolveR(a, b *InfraHamilton) (*InfraHamilton, error) {
+	x := solve(a.Rmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"InfraHamilton", "SolveR", new(InfraHamilton).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveL sets z equal to the solution x of ax = b, and returns z. The
+// equation is solved exactly with the matrix of left multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveL returns a
+// *SolveError.
+func (z *SupraComplex) SolveL(a, b *SupraComplex) (*SupraComplex, error) {
+	x := solve(a.Lmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"SupraComplex", "SolveL", new(SupraComplex).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveR sets z equal to the solution x of xa = b, and returns z. The
+// equation is solved exactly with the matrix of right multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveR returns a
+// *SolveError.
+func (z *SupraComplex) SolveR(a, b *SupraComplex) (*SupraComplex, error) {
+	x := solve(a.Rmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"SupraComplex", "SolveR", new(SupraComplex).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveL sets z equal to the solution x of ax = b, and returns z. The
+// equation is solved exactly with the matrix of left multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveL returns a
+// *SolveError.
+func (z *SupraPerplex) SolveL(a, b *SupraPerplex) (*SupraPerplex, error) {
+	x := solve(a.Lmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"SupraPerplex", "SolveL", new(SupraPerplex).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveR sets z equal to the solution x of xa = b, and returns z. The
+// equation is solved exactly with the matrix of right multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveR returns a
+// *SolveError.
+func (z *SupraPerplex) SolveR(a, b *SupraPerplex) (*SupraPerplex, error) {
+	x := solve(a.Rmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"SupraPerplex", "SolveR", new(SupraPerplex).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveL sets z equal to the solution x of ax = b, and returns z. The
+// equation is solved exactly with the matrix of left multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveL returns a
+// *SolveError.
+func (z *Ultra) SolveL(a, b *Ultra) (*Ultra, error) {
+	x := solve(a.Lmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"Ultra", "SolveL", new(Ultra).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveR sets z equal to the solution x of xa = b, and returns z. The
+// equation is solved exactly with the matrix of right multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveR returns a
+// *SolveError.
+func (z *Ultra) SolveR(a, b *Ultra) (*Ultra, error) {
+	x := solve(a.Rmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"Ultra", "SolveR", new(Ultra).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveL sets z equal to the solution x of ax = b, and returns z. The
+// equation is solved exactly with the matrix of left multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveL returns a
+// *SolveError.
+func (z *Zorn) SolveL(a, b *Zorn) (*Zorn, error) {
+	x := solve(a.Lmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"Zorn", "SolveL", new(Zorn).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
+
+// SolveR sets z equal to the solution x of xa = b, and returns z. The
+// equation is solved exactly with the matrix of right multiplication by a.
+// If a is a zero divisor, then z is left unchanged and SolveR returns a
+// *SolveError.
+func (z *Zorn) SolveR(a, b *Zorn) (*Zorn, error) {
+	x := solve(a.Rmat(), b.Components())
+	if x == nil {
+		return nil, &SolveError{"Zorn", "SolveR", new(Zorn).Set(a)}
+	}
+	setComponents(z.Components(), x)
+	return z, nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestHamiltonSolve(t *testing.T) {
+	f := func(a, x *Hamilton) bool {
+		// t.Logf("a = %v, x = %v", a, x)
+		l, err := new(Hamilton).SolveL(a, new(Hamilton).Mul(a, x))
+		if err != nil || !l.Equals(x) {
+			return false
+		}
+		r, err := new(Hamilton).SolveR(a, new(Hamilton).Mul(x, a))
+		return err == nil && r.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestZornSolve(t *testing.T) {
+	f := func(a, x *Zorn) bool {
+		// t.Logf("a = %v, x = %v", a, x)
+		if a.IsZeroDivisor() {
+			return true
+		}
+		l, err := new(Zorn).SolveL(a, new(Zorn).Mul(a, x))
+		if err != nil || !l.Equals(x) {
+			return false
+		}
+		r, err := new(Zorn).SolveR(a, new(Zorn).Mul(x, a))
+		return err == nil && r.Equals(x)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCockleSolveZeroDivisor(t *testing.T) {
+	one, zero := big.NewRat(1, 1), big.NewRat(0, 1)
+	a := NewCockle(one, zero, one, zero)
+	b := NewCockle(zero, one, zero, zero)
+	z := NewCockle(one, one, one, one)
+	_, err := z.SolveL(a, b)
+	var e *SolveError
+	if !errors.As(err, &e) || e.Op != "SolveL" || e.Type != "Cockle" {
+		t.Errorf("SolveL(%v, %v) error = %v", a, b, err)
+	}
+	if !z.Equals(NewCockle(one, one, one, one)) {
+		t.Errorf("SolveL changed z to %v", z)
+	}
+	if _, err := z.SolveR(a, b); err == nil {
+		t.Errorf("SolveR(%v, %v) succeeded", a, b)
+	}
+}
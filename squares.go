@@ -0,0 +1,150 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"errors"
+	"math/big"
+)
+
+// primeQuaternion returns a Lipschitz integer with quadrance equal to the
+// prime p. It starts from a Lipschitz integer with quadrance mp, for some
+// m < p, and repeatedly divides out m by Euler's descent.
+func primeQuaternion(p *big.Int) *Hamilton {
+	zero, one := big.NewInt(0), big.NewInt(1)
+	if p.Cmp(big.NewInt(2)) == 0 {
+		return new(Hamilton).Lipschitz(one, one, zero, zero)
+	}
+	// x² + y² + 1 ≡ 0 (mod p)
+	x, y := new(big.Int), new(big.Int)
+	t := new(big.Int)
+	for ; ; x.Add(x, one) {
+		t.Mul(x, x)
+		t.Add(t, one)
+		t.Neg(t)
+		t.Mod(t, p)
+		if big.Jacobi(t, p) >= 0 {
+			y.ModSqrt(t, p)
+			break
+		}
+	}
+	a := new(Hamilton).Lipschitz(x, y, one, zero)
+	m := new(big.Int).Quo(a.Quad().Num(), p)
+	for m.Cmp(one) > 0 {
+		v := lipschitz(a)
+		if m.Bit(0) == 0 {
+			// pair components of equal parity and halve
+			for i := 1; i < 4; i++ {
+				if v[i].Bit(0) == v[0].Bit(0) {
+					v[1], v[i] = v[i], v[1]
+					break
+				}
+			}
+			s := func(x, y *big.Int) *big.Int {
+				return new(big.Int).Rsh(new(big.Int).Add(x, y), 1)
+			}
+			d := func(x, y *big.Int) *big.Int {
+				return new(big.Int).Rsh(new(big.Int).Sub(x, y), 1)
+			}
+			a.Lipschitz(s(v[0], v[1]), d(v[0], v[1]), s(v[2], v[3]), d(v[2], v[3]))
+			m.Rsh(m, 1)
+			continue
+		}
+		// b ≡ a (mod m), with components in (-m/2, m/2]
+		half := new(big.Int).Rsh(m, 1)
+		for i := range v {
+			v[i].Mod(v[i], m)
+			if v[i].Cmp(half) > 0 {
+				v[i].Sub(v[i], m)
+			}
+		}
+		b := new(Hamilton).Lipschitz(v[0], v[1], v[2], v[3])
+		r := new(big.Int).Quo(b.Quad().Num(), m)
+		a.Mul(a, b.Conj(b))
+		a.Scal(a, new(big.Rat).SetFrac(one, m))
+		m = r
+	}
+	return a
+}
+
+// lipschitz returns the integer components of the Lipschitz integer z.
+func lipschitz(z *Hamilton) [4]*big.Int {
+	var v [4]*big.Int
+	for i, c := range rats(z.Rats()) {
+		v[i] = new(big.Int).Set(c.Num())
+	}
+	return v
+}
+
+// trialFactor returns the prime factors of the positive integer n, with
+// multiplicity and in increasing order, found by trial division.
+func trialFactor(n *big.Int) []*big.Int {
+	var f []*big.Int
+	m := new(big.Int).Set(n)
+	r := new(big.Int)
+	for p := big.NewInt(2); new(big.Int).Mul(p, p).Cmp(m) <= 0; p.Add(p, big.NewInt(1)) {
+		for {
+			q, _ := new(big.Int).QuoRem(m, p, r)
+			if r.Sign() != 0 {
+				break
+			}
+			f = append(f, new(big.Int).Set(p))
+			m = q
+		}
+	}
+	if m.Cmp(big.NewInt(1)) > 0 {
+		f = append(f, m)
+	}
+	return f
+}
+
+// FourSquares returns a Lipschitz integer a+bi+cj+dk with quadrance n, so that
+// 		n = a² + b² + c² + d²
+// The prime factors of n are found by trial division. Each prime is written as
+// the quadrance of a Lipschitz integer, and these are multiplied together:
+// since Quad is multiplicative, the product has quadrance n. If n is
+// negative, then FourSquares returns an error.
+func FourSquares(n *big.Int) (*Hamilton, error) {
+	if n.Sign() < 0 {
+		return nil, errors.New("rational: negative integer is not a sum of squares")
+	}
+	z := new(Hamilton)
+	if n.Sign() == 0 {
+		return z, nil
+	}
+	z.Real().SetInt64(1)
+	for _, p := range trialFactor(n) {
+		z.Mul(z, primeQuaternion(p))
+	}
+	return z, nil
+}
+
+// TwoSquares returns a Gaussian integer a+bi with quadrance n, so that
+// 		n = a² + b²
+// Each prime factor of n is the quadrance of a Gaussian prime, except for the
+// primes p ≡ 3 (mod 4), which must occur to an even power, and these are
+// multiplied together as in FourSquares. If n is negative or not a sum of two
+// squares, then TwoSquares returns an error.
+func TwoSquares(n *big.Int) (*Complex, error) {
+	if n.Sign() < 0 {
+		return nil, errors.New("rational: negative integer is not a sum of squares")
+	}
+	z := new(ComplexInt)
+	if n.Sign() == 0 {
+		return z.Rat(), nil
+	}
+	z.l.SetInt64(1)
+	f := trialFactor(n)
+	for i := 0; i < len(f); i++ {
+		p := f[i]
+		if p.Bit(0) == 1 && p.Bit(1) == 1 {
+			if i+1 == len(f) || f[i+1].Cmp(p) != 0 {
+				return nil, errors.New("rational: integer is not a sum of two squares")
+			}
+			i++
+		}
+		z.Mul(z, gaussianPrime(p))
+	}
+	return z.Rat(), nil
+}
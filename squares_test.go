@@ -0,0 +1,51 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestFourSquares(t *testing.T) {
+	for _, n := range []int64{0, 1, 2, 3, 7, 8, 15, 23, 60, 97, 128, 1001, 65537, 999983, 123456789} {
+		z, err := FourSquares(big.NewInt(n))
+		if err != nil {
+			t.Errorf("FourSquares(%d) returned error: %v", n, err)
+			continue
+		}
+		for _, c := range rats(z.Rats()) {
+			if !c.IsInt() {
+				t.Errorf("FourSquares(%d) = %v is not a Lipschitz integer", n, z)
+			}
+		}
+		if q := z.Quad(); q.Cmp(big.NewRat(n, 1)) != 0 {
+			t.Errorf("Quad(FourSquares(%d)) = %v", n, q)
+		}
+	}
+	if _, err := FourSquares(big.NewInt(-1)); err == nil {
+		t.Error("FourSquares(-1) succeeded")
+	}
+}
+
+func TestTwoSquares(t *testing.T) {
+	for _, n := range []int64{0, 1, 2, 5, 9, 45, 49, 65, 1000, 65537} {
+		z, err := TwoSquares(big.NewInt(n))
+		if err != nil {
+			t.Errorf("TwoSquares(%d) returned error: %v", n, err)
+			continue
+		}
+		if !z.l.IsInt() || !z.r.IsInt() {
+			t.Errorf("TwoSquares(%d) = %v is not a Gaussian integer", n, z)
+		}
+		if q := z.Quad(); q.Cmp(big.NewRat(n, 1)) != 0 {
+			t.Errorf("Quad(TwoSquares(%d)) = %v", n, q)
+		}
+	}
+	for _, n := range []int64{-1, 3, 7, 21, 63} {
+		if z, err := TwoSquares(big.NewInt(n)); err == nil {
+			t.Errorf("TwoSquares(%d) = %v", n, z)
+		}
+	}
+}
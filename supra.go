@@ -5,6 +5,7 @@ package rational
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"reflect"
@@ -18,16 +19,29 @@ type Supra struct {
 	l, r Infra
 }
 
-// Real returns the (rational) real part of z.
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
 func (z *Supra) Real() *big.Rat {
 	return (&z.l).Real()
 }
 
-// Rats returns the four rational components of z.
+// Rats returns the four rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
 func (z *Supra) Rats() (*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
 	return &z.l.l, &z.l.r, &z.r.l, &z.r.r
 }
 
+// RatsCopy returns copies of the four rational components of z.
+func (z *Supra) RatsCopy() (*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
+	return new(Supra).Set(z).Rats()
+}
+
+// Components returns the four rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *Supra) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
 // String returns the string representation of a Supra value.
 //
 // If z corresponds to a + bα + cβ + dγ, then the string is "(a+bα+cβ+dγ)",
@@ -37,7 +51,8 @@ func (z *Supra) String() string {
 	v[0], v[1] = z.l.Rats()
 	v[2], v[3] = z.r.Rats()
 	a := make([]string, 9)
-	a[0] = leftBracket
+	br := brackets()
+	a[0] = br[0]
 	a[1] = fmt.Sprintf("%v", v[0].RatString())
 	i := 1
 	for j := 2; j < 8; j = j + 2 {
@@ -49,7 +64,7 @@ func (z *Supra) String() string {
 		a[j+1] = symbSupra[i]
 		i++
 	}
-	a[8] = rightBracket
+	a[8] = br[1]
 	return strings.Join(a, "")
 }
 
@@ -61,6 +76,16 @@ func (z *Supra) Equals(y *Supra) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *Supra) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Infra))
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *Supra) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *Supra) Set(y *Supra) *Supra {
 	z.l.Set(&y.l)
@@ -121,12 +146,26 @@ func (z *Supra) Sub(x, y *Supra) *Supra {
 // 		Mul(β, γ) = Mul(γ, β) = 0
 // 		Mul(γ, α) = Mul(α, γ) = 0
 // This binary operation is noncommutative but associative.
-func (z *Supra) Mul(x, y *Supra) *Supra {
-	a := new(Infra).Set(&x.l)
-	b := new(Infra).Set(&x.r)
-	c := new(Infra).Set(&y.l)
-	d := new(Infra).Set(&y.r)
-	temp := new(Infra)
+func (z *Supra) Mul(x, y *Supra) (product *Supra) {
+	if h := currentHook(); h != nil {
+		x, y := new(Supra).Set(x), new(Supra).Set(y)
+		defer func() { traceMul(h, "Supra.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	a, b, c, d := &x.l, &x.r, &y.l, &y.r
+	if z == x || z == y {
+		// z.l is written before z.r is computed
+		a, b = infraArena.get().Set(a), infraArena.get().Set(b)
+		c, d = infraArena.get().Set(c), infraArena.get().Set(d)
+		defer infraArena.put(a, b, c, d)
+	}
+	temp := infraArena.get()
+	defer infraArena.put(temp)
 	z.l.Mul(a, c)
 	z.r.Add(
 		z.r.Mul(d, a),
@@ -159,11 +198,16 @@ func (z *Supra) IsZeroDivisor() bool {
 
 // Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
 // then Inv panics.
-func (z *Supra) Inv(y *Supra) *Supra {
+func (z *Supra) Inv(y *Supra) (inverse *Supra) {
+	if h := currentHook(); h != nil {
+		y := new(Supra).Set(y)
+		defer func() { traceInv(h, "Supra.Inv", inverse != nil, z, y) }()
+	}
 	if y.IsZeroDivisor() {
-		panic("inverse of zero divisor")
+		panic(divisorError("inverse of zero divisor"))
 	}
 	a := y.Quad()
+	defer putRat(a)
 	a.Inv(a)
 	return z.Scal(z.Conj(y), a)
 }
@@ -173,7 +217,7 @@ func (z *Supra) Inv(y *Supra) *Supra {
 // Then it returns z. If y is a zero divisor, then QuoL panics.
 func (z *Supra) QuoL(x, y *Supra) *Supra {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(z.Inv(y), x)
 }
@@ -183,7 +227,7 @@ func (z *Supra) QuoL(x, y *Supra) *Supra {
 // Then it returns z. If y is a zero divisor, then QuoR panics.
 func (z *Supra) QuoR(x, y *Supra) *Supra {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(x, z.Inv(y))
 }
@@ -251,17 +295,231 @@ func (z *Supra) Dot(y *Supra) *big.Rat {
 	return z.l.Dot(&y.l)
 }
 
+// Rescale sets z equal to the image of y under the automorphism that scales
+// the nilpotent generator with the given unit index by a, and returns z. The
+// valid units are 1 (α) and 2 (β); any other unit makes Rescale panic.
+func (z *Supra) Rescale(y *Supra, unit int, a *big.Rat) *Supra {
+	switch unit {
+	case 1:
+		z.l.Rescale(&y.l, 1, a)
+		z.r.Rescale(&y.r, 1, a)
+	case 2:
+		z.l.Set(&y.l)
+		z.r.Scal(&y.r, a)
+	default:
+		panic("invalid unit")
+	}
+	return z
+}
+
+// LeftMul returns the linear operator that sends y to Mul(z, y), acting on
+// the rational components of y.
+func (z *Supra) LeftMul() *Operator {
+	a := new(Supra).Set(z)
+	return &Operator{4, func(v []*big.Rat) []*big.Rat {
+		y := NewSupra(v[0], v[1], v[2], v[3])
+		return rats(y.Mul(a, y).Rats())
+	}}
+}
+
+// RightMul returns the linear operator that sends y to Mul(y, z), acting on
+// the rational components of y.
+func (z *Supra) RightMul() *Operator {
+	a := new(Supra).Set(z)
+	return &Operator{4, func(v []*big.Rat) []*big.Rat {
+		y := NewSupra(v[0], v[1], v[2], v[3])
+		return rats(y.Mul(y, a).Rats())
+	}}
+}
+
+// MinPoly returns the minimal polynomial of z. If z is real, then the
+// minimal polynomial is
+// 		t - Real(z)
+// Otherwise, it is
+// 		t² - 2Real(z)t + Quad(z)
+func (z *Supra) MinPoly() Laurent {
+	re := new(big.Rat).Set(z.Real())
+	pure := new(Supra).Set(z)
+	pure.Real().SetInt64(0)
+	if pure.Equals(new(Supra)) {
+		return Laurent{0: re.Neg(re), 1: big.NewRat(1, 1)}
+	}
+	return Laurent{
+		0: z.Quad(),
+		1: re.Mul(re, big.NewRat(-2, 1)),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// EvaluateMinPoly returns true if z satisfies its minimal polynomial.
+func (z *Supra) EvaluateMinPoly() bool {
+	return satisfies(z, z.MinPoly())
+}
+
+// PowViaMinPoly sets z equal to y raised to the power n, and returns z. High
+// powers are reduced with the minimal polynomial of y, so only O(log n)
+// rational multiplications are needed.
+func (z *Supra) PowViaMinPoly(y *Supra, n uint64) *Supra {
+	p, q := quadPow(y.MinPoly(), n)
+	z.Scal(y, p)
+	z.Real().Add(z.Real(), q)
+	return z
+}
+
+// WriteTo writes a canonical encoding of z to w, and returns the number of
+// bytes written. Equal values produce equal encodings, so any hash.Hash can be
+// used as w to hash z without building an intermediate byte slice.
+func (z *Supra) WriteTo(w io.Writer) (int64, error) {
+	return writeRats(w, rats(z.Rats()))
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// the rational parameter t, and returns z. The curve is evaluated exactly with
+// the De Casteljau algorithm. If p is empty, then Bezier panics.
+func (z *Supra) Bezier(t *big.Rat, p ...*Supra) *Supra {
+	if len(p) == 0 {
+		panic("no control points")
+	}
+	s := new(big.Rat).Sub(big.NewRat(1, 1), t)
+	b := make([]*Supra, len(p))
+	for i := range p {
+		b[i] = new(Supra).Set(p[i])
+	}
+	temp := new(Supra)
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			b[i].Add(b[i].Scal(b[i], s), temp.Scal(b[i+1], t))
+		}
+	}
+	return z.Set(b[0])
+}
+
+// Exp sets z equal to the exponential of y, and returns z. The exponential
+// series terminates because y is nilpotent. If y is not nilpotent, then Exp
+// panics.
+func (z *Supra) Exp(y *Supra) *Supra {
+	if !y.IsZeroDivisor() {
+		panic("exponential of non-nilpotent")
+	}
+	zero := new(Supra)
+	sum, term := new(Supra), new(Supra)
+	sum.Real().SetInt64(1)
+	term.Real().SetInt64(1)
+	for k := int64(1); ; k++ {
+		term.Mul(term, y)
+		if term.Equals(zero) {
+			break
+		}
+		term.Scal(term, big.NewRat(1, k))
+		sum.Add(sum, term)
+	}
+	return z.Set(sum)
+}
+
+// Log sets z equal to the logarithm of y, and returns z. This is the inverse
+// of Exp: the logarithmic series terminates because y - 1 is nilpotent. If
+// y - 1 is not nilpotent, then Log panics.
+func (z *Supra) Log(y *Supra) *Supra {
+	one := new(Supra)
+	one.Real().SetInt64(1)
+	n := new(Supra).Sub(y, one)
+	if !n.IsZeroDivisor() {
+		panic("logarithm of non-unipotent")
+	}
+	zero := new(Supra)
+	sum, term := new(Supra), new(Supra).Set(one)
+	for k := int64(1); ; k++ {
+		term.Mul(term, n)
+		if term.Equals(zero) {
+			break
+		}
+		c := big.NewRat(1, k)
+		if k%2 == 0 {
+			c.Neg(c)
+		}
+		sum.Add(sum, new(Supra).Scal(term, c))
+	}
+	return z.Set(sum)
+}
+
+// BCH sets z equal to the Baker–Campbell–Hausdorff combination of x and y,
+// truncated after the terms of degree three:
+// 		x + y + [x, y]/2 + ([x, [x, y]] + [y, [y, x]])/12
+// Then it returns z. For nilpotent x and y every omitted term vanishes, so
+// Exp(BCH(x, y)) = Mul(Exp(x), Exp(y)) exactly.
+func (z *Supra) BCH(x, y *Supra) *Supra {
+	c := new(Supra).Commutator(x, y)
+	t1 := new(Supra).Commutator(x, c)
+	t2 := new(Supra).Commutator(y, c)
+	t1.Sub(t1, t2)
+	t1.Scal(t1, big.NewRat(1, 12))
+	c.Scal(c, big.NewRat(1, 2))
+	z.Add(x, y)
+	z.Add(z, c)
+	return z.Add(z, t1)
+}
+
+// Round sets z equal to y with each component rounded to a multiple of 1/den
+// in the direction given by mode, and returns z. Directed modes round every
+// component the same way, so interval bounds stay rigorous. If den is not
+// positive, then Round panics.
+func (z *Supra) Round(y *Supra, den *big.Int, mode big.RoundingMode) *Supra {
+	z.Set(y)
+	for _, c := range rats(z.Rats()) {
+		roundRat(c, c, den, mode)
+	}
+	return z
+}
+
+// Act returns the left action of z on the pair (a, b) of Infra values, which
+// is identified with the Supra value a + bβ and multiplied on the left by z.
+// Supra is associative, so Act is a representation of Supra on pairs of Infra
+// values, and the pairs (0, b) are invariant, since β is nilpotent.
+func (z *Supra) Act(a, b *Infra) (*Infra, *Infra) {
+	v := new(Supra)
+	v.l.Set(a)
+	v.r.Set(b)
+	v.Mul(z, v)
+	return &v.l, &v.r
+}
+
+// SolveCrossRatioL sets z equal to the point y such that the left
+// cross-ratio of v, w, x, and y is c, and returns z. With
+// A = Inv(w - x) * (v - x) and B = Inv(A) * c, the point is
+// 		(v*B - w) * Inv(B - 1)
+// If the point is not uniquely determined, then SolveCrossRatioL panics.
+func (z *Supra) SolveCrossRatioL(v, w, x, c *Supra) *Supra {
+	one := new(Supra)
+	one.Real().SetInt64(1)
+	b := new(Supra).Sub(v, x)
+	b.Mul(new(Supra).Inv(new(Supra).Sub(w, x)), b)
+	b.Mul(b.Inv(b), c)
+	temp := new(Supra).Sub(b, one)
+	temp.Inv(temp)
+	z.Mul(v, b)
+	z.Sub(z, w)
+	return z.Mul(z, temp)
+}
+
+// SolveCrossRatioR sets z equal to the point y such that the right
+// cross-ratio of v, w, x, and y is c, and returns z. With
+// A = (v - x) * Inv(w - x) and B = Inv(A) * c, the point is
+// 		Inv(B - 1) * (B*v - w)
+// If the point is not uniquely determined, then SolveCrossRatioR panics.
+func (z *Supra) SolveCrossRatioR(v, w, x, c *Supra) *Supra {
+	one := new(Supra)
+	one.Real().SetInt64(1)
+	b := new(Supra).Sub(v, x)
+	b.Mul(b, new(Supra).Inv(new(Supra).Sub(w, x)))
+	b.Mul(b.Inv(b), c)
+	temp := new(Supra).Sub(b, one)
+	temp.Inv(temp)
+	z.Mul(b, v)
+	z.Sub(z, w)
+	return z.Mul(temp, z)
+}
+
 // Generate returns a random Supra value for quick.Check testing.
 func (z *Supra) Generate(rand *rand.Rand, size int) reflect.Value {
-	randomSupra := &Supra{
-		*NewInfra(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-		*NewInfra(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-	}
-	return reflect.ValueOf(randomSupra)
+	return reflect.ValueOf(new(Supra).Random(rand, generateOptions))
 }
@@ -343,3 +343,90 @@ func TestSupraComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Automorphism
+
+func TestSupraRescaleMulDistributive(t *testing.T) {
+	a := big.NewRat(3, 5)
+	f := func(x, y *Supra) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		for _, u := range []int{1, 2} {
+			l, r := new(Supra), new(Supra)
+			l.Rescale(l.Mul(x, y), u, a)
+			r.Mul(new(Supra).Rescale(x, u, a), r.Rescale(y, u, a))
+			if !l.Equals(r) {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Scalar operands
+
+func TestSupraMulReal(t *testing.T) {
+	f := func(x, y *Supra) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(big.Rat).Set(y.Real())
+		s := new(Supra)
+		s.Real().Set(a)
+		if !s.IsReal() || x.IsReal() {
+			return false
+		}
+		l, r := new(Supra), new(Supra)
+		l.Mul(s, x)
+		r.Mul(x, s)
+		// an independent product: s x = (s + x)x - x x, with no real factor
+		xx := new(Supra).Mul(x, x)
+		sx := new(Supra).Add(s, x)
+		return l.Equals(new(Supra).Sub(new(Supra).Mul(sx, x), xx)) &&
+			r.Equals(new(Supra).Sub(new(Supra).Mul(x, sx), xx))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSupraIsPure(t *testing.T) {
+	f := func(x *Supra) bool {
+		// t.Logf("x = %v", x)
+		p := new(Supra).Set(x)
+		p.Real().SetInt64(0)
+		return p.IsPure() && !x.IsPure()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Exponential
+
+func TestSupraLogExp(t *testing.T) {
+	f := func(x *Supra) bool {
+		// t.Logf("x = %v", x)
+		x.Real().SetInt64(0)
+		l := new(Supra).Exp(x)
+		l.Log(l)
+		return l.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSupraExpBCH(t *testing.T) {
+	f := func(x, y *Supra) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		x.Real().SetInt64(0)
+		y.Real().SetInt64(0)
+		l := new(Supra).Exp(new(Supra).BCH(x, y))
+		r := new(Supra).Mul(new(Supra).Exp(x), new(Supra).Exp(y))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
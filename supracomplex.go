@@ -5,6 +5,7 @@ package rational
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"reflect"
@@ -18,18 +19,32 @@ type SupraComplex struct {
 	l, r InfraComplex
 }
 
-// Real returns the (rational) real part of z.
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
 func (z *SupraComplex) Real() *big.Rat {
 	return (&z.l).Real()
 }
 
-// Rats returns the eight rational components of z.
+// Rats returns the eight rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
 func (z *SupraComplex) Rats() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
 	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
 	return &z.l.l.l, &z.l.l.r, &z.l.r.l, &z.l.r.r,
 		&z.r.l.l, &z.r.l.r, &z.r.r.l, &z.r.r.r
 }
 
+// RatsCopy returns copies of the eight rational components of z.
+func (z *SupraComplex) RatsCopy() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
+	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
+	return new(SupraComplex).Set(z).Rats()
+}
+
+// Components returns the eight rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *SupraComplex) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
 // String returns the string representation of a SupraComplex value.
 //
 // If z corresponds to a + bi + cα + dβ + eγ + fδ + gε + hζ, then the string
@@ -39,7 +54,8 @@ func (z *SupraComplex) String() string {
 	v[0], v[1], v[2], v[3] = z.l.Rats()
 	v[4], v[5], v[6], v[7] = z.r.Rats()
 	a := make([]string, 17)
-	a[0] = leftBracket
+	br := brackets()
+	a[0] = br[0]
 	a[1] = fmt.Sprintf("%v", v[0].RatString())
 	i := 1
 	for j := 2; j < 16; j = j + 2 {
@@ -51,7 +67,7 @@ func (z *SupraComplex) String() string {
 		a[j+1] = symbSupraComplex[i]
 		i++
 	}
-	a[16] = rightBracket
+	a[16] = br[1]
 	return strings.Join(a, "")
 }
 
@@ -63,6 +79,16 @@ func (z *SupraComplex) Equals(y *SupraComplex) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *SupraComplex) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(InfraComplex))
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *SupraComplex) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *SupraComplex) Set(y *SupraComplex) *SupraComplex {
 	z.l.Set(&y.l)
@@ -148,12 +174,26 @@ func (z *SupraComplex) Sub(x, y *SupraComplex) *SupraComplex {
 // 		Mul(δ, ζ) = Mul(ζ, δ) = 0
 // 		Mul(ε, ζ) = Mul(ζ, ε) = 0
 // This binary operation is noncommutative and nonassociative.
-func (z *SupraComplex) Mul(x, y *SupraComplex) *SupraComplex {
-	a := new(InfraComplex).Set(&x.l)
-	b := new(InfraComplex).Set(&x.r)
-	c := new(InfraComplex).Set(&y.l)
-	d := new(InfraComplex).Set(&y.r)
-	temp := new(InfraComplex)
+func (z *SupraComplex) Mul(x, y *SupraComplex) (product *SupraComplex) {
+	if h := currentHook(); h != nil {
+		x, y := new(SupraComplex).Set(x), new(SupraComplex).Set(y)
+		defer func() { traceMul(h, "SupraComplex.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	a, b, c, d := &x.l, &x.r, &y.l, &y.r
+	if z == x || z == y {
+		// z.l is written before z.r is computed
+		a, b = infraComplexArena.get().Set(a), infraComplexArena.get().Set(b)
+		c, d = infraComplexArena.get().Set(c), infraComplexArena.get().Set(d)
+		defer infraComplexArena.put(a, b, c, d)
+	}
+	temp := infraComplexArena.get()
+	defer infraComplexArena.put(temp)
 	z.l.Mul(a, c)
 	z.r.Add(
 		z.r.Mul(d, a),
@@ -194,17 +234,21 @@ func (z *SupraComplex) Quad() *big.Rat {
 // IsZeroDivisor returns true if z is a zero divisor. This is equivalent to z being
 // nilpotent.
 func (z *SupraComplex) IsZeroDivisor() bool {
-	zero := new(InfraComplex)
-	return z.l.Equals(zero)
+	return z.l.IsZeroDivisor()
 }
 
 // Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
 // then Inv panics.
-func (z *SupraComplex) Inv(y *SupraComplex) *SupraComplex {
+func (z *SupraComplex) Inv(y *SupraComplex) (inverse *SupraComplex) {
+	if h := currentHook(); h != nil {
+		y := new(SupraComplex).Set(y)
+		defer func() { traceInv(h, "SupraComplex.Inv", inverse != nil, z, y) }()
+	}
 	if y.IsZeroDivisor() {
-		panic("inverse of zero divisor")
+		panic(divisorError("inverse of zero divisor"))
 	}
 	a := y.Quad()
+	defer putRat(a)
 	a.Inv(a)
 	return z.Scal(z.Conj(y), a)
 }
@@ -214,7 +258,7 @@ func (z *SupraComplex) Inv(y *SupraComplex) *SupraComplex {
 // Then it returns z. If y is a zero divisor, then QuoL panics.
 func (z *SupraComplex) QuoL(x, y *SupraComplex) *SupraComplex {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(z.Inv(y), x)
 }
@@ -224,26 +268,119 @@ func (z *SupraComplex) QuoL(x, y *SupraComplex) *SupraComplex {
 // Then it returns z. If y is a zero divisor, then QuoR panics.
 func (z *SupraComplex) QuoR(x, y *SupraComplex) *SupraComplex {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(x, z.Inv(y))
 }
 
+// LeftMul returns the linear operator that sends y to Mul(z, y), acting on
+// the rational components of y.
+func (z *SupraComplex) LeftMul() *Operator {
+	a := new(SupraComplex).Set(z)
+	return &Operator{8, func(v []*big.Rat) []*big.Rat {
+		y := NewSupraComplex(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+		return rats(y.Mul(a, y).Rats())
+	}}
+}
+
+// RightMul returns the linear operator that sends y to Mul(y, z), acting on
+// the rational components of y.
+func (z *SupraComplex) RightMul() *Operator {
+	a := new(SupraComplex).Set(z)
+	return &Operator{8, func(v []*big.Rat) []*big.Rat {
+		y := NewSupraComplex(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+		return rats(y.Mul(y, a).Rats())
+	}}
+}
+
+// MinPoly returns the minimal polynomial of z. If z is real, then the
+// minimal polynomial is
+// 		t - Real(z)
+// Otherwise, it is
+// 		t² - 2Real(z)t + Quad(z)
+func (z *SupraComplex) MinPoly() Laurent {
+	re := new(big.Rat).Set(z.Real())
+	pure := new(SupraComplex).Set(z)
+	pure.Real().SetInt64(0)
+	if pure.Equals(new(SupraComplex)) {
+		return Laurent{0: re.Neg(re), 1: big.NewRat(1, 1)}
+	}
+	return Laurent{
+		0: z.Quad(),
+		1: re.Mul(re, big.NewRat(-2, 1)),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// EvaluateMinPoly returns true if z satisfies its minimal polynomial.
+func (z *SupraComplex) EvaluateMinPoly() bool {
+	return satisfies(z, z.MinPoly())
+}
+
+// PowViaMinPoly sets z equal to y raised to the power n, and returns z. High
+// powers are reduced with the minimal polynomial of y, so only O(log n)
+// rational multiplications are needed.
+func (z *SupraComplex) PowViaMinPoly(y *SupraComplex, n uint64) *SupraComplex {
+	p, q := quadPow(y.MinPoly(), n)
+	z.Scal(y, p)
+	z.Real().Add(z.Real(), q)
+	return z
+}
+
+// WriteTo writes a canonical encoding of z to w, and returns the number of
+// bytes written. Equal values produce equal encodings, so any hash.Hash can be
+// used as w to hash z without building an intermediate byte slice.
+func (z *SupraComplex) WriteTo(w io.Writer) (int64, error) {
+	return writeRats(w, rats(z.Rats()))
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// the rational parameter t, and returns z. The curve is evaluated exactly with
+// the De Casteljau algorithm. If p is empty, then Bezier panics.
+func (z *SupraComplex) Bezier(t *big.Rat, p ...*SupraComplex) *SupraComplex {
+	if len(p) == 0 {
+		panic("no control points")
+	}
+	s := new(big.Rat).Sub(big.NewRat(1, 1), t)
+	b := make([]*SupraComplex, len(p))
+	for i := range p {
+		b[i] = new(SupraComplex).Set(p[i])
+	}
+	temp := new(SupraComplex)
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			b[i].Add(b[i].Scal(b[i], s), temp.Scal(b[i+1], t))
+		}
+	}
+	return z.Set(b[0])
+}
+
+// Round sets z equal to y with each component rounded to a multiple of 1/den
+// in the direction given by mode, and returns z. Directed modes round every
+// component the same way, so interval bounds stay rigorous. If den is not
+// positive, then Round panics.
+func (z *SupraComplex) Round(y *SupraComplex, den *big.Int, mode big.RoundingMode) *SupraComplex {
+	z.Set(y)
+	for _, c := range rats(z.Rats()) {
+		roundRat(c, c, den, mode)
+	}
+	return z
+}
+
+// Act returns the left action of z on the pair (a, b) of InfraComplex values,
+// which is identified with the SupraComplex value a + bβ and multiplied on the
+// left by z. SupraComplex is not associative, so acting by Mul(x, y) is not in
+// general acting by y and then by x, and Act is not a representation of
+// SupraComplex. Being alternative, it does act by x twice as by Mul(x, x).
+func (z *SupraComplex) Act(a, b *InfraComplex) (*InfraComplex, *InfraComplex) {
+	v := new(SupraComplex)
+	v.l.Set(a)
+	v.r.Set(b)
+	v.Mul(z, v)
+	return &v.l, &v.r
+}
+
 // Generate returns a random SupraComplex value for quick.Check testing.
 func (z *SupraComplex) Generate(rand *rand.Rand, size int) reflect.Value {
-	randomSupraComplex := &SupraComplex{
-		*NewInfraComplex(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-		*NewInfraComplex(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-	}
-	return reflect.ValueOf(randomSupraComplex)
+	return reflect.ValueOf(new(SupraComplex).Random(rand, generateOptions))
 }
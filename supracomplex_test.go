@@ -332,6 +332,22 @@ func TestSupraComplexQuadPositive(t *testing.T) {
 	}
 }
 
+// Zero divisors
+
+func TestSupraComplexIsZeroDivisorNilpotent(t *testing.T) {
+	// the first half is nilpotent but not zero
+	x := NewSupraComplex(
+		big.NewRat(0, 1), big.NewRat(0, 1), big.NewRat(2, 1), big.NewRat(3, 1),
+		big.NewRat(4, 1), big.NewRat(5, 1), big.NewRat(6, 1), big.NewRat(7, 1),
+	)
+	if !x.IsZeroDivisor() {
+		t.Errorf("IsZeroDivisor(%v) = false", x)
+	}
+	if q := x.Quad(); q.Sign() != 0 {
+		t.Errorf("Quad(%v) = %v", x, q)
+	}
+}
+
 // Left-alternativity
 
 func TestSupraComplexLeftAlternative(t *testing.T) {
@@ -378,3 +394,40 @@ func TestSupraComplexComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Scalar operands
+
+func TestSupraComplexMulReal(t *testing.T) {
+	f := func(x, y *SupraComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(big.Rat).Set(y.Real())
+		s := new(SupraComplex)
+		s.Real().Set(a)
+		if !s.IsReal() || x.IsReal() {
+			return false
+		}
+		l, r := new(SupraComplex), new(SupraComplex)
+		l.Mul(s, x)
+		r.Mul(x, s)
+		// an independent product: s x = (s + x)x - x x, with no real factor
+		xx := new(SupraComplex).Mul(x, x)
+		sx := new(SupraComplex).Add(s, x)
+		return l.Equals(new(SupraComplex).Sub(new(SupraComplex).Mul(sx, x), xx)) &&
+			r.Equals(new(SupraComplex).Sub(new(SupraComplex).Mul(x, sx), xx))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSupraComplexIsPure(t *testing.T) {
+	f := func(x *SupraComplex) bool {
+		// t.Logf("x = %v", x)
+		p := new(SupraComplex).Set(x)
+		p.Real().SetInt64(0)
+		return p.IsPure() && !x.IsPure()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
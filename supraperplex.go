@@ -5,6 +5,7 @@ package rational
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"reflect"
@@ -18,18 +19,32 @@ type SupraPerplex struct {
 	l, r InfraPerplex
 }
 
-// Real returns the (rational) real part of z.
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
 func (z *SupraPerplex) Real() *big.Rat {
 	return (&z.l).Real()
 }
 
-// Rats returns the eight rational components of z.
+// Rats returns the eight rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
 func (z *SupraPerplex) Rats() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
 	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
 	return &z.l.l.l, &z.l.l.r, &z.l.r.l, &z.l.r.r,
 		&z.r.l.l, &z.r.l.r, &z.r.r.l, &z.r.r.r
 }
 
+// RatsCopy returns copies of the eight rational components of z.
+func (z *SupraPerplex) RatsCopy() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
+	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
+	return new(SupraPerplex).Set(z).Rats()
+}
+
+// Components returns the eight rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *SupraPerplex) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
 // String returns the string representation of an SupraPerplex value.
 //
 // If z corresponds to a + bs + cρ + dσ + eτ + fυ + gφ + hψ, then the string
@@ -39,7 +54,8 @@ func (z *SupraPerplex) String() string {
 	v[0], v[1], v[2], v[3] = z.l.Rats()
 	v[4], v[5], v[6], v[7] = z.r.Rats()
 	a := make([]string, 17)
-	a[0] = leftBracket
+	br := brackets()
+	a[0] = br[0]
 	a[1] = fmt.Sprintf("%v", v[0].RatString())
 	i := 1
 	for j := 2; j < 16; j = j + 2 {
@@ -51,7 +67,7 @@ func (z *SupraPerplex) String() string {
 		a[j+1] = symbSupraPerplex[i]
 		i++
 	}
-	a[16] = rightBracket
+	a[16] = br[1]
 	return strings.Join(a, "")
 }
 
@@ -63,6 +79,16 @@ func (z *SupraPerplex) Equals(y *SupraPerplex) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *SupraPerplex) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(InfraPerplex))
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *SupraPerplex) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *SupraPerplex) Set(y *SupraPerplex) *SupraPerplex {
 	z.l.Set(&y.l)
@@ -148,12 +174,26 @@ func (z *SupraPerplex) Sub(x, y *SupraPerplex) *SupraPerplex {
 // 		Mul(υ, ψ) = Mul(ψ, υ) = 0
 // 		Mul(φ, ψ) = Mul(ψ, φ) = 0
 // This binary operation is noncommutative and nonassociative.
-func (z *SupraPerplex) Mul(x, y *SupraPerplex) *SupraPerplex {
-	a := new(InfraPerplex).Set(&x.l)
-	b := new(InfraPerplex).Set(&x.r)
-	c := new(InfraPerplex).Set(&y.l)
-	d := new(InfraPerplex).Set(&y.r)
-	temp := new(InfraPerplex)
+func (z *SupraPerplex) Mul(x, y *SupraPerplex) (product *SupraPerplex) {
+	if h := currentHook(); h != nil {
+		x, y := new(SupraPerplex).Set(x), new(SupraPerplex).Set(y)
+		defer func() { traceMul(h, "SupraPerplex.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	a, b, c, d := &x.l, &x.r, &y.l, &y.r
+	if z == x || z == y {
+		// z.l is written before z.r is computed
+		a, b = infraPerplexArena.get().Set(a), infraPerplexArena.get().Set(b)
+		c, d = infraPerplexArena.get().Set(c), infraPerplexArena.get().Set(d)
+		defer infraPerplexArena.put(a, b, c, d)
+	}
+	temp := infraPerplexArena.get()
+	defer infraPerplexArena.put(temp)
 	z.l.Mul(a, c)
 	z.r.Add(
 		z.r.Mul(d, a),
@@ -199,11 +239,16 @@ func (z *SupraPerplex) IsZeroDivisor() bool {
 
 // Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
 // then Inv panics.
-func (z *SupraPerplex) Inv(y *SupraPerplex) *SupraPerplex {
+func (z *SupraPerplex) Inv(y *SupraPerplex) (inverse *SupraPerplex) {
+	if h := currentHook(); h != nil {
+		y := new(SupraPerplex).Set(y)
+		defer func() { traceInv(h, "SupraPerplex.Inv", inverse != nil, z, y) }()
+	}
 	if y.IsZeroDivisor() {
-		panic("inverse of zero divisor")
+		panic(divisorError("inverse of zero divisor"))
 	}
 	a := y.Quad()
+	defer putRat(a)
 	a.Inv(a)
 	return z.Scal(z.Conj(y), a)
 }
@@ -213,7 +258,7 @@ func (z *SupraPerplex) Inv(y *SupraPerplex) *SupraPerplex {
 // Then it returns z. If y is a zero divisor, then QuoL panics.
 func (z *SupraPerplex) QuoL(x, y *SupraPerplex) *SupraPerplex {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(z.Inv(y), x)
 }
@@ -223,26 +268,119 @@ func (z *SupraPerplex) QuoL(x, y *SupraPerplex) *SupraPerplex {
 // Then it returns z. If y is a zero divisor, then QuoR panics.
 func (z *SupraPerplex) QuoR(x, y *SupraPerplex) *SupraPerplex {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(x, z.Inv(y))
 }
 
+// LeftMul returns the linear operator that sends y to Mul(z, y), acting on
+// the rational components of y.
+func (z *SupraPerplex) LeftMul() *Operator {
+	a := new(SupraPerplex).Set(z)
+	return &Operator{8, func(v []*big.Rat) []*big.Rat {
+		y := NewSupraPerplex(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+		return rats(y.Mul(a, y).Rats())
+	}}
+}
+
+// RightMul returns the linear operator that sends y to Mul(y, z), acting on
+// the rational components of y.
+func (z *SupraPerplex) RightMul() *Operator {
+	a := new(SupraPerplex).Set(z)
+	return &Operator{8, func(v []*big.Rat) []*big.Rat {
+		y := NewSupraPerplex(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+		return rats(y.Mul(y, a).Rats())
+	}}
+}
+
+// MinPoly returns the minimal polynomial of z. If z is real, then the
+// minimal polynomial is
+// 		t - Real(z)
+// Otherwise, it is
+// 		t² - 2Real(z)t + Quad(z)
+func (z *SupraPerplex) MinPoly() Laurent {
+	re := new(big.Rat).Set(z.Real())
+	pure := new(SupraPerplex).Set(z)
+	pure.Real().SetInt64(0)
+	if pure.Equals(new(SupraPerplex)) {
+		return Laurent{0: re.Neg(re), 1: big.NewRat(1, 1)}
+	}
+	return Laurent{
+		0: z.Quad(),
+		1: re.Mul(re, big.NewRat(-2, 1)),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// EvaluateMinPoly returns true if z satisfies its minimal polynomial.
+func (z *SupraPerplex) EvaluateMinPoly() bool {
+	return satisfies(z, z.MinPoly())
+}
+
+// PowViaMinPoly sets z equal to y raised to the power n, and returns z. High
+// powers are reduced with the minimal polynomial of y, so only O(log n)
+// rational multiplications are needed.
+func (z *SupraPerplex) PowViaMinPoly(y *SupraPerplex, n uint64) *SupraPerplex {
+	p, q := quadPow(y.MinPoly(), n)
+	z.Scal(y, p)
+	z.Real().Add(z.Real(), q)
+	return z
+}
+
+// WriteTo writes a canonical encoding of z to w, and returns the number of
+// bytes written. Equal values produce equal encodings, so any hash.Hash can be
+// used as w to hash z without building an intermediate byte slice.
+func (z *SupraPerplex) WriteTo(w io.Writer) (int64, error) {
+	return writeRats(w, rats(z.Rats()))
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// the rational parameter t, and returns z. The curve is evaluated exactly with
+// the De Casteljau algorithm. If p is empty, then Bezier panics.
+func (z *SupraPerplex) Bezier(t *big.Rat, p ...*SupraPerplex) *SupraPerplex {
+	if len(p) == 0 {
+		panic("no control points")
+	}
+	s := new(big.Rat).Sub(big.NewRat(1, 1), t)
+	b := make([]*SupraPerplex, len(p))
+	for i := range p {
+		b[i] = new(SupraPerplex).Set(p[i])
+	}
+	temp := new(SupraPerplex)
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			b[i].Add(b[i].Scal(b[i], s), temp.Scal(b[i+1], t))
+		}
+	}
+	return z.Set(b[0])
+}
+
+// Round sets z equal to y with each component rounded to a multiple of 1/den
+// in the direction given by mode, and returns z. Directed modes round every
+// component the same way, so interval bounds stay rigorous. If den is not
+// positive, then Round panics.
+func (z *SupraPerplex) Round(y *SupraPerplex, den *big.Int, mode big.RoundingMode) *SupraPerplex {
+	z.Set(y)
+	for _, c := range rats(z.Rats()) {
+		roundRat(c, c, den, mode)
+	}
+	return z
+}
+
+// Act returns the left action of z on the pair (a, b) of InfraPerplex values,
+// which is identified with the SupraPerplex value a + bσ and multiplied on the
+// left by z. SupraPerplex is not associative, so Act is not a representation
+// of SupraPerplex, though by alternativity acting by x twice is acting by
+// Mul(x, x).
+func (z *SupraPerplex) Act(a, b *InfraPerplex) (*InfraPerplex, *InfraPerplex) {
+	v := new(SupraPerplex)
+	v.l.Set(a)
+	v.r.Set(b)
+	v.Mul(z, v)
+	return &v.l, &v.r
+}
+
 // Generate returns a random SupraPerplex value for quick.Check testing.
 func (z *SupraPerplex) Generate(rand *rand.Rand, size int) reflect.Value {
-	randomSupraPerplex := &SupraPerplex{
-		*NewInfraPerplex(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-		*NewInfraPerplex(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-	}
-	return reflect.ValueOf(randomSupraPerplex)
+	return reflect.ValueOf(new(SupraPerplex).Random(rand, generateOptions))
 }
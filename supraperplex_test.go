@@ -366,3 +366,40 @@ func TestSupraPerplexComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Scalar operands
+
+func TestSupraPerplexMulReal(t *testing.T) {
+	f := func(x, y *SupraPerplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(big.Rat).Set(y.Real())
+		s := new(SupraPerplex)
+		s.Real().Set(a)
+		if !s.IsReal() || x.IsReal() {
+			return false
+		}
+		l, r := new(SupraPerplex), new(SupraPerplex)
+		l.Mul(s, x)
+		r.Mul(x, s)
+		// an independent product: s x = (s + x)x - x x, with no real factor
+		xx := new(SupraPerplex).Mul(x, x)
+		sx := new(SupraPerplex).Add(s, x)
+		return l.Equals(new(SupraPerplex).Sub(new(SupraPerplex).Mul(sx, x), xx)) &&
+			r.Equals(new(SupraPerplex).Sub(new(SupraPerplex).Mul(x, sx), xx))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSupraPerplexIsPure(t *testing.T) {
+	f := func(x *SupraPerplex) bool {
+		// t.Logf("x = %v", x)
+		p := new(SupraPerplex).Set(x)
+		p.Real().SetInt64(0)
+		return p.IsPure() && !x.IsPure()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
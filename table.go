@@ -0,0 +1,229 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"strings"
+)
+
+// A TableReport describes the multiplication table of an algebra, as found
+// by multiplying every pair of basis units.
+type TableReport struct {
+	Name  string
+	Basis []string
+	// Table holds the products of the basis units written in the basis, so
+	// that Table[1][2] = "k" for Hamilton.
+	Table [][]string
+	// Problems lists the inconsistencies found, such as a Mul that disagrees
+	// with its own table. A consistent table has no problems.
+	Problems []string
+
+	Commutative bool
+	Associative bool
+	// Alternative is true if x(xy) = (xx)y and (yx)x = y(xx) for all x and
+	// y, which holds in every associative algebra and in Cayley.
+	Alternative bool
+	// ConjReverses is true if Conj(xy) = Conj(y)Conj(x), and ConjPreserves
+	// is true if Conj(xy) = Conj(x)Conj(y). Both are false if the algebra has
+	// no Conj operation.
+	ConjReverses  bool
+	ConjPreserves bool
+}
+
+// String returns the multiplication table of r as aligned text, followed by
+// its problems, one per line.
+func (r *TableReport) String() string {
+	n := len(r.Basis)
+	width := 0
+	for i := range r.Basis {
+		width = max(width, len([]rune(r.Basis[i])))
+		for j := range r.Table[i] {
+			width = max(width, len([]rune(r.Table[i][j])))
+		}
+	}
+	pad := func(s string) string {
+		return strings.Repeat(" ", width-len([]rune(s))) + s
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n%s", r.Name, pad(""))
+	for j := 0; j < n; j++ {
+		b.WriteString(" " + pad(r.Basis[j]))
+	}
+	for i := 0; i < n; i++ {
+		b.WriteString("\n" + pad(r.Basis[i]))
+		for j := 0; j < n; j++ {
+			b.WriteString(" " + pad(r.Table[i][j]))
+		}
+	}
+	for _, p := range r.Problems {
+		b.WriteString("\n" + p)
+	}
+	return b.String()
+}
+
+// formatBasis returns v written in the basis, as in "-k" or "1/2+i".
+func formatBasis(v []*big.Rat, basis []string) string {
+	var b strings.Builder
+	for k, c := range v {
+		if c.Sign() == 0 {
+			continue
+		}
+		switch {
+		case c.Sign() > 0 && b.Len() > 0:
+			b.WriteString("+")
+		case c.Sign() < 0:
+			b.WriteString("-")
+		}
+		a := new(big.Rat).Abs(c)
+		if basis[k] == "1" || a.Cmp(big.NewRat(1, 1)) != 0 {
+			b.WriteString(a.RatString())
+		}
+		if basis[k] != "1" {
+			b.WriteString(basis[k])
+		}
+	}
+	if b.Len() == 0 {
+		return "0"
+	}
+	return b.String()
+}
+
+// CheckStructureConstants multiplies every pair of basis units of impl, whose
+// "Mul" operation is required and whose "Conj" operation is optional, and
+// returns a report of its table. The basis symbols name the units in the
+// report, starting with "1". Besides the algebraic properties, it checks
+// that:
+// 	- the first basis unit is a two-sided identity;
+// 	- Mul is bilinear, agreeing with the table on random values;
+// 	- Conj, if present, is an involution that fixes the identity.
+// It is meant for both the tests of this package and for users extending it
+// with new algebras, whose Impl can be checked before use. If impl has no
+// "Mul" operation, or if the number of basis symbols differs from its
+// dimension, then CheckStructureConstants panics.
+func CheckStructureConstants(impl *Impl, basis []string) *TableReport {
+	n := impl.Dim
+	mul, ok := impl.Ops["Mul"]
+	if !ok {
+		panic("no Mul operation")
+	}
+	if len(basis) != n {
+		panic("wrong number of basis symbols")
+	}
+	conj, hasConj := impl.Ops["Conj"]
+	r := &TableReport{
+		Name:          impl.Name,
+		Basis:         basis,
+		Table:         make([][]string, n),
+		Commutative:   true,
+		Associative:   true,
+		Alternative:   true,
+		ConjReverses:  hasConj,
+		ConjPreserves: hasConj,
+	}
+	problem := func(format string, a ...interface{}) {
+		r.Problems = append(r.Problems, fmt.Sprintf(format, a...))
+	}
+	prod := make([][][]*big.Rat, n)
+	for i := 0; i < n; i++ {
+		prod[i] = make([][]*big.Rat, n)
+		r.Table[i] = make([]string, n)
+		for j := 0; j < n; j++ {
+			prod[i][j] = mul(unit(n, i), unit(n, j))
+			r.Table[i][j] = formatBasis(prod[i][j], basis)
+		}
+	}
+	for i := 0; i < n; i++ {
+		if !equalRats(prod[0][i], unit(n, i)) || !equalRats(prod[i][0], unit(n, i)) {
+			problem("%s is not a two-sided identity for %s: %s, %s",
+				basis[0], basis[i], r.Table[0][i], r.Table[i][0])
+		}
+	}
+	// products of basis units extend to all values by bilinearity
+	table := func(x, y []*big.Rat) []*big.Rat {
+		v := make([]*big.Rat, n)
+		for k := range v {
+			v[k] = new(big.Rat)
+		}
+		temp := new(big.Rat)
+		for i := range x {
+			for j := range y {
+				for k, c := range prod[i][j] {
+					v[k].Add(v[k], temp.Mul(temp.Mul(x[i], y[j]), c))
+				}
+			}
+		}
+		return v
+	}
+	rnd := rand.New(rand.NewSource(1))
+	random := func() []*big.Rat {
+		v := make([]*big.Rat, n)
+		for k := range v {
+			v[k] = big.NewRat(rnd.Int63n(19)-9, rnd.Int63n(4)+1)
+		}
+		return v
+	}
+	for t := 0; t < 8; t++ {
+		x, y := random(), random()
+		if got, want := mul(x, y), table(x, y); !equalRats(got, want) {
+			problem("Mul(%s, %s) = %s, but the table gives %s", formatBasis(x, basis),
+				formatBasis(y, basis), formatBasis(got, basis), formatBasis(want, basis))
+			break
+		}
+	}
+	for i := 0; i < n; i++ {
+		x := unit(n, i)
+		if hasConj && !equalRats(conj(conj(x, nil), nil), x) {
+			problem("Conj is not an involution on %s", basis[i])
+		}
+		for j := 0; j < n; j++ {
+			y := unit(n, j)
+			if !equalRats(prod[i][j], prod[j][i]) {
+				r.Commutative = false
+			}
+			xx := prod[i][i]
+			if !equalRats(mul(x, prod[i][j]), mul(xx, y)) ||
+				!equalRats(mul(prod[j][i], x), mul(y, xx)) {
+				r.Alternative = false
+			}
+			if hasConj {
+				c := conj(prod[i][j], nil)
+				if !equalRats(c, mul(conj(y, nil), conj(x, nil))) {
+					r.ConjReverses = false
+				}
+				if !equalRats(c, mul(conj(x, nil), conj(y, nil))) {
+					r.ConjPreserves = false
+				}
+			}
+			for k := 0; k < n && r.Associative; k++ {
+				r.Associative = equalRats(mul(prod[i][j], unit(n, k)), mul(x, prod[j][k]))
+			}
+		}
+	}
+	if hasConj && !equalRats(conj(unit(n, 0), nil), unit(n, 0)) {
+		problem("Conj does not fix %s", basis[0])
+	}
+	// alternativity on units does not imply it on sums, so check those too
+	for t := 0; t < 4 && r.Alternative; t++ {
+		x, y := random(), random()
+		xx := mul(x, x)
+		r.Alternative = equalRats(mul(x, mul(x, y)), mul(xx, y)) &&
+			equalRats(mul(mul(y, x), x), mul(y, xx))
+	}
+	return r
+}
+
+// VerifyTable returns the report of CheckStructureConstants for the type of
+// this package with the given name, such as "BiCockle". The second result
+// is false if there is no such type.
+func VerifyTable(name string) (*TableReport, bool) {
+	for _, a := range algebras {
+		if impl := a.impl(); impl.Name == name {
+			return CheckStructureConstants(impl, a.basis), true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestVerifyTable(t *testing.T) {
+	for _, s := range Schemas() {
+		r, ok := VerifyTable(s.Name)
+		if !ok {
+			t.Errorf("VerifyTable(%q) found no type", s.Name)
+			continue
+		}
+		if len(r.Problems) > 0 {
+			t.Errorf("VerifyTable(%q):\n%v", s.Name, r)
+		}
+		if r.Commutative != s.Commutative || r.Associative != s.Associative {
+			t.Errorf("VerifyTable(%q) disagrees with SchemaOf", s.Name)
+		}
+	}
+	if _, ok := VerifyTable("Octonion"); ok {
+		t.Error("VerifyTable(\"Octonion\") succeeded")
+	}
+}
+
+func TestVerifyTableProperties(t *testing.T) {
+	for _, test := range []struct {
+		name                  string
+		assoc, alt, rev, pres bool
+	}{
+		{"Complex", true, true, true, true},
+		{"Hamilton", true, true, true, false},
+		{"Cayley", false, true, true, false},
+		{"Zorn", false, true, true, false},
+		{"BiComplex", true, true, true, true},
+	} {
+		r, _ := VerifyTable(test.name)
+		if r.Associative != test.assoc || r.Alternative != test.alt ||
+			r.ConjReverses != test.rev || r.ConjPreserves != test.pres {
+			t.Errorf("VerifyTable(%q) = %+v", test.name, r)
+		}
+	}
+	r, _ := VerifyTable("Hamilton")
+	if r.Table[1][2] != "k" || r.Table[2][1] != "-k" || r.Table[1][1] != "-1" {
+		t.Errorf("Hamilton table:\n%v", r)
+	}
+	if !strings.Contains(r.String(), " 1  i  j  k") {
+		t.Errorf("String =\n%v", r)
+	}
+}
+
+func TestCheckStructureConstantsProblems(t *testing.T) {
+	impl := ComplexImpl()
+	mul := impl.Ops["Mul"]
+	// a Mul that is not bilinear
+	impl.Ops["Mul"] = func(x, y []*big.Rat) []*big.Rat {
+		v := mul(x, y)
+		if x[0].Sign() > 0 && x[1].Sign() != 0 {
+			v[1].Add(v[1], big.NewRat(1, 1))
+		}
+		return v
+	}
+	// a Conj that is not an involution
+	impl.Ops["Conj"] = func(x, _ []*big.Rat) []*big.Rat {
+		return []*big.Rat{new(big.Rat).Set(x[0]), new(big.Rat).Set(x[0])}
+	}
+	r := CheckStructureConstants(impl, []string{"1", "i"})
+	if len(r.Problems) < 3 {
+		t.Errorf("CheckStructureConstants found no problems:\n%v", r)
+	}
+}
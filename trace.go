@@ -0,0 +1,272 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// Trace returns the trace of z, which is
+// 		z + Conj(z) = 2Real(z)
+// It is the sum of the two roots of CharPoly, so that z is a zero of
+// 		t² - Trace(z)t + Quad(z)
+func (z *Complex) Trace() *big.Rat {
+	return new(big.Rat).Mul(z.Real(), big.NewRat(2, 1))
+}
+
+// Trace returns the trace of z, which is
+// 		z + Conj(z) = 2Real(z)
+// It is the sum of the two roots of CharPoly, so that z is a zero of
+// 		t² - Trace(z)t + Quad(z)
+func (z *Infra) Trace() *big.Rat {
+	return new(big.Rat).Mul(z.Real(), big.NewRat(2, 1))
+}
+
+// Trace returns the trace of z, which is
+// 		z + Conj(z) = 2Real(z)
+// It is the sum of the two roots of CharPoly, so that z is a zero of
+// 		t² - Trace(z)t + Quad(z)
+func (z *Perplex) Trace() *big.Rat {
+	return new(big.Rat).Mul(z.Real(), big.NewRat(2, 1))
+}
+
+// BiTrace returns the biscalar trace z + Conj(z), a Complex value. Over
+// Complex, z is a zero of
+// 		t² - BiTrace(z)t + z Conj(z)
+func (z *BiComplex) BiTrace() *Complex {
+	tr := new(BiComplex).Add(z, new(BiComplex).Conj(z))
+	return new(Complex).Set(&tr.l)
+}
+
+// Trace returns the rational trace of z, the trace of its BiTrace, which is
+// 4Real(z). It is the sum of the 4 roots of CharPoly.
+func (z *BiComplex) Trace() *big.Rat {
+	return z.BiTrace().Trace()
+}
+
+// BiTrace returns the biscalar trace z + Conj(z), a Perplex value. Over
+// Perplex, z is a zero of
+// 		t² - BiTrace(z)t + z Conj(z)
+func (z *BiPerplex) BiTrace() *Perplex {
+	tr := new(BiPerplex).Add(z, new(BiPerplex).Conj(z))
+	return new(Perplex).Set(&tr.l)
+}
+
+// Trace returns the rational trace of z, the trace of its BiTrace, which is
+// 4Real(z). It is the sum of the 4 roots of CharPoly.
+func (z *BiPerplex) Trace() *big.Rat {
+	return z.BiTrace().Trace()
+}
+
+// Trace returns the trace of z, which is
+// 		z + Conj(z) = 2Real(z)
+// It is the sum of the two roots of CharPoly, so that z is a zero of
+// 		t² - Trace(z)t + Quad(z)
+func (z *Cockle) Trace() *big.Rat {
+	return new(big.Rat).Mul(z.Real(), big.NewRat(2, 1))
+}
+
+// BiTrace returns the biscalar trace z + Conj(z), a Complex value. Over
+// Complex, z is a zero of
+// 		t² - BiTrace(z)t + z Conj(z)
+func (z *DualComplex) BiTrace() *Complex {
+	tr := new(DualComplex).Add(z, new(DualComplex).Conj(z))
+	return new(Complex).Set(&tr.l)
+}
+
+// Trace returns the rational trace of z, the trace of its BiTrace, which is
+// 4Real(z). It is the sum of the 4 roots of CharPoly.
+func (z *DualComplex) Trace() *big.Rat {
+	return z.BiTrace().Trace()
+}
+
+// BiTrace returns the biscalar trace z + Conj(z), a Perplex value. Over
+// Perplex, z is a zero of
+// 		t² - BiTrace(z)t + z Conj(z)
+func (z *DualPerplex) BiTrace() *Perplex {
+	tr := new(DualPerplex).Add(z, new(DualPerplex).Conj(z))
+	return new(Perplex).Set(&tr.l)
+}
+
+// Trace returns the rational trace of z, the trace of its BiTrace, which is
+// 4Real(z). It is the sum of the 4 roots of CharPoly.
+func (z *DualPerplex) Trace() *big.Rat {
+	return z.BiTrace().Trace()
+}
+
+// Trace returns the trace of z, which is
+// 		z + Conj(z) = 2Real(z)
+// It is the sum of the two roots of CharPoly, so that z is a zero of
+// 		t² - Trace(z)t + Quad(z)
+func (z *Hamilton) Trace() *big.Rat {
+	return new(big.Rat).Mul(z.Real(), big.NewRat(2, 1))
+}
+
+// BiTrace returns the biscalar trace z + Conj(z), a Infra value. Over
+// Infra, z is a zero of
+// 		t² - BiTrace(z)t + z Conj(z)
+func (z *Hyper) BiTrace() *Infra {
+	tr := new(Hyper).Add(z, new(Hyper).Conj(z))
+	return new(Infra).Set(&tr.l)
+}
+
+// Trace returns the rational trace of z, the trace of its BiTrace, which is
+// 4Real(z). It is the sum of the 4 roots of CharPoly.
+func (z *Hyper) Trace() *big.Rat {
+	return z.BiTrace().Trace()
+}
+
+// Trace returns the trace of z, which is
+// 		z + Conj(z) = 2Real(z)
+// It is the sum of the two roots of CharPoly, so that z is a zero of
+// 		t² - Trace(z)t + Quad(z)
+func (z *InfraComplex) Trace() *big.Rat {
+	return new(big.Rat).Mul(z.Real(), big.NewRat(2, 1))
+}
+
+// Trace returns the trace of z, which is
+// 		z + Conj(z) = 2Real(z)
+// It is the sum of the two roots of CharPoly, so that z is a zero of
+// 		t² - Trace(z)t + Quad(z)
+func (z *InfraPerplex) Trace() *big.Rat {
+	return new(big.Rat).Mul(z.Real(), big.NewRat(2, 1))
+}
+
+// Trace returns the trace of z, which is
+// 		z + Conj(z) = 2Real(z)
+// It is the sum of the two roots of CharPoly, so that z is a zero of
+// 		t² - Trace(z)t + Quad(z)
+func (z *Supra) Trace() *big.Rat {
+	return new(big.Rat).Mul(z.Real(), big.NewRat(2, 1))
+}
+
+// BiTrace returns the biscalar trace z + Conj(z), a Complex value in the
+// span of 1 and H. Over these Complex numbers, z is a zero of
+// 		t² - BiTrace(z)t + z Conj(z)
+func (z *BiCockle) BiTrace() *Complex {
+	tr := NewComplex(z.l.Real(), z.r.Real())
+	return tr.Add(tr, tr)
+}
+
+// Trace returns the rational trace of z, the trace of its BiTrace, which is
+// 4Real(z). It is the sum of the 4 roots of CharPoly.
+func (z *BiCockle) Trace() *big.Rat {
+	return z.BiTrace().Trace()
+}
+
+// BiTrace returns the biscalar trace z + Conj(z), a Complex value in the
+// span of 1 and H. Over these Complex numbers, z is a zero of
+// 		t² - BiTrace(z)t + z Conj(z)
+func (z *BiHamilton) BiTrace() *Complex {
+	tr := NewComplex(z.l.Real(), z.r.Real())
+	return tr.Add(tr, tr)
+}
+
+// Trace returns the rational trace of z, the trace of its BiTrace, which is
+// 4Real(z). It is the sum of the 4 roots of CharPoly.
+func (z *BiHamilton) Trace() *big.Rat {
+	return z.BiTrace().Trace()
+}
+
+// Trace returns the trace of z, which is
+// 		z + Conj(z) = 2Real(z)
+// It is the sum of the two roots of CharPoly, so that z is a zero of
+// 		t² - Trace(z)t + Quad(z)
+func (z *Cayley) Trace() *big.Rat {
+	return new(big.Rat).Mul(z.Real(), big.NewRat(2, 1))
+}
+
+// Trace returns the trace of z, which is
+// 		z + Conj(z) = 2Real(z)
+// It is the sum of the two roots of CharPoly, so that z is a zero of
+// 		t² - Trace(z)t + Quad(z)
+func (z *InfraCockle) Trace() *big.Rat {
+	return new(big.Rat).Mul(z.Real(), big.NewRat(2, 1))
+}
+
+// Trace returns the trace of z, which is
+// 		z + Conj(z) = 2Real(z)
+// It is the sum of the two roots of CharPoly, so that z is a zero of
+// 		t² - Trace(z)t + Quad(z)
+func (z *InfraHamilton) Trace() *big.Rat {
+	return new(big.Rat).Mul(z.Real(), big.NewRat(2, 1))
+}
+
+// Trace returns the trace of z, which is
+// 		z + Conj(z) = 2Real(z)
+// It is the sum of the two roots of CharPoly, so that z is a zero of
+// 		t² - Trace(z)t + Quad(z)
+func (z *SupraComplex) Trace() *big.Rat {
+	return new(big.Rat).Mul(z.Real(), big.NewRat(2, 1))
+}
+
+// Trace returns the trace of z, which is
+// 		z + Conj(z) = 2Real(z)
+// It is the sum of the two roots of CharPoly, so that z is a zero of
+// 		t² - Trace(z)t + Quad(z)
+func (z *SupraPerplex) Trace() *big.Rat {
+	return new(big.Rat).Mul(z.Real(), big.NewRat(2, 1))
+}
+
+// BiTrace returns the biscalar trace z + Conj(z), a BiComplex value. Over
+// BiComplex, z is a zero of
+// 		t² - BiTrace(z)t + z Conj(z)
+func (z *TriComplex) BiTrace() *BiComplex {
+	tr := new(TriComplex).Add(z, new(TriComplex).Conj(z))
+	return new(BiComplex).Set(&tr.l)
+}
+
+// Trace returns the rational trace of z, the trace of its BiTrace, which is
+// 8Real(z). It is the sum of the 8 roots of CharPoly.
+func (z *TriComplex) Trace() *big.Rat {
+	return z.BiTrace().Trace()
+}
+
+// BiTrace returns the biscalar trace z + Conj(z), a Hyper value. Over
+// Hyper, z is a zero of
+// 		t² - BiTrace(z)t + z Conj(z)
+func (z *TriNilplex) BiTrace() *Hyper {
+	tr := new(TriNilplex).Add(z, new(TriNilplex).Conj(z))
+	return new(Hyper).Set(&tr.l)
+}
+
+// Trace returns the rational trace of z, the trace of its BiTrace, which is
+// 8Real(z). It is the sum of the 8 roots of CharPoly.
+func (z *TriNilplex) Trace() *big.Rat {
+	return z.BiTrace().Trace()
+}
+
+// BiTrace returns the biscalar trace z + Conj(z), a BiPerplex value. Over
+// BiPerplex, z is a zero of
+// 		t² - BiTrace(z)t + z Conj(z)
+func (z *TriPerplex) BiTrace() *BiPerplex {
+	tr := new(TriPerplex).Add(z, new(TriPerplex).Conj(z))
+	return new(BiPerplex).Set(&tr.l)
+}
+
+// Trace returns the rational trace of z, the trace of its BiTrace, which is
+// 8Real(z). It is the sum of the 8 roots of CharPoly.
+func (z *TriPerplex) Trace() *big.Rat {
+	return z.BiTrace().Trace()
+}
+
+// Trace returns the trace of z, which is
+// 		z + Conj(z) = 2Real(z)
+// It is the sum of the two roots of CharPoly, so that z is a zero of
+// 		t² - Trace(z)t + Quad(z)
+func (z *Ultra) Trace() *big.Rat {
+	return new(big.Rat).Mul(z.Real(), big.NewRat(2, 1))
+}
+
+// Trace returns the trace of z, which is
+// 		z + Conj(z) = 2Real(z)
+// It is the sum of the two roots of CharPoly, so that z is a zero of
+// 		t² - Trace(z)t + Quad(z)
+func (z *Zorn) Trace() *big.Rat {
+	return new(big.Rat).Mul(z.Real(), big.NewRat(2, 1))
+}
+
+// Trace returns the trace of z, which is
+// 		z + Conj(z) = 2Real(z)
+func (z *Double[S, T]) Trace() *big.Rat {
+	return new(big.Rat).Mul(z.Real(), big.NewRat(2, 1))
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestTraceCharPoly(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i, f := range []func() (*big.Rat, Laurent){
+		func() (*big.Rat, Laurent) {
+			x := new(Complex).Generate(r, 0).Interface().(*Complex)
+			return x.Trace(), x.CharPoly()
+		},
+		func() (*big.Rat, Laurent) {
+			x := new(Infra).Generate(r, 0).Interface().(*Infra)
+			return x.Trace(), x.CharPoly()
+		},
+		func() (*big.Rat, Laurent) {
+			x := new(Perplex).Generate(r, 0).Interface().(*Perplex)
+			return x.Trace(), x.CharPoly()
+		},
+		func() (*big.Rat, Laurent) {
+			x := new(BiComplex).Generate(r, 0).Interface().(*BiComplex)
+			return x.Trace(), x.CharPoly()
+		},
+		func() (*big.Rat, Laurent) {
+			x := new(BiPerplex).Generate(r, 0).Interface().(*BiPerplex)
+			return x.Trace(), x.CharPoly()
+		},
+		func() (*big.Rat, Laurent) {
+			x := new(Cockle).Generate(r, 0).Interface().(*Cockle)
+			return x.Trace(), x.CharPoly()
+		},
+		func() (*big.Rat, Laurent) {
+			x := new(DualComplex).Generate(r, 0).Interface().(*DualComplex)
+			return x.Trace(), x.CharPoly()
+		},
+		func() (*big.Rat, Laurent) {
+			x := new(DualPerplex).Generate(r, 0).Interface().(*DualPerplex)
+			return x.Trace(), x.CharPoly()
+		},
+		func() (*big.Rat, Laurent) {
+			x := new(Hamilton).Generate(r, 0).Interface().(*Hamilton)
+			return x.Trace(), x.CharPoly()
+		},
+		func() (*big.Rat, Laurent) {
+			x := new(Hyper).Generate(r, 0).Interface().(*Hyper)
+			return x.Trace(), x.CharPoly()
+		},
+		func() (*big.Rat, Laurent) {
+			x := new(InfraComplex).Generate(r, 0).Interface().(*InfraComplex)
+			return x.Trace(), x.CharPoly()
+		},
+		func() (*big.Rat, Laurent) {
+			x := new(InfraPerplex).Generate(r, 0).Interface().(*InfraPerplex)
+			return x.Trace(), x.CharPoly()
+		},
+		func() (*big.Rat, Laurent) {
+			x := new(Supra).Generate(r, 0).Interface().(*Supra)
+			return x.Trace(), x.CharPoly()
+		},
+		func() (*big.Rat, Laurent) {
+			x := new(BiCockle).Generate(r, 0).Interface().(*BiCockle)
+			return x.Trace(), x.CharPoly()
+		},
+		func() (*big.Rat, Laurent) {
+			x := new(BiHamilton).Generate(r, 0).Interface().(*BiHamilton)
+			return x.Trace(), x.CharPoly()
+		},
+		func() (*big.Rat, Laurent) {
+			x := new(Cayley).Generate(r, 0).Interface().(*Cayley)
+			return x.Trace(), x.CharPoly()
+		},
+		func() (*big.Rat, Laurent) {
+			x := new(InfraCockle).Generate(r, 0).Interface().(*InfraCockle)
+			return x.Trace(), x.CharPoly()
+		},
+		func() (*big.Rat, Laurent) {
+			x := new(InfraHamilton).Generate(r, 0).Interface().(*InfraHamilton)
+			return x.Trace(), x.CharPoly()
+		},
+		func() (*big.Rat, Laurent) {
+			x := new(SupraComplex).Generate(r, 0).Interface().(*SupraComplex)
+			return x.Trace(), x.CharPoly()
+		},
+		func() (*big.Rat, Laurent) {
+			x := new(SupraPerplex).Generate(r, 0).Interface().(*SupraPerplex)
+			return x.Trace(), x.CharPoly()
+		},
+		func() (*big.Rat, Laurent) {
+			x := new(TriComplex).Generate(r, 0).Interface().(*TriComplex)
+			return x.Trace(), x.CharPoly()
+		},
+		func() (*big.Rat, Laurent) {
+			x := new(TriNilplex).Generate(r, 0).Interface().(*TriNilplex)
+			return x.Trace(), x.CharPoly()
+		},
+		func() (*big.Rat, Laurent) {
+			x := new(TriPerplex).Generate(r, 0).Interface().(*TriPerplex)
+			return x.Trace(), x.CharPoly()
+		},
+		func() (*big.Rat, Laurent) {
+			x := new(Ultra).Generate(r, 0).Interface().(*Ultra)
+			return x.Trace(), x.CharPoly()
+		},
+		func() (*big.Rat, Laurent) {
+			x := new(Zorn).Generate(r, 0).Interface().(*Zorn)
+			return x.Trace(), x.CharPoly()
+		},
+	} {
+		tr, p := f()
+		c := p[int64(len(p)-2)]
+		if c.Cmp(new(big.Rat).Neg(tr)) != 0 {
+			t.Errorf("case %d: Trace = %v, but CharPoly = %v", i, tr, p)
+		}
+	}
+}
+
+func TestBiTrace(t *testing.T) {
+	one, two := big.NewRat(1, 1), big.NewRat(2, 1)
+	x := NewBiHamilton(one, two, one, two, two, one, two, one)
+	if got, want := x.BiTrace(), NewComplex(two, big.NewRat(4, 1)); !got.Equals(want) {
+		t.Errorf("BiTrace(%v) = %v, want %v", x, got, want)
+	}
+	y := NewTriComplex(one, two, one, two, two, one, two, one)
+	if got := y.Trace(); got.Cmp(big.NewRat(8, 1)) != 0 {
+		t.Errorf("Trace(%v) = %v, want 8", y, got)
+	}
+}
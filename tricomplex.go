@@ -5,6 +5,7 @@ package rational
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"reflect"
@@ -18,25 +19,40 @@ type TriComplex struct {
 	l, r BiComplex
 }
 
-// Real returns the (rational) real part of z.
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
 func (z *TriComplex) Real() *big.Rat {
 	return (&z.l).Real()
 }
 
-// Rats returns the eight rational components of z.
+// Rats returns the eight rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
 func (z *TriComplex) Rats() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
 	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
 	return &z.l.l.l, &z.l.l.r, &z.l.r.l, &z.l.r.r,
 		&z.r.l.l, &z.r.l.r, &z.r.r.l, &z.r.r.r
 }
 
+// RatsCopy returns copies of the eight rational components of z.
+func (z *TriComplex) RatsCopy() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
+	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
+	return new(TriComplex).Set(z).Rats()
+}
+
+// Components returns the eight rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *TriComplex) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
 // String returns the string representation of a TriComplex value.
 func (z *TriComplex) String() string {
 	v := make([]*big.Rat, 8)
 	v[0], v[1], v[2], v[3] = z.l.Rats()
 	v[4], v[5], v[6], v[7] = z.r.Rats()
 	a := make([]string, 17)
-	a[0] = leftBracket
+	br := brackets()
+	a[0] = br[0]
 	a[1] = fmt.Sprintf("%v", v[0].RatString())
 	i := 1
 	for j := 2; j < 16; j = j + 2 {
@@ -48,7 +64,7 @@ func (z *TriComplex) String() string {
 		a[j+1] = symbTriComplex[i]
 		i++
 	}
-	a[16] = rightBracket
+	a[16] = br[1]
 	return strings.Join(a, "")
 }
 
@@ -60,6 +76,16 @@ func (z *TriComplex) Equals(y *TriComplex) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *TriComplex) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(BiComplex))
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *TriComplex) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *TriComplex) Set(y *TriComplex) *TriComplex {
 	z.l.Set(&y.l)
@@ -95,13 +121,26 @@ func (z *TriComplex) Neg(y *TriComplex) *TriComplex {
 	return z
 }
 
-// Conj sets z equal to the conjugate of y, and returns z.
+// Conj sets z equal to the conjugate of y, and returns z. This operation
+// changes the sign of all the components with K, and fixes the subalgebra of
+// values with no K, a copy of BiComplex.
 func (z *TriComplex) Conj(y *TriComplex) *TriComplex {
 	z.l.Set(&y.l)
 	z.r.Neg(&y.r)
 	return z
 }
 
+// Star sets z equal to the star conjugate of y, and returns z. This operation
+// changes the sign of all the components with i, and fixes the subalgebra
+// spanned by 1, J, K, and JK, another copy of BiComplex. Its composition with
+// Conj changes the signs of i and K, and fixes the subalgebra spanned by 1, J,
+// iK, and iJK, a third copy of BiComplex.
+func (z *TriComplex) Star(y *TriComplex) *TriComplex {
+	z.l.Star(&y.l)
+	z.r.Star(&y.r)
+	return z
+}
+
 // Add sets z equal to x+y, and returns z.
 func (z *TriComplex) Add(x, y *TriComplex) *TriComplex {
 	z.l.Add(&x.l, &y.l)
@@ -124,12 +163,26 @@ func (z *TriComplex) Sub(x, y *TriComplex) *TriComplex {
 // 		Mul(i, K) = Mul(K, i)
 // 		Mul(J, K) = Mul(K, J)
 // This binary operation is commutative and associative.
-func (z *TriComplex) Mul(x, y *TriComplex) *TriComplex {
-	a := new(BiComplex).Set(&x.l)
-	b := new(BiComplex).Set(&x.r)
-	c := new(BiComplex).Set(&y.l)
-	d := new(BiComplex).Set(&y.r)
-	temp := new(BiComplex)
+func (z *TriComplex) Mul(x, y *TriComplex) (product *TriComplex) {
+	if h := currentHook(); h != nil {
+		x, y := new(TriComplex).Set(x), new(TriComplex).Set(y)
+		defer func() { traceMul(h, "TriComplex.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	a, b, c, d := &x.l, &x.r, &y.l, &y.r
+	if z == x || z == y {
+		// z.l is written before z.r is computed
+		a, b = biComplexArena.get().Set(a), biComplexArena.get().Set(b)
+		c, d = biComplexArena.get().Set(c), biComplexArena.get().Set(d)
+		defer biComplexArena.put(a, b, c, d)
+	}
+	temp := biComplexArena.get()
+	defer biComplexArena.put(temp)
 	z.l.Sub(
 		z.l.Mul(a, c),
 		temp.Mul(b, d),
@@ -145,9 +198,10 @@ func (z *TriComplex) Mul(x, y *TriComplex) *TriComplex {
 // 		a² - b² + c² - d² + 2(ab + cd)i
 // Note that this is a bicomplex number.
 func (z *TriComplex) Quad() *BiComplex {
-	quad := new(BiComplex)
+	quad, temp := biComplexArena.get(), biComplexArena.get()
+	defer biComplexArena.put(temp)
 	quad.Mul(&z.l, &z.l)
-	return quad.Add(quad, new(BiComplex).Mul(&z.r, &z.r))
+	return quad.Add(quad, temp.Mul(&z.r, &z.r))
 }
 
 // Norm returns the norm of z. If z = a+bi+cJ+dS, then the norm is
@@ -166,11 +220,16 @@ func (z *TriComplex) IsZeroDivisor() bool {
 
 // Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
 // then Inv panics.
-func (z *TriComplex) Inv(y *TriComplex) *TriComplex {
+func (z *TriComplex) Inv(y *TriComplex) (inverse *TriComplex) {
+	if h := currentHook(); h != nil {
+		y := new(TriComplex).Set(y)
+		defer func() { traceInv(h, "TriComplex.Inv", inverse != nil, z, y) }()
+	}
 	if y.IsZeroDivisor() {
-		panic("inverse of zero divisor")
+		panic(divisorError("inverse of zero divisor"))
 	}
 	quad := y.Quad()
+	defer biComplexArena.put(quad)
 	quad.Inv(quad)
 	z.Conj(y)
 	z.l.Mul(&z.l, quad)
@@ -182,7 +241,7 @@ func (z *TriComplex) Inv(y *TriComplex) *TriComplex {
 // Quo panics.
 func (z *TriComplex) Quo(x, y *TriComplex) *TriComplex {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(z.Inv(y), x)
 }
@@ -216,21 +275,104 @@ func (z *TriComplex) Möbius(y, a, b, c, d *TriComplex) *TriComplex {
 	return z.Mul(z, temp)
 }
 
+// LeftMul returns the linear operator that sends y to Mul(z, y), acting on
+// the rational components of y.
+func (z *TriComplex) LeftMul() *Operator {
+	a := new(TriComplex).Set(z)
+	return &Operator{8, func(v []*big.Rat) []*big.Rat {
+		y := NewTriComplex(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+		return rats(y.Mul(a, y).Rats())
+	}}
+}
+
+// RightMul returns the linear operator that sends y to Mul(y, z), acting on
+// the rational components of y.
+func (z *TriComplex) RightMul() *Operator {
+	a := new(TriComplex).Set(z)
+	return &Operator{8, func(v []*big.Rat) []*big.Rat {
+		y := NewTriComplex(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+		return rats(y.Mul(y, a).Rats())
+	}}
+}
+
+// WriteTo writes a canonical encoding of z to w, and returns the number of
+// bytes written. Equal values produce equal encodings, so any hash.Hash can be
+// used as w to hash z without building an intermediate byte slice.
+func (z *TriComplex) WriteTo(w io.Writer) (int64, error) {
+	return writeRats(w, rats(z.Rats()))
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// the rational parameter t, and returns z. The curve is evaluated exactly with
+// the De Casteljau algorithm. If p is empty, then Bezier panics.
+func (z *TriComplex) Bezier(t *big.Rat, p ...*TriComplex) *TriComplex {
+	if len(p) == 0 {
+		panic("no control points")
+	}
+	s := new(big.Rat).Sub(big.NewRat(1, 1), t)
+	b := make([]*TriComplex, len(p))
+	for i := range p {
+		b[i] = new(TriComplex).Set(p[i])
+	}
+	temp := new(TriComplex)
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			b[i].Add(b[i].Scal(b[i], s), temp.Scal(b[i+1], t))
+		}
+	}
+	return z.Set(b[0])
+}
+
+// Less returns true if z precedes y in the lexicographic order on the
+// rational components. This is a total order, compatible with Equals, so
+// TriComplex values can be sorted deterministically.
+func (z *TriComplex) Less(y *TriComplex) bool {
+	return lexLess(rats(z.Rats()), rats(y.Rats()))
+}
+
+// Round sets z equal to y with each component rounded to a multiple of 1/den
+// in the direction given by mode, and returns z. Directed modes round every
+// component the same way, so interval bounds stay rigorous. If den is not
+// positive, then Round panics.
+func (z *TriComplex) Round(y *TriComplex, den *big.Int, mode big.RoundingMode) *TriComplex {
+	z.Set(y)
+	for _, c := range rats(z.Rats()) {
+		roundRat(c, c, den, mode)
+	}
+	return z
+}
+
+// Act returns the left action of z on the pair (a, b) of BiComplex values,
+// which is identified with the TriComplex value a + bK and multiplied on the
+// left by z. TriComplex is commutative and associative, so Act is a
+// representation of TriComplex by 2×2 matrices over BiComplex.
+func (z *TriComplex) Act(a, b *BiComplex) (*BiComplex, *BiComplex) {
+	v := new(TriComplex)
+	v.l.Set(a)
+	v.r.Set(b)
+	v.Mul(z, v)
+	return &v.l, &v.r
+}
+
+// SolveCrossRatio sets z equal to the point y such that the cross-ratio of v,
+// w, x, and y is c, and returns z. With A = Inv(w - x) * (v - x) and
+// B = Inv(A) * c, the point is
+// 		(v*B - w) * Inv(B - 1)
+// If the point is not uniquely determined, then SolveCrossRatio panics.
+func (z *TriComplex) SolveCrossRatio(v, w, x, c *TriComplex) *TriComplex {
+	one := new(TriComplex)
+	one.Real().SetInt64(1)
+	b := new(TriComplex).Sub(v, x)
+	b.Mul(new(TriComplex).Inv(new(TriComplex).Sub(w, x)), b)
+	b.Mul(b.Inv(b), c)
+	temp := new(TriComplex).Sub(b, one)
+	temp.Inv(temp)
+	z.Mul(v, b)
+	z.Sub(z, w)
+	return z.Mul(z, temp)
+}
+
 // Generate returns a random TriComplex value for quick.Check testing.
 func (z *TriComplex) Generate(rand *rand.Rand, size int) reflect.Value {
-	randomTriComplex := &TriComplex{
-		*NewBiComplex(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-		*NewBiComplex(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-	}
-	return reflect.ValueOf(randomTriComplex)
+	return reflect.ValueOf(new(TriComplex).Random(rand, generateOptions))
 }
@@ -345,3 +345,71 @@ func TestTriComplexComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Scalar operands
+
+func TestTriComplexMulReal(t *testing.T) {
+	f := func(x, y *TriComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(big.Rat).Set(y.Real())
+		s := new(TriComplex)
+		s.Real().Set(a)
+		if !s.IsReal() || x.IsReal() {
+			return false
+		}
+		l, r := new(TriComplex), new(TriComplex)
+		l.Mul(s, x)
+		r.Mul(x, s)
+		// an independent product: s x = (s + x)x - x x, with no real factor
+		xx := new(TriComplex).Mul(x, x)
+		sx := new(TriComplex).Add(s, x)
+		return l.Equals(new(TriComplex).Sub(new(TriComplex).Mul(sx, x), xx)) &&
+			r.Equals(new(TriComplex).Sub(new(TriComplex).Mul(x, sx), xx))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTriComplexIsPure(t *testing.T) {
+	f := func(x *TriComplex) bool {
+		// t.Logf("x = %v", x)
+		p := new(TriComplex).Set(x)
+		p.Real().SetInt64(0)
+		return p.IsPure() && !x.IsPure()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Ordering
+
+func TestTriComplexLessTrichotomy(t *testing.T) {
+	f := func(x, y *TriComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		n := 0
+		for _, b := range []bool{x.Less(y), y.Less(x), x.Equals(y)} {
+			if b {
+				n++
+			}
+		}
+		return n == 1 && !x.Less(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTriComplexLessTransitive(t *testing.T) {
+	f := func(x, y, z *TriComplex) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		if x.Less(y) && y.Less(z) {
+			return x.Less(z)
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
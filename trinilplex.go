@@ -5,6 +5,7 @@ package rational
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"reflect"
@@ -18,25 +19,40 @@ type TriNilplex struct {
 	l, r Hyper
 }
 
-// Real returns the (rational) real part of z.
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
 func (z *TriNilplex) Real() *big.Rat {
 	return (&z.l).Real()
 }
 
-// Rats returns the eight rational components of z.
+// Rats returns the eight rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
 func (z *TriNilplex) Rats() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
 	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
 	return &z.l.l.l, &z.l.l.r, &z.l.r.l, &z.l.r.r,
 		&z.r.l.l, &z.r.l.r, &z.r.r.l, &z.r.r.r
 }
 
+// RatsCopy returns copies of the eight rational components of z.
+func (z *TriNilplex) RatsCopy() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
+	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
+	return new(TriNilplex).Set(z).Rats()
+}
+
+// Components returns the eight rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *TriNilplex) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
 // String returns the string representation of a TriNilplex value.
 func (z *TriNilplex) String() string {
 	v := make([]*big.Rat, 8)
 	v[0], v[1], v[2], v[3] = z.l.Rats()
 	v[4], v[5], v[6], v[7] = z.r.Rats()
 	a := make([]string, 17)
-	a[0] = leftBracket
+	br := brackets()
+	a[0] = br[0]
 	a[1] = fmt.Sprintf("%v", v[0].RatString())
 	i := 1
 	for j := 2; j < 16; j = j + 2 {
@@ -48,7 +64,7 @@ func (z *TriNilplex) String() string {
 		a[j+1] = symbTriNilplex[i]
 		i++
 	}
-	a[16] = rightBracket
+	a[16] = br[1]
 	return strings.Join(a, "")
 }
 
@@ -60,6 +76,16 @@ func (z *TriNilplex) Equals(y *TriNilplex) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *TriNilplex) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Hyper))
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *TriNilplex) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *TriNilplex) Set(y *TriNilplex) *TriNilplex {
 	z.l.Set(&y.l)
@@ -95,13 +121,26 @@ func (z *TriNilplex) Neg(y *TriNilplex) *TriNilplex {
 	return z
 }
 
-// Conj sets z equal to the conjugate of y, and returns z.
+// Conj sets z equal to the conjugate of y, and returns z. This operation
+// changes the sign of all the components with Λ, and fixes the subalgebra of
+// values with no Λ, a copy of Hyper.
 func (z *TriNilplex) Conj(y *TriNilplex) *TriNilplex {
 	z.l.Set(&y.l)
 	z.r.Neg(&y.r)
 	return z
 }
 
+// Star sets z equal to the star conjugate of y, and returns z. This operation
+// changes the sign of all the components with α, and fixes the subalgebra
+// spanned by 1, Γ, Λ, and ΓΛ, another copy of Hyper. Its composition with Conj
+// changes the signs of α and Λ, and fixes the subalgebra spanned by 1, Γ, αΛ,
+// and αΓΛ, a third copy of Hyper.
+func (z *TriNilplex) Star(y *TriNilplex) *TriNilplex {
+	z.l.Star(&y.l)
+	z.r.Star(&y.r)
+	return z
+}
+
 // Add sets z equal to x+y, and returns z.
 func (z *TriNilplex) Add(x, y *TriNilplex) *TriNilplex {
 	z.l.Add(&x.l, &y.l)
@@ -124,12 +163,26 @@ func (z *TriNilplex) Sub(x, y *TriNilplex) *TriNilplex {
 // 		Mul(α, Λ) = Mul(Λ, α)
 // 		Mul(Γ, Λ) = Mul(Λ, Γ)
 // This binary operation is commutative and associative.
-func (z *TriNilplex) Mul(x, y *TriNilplex) *TriNilplex {
-	a := new(Hyper).Set(&x.l)
-	b := new(Hyper).Set(&x.r)
-	c := new(Hyper).Set(&y.l)
-	d := new(Hyper).Set(&y.r)
-	temp := new(Hyper)
+func (z *TriNilplex) Mul(x, y *TriNilplex) (product *TriNilplex) {
+	if h := currentHook(); h != nil {
+		x, y := new(TriNilplex).Set(x), new(TriNilplex).Set(y)
+		defer func() { traceMul(h, "TriNilplex.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	a, b, c, d := &x.l, &x.r, &y.l, &y.r
+	if z == x || z == y {
+		// z.l is written before z.r is computed
+		a, b = hyperArena.get().Set(a), hyperArena.get().Set(b)
+		c, d = hyperArena.get().Set(c), hyperArena.get().Set(d)
+		defer hyperArena.put(a, b, c, d)
+	}
+	temp := hyperArena.get()
+	defer hyperArena.put(temp)
 	z.l.Mul(a, c)
 	z.r.Add(
 		z.r.Mul(a, d),
@@ -143,7 +196,7 @@ func (z *TriNilplex) Mul(x, y *TriNilplex) *TriNilplex {
 // 		a² + 2abα + 2acΓ + 2(ad + bc)αΓ
 // Note that this is a bicomplex number.
 func (z *TriNilplex) Quad() *Hyper {
-	quad := new(Hyper)
+	quad := hyperArena.get()
 	return quad.Mul(&z.l, &z.l)
 }
 
@@ -162,11 +215,16 @@ func (z *TriNilplex) IsZeroDivisor() bool {
 
 // Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
 // then Inv panics.
-func (z *TriNilplex) Inv(y *TriNilplex) *TriNilplex {
+func (z *TriNilplex) Inv(y *TriNilplex) (inverse *TriNilplex) {
+	if h := currentHook(); h != nil {
+		y := new(TriNilplex).Set(y)
+		defer func() { traceInv(h, "TriNilplex.Inv", inverse != nil, z, y) }()
+	}
 	if y.IsZeroDivisor() {
-		panic("inverse of zero divisor")
+		panic(divisorError("inverse of zero divisor"))
 	}
 	quad := y.Quad()
+	defer hyperArena.put(quad)
 	quad.Inv(quad)
 	z.Conj(y)
 	z.l.Mul(&z.l, quad)
@@ -178,7 +236,7 @@ func (z *TriNilplex) Inv(y *TriNilplex) *TriNilplex {
 // Quo panics.
 func (z *TriNilplex) Quo(x, y *TriNilplex) *TriNilplex {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(z.Inv(y), x)
 }
@@ -212,21 +270,146 @@ func (z *TriNilplex) Möbius(y, a, b, c, d *TriNilplex) *TriNilplex {
 	return z.Mul(z, temp)
 }
 
+// LeftMul returns the linear operator that sends y to Mul(z, y), acting on
+// the rational components of y.
+func (z *TriNilplex) LeftMul() *Operator {
+	a := new(TriNilplex).Set(z)
+	return &Operator{8, func(v []*big.Rat) []*big.Rat {
+		y := NewTriNilplex(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+		return rats(y.Mul(a, y).Rats())
+	}}
+}
+
+// RightMul returns the linear operator that sends y to Mul(y, z), acting on
+// the rational components of y.
+func (z *TriNilplex) RightMul() *Operator {
+	a := new(TriNilplex).Set(z)
+	return &Operator{8, func(v []*big.Rat) []*big.Rat {
+		y := NewTriNilplex(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+		return rats(y.Mul(y, a).Rats())
+	}}
+}
+
+// WriteTo writes a canonical encoding of z to w, and returns the number of
+// bytes written. Equal values produce equal encodings, so any hash.Hash can be
+// used as w to hash z without building an intermediate byte slice.
+func (z *TriNilplex) WriteTo(w io.Writer) (int64, error) {
+	return writeRats(w, rats(z.Rats()))
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// the rational parameter t, and returns z. The curve is evaluated exactly with
+// the De Casteljau algorithm. If p is empty, then Bezier panics.
+func (z *TriNilplex) Bezier(t *big.Rat, p ...*TriNilplex) *TriNilplex {
+	if len(p) == 0 {
+		panic("no control points")
+	}
+	s := new(big.Rat).Sub(big.NewRat(1, 1), t)
+	b := make([]*TriNilplex, len(p))
+	for i := range p {
+		b[i] = new(TriNilplex).Set(p[i])
+	}
+	temp := new(TriNilplex)
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			b[i].Add(b[i].Scal(b[i], s), temp.Scal(b[i+1], t))
+		}
+	}
+	return z.Set(b[0])
+}
+
+// Round sets z equal to y with each component rounded to a multiple of 1/den
+// in the direction given by mode, and returns z. Directed modes round every
+// component the same way, so interval bounds stay rigorous. If den is not
+// positive, then Round panics.
+func (z *TriNilplex) Round(y *TriNilplex, den *big.Int, mode big.RoundingMode) *TriNilplex {
+	z.Set(y)
+	for _, c := range rats(z.Rats()) {
+		roundRat(c, c, den, mode)
+	}
+	return z
+}
+
+// Act returns the left action of z on the pair (a, b) of Hyper values, which
+// is identified with the TriNilplex value a + bΛ and multiplied on the left by
+// z. TriNilplex is commutative and associative, so Act is a representation of
+// TriNilplex by 2×2 matrices over Hyper, with the pairs (0, b) invariant since
+// Λ is nilpotent.
+func (z *TriNilplex) Act(a, b *Hyper) (*Hyper, *Hyper) {
+	v := new(TriNilplex)
+	v.l.Set(a)
+	v.r.Set(b)
+	v.Mul(z, v)
+	return &v.l, &v.r
+}
+
+// SolveCrossRatio sets z equal to the point y such that the cross-ratio of v,
+// w, x, and y is c, and returns z. With A = Inv(w - x) * (v - x) and
+// B = Inv(A) * c, the point is
+// 		(v*B - w) * Inv(B - 1)
+// If the point is not uniquely determined, then SolveCrossRatio panics.
+func (z *TriNilplex) SolveCrossRatio(v, w, x, c *TriNilplex) *TriNilplex {
+	one := new(TriNilplex)
+	one.Real().SetInt64(1)
+	b := new(TriNilplex).Sub(v, x)
+	b.Mul(new(TriNilplex).Inv(new(TriNilplex).Sub(w, x)), b)
+	b.Mul(b.Inv(b), c)
+	temp := new(TriNilplex).Sub(b, one)
+	temp.Inv(temp)
+	z.Mul(v, b)
+	z.Sub(z, w)
+	return z.Mul(z, temp)
+}
+
+// Exp sets z equal to the exponential of y, and returns z. The exponential
+// series terminates because y is nilpotent. If y is not nilpotent, then Exp
+// panics.
+func (z *TriNilplex) Exp(y *TriNilplex) *TriNilplex {
+	if !y.IsZeroDivisor() {
+		panic("exponential of non-nilpotent")
+	}
+	zero := new(TriNilplex)
+	sum, term := new(TriNilplex), new(TriNilplex)
+	sum.Real().SetInt64(1)
+	term.Real().SetInt64(1)
+	for k := int64(1); ; k++ {
+		term.Mul(term, y)
+		if term.Equals(zero) {
+			break
+		}
+		term.Scal(term, big.NewRat(1, k))
+		sum.Add(sum, term)
+	}
+	return z.Set(sum)
+}
+
+// Log sets z equal to the logarithm of y, and returns z. This is the inverse
+// of Exp: the logarithmic series terminates because y - 1 is nilpotent. If y -
+// 1 is not nilpotent, then Log panics.
+func (z *TriNilplex) Log(y *TriNilplex) *TriNilplex {
+	one := new(TriNilplex)
+	one.Real().SetInt64(1)
+	n := new(TriNilplex).Sub(y, one)
+	if !n.IsZeroDivisor() {
+		panic("logarithm of non-unipotent")
+	}
+	zero := new(TriNilplex)
+	sum, term := new(TriNilplex), new(TriNilplex).Set(one)
+	for k := int64(1); ; k++ {
+		term.Mul(term, n)
+		if term.Equals(zero) {
+			break
+		}
+		c := big.NewRat(1, k)
+		if k%2 == 0 {
+			c.Neg(c)
+		}
+		sum.Add(sum, new(TriNilplex).Scal(term, c))
+	}
+	return z.Set(sum)
+}
+
 // Generate returns a random TriNilplex value for quick.Check testing.
 func (z *TriNilplex) Generate(rand *rand.Rand, size int) reflect.Value {
-	randomTriNilplex := &TriNilplex{
-		*NewHyper(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-		*NewHyper(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-	}
-	return reflect.ValueOf(randomTriNilplex)
+	return reflect.ValueOf(new(TriNilplex).Random(rand, generateOptions))
 }
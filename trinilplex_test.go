@@ -345,3 +345,69 @@ func TestTriNilplexComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Scalar operands
+
+func TestTriNilplexMulReal(t *testing.T) {
+	f := func(x, y *TriNilplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(big.Rat).Set(y.Real())
+		s := new(TriNilplex)
+		s.Real().Set(a)
+		if !s.IsReal() || x.IsReal() {
+			return false
+		}
+		l, r := new(TriNilplex), new(TriNilplex)
+		l.Mul(s, x)
+		r.Mul(x, s)
+		// an independent product: s x = (s + x)x - x x, with no real factor
+		xx := new(TriNilplex).Mul(x, x)
+		sx := new(TriNilplex).Add(s, x)
+		return l.Equals(new(TriNilplex).Sub(new(TriNilplex).Mul(sx, x), xx)) &&
+			r.Equals(new(TriNilplex).Sub(new(TriNilplex).Mul(x, sx), xx))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTriNilplexIsPure(t *testing.T) {
+	f := func(x *TriNilplex) bool {
+		// t.Logf("x = %v", x)
+		p := new(TriNilplex).Set(x)
+		p.Real().SetInt64(0)
+		return p.IsPure() && !x.IsPure()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Exponential
+
+func TestTriNilplexLogExp(t *testing.T) {
+	f := func(x *TriNilplex) bool {
+		// t.Logf("x = %v", x)
+		x.Real().SetInt64(0)
+		l := new(TriNilplex).Exp(x)
+		l.Log(l)
+		return l.Equals(x)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTriNilplexExpAdd(t *testing.T) {
+	f := func(x, y *TriNilplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		x.Real().SetInt64(0)
+		y.Real().SetInt64(0)
+		l := new(TriNilplex).Exp(new(TriNilplex).Add(x, y))
+		r := new(TriNilplex).Mul(new(TriNilplex).Exp(x), new(TriNilplex).Exp(y))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
@@ -5,6 +5,7 @@ package rational
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"reflect"
@@ -18,25 +19,40 @@ type TriPerplex struct {
 	l, r BiPerplex
 }
 
-// Real returns the (rational) real part of z.
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
 func (z *TriPerplex) Real() *big.Rat {
 	return (&z.l).Real()
 }
 
-// Rats returns the eight rational components of z.
+// Rats returns the eight rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
 func (z *TriPerplex) Rats() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
 	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
 	return &z.l.l.l, &z.l.l.r, &z.l.r.l, &z.l.r.r,
 		&z.r.l.l, &z.r.l.r, &z.r.r.l, &z.r.r.r
 }
 
+// RatsCopy returns copies of the eight rational components of z.
+func (z *TriPerplex) RatsCopy() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
+	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
+	return new(TriPerplex).Set(z).Rats()
+}
+
+// Components returns the eight rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *TriPerplex) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
 // String returns the string representation of a TriPerplex value.
 func (z *TriPerplex) String() string {
 	v := make([]*big.Rat, 8)
 	v[0], v[1], v[2], v[3] = z.l.Rats()
 	v[4], v[5], v[6], v[7] = z.r.Rats()
 	a := make([]string, 17)
-	a[0] = leftBracket
+	br := brackets()
+	a[0] = br[0]
 	a[1] = fmt.Sprintf("%v", v[0].RatString())
 	i := 1
 	for j := 2; j < 16; j = j + 2 {
@@ -48,7 +64,7 @@ func (z *TriPerplex) String() string {
 		a[j+1] = symbTriPerplex[i]
 		i++
 	}
-	a[16] = rightBracket
+	a[16] = br[1]
 	return strings.Join(a, "")
 }
 
@@ -60,6 +76,16 @@ func (z *TriPerplex) Equals(y *TriPerplex) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *TriPerplex) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(BiPerplex))
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *TriPerplex) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *TriPerplex) Set(y *TriPerplex) *TriPerplex {
 	z.l.Set(&y.l)
@@ -95,13 +121,26 @@ func (z *TriPerplex) Neg(y *TriPerplex) *TriPerplex {
 	return z
 }
 
-// Conj sets z equal to the conjugate of y, and returns z.
+// Conj sets z equal to the conjugate of y, and returns z. This operation
+// changes the sign of all the components with U, and fixes the subalgebra of
+// values with no U, a copy of BiPerplex.
 func (z *TriPerplex) Conj(y *TriPerplex) *TriPerplex {
 	z.l.Set(&y.l)
 	z.r.Neg(&y.r)
 	return z
 }
 
+// Star sets z equal to the star conjugate of y, and returns z. This operation
+// changes the sign of all the components with s, and fixes the subalgebra
+// spanned by 1, T, U, and TU, another copy of BiPerplex. Its composition with
+// Conj changes the signs of s and U, and fixes the subalgebra spanned by 1, T,
+// sU, and sTU, a third copy of BiPerplex.
+func (z *TriPerplex) Star(y *TriPerplex) *TriPerplex {
+	z.l.Star(&y.l)
+	z.r.Star(&y.r)
+	return z
+}
+
 // Add sets z equal to x+y, and returns z.
 func (z *TriPerplex) Add(x, y *TriPerplex) *TriPerplex {
 	z.l.Add(&x.l, &y.l)
@@ -124,12 +163,26 @@ func (z *TriPerplex) Sub(x, y *TriPerplex) *TriPerplex {
 // 		Mul(s, U) = Mul(U, s)
 // 		Mul(T, U) = Mul(U, T)
 // This binary operation is commutative and associative.
-func (z *TriPerplex) Mul(x, y *TriPerplex) *TriPerplex {
-	a := new(BiPerplex).Set(&x.l)
-	b := new(BiPerplex).Set(&x.r)
-	c := new(BiPerplex).Set(&y.l)
-	d := new(BiPerplex).Set(&y.r)
-	temp := new(BiPerplex)
+func (z *TriPerplex) Mul(x, y *TriPerplex) (product *TriPerplex) {
+	if h := currentHook(); h != nil {
+		x, y := new(TriPerplex).Set(x), new(TriPerplex).Set(y)
+		defer func() { traceMul(h, "TriPerplex.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	a, b, c, d := &x.l, &x.r, &y.l, &y.r
+	if z == x || z == y {
+		// z.l is written before z.r is computed
+		a, b = biPerplexArena.get().Set(a), biPerplexArena.get().Set(b)
+		c, d = biPerplexArena.get().Set(c), biPerplexArena.get().Set(d)
+		defer biPerplexArena.put(a, b, c, d)
+	}
+	temp := biPerplexArena.get()
+	defer biPerplexArena.put(temp)
 	z.l.Add(
 		z.l.Mul(a, c),
 		temp.Mul(b, d),
@@ -146,9 +199,10 @@ func (z *TriPerplex) Mul(x, y *TriPerplex) *TriPerplex {
 // 		a² - b² + c² - d² + 2(ab + cd)i
 // Note that this is a biperplex number.
 func (z *TriPerplex) Quad() *BiPerplex {
-	quad := new(BiPerplex)
+	quad, temp := biPerplexArena.get(), biPerplexArena.get()
+	defer biPerplexArena.put(temp)
 	quad.Mul(&z.l, &z.l)
-	return quad.Sub(quad, new(BiPerplex).Mul(&z.r, &z.r))
+	return quad.Sub(quad, temp.Mul(&z.r, &z.r))
 }
 
 // Norm returns the norm of z. If z = a+bs+cT+dsT+eU+fsU+gTU+hsTU, then the
@@ -168,11 +222,16 @@ func (z *TriPerplex) IsZeroDivisor() bool {
 
 // Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
 // then Inv panics.
-func (z *TriPerplex) Inv(y *TriPerplex) *TriPerplex {
+func (z *TriPerplex) Inv(y *TriPerplex) (inverse *TriPerplex) {
+	if h := currentHook(); h != nil {
+		y := new(TriPerplex).Set(y)
+		defer func() { traceInv(h, "TriPerplex.Inv", inverse != nil, z, y) }()
+	}
 	if y.IsZeroDivisor() {
-		panic("inverse of zero divisor")
+		panic(divisorError("inverse of zero divisor"))
 	}
 	quad := y.Quad()
+	defer biPerplexArena.put(quad)
 	quad.Inv(quad)
 	z.Conj(y)
 	z.l.Mul(&z.l, quad)
@@ -184,7 +243,7 @@ func (z *TriPerplex) Inv(y *TriPerplex) *TriPerplex {
 // Quo panics.
 func (z *TriPerplex) Quo(x, y *TriPerplex) *TriPerplex {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(z.Inv(y), x)
 }
@@ -218,21 +277,105 @@ func (z *TriPerplex) Möbius(y, a, b, c, d *TriPerplex) *TriPerplex {
 	return z.Mul(z, temp)
 }
 
+// LeftMul returns the linear operator that sends y to Mul(z, y), acting on
+// the rational components of y.
+func (z *TriPerplex) LeftMul() *Operator {
+	a := new(TriPerplex).Set(z)
+	return &Operator{8, func(v []*big.Rat) []*big.Rat {
+		y := NewTriPerplex(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+		return rats(y.Mul(a, y).Rats())
+	}}
+}
+
+// RightMul returns the linear operator that sends y to Mul(y, z), acting on
+// the rational components of y.
+func (z *TriPerplex) RightMul() *Operator {
+	a := new(TriPerplex).Set(z)
+	return &Operator{8, func(v []*big.Rat) []*big.Rat {
+		y := NewTriPerplex(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+		return rats(y.Mul(y, a).Rats())
+	}}
+}
+
+// WriteTo writes a canonical encoding of z to w, and returns the number of
+// bytes written. Equal values produce equal encodings, so any hash.Hash can be
+// used as w to hash z without building an intermediate byte slice.
+func (z *TriPerplex) WriteTo(w io.Writer) (int64, error) {
+	return writeRats(w, rats(z.Rats()))
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// the rational parameter t, and returns z. The curve is evaluated exactly with
+// the De Casteljau algorithm. If p is empty, then Bezier panics.
+func (z *TriPerplex) Bezier(t *big.Rat, p ...*TriPerplex) *TriPerplex {
+	if len(p) == 0 {
+		panic("no control points")
+	}
+	s := new(big.Rat).Sub(big.NewRat(1, 1), t)
+	b := make([]*TriPerplex, len(p))
+	for i := range p {
+		b[i] = new(TriPerplex).Set(p[i])
+	}
+	temp := new(TriPerplex)
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			b[i].Add(b[i].Scal(b[i], s), temp.Scal(b[i+1], t))
+		}
+	}
+	return z.Set(b[0])
+}
+
+// Less returns true if z precedes y in the lexicographic order on the
+// rational components. This is a total order, compatible with Equals, so
+// TriPerplex values can be sorted deterministically.
+func (z *TriPerplex) Less(y *TriPerplex) bool {
+	return lexLess(rats(z.Rats()), rats(y.Rats()))
+}
+
+// Round sets z equal to y with each component rounded to a multiple of 1/den
+// in the direction given by mode, and returns z. Directed modes round every
+// component the same way, so interval bounds stay rigorous. If den is not
+// positive, then Round panics.
+func (z *TriPerplex) Round(y *TriPerplex, den *big.Int, mode big.RoundingMode) *TriPerplex {
+	z.Set(y)
+	for _, c := range rats(z.Rats()) {
+		roundRat(c, c, den, mode)
+	}
+	return z
+}
+
+// Act returns the left action of z on the pair (a, b) of BiPerplex values,
+// which is identified with the TriPerplex value a + bU and multiplied on the
+// left by z. Since TriPerplex is commutative and associative, Act is a
+// representation of TriPerplex by 2×2 matrices over BiPerplex, the actions of
+// any two values commuting.
+func (z *TriPerplex) Act(a, b *BiPerplex) (*BiPerplex, *BiPerplex) {
+	v := new(TriPerplex)
+	v.l.Set(a)
+	v.r.Set(b)
+	v.Mul(z, v)
+	return &v.l, &v.r
+}
+
+// SolveCrossRatio sets z equal to the point y such that the cross-ratio of v,
+// w, x, and y is c, and returns z. With A = Inv(w - x) * (v - x) and
+// B = Inv(A) * c, the point is
+// 		(v*B - w) * Inv(B - 1)
+// If the point is not uniquely determined, then SolveCrossRatio panics.
+func (z *TriPerplex) SolveCrossRatio(v, w, x, c *TriPerplex) *TriPerplex {
+	one := new(TriPerplex)
+	one.Real().SetInt64(1)
+	b := new(TriPerplex).Sub(v, x)
+	b.Mul(new(TriPerplex).Inv(new(TriPerplex).Sub(w, x)), b)
+	b.Mul(b.Inv(b), c)
+	temp := new(TriPerplex).Sub(b, one)
+	temp.Inv(temp)
+	z.Mul(v, b)
+	z.Sub(z, w)
+	return z.Mul(z, temp)
+}
+
 // Generate returns a random TriPerplex value for quick.Check testing.
 func (z *TriPerplex) Generate(rand *rand.Rand, size int) reflect.Value {
-	randomTriPerplex := &TriPerplex{
-		*NewBiPerplex(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-		*NewBiPerplex(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-	}
-	return reflect.ValueOf(randomTriPerplex)
+	return reflect.ValueOf(new(TriPerplex).Random(rand, generateOptions))
 }
@@ -333,3 +333,71 @@ func TestTriPerplexComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Scalar operands
+
+func TestTriPerplexMulReal(t *testing.T) {
+	f := func(x, y *TriPerplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(big.Rat).Set(y.Real())
+		s := new(TriPerplex)
+		s.Real().Set(a)
+		if !s.IsReal() || x.IsReal() {
+			return false
+		}
+		l, r := new(TriPerplex), new(TriPerplex)
+		l.Mul(s, x)
+		r.Mul(x, s)
+		// an independent product: s x = (s + x)x - x x, with no real factor
+		xx := new(TriPerplex).Mul(x, x)
+		sx := new(TriPerplex).Add(s, x)
+		return l.Equals(new(TriPerplex).Sub(new(TriPerplex).Mul(sx, x), xx)) &&
+			r.Equals(new(TriPerplex).Sub(new(TriPerplex).Mul(x, sx), xx))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTriPerplexIsPure(t *testing.T) {
+	f := func(x *TriPerplex) bool {
+		// t.Logf("x = %v", x)
+		p := new(TriPerplex).Set(x)
+		p.Real().SetInt64(0)
+		return p.IsPure() && !x.IsPure()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Ordering
+
+func TestTriPerplexLessTrichotomy(t *testing.T) {
+	f := func(x, y *TriPerplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		n := 0
+		for _, b := range []bool{x.Less(y), y.Less(x), x.Equals(y)} {
+			if b {
+				n++
+			}
+		}
+		return n == 1 && !x.Less(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTriPerplexLessTransitive(t *testing.T) {
+	f := func(x, y, z *TriPerplex) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		if x.Less(y) && y.Less(z) {
+			return x.Less(z)
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
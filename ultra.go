@@ -5,6 +5,7 @@ package rational
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"reflect"
@@ -18,18 +19,32 @@ type Ultra struct {
 	l, r Supra
 }
 
-// Real returns the (rational) real part of z.
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
 func (z *Ultra) Real() *big.Rat {
 	return (&z.l).Real()
 }
 
-// Rats returns the eight rational components of z.
+// Rats returns the eight rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
 func (z *Ultra) Rats() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
 	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
 	return &z.l.l.l, &z.l.l.r, &z.l.r.l, &z.l.r.r,
 		&z.r.l.l, &z.r.l.r, &z.r.r.l, &z.r.r.r
 }
 
+// RatsCopy returns copies of the eight rational components of z.
+func (z *Ultra) RatsCopy() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
+	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
+	return new(Ultra).Set(z).Rats()
+}
+
+// Components returns the eight rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *Ultra) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
 // String returns the string representation of an Ultra value.
 //
 // If z corresponds to a + bα + cβ + dγ + eδ + fε + gζ + hη, then the string
@@ -39,7 +54,8 @@ func (z *Ultra) String() string {
 	v[0], v[1], v[2], v[3] = z.l.Rats()
 	v[4], v[5], v[6], v[7] = z.r.Rats()
 	a := make([]string, 17)
-	a[0] = leftBracket
+	br := brackets()
+	a[0] = br[0]
 	a[1] = fmt.Sprintf("%v", v[0].RatString())
 	i := 1
 	for j := 2; j < 16; j = j + 2 {
@@ -51,7 +67,7 @@ func (z *Ultra) String() string {
 		a[j+1] = symbUltra[i]
 		i++
 	}
-	a[16] = rightBracket
+	a[16] = br[1]
 	return strings.Join(a, "")
 }
 
@@ -63,6 +79,16 @@ func (z *Ultra) Equals(y *Ultra) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *Ultra) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Supra))
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *Ultra) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *Ultra) Set(y *Ultra) *Ultra {
 	z.l.Set(&y.l)
@@ -146,12 +172,26 @@ func (z *Ultra) Sub(x, y *Ultra) *Ultra {
 // 		Mul(ε, η) = Mul(η, ε) = 0
 // 		Mul(ζ, η) = Mul(η, ζ) = 0
 // This binary operation is noncommutative and nonassociative.
-func (z *Ultra) Mul(x, y *Ultra) *Ultra {
-	a := new(Supra).Set(&x.l)
-	b := new(Supra).Set(&x.r)
-	c := new(Supra).Set(&y.l)
-	d := new(Supra).Set(&y.r)
-	temp := new(Supra)
+func (z *Ultra) Mul(x, y *Ultra) (product *Ultra) {
+	if h := currentHook(); h != nil {
+		x, y := new(Ultra).Set(x), new(Ultra).Set(y)
+		defer func() { traceMul(h, "Ultra.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	a, b, c, d := &x.l, &x.r, &y.l, &y.r
+	if z == x || z == y {
+		// z.l is written before z.r is computed
+		a, b = supraArena.get().Set(a), supraArena.get().Set(b)
+		c, d = supraArena.get().Set(c), supraArena.get().Set(d)
+		defer supraArena.put(a, b, c, d)
+	}
+	temp := supraArena.get()
+	defer supraArena.put(temp)
 	z.l.Mul(a, c)
 	z.r.Add(
 		z.r.Mul(d, a),
@@ -196,11 +236,16 @@ func (z *Ultra) IsZeroDivisor() bool {
 
 // Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
 // then Inv panics.
-func (z *Ultra) Inv(y *Ultra) *Ultra {
+func (z *Ultra) Inv(y *Ultra) (inverse *Ultra) {
+	if h := currentHook(); h != nil {
+		y := new(Ultra).Set(y)
+		defer func() { traceInv(h, "Ultra.Inv", inverse != nil, z, y) }()
+	}
 	if y.IsZeroDivisor() {
-		panic("inverse of zero divisor")
+		panic(divisorError("inverse of zero divisor"))
 	}
 	a := y.Quad()
+	defer putRat(a)
 	a.Inv(a)
 	return z.Scal(z.Conj(y), a)
 }
@@ -210,7 +255,7 @@ func (z *Ultra) Inv(y *Ultra) *Ultra {
 // Then it returns z. If y is a zero divisor, then QuoL panics.
 func (z *Ultra) QuoL(x, y *Ultra) *Ultra {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(z.Inv(y), x)
 }
@@ -220,26 +265,137 @@ func (z *Ultra) QuoL(x, y *Ultra) *Ultra {
 // Then it returns z. If y is a zero divisor, then QuoR panics.
 func (z *Ultra) QuoR(x, y *Ultra) *Ultra {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(x, z.Inv(y))
 }
 
+// Rescale sets z equal to the image of y under the automorphism that scales
+// the nilpotent generator with the given unit index by a, and returns z. The
+// valid units are 1 (α), 2 (β), and 4 (δ); any other unit makes Rescale
+// panic.
+func (z *Ultra) Rescale(y *Ultra, unit int, a *big.Rat) *Ultra {
+	switch unit {
+	case 1, 2:
+		z.l.Rescale(&y.l, unit, a)
+		z.r.Rescale(&y.r, unit, a)
+	case 4:
+		z.l.Set(&y.l)
+		z.r.Scal(&y.r, a)
+	default:
+		panic("invalid unit")
+	}
+	return z
+}
+
+// LeftMul returns the linear operator that sends y to Mul(z, y), acting on
+// the rational components of y.
+func (z *Ultra) LeftMul() *Operator {
+	a := new(Ultra).Set(z)
+	return &Operator{8, func(v []*big.Rat) []*big.Rat {
+		y := NewUltra(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+		return rats(y.Mul(a, y).Rats())
+	}}
+}
+
+// RightMul returns the linear operator that sends y to Mul(y, z), acting on
+// the rational components of y.
+func (z *Ultra) RightMul() *Operator {
+	a := new(Ultra).Set(z)
+	return &Operator{8, func(v []*big.Rat) []*big.Rat {
+		y := NewUltra(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+		return rats(y.Mul(y, a).Rats())
+	}}
+}
+
+// MinPoly returns the minimal polynomial of z. If z is real, then the
+// minimal polynomial is
+// 		t - Real(z)
+// Otherwise, it is
+// 		t² - 2Real(z)t + Quad(z)
+func (z *Ultra) MinPoly() Laurent {
+	re := new(big.Rat).Set(z.Real())
+	pure := new(Ultra).Set(z)
+	pure.Real().SetInt64(0)
+	if pure.Equals(new(Ultra)) {
+		return Laurent{0: re.Neg(re), 1: big.NewRat(1, 1)}
+	}
+	return Laurent{
+		0: z.Quad(),
+		1: re.Mul(re, big.NewRat(-2, 1)),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// EvaluateMinPoly returns true if z satisfies its minimal polynomial.
+func (z *Ultra) EvaluateMinPoly() bool {
+	return satisfies(z, z.MinPoly())
+}
+
+// PowViaMinPoly sets z equal to y raised to the power n, and returns z. High
+// powers are reduced with the minimal polynomial of y, so only O(log n)
+// rational multiplications are needed.
+func (z *Ultra) PowViaMinPoly(y *Ultra, n uint64) *Ultra {
+	p, q := quadPow(y.MinPoly(), n)
+	z.Scal(y, p)
+	z.Real().Add(z.Real(), q)
+	return z
+}
+
+// WriteTo writes a canonical encoding of z to w, and returns the number of
+// bytes written. Equal values produce equal encodings, so any hash.Hash can be
+// used as w to hash z without building an intermediate byte slice.
+func (z *Ultra) WriteTo(w io.Writer) (int64, error) {
+	return writeRats(w, rats(z.Rats()))
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// the rational parameter t, and returns z. The curve is evaluated exactly with
+// the De Casteljau algorithm. If p is empty, then Bezier panics.
+func (z *Ultra) Bezier(t *big.Rat, p ...*Ultra) *Ultra {
+	if len(p) == 0 {
+		panic("no control points")
+	}
+	s := new(big.Rat).Sub(big.NewRat(1, 1), t)
+	b := make([]*Ultra, len(p))
+	for i := range p {
+		b[i] = new(Ultra).Set(p[i])
+	}
+	temp := new(Ultra)
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			b[i].Add(b[i].Scal(b[i], s), temp.Scal(b[i+1], t))
+		}
+	}
+	return z.Set(b[0])
+}
+
+// Round sets z equal to y with each component rounded to a multiple of 1/den
+// in the direction given by mode, and returns z. Directed modes round every
+// component the same way, so interval bounds stay rigorous. If den is not
+// positive, then Round panics.
+func (z *Ultra) Round(y *Ultra, den *big.Int, mode big.RoundingMode) *Ultra {
+	z.Set(y)
+	for _, c := range rats(z.Rats()) {
+		roundRat(c, c, den, mode)
+	}
+	return z
+}
+
+// Act returns the left action of z on the pair (a, b) of Supra values, which
+// is identified with the Ultra value a + bδ and multiplied on the left by z.
+// Ultra is not associative, so Act is not a representation of Ultra: acting by
+// Mul(x, y) need not be acting by y and then by x. Ultra is alternative, so
+// acting by x twice is acting by Mul(x, x).
+func (z *Ultra) Act(a, b *Supra) (*Supra, *Supra) {
+	v := new(Ultra)
+	v.l.Set(a)
+	v.r.Set(b)
+	v.Mul(z, v)
+	return &v.l, &v.r
+}
+
 // Generate returns a random Ultra value for quick.Check testing.
 func (z *Ultra) Generate(rand *rand.Rand, size int) reflect.Value {
-	randomUltra := &Ultra{
-		*NewSupra(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-		*NewSupra(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-	}
-	return reflect.ValueOf(randomUltra)
+	return reflect.ValueOf(new(Ultra).Random(rand, generateOptions))
 }
@@ -378,3 +378,61 @@ func TestUltraComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Automorphism
+
+func TestUltraRescaleMulDistributive(t *testing.T) {
+	a := big.NewRat(3, 5)
+	f := func(x, y *Ultra) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		for _, u := range []int{1, 2, 4} {
+			l, r := new(Ultra), new(Ultra)
+			l.Rescale(l.Mul(x, y), u, a)
+			r.Mul(new(Ultra).Rescale(x, u, a), r.Rescale(y, u, a))
+			if !l.Equals(r) {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Scalar operands
+
+func TestUltraMulReal(t *testing.T) {
+	f := func(x, y *Ultra) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(big.Rat).Set(y.Real())
+		s := new(Ultra)
+		s.Real().Set(a)
+		if !s.IsReal() || x.IsReal() {
+			return false
+		}
+		l, r := new(Ultra), new(Ultra)
+		l.Mul(s, x)
+		r.Mul(x, s)
+		// an independent product: s x = (s + x)x - x x, with no real factor
+		xx := new(Ultra).Mul(x, x)
+		sx := new(Ultra).Add(s, x)
+		return l.Equals(new(Ultra).Sub(new(Ultra).Mul(sx, x), xx)) &&
+			r.Equals(new(Ultra).Sub(new(Ultra).Mul(x, sx), xx))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUltraIsPure(t *testing.T) {
+	f := func(x *Ultra) bool {
+		// t.Logf("x = %v", x)
+		p := new(Ultra).Set(x)
+		p.Real().SetInt64(0)
+		return p.IsPure() && !x.IsPure()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
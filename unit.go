@@ -0,0 +1,424 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// mulTable returns the products of the n basis units of S.
+func mulTable[S any, T Elem[S]](n int, unit func(z T, i int) T) [][]T {
+	table := make([][]T, n)
+	for i := range table {
+		table[i] = make([]T, n)
+		x := unit(T(new(S)), i)
+		for j := range table[i] {
+			table[i][j] = T(new(S)).Mul(x, unit(T(new(S)), j))
+		}
+	}
+	return table
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one, and Unit(1) is i. If i is out of range, then Unit panics.
+func (z *Complex) Unit(i int) *Complex {
+	z.Set(new(Complex))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of Complex: the entry in row i and
+// column j is Unit(i) times Unit(j), and its components are the structure
+// constants. The value of z is not used.
+func (z *Complex) MulTable() [][]*Complex {
+	return mulTable(2, (*Complex).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one. If i is out of range, then Unit panics.
+func (z *Infra) Unit(i int) *Infra {
+	z.Set(new(Infra))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of Infra: the entry in row i and
+// column j is Unit(i) times Unit(j), and its components are the structure
+// constants. The value of z is not used.
+func (z *Infra) MulTable() [][]*Infra {
+	return mulTable(2, (*Infra).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one. If i is out of range, then Unit panics.
+func (z *Perplex) Unit(i int) *Perplex {
+	z.Set(new(Perplex))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of Perplex: the entry in row i and
+// column j is Unit(i) times Unit(j), and its components are the structure
+// constants. The value of z is not used.
+func (z *Perplex) MulTable() [][]*Perplex {
+	return mulTable(2, (*Perplex).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one. If i is out of range, then Unit panics.
+func (z *BiComplex) Unit(i int) *BiComplex {
+	z.Set(new(BiComplex))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of BiComplex: the entry in row i
+// and column j is Unit(i) times Unit(j), and its components are the structure
+// constants. The value of z is not used.
+func (z *BiComplex) MulTable() [][]*BiComplex {
+	return mulTable(4, (*BiComplex).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one. If i is out of range, then Unit panics.
+func (z *BiPerplex) Unit(i int) *BiPerplex {
+	z.Set(new(BiPerplex))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of BiPerplex: the entry in row i
+// and column j is Unit(i) times Unit(j), and its components are the structure
+// constants. The value of z is not used.
+func (z *BiPerplex) MulTable() [][]*BiPerplex {
+	return mulTable(4, (*BiPerplex).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one. If i is out of range, then Unit panics.
+func (z *Cockle) Unit(i int) *Cockle {
+	z.Set(new(Cockle))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of Cockle: the entry in row i and
+// column j is Unit(i) times Unit(j), and its components are the structure
+// constants. The value of z is not used.
+func (z *Cockle) MulTable() [][]*Cockle {
+	return mulTable(4, (*Cockle).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one. If i is out of range, then Unit panics.
+func (z *DualComplex) Unit(i int) *DualComplex {
+	z.Set(new(DualComplex))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of DualComplex: the entry in row i
+// and column j is Unit(i) times Unit(j), and its components are the structure
+// constants. The value of z is not used.
+func (z *DualComplex) MulTable() [][]*DualComplex {
+	return mulTable(4, (*DualComplex).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one. If i is out of range, then Unit panics.
+func (z *DualPerplex) Unit(i int) *DualPerplex {
+	z.Set(new(DualPerplex))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of DualPerplex: the entry in row i
+// and column j is Unit(i) times Unit(j), and its components are the structure
+// constants. The value of z is not used.
+func (z *DualPerplex) MulTable() [][]*DualPerplex {
+	return mulTable(4, (*DualPerplex).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one, and Unit(3) is k. If i is out of range, then Unit panics.
+func (z *Hamilton) Unit(i int) *Hamilton {
+	z.Set(new(Hamilton))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of Hamilton: the entry in row i
+// and column j is Unit(i) times Unit(j), and its components are the structure
+// constants. The value of z is not used.
+func (z *Hamilton) MulTable() [][]*Hamilton {
+	return mulTable(4, (*Hamilton).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one. If i is out of range, then Unit panics.
+func (z *Hyper) Unit(i int) *Hyper {
+	z.Set(new(Hyper))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of Hyper: the entry in row i and
+// column j is Unit(i) times Unit(j), and its components are the structure
+// constants. The value of z is not used.
+func (z *Hyper) MulTable() [][]*Hyper {
+	return mulTable(4, (*Hyper).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one. If i is out of range, then Unit panics.
+func (z *InfraComplex) Unit(i int) *InfraComplex {
+	z.Set(new(InfraComplex))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of InfraComplex: the entry in row
+// i and column j is Unit(i) times Unit(j), and its components are the
+// structure constants. The value of z is not used.
+func (z *InfraComplex) MulTable() [][]*InfraComplex {
+	return mulTable(4, (*InfraComplex).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one. If i is out of range, then Unit panics.
+func (z *InfraPerplex) Unit(i int) *InfraPerplex {
+	z.Set(new(InfraPerplex))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of InfraPerplex: the entry in row
+// i and column j is Unit(i) times Unit(j), and its components are the
+// structure constants. The value of z is not used.
+func (z *InfraPerplex) MulTable() [][]*InfraPerplex {
+	return mulTable(4, (*InfraPerplex).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one. If i is out of range, then Unit panics.
+func (z *Supra) Unit(i int) *Supra {
+	z.Set(new(Supra))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of Supra: the entry in row i and
+// column j is Unit(i) times Unit(j), and its components are the structure
+// constants. The value of z is not used.
+func (z *Supra) MulTable() [][]*Supra {
+	return mulTable(4, (*Supra).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one. If i is out of range, then Unit panics.
+func (z *BiCockle) Unit(i int) *BiCockle {
+	z.Set(new(BiCockle))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of BiCockle: the entry in row i
+// and column j is Unit(i) times Unit(j), and its components are the structure
+// constants. The value of z is not used.
+func (z *BiCockle) MulTable() [][]*BiCockle {
+	return mulTable(8, (*BiCockle).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one. If i is out of range, then Unit panics.
+func (z *BiHamilton) Unit(i int) *BiHamilton {
+	z.Set(new(BiHamilton))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of BiHamilton: the entry in row i
+// and column j is Unit(i) times Unit(j), and its components are the structure
+// constants. The value of z is not used.
+func (z *BiHamilton) MulTable() [][]*BiHamilton {
+	return mulTable(8, (*BiHamilton).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one. If i is out of range, then Unit panics.
+func (z *Cayley) Unit(i int) *Cayley {
+	z.Set(new(Cayley))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of Cayley: the entry in row i and
+// column j is Unit(i) times Unit(j), and its components are the structure
+// constants. The value of z is not used.
+func (z *Cayley) MulTable() [][]*Cayley {
+	return mulTable(8, (*Cayley).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one. If i is out of range, then Unit panics.
+func (z *InfraCockle) Unit(i int) *InfraCockle {
+	z.Set(new(InfraCockle))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of InfraCockle: the entry in row i
+// and column j is Unit(i) times Unit(j), and its components are the structure
+// constants. The value of z is not used.
+func (z *InfraCockle) MulTable() [][]*InfraCockle {
+	return mulTable(8, (*InfraCockle).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one. If i is out of range, then Unit panics.
+func (z *InfraHamilton) Unit(i int) *InfraHamilton {
+	z.Set(new(InfraHamilton))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of InfraHamilton: the entry in row
+// i and column j is Unit(i) times Unit(j), and its components are the
+// structure constants. The value of z is not used.
+func (z *InfraHamilton) MulTable() [][]*InfraHamilton {
+	return mulTable(8, (*InfraHamilton).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one. If i is out of range, then Unit panics.
+func (z *SupraComplex) Unit(i int) *SupraComplex {
+	z.Set(new(SupraComplex))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of SupraComplex: the entry in row
+// i and column j is Unit(i) times Unit(j), and its components are the
+// structure constants. The value of z is not used.
+func (z *SupraComplex) MulTable() [][]*SupraComplex {
+	return mulTable(8, (*SupraComplex).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one. If i is out of range, then Unit panics.
+func (z *SupraPerplex) Unit(i int) *SupraPerplex {
+	z.Set(new(SupraPerplex))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of SupraPerplex: the entry in row
+// i and column j is Unit(i) times Unit(j), and its components are the
+// structure constants. The value of z is not used.
+func (z *SupraPerplex) MulTable() [][]*SupraPerplex {
+	return mulTable(8, (*SupraPerplex).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one. If i is out of range, then Unit panics.
+func (z *TriComplex) Unit(i int) *TriComplex {
+	z.Set(new(TriComplex))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of TriComplex: the entry in row i
+// and column j is Unit(i) times Unit(j), and its components are the structure
+// constants. The value of z is not used.
+func (z *TriComplex) MulTable() [][]*TriComplex {
+	return mulTable(8, (*TriComplex).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one. If i is out of range, then Unit panics.
+func (z *TriNilplex) Unit(i int) *TriNilplex {
+	z.Set(new(TriNilplex))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of TriNilplex: the entry in row i
+// and column j is Unit(i) times Unit(j), and its components are the structure
+// constants. The value of z is not used.
+func (z *TriNilplex) MulTable() [][]*TriNilplex {
+	return mulTable(8, (*TriNilplex).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one. If i is out of range, then Unit panics.
+func (z *TriPerplex) Unit(i int) *TriPerplex {
+	z.Set(new(TriPerplex))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of TriPerplex: the entry in row i
+// and column j is Unit(i) times Unit(j), and its components are the structure
+// constants. The value of z is not used.
+func (z *TriPerplex) MulTable() [][]*TriPerplex {
+	return mulTable(8, (*TriPerplex).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one. If i is out of range, then Unit panics.
+func (z *Ultra) Unit(i int) *Ultra {
+	z.Set(new(Ultra))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of Ultra: the entry in row i and
+// column j is Unit(i) times Unit(j), and its components are the structure
+// constants. The value of z is not used.
+func (z *Ultra) MulTable() [][]*Ultra {
+	return mulTable(8, (*Ultra).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one. If i is out of range, then Unit panics.
+func (z *Zorn) Unit(i int) *Zorn {
+	z.Set(new(Zorn))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of Zorn: the entry in row i and
+// column j is Unit(i) times Unit(j), and its components are the structure
+// constants. The value of z is not used.
+func (z *Zorn) MulTable() [][]*Zorn {
+	return mulTable(8, (*Zorn).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one. If i is out of range, then Unit panics.
+func (z *Double[S, T]) Unit(i int) *Double[S, T] {
+	z.Set(new(Double[S, T]))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of the type of z: the entry in row
+// i and column j is Unit(i) times Unit(j), and its components are the
+// structure constants. The value of z is not used.
+func (z *Double[S, T]) MulTable() [][]*Double[S, T] {
+	return mulTable(len(z.Components()), (*Double[S, T]).Unit)
+}
+
+// Unit sets z equal to the i-th basis unit, the value whose i-th component in
+// the order of Components is one and whose others are zero, and returns z.
+// Unit(0) is one, and Unit(4) is Γ. If i is out of range, then Unit panics.
+func (z *DualHamilton) Unit(i int) *DualHamilton {
+	z.Set(new(DualHamilton))
+	return z.SetCoeff(i, big.NewRat(1, 1))
+}
+
+// MulTable returns the multiplication table of DualHamilton: the entry in row
+// i and column j is Unit(i) times Unit(j), and its components are the
+// structure constants. The value of z is not used.
+func (z *DualHamilton) MulTable() [][]*DualHamilton {
+	return mulTable(8, (*DualHamilton).Unit)
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestHamiltonMulTable(t *testing.T) {
+	one := big.NewRat(1, 1)
+	table := new(Hamilton).MulTable()
+	for _, test := range []struct {
+		i, j, k int
+		sign    int64
+	}{
+		{0, 0, 0, 1},
+		{1, 1, 0, -1},
+		{1, 2, 3, 1},
+		{2, 1, 3, -1},
+		{2, 3, 1, 1},
+		{3, 1, 2, 1},
+	} {
+		want := new(Hamilton).Scal(new(Hamilton).Unit(test.k), big.NewRat(test.sign, 1))
+		if got := table[test.i][test.j]; !got.Equals(want) {
+			t.Errorf("MulTable[%d][%d] = %v, want %v", test.i, test.j, got, want)
+		}
+	}
+	if c := new(Hamilton).Unit(3).Coeff(3); c.Cmp(one) != 0 {
+		t.Errorf("Unit(3) has k component %v", c)
+	}
+}
+
+func TestInfraMulTable(t *testing.T) {
+	table := new(Infra).MulTable()
+	if !table[1][1].Equals(new(Infra)) {
+		t.Errorf("α² = %v, want 0", table[1][1])
+	}
+}
+
+func TestSedenionMulTable(t *testing.T) {
+	table := new(Sedenion).MulTable()
+	if len(table) != 16 || len(table[15]) != 16 {
+		t.Fatalf("MulTable is %d by %d, want 16 by 16", len(table), len(table[15]))
+	}
+	// every unit beyond one squares to -1
+	for i := 1; i < 16; i++ {
+		if want := new(Sedenion).Neg(new(Sedenion).Unit(0)); !table[i][i].Equals(want) {
+			t.Errorf("e%d² = %v, want -1", i, table[i][i])
+		}
+	}
+}
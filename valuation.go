@@ -0,0 +1,471 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import "math/big"
+
+// intValuation returns the number of times p divides the non-zero integer n.
+func intValuation(n, p *big.Int) int {
+	v := 0
+	q, r := new(big.Int).Set(n), new(big.Int)
+	for {
+		if q.QuoRem(q, p, r); r.Sign() != 0 {
+			return v
+		}
+		v++
+	}
+}
+
+// valuation returns the least p-adic valuation of the non-zero components v,
+// and false if they are all zero. If p is less than 2, then valuation panics.
+func valuation(v []*big.Rat, p *big.Int) (int, bool) {
+	if p.Cmp(big.NewInt(2)) < 0 {
+		panic("invalid prime")
+	}
+	least, ok := 0, false
+	for _, c := range v {
+		if c.Sign() == 0 {
+			continue
+		}
+		// the numerator and the denominator are coprime, so at most one of
+		// them is divisible by p
+		n := intValuation(c.Num(), p) - intValuation(c.Denom(), p)
+		if !ok || n < least {
+			least, ok = n, true
+		}
+	}
+	return least, ok
+}
+
+// scaleToIntegral multiplies the components v by the least common multiple of
+// their denominators, and returns it.
+func scaleToIntegral(v []*big.Rat) *big.Int {
+	d := big.NewInt(1)
+	g := new(big.Int)
+	for _, c := range v {
+		g.GCD(nil, nil, d, c.Denom())
+		d.Mul(d, new(big.Int).Quo(c.Denom(), g))
+	}
+	s := new(big.Rat).SetInt(d)
+	for _, c := range v {
+		c.Mul(c, s)
+	}
+	return d
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *Complex) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *Complex) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *Infra) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *Infra) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *Perplex) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *Perplex) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *BiComplex) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *BiComplex) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *BiPerplex) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *BiPerplex) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *Cockle) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *Cockle) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *DualComplex) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *DualComplex) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *DualPerplex) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *DualPerplex) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *Hamilton) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *Hamilton) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *Hyper) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *Hyper) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *InfraComplex) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *InfraComplex) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *InfraPerplex) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *InfraPerplex) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *Supra) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *Supra) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *BiCockle) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *BiCockle) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *BiHamilton) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *BiHamilton) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *Cayley) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *Cayley) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *DualHamilton) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *DualHamilton) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *InfraCockle) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *InfraCockle) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *InfraHamilton) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *InfraHamilton) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *SupraComplex) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *SupraComplex) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *SupraPerplex) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *SupraPerplex) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *TriComplex) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *TriComplex) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *TriNilplex) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *TriNilplex) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *TriPerplex) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *TriPerplex) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *Ultra) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *Ultra) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
+
+// Valuation returns the least p-adic valuation of the components of z, the
+// exponent of the largest power of the prime p that can be factored out of z,
+// which is negative if p divides a denominator. If z is zero, then its
+// valuation is infinite and Valuation returns false. If p is less than 2, then
+// Valuation panics.
+func (z *Zorn) Valuation(p *big.Int) (int, bool) {
+	return valuation(z.Components(), p)
+}
+
+// ScaleToIntegral multiplies z by the least common multiple of the
+// denominators of its components, so that they become integers, and returns
+// that multiple. It is the least positive integer that makes z integral.
+func (z *Zorn) ScaleToIntegral() *big.Int {
+	return scaleToIntegral(z.Components())
+}
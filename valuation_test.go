@@ -0,0 +1,70 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+func TestHamiltonValuation(t *testing.T) {
+	p := big.NewInt(3)
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		v, ok := x.Valuation(p)
+		if !ok {
+			return x.Equals(new(Hamilton))
+		}
+		// scaling by p² raises the valuation by 2, and scaling by 1/p lowers
+		// it by 1
+		y := new(Hamilton).Scal(x, big.NewRat(9, 1))
+		if w, _ := y.Valuation(p); w != v+2 {
+			return false
+		}
+		y.Scal(x, big.NewRat(1, 3))
+		if w, _ := y.Valuation(p); w != v-1 {
+			return false
+		}
+		return true
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+	x := NewHamilton(big.NewRat(6, 1), big.NewRat(9, 5), big.NewRat(0, 1), big.NewRat(27, 1))
+	if v, ok := x.Valuation(p); !ok || v != 1 {
+		t.Errorf("Valuation(%v, 3) = %v, %v", x, v, ok)
+	}
+	if v, ok := x.Valuation(big.NewInt(5)); !ok || v != -1 {
+		t.Errorf("Valuation(%v, 5) = %v, %v", x, v, ok)
+	}
+	if _, ok := new(Hamilton).Valuation(p); ok {
+		t.Error("Valuation(0) is finite")
+	}
+}
+
+func TestCayleyScaleToIntegral(t *testing.T) {
+	f := func(x *Cayley) bool {
+		// t.Logf("x = %v", x)
+		y := new(Cayley).Set(x)
+		d := y.ScaleToIntegral()
+		if !y.Equals(new(Cayley).Scal(x, new(big.Rat).SetInt(d))) {
+			return false
+		}
+		for _, c := range y.Components() {
+			if !c.IsInt() {
+				return false
+			}
+		}
+		// no smaller multiple works, so the components and d are coprime
+		g := new(big.Int).Set(d)
+		for _, c := range y.Components() {
+			g.GCD(nil, nil, g, c.Num())
+		}
+		return g.Cmp(big.NewInt(1)) == 0 || x.Equals(new(Cayley))
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"runtime"
+	"sync"
+)
+
+// A Vec is a vector of values of a construct S with pointer type T, such as
+// 		Vec[Hamilton, *Hamilton]
+// Its methods act element-wise or reduce the vector, and their Parallel
+// variants split the work among goroutines, which pays off for long vectors
+// or large components. The results of both are exactly equal. The elements
+// of a receiver must not be nil; NewVec allocates them.
+type Vec[S any, T Elem[S]] []T
+
+// NewVec returns a Vec of n zero values.
+func NewVec[S any, T Elem[S]](n int) Vec[S, T] {
+	z := make(Vec[S, T], n)
+	for i := range z {
+		z[i] = T(new(S))
+	}
+	return z
+}
+
+// parallelChunk is the smallest number of elements that one goroutine of the
+// Parallel methods handles.
+const parallelChunk = 64
+
+// parallelParts returns the number of goroutines that the Parallel methods
+// use for n elements, at least one.
+func parallelParts(n int) int {
+	return max(1, min(runtime.GOMAXPROCS(0), n/parallelChunk))
+}
+
+// parallel splits [0, n) into m consecutive ranges, and calls f with the
+// index and bounds of each range from its own goroutine, under the hook that
+// Trace installed for the caller. It returns when every call has returned.
+func parallel(n, m int, f func(k, lo, hi int)) {
+	if m == 1 {
+		f(0, 0, n)
+		return
+	}
+	var wg sync.WaitGroup
+	for k := 0; k < m; k++ {
+		wg.Add(1)
+		k := k
+		go inScope(func() {
+			defer wg.Done()
+			f(k, k*n/m, (k+1)*n/m)
+		})()
+	}
+	wg.Wait()
+}
+
+// checkLen panics if the lengths of x and y differ.
+func checkLen[S any, T Elem[S]](x, y Vec[S, T]) {
+	if len(x) != len(y) {
+		panic("length mismatch")
+	}
+}
+
+// Add sets each z[i] equal to x[i]+y[i], and returns z. If the lengths of z,
+// x, and y differ, then Add panics.
+func (z Vec[S, T]) Add(x, y Vec[S, T]) Vec[S, T] {
+	checkLen(z, x)
+	checkLen(z, y)
+	for i := range z {
+		z[i].Add(x[i], y[i])
+	}
+	return z
+}
+
+// Sub sets each z[i] equal to x[i]-y[i], and returns z. If the lengths of z,
+// x, and y differ, then Sub panics.
+func (z Vec[S, T]) Sub(x, y Vec[S, T]) Vec[S, T] {
+	checkLen(z, x)
+	checkLen(z, y)
+	for i := range z {
+		z[i].Sub(x[i], y[i])
+	}
+	return z
+}
+
+// Scal sets each z[i] equal to y[i] scaled by a, and returns z. If the lengths
+// of z and y differ, then Scal panics.
+func (z Vec[S, T]) Scal(y Vec[S, T], a *big.Rat) Vec[S, T] {
+	checkLen(z, y)
+	for i := range z {
+		z[i].Scal(y[i], a)
+	}
+	return z
+}
+
+// Sum returns the sum of the elements of z as a new value. The sum of no
+// values is zero.
+func (z Vec[S, T]) Sum() T {
+	sum := T(new(S))
+	for _, x := range z {
+		sum.Add(sum, x)
+	}
+	return sum
+}
+
+// Prod returns the product of the elements of z as a new value, multiplied
+// from left to right, as in Product. The product of no values is one.
+func (z Vec[S, T]) Prod() T {
+	prod := T(new(S))
+	prod.Real().SetInt64(1)
+	for _, x := range z {
+		prod.Mul(prod, x)
+	}
+	return prod
+}
+
+// Inner returns the Conj-symmetric inner product of z and y as a new value:
+// 		Conj(z[0]) y[0] + Conj(z[1]) y[1] + ...
+// If the lengths of z and y differ, then Inner panics.
+func (z Vec[S, T]) Inner(y Vec[S, T]) T {
+	checkLen(z, y)
+	return z.inner(y, 0, len(z))
+}
+
+// inner returns the inner product of z[lo:hi] and y[lo:hi].
+func (z Vec[S, T]) inner(y Vec[S, T], lo, hi int) T {
+	sum, c := T(new(S)), T(new(S))
+	for i := lo; i < hi; i++ {
+		c.Conj(z[i])
+		sum.Add(sum, c.Mul(c, y[i]))
+	}
+	return sum
+}
+
+// Dot returns the real part of Inner(z, y), the sum of the dot products of
+// the elements for the types with a Dot method. Dot(z, z) is the sum of their
+// quadrances. If the lengths of z and y differ, then Dot panics.
+func (z Vec[S, T]) Dot(y Vec[S, T]) *big.Rat {
+	return z.Inner(y).Real()
+}
+
+// ParallelAdd is like Add, with the work split among goroutines.
+func (z Vec[S, T]) ParallelAdd(x, y Vec[S, T]) Vec[S, T] {
+	checkLen(z, x)
+	checkLen(z, y)
+	parallel(len(z), parallelParts(len(z)), func(_, lo, hi int) {
+		z[lo:hi].Add(x[lo:hi], y[lo:hi])
+	})
+	return z
+}
+
+// ParallelScal is like Scal, with the work split among goroutines.
+func (z Vec[S, T]) ParallelScal(y Vec[S, T], a *big.Rat) Vec[S, T] {
+	checkLen(z, y)
+	parallel(len(z), parallelParts(len(z)), func(_, lo, hi int) {
+		z[lo:hi].Scal(y[lo:hi], a)
+	})
+	return z
+}
+
+// ParallelSum is like Sum, with the work split among goroutines, each of
+// which sums a part of z.
+func (z Vec[S, T]) ParallelSum() T {
+	m := parallelParts(len(z))
+	parts := make(Vec[S, T], m)
+	parallel(len(z), m, func(k, lo, hi int) {
+		parts[k] = z[lo:hi].Sum()
+	})
+	return parts.Sum()
+}
+
+// ParallelInner is like Inner, with the work split among goroutines, each of
+// which sums a part of the products.
+func (z Vec[S, T]) ParallelInner(y Vec[S, T]) T {
+	checkLen(z, y)
+	m := parallelParts(len(z))
+	parts := make(Vec[S, T], m)
+	parallel(len(z), m, func(k, lo, hi int) {
+		parts[k] = z.inner(y, lo, hi)
+	})
+	return parts.Sum()
+}
+
+// ParallelDot is like Dot, with the work split among goroutines.
+func (z Vec[S, T]) ParallelDot(y Vec[S, T]) *big.Rat {
+	return z.ParallelInner(y).Real()
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package rational
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func randomVec[S any, T Elem[S]](r *rand.Rand, n int) Vec[S, T] {
+	z := NewVec[S, T](n)
+	for _, x := range z {
+		random(x, r, nil)
+	}
+	return z
+}
+
+func TestVecParallel(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	x := randomVec[Hamilton](r, 1000)
+	y := randomVec[Hamilton](r, 1000)
+	if a, b := x.Sum(), x.ParallelSum(); !a.Equals(b) {
+		t.Errorf("Sum = %v, ParallelSum = %v", a, b)
+	}
+	if a, b := x.Inner(y), x.ParallelInner(y); !a.Equals(b) {
+		t.Errorf("Inner = %v, ParallelInner = %v", a, b)
+	}
+	if a, b := x.Dot(y), x.ParallelDot(y); a.Cmp(b) != 0 {
+		t.Errorf("Dot = %v, ParallelDot = %v", a, b)
+	}
+	a := NewVec[Hamilton](1000).Add(x, y)
+	b := NewVec[Hamilton](1000).ParallelAdd(x, y)
+	c := NewVec[Hamilton](1000).ParallelScal(x, big.NewRat(-3, 7))
+	for i := range a {
+		if !a[i].Equals(b[i]) {
+			t.Errorf("Add[%d] = %v, ParallelAdd[%d] = %v", i, a[i], i, b[i])
+		}
+		if !c[i].Equals(new(Hamilton).Scal(x[i], big.NewRat(-3, 7))) {
+			t.Errorf("ParallelScal[%d] = %v", i, c[i])
+		}
+	}
+	if s := NewVec[Hamilton](1000).Sub(a, y); !s.Sum().Equals(x.Sum()) {
+		t.Errorf("Sub(Add(x, y), y) does not sum to Sum(x)")
+	}
+}
+
+func TestVecDot(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	x, y := randomVec[Complex](r, 10), randomVec[Complex](r, 10)
+	p, q := randomVec[Perplex](r, 10), randomVec[Perplex](r, 10)
+	h, k := randomVec[Hamilton](r, 10), randomVec[Hamilton](r, 10)
+	c, d := randomVec[Cockle](r, 10), randomVec[Cockle](r, 10)
+	sums := make([]*big.Rat, 4)
+	for i := range sums {
+		sums[i] = new(big.Rat)
+	}
+	for i := 0; i < 10; i++ {
+		sums[0].Add(sums[0], x[i].Dot(y[i]))
+		sums[1].Add(sums[1], p[i].Dot(q[i]))
+		sums[2].Add(sums[2], h[i].Dot(k[i]))
+		sums[3].Add(sums[3], c[i].Dot(d[i]))
+	}
+	for i, dot := range []*big.Rat{x.Dot(y), p.Dot(q), h.Dot(k), c.Dot(d)} {
+		if dot.Cmp(sums[i]) != 0 {
+			t.Errorf("case %d: Vec.Dot = %v, want %v", i, dot, sums[i])
+		}
+	}
+	quad := new(big.Rat)
+	for _, v := range h {
+		quad.Add(quad, v.Quad())
+	}
+	if dot := h.Dot(h); dot.Cmp(quad) != 0 {
+		t.Errorf("Dot(h, h) = %v, want %v", dot, quad)
+	}
+	if prod := h[:3].Prod(); !prod.Equals(new(Hamilton).Mul(new(Hamilton).Mul(h[0], h[1]), h[2])) {
+		t.Errorf("Prod = %v", prod)
+	}
+}
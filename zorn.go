@@ -5,6 +5,7 @@ package rational
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"reflect"
@@ -18,18 +19,32 @@ type Zorn struct {
 	l, r Hamilton
 }
 
-// Real returns the (rational) real part of z.
+// Real returns the (rational) real part of z. The result aliases z, so
+// modifying it modifies z.
 func (z *Zorn) Real() *big.Rat {
 	return (&z.l).Real()
 }
 
-// Rats returns the eight rational components of z.
+// Rats returns the eight rational components of z. The results alias z,
+// so modifying them modifies z; use RatsCopy for independent copies.
 func (z *Zorn) Rats() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
 	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
 	return &z.l.l.l, &z.l.l.r, &z.l.r.l, &z.l.r.r,
 		&z.r.l.l, &z.r.l.r, &z.r.r.l, &z.r.r.r
 }
 
+// RatsCopy returns copies of the eight rational components of z.
+func (z *Zorn) RatsCopy() (*big.Rat, *big.Rat, *big.Rat, *big.Rat,
+	*big.Rat, *big.Rat, *big.Rat, *big.Rat) {
+	return new(Zorn).Set(z).Rats()
+}
+
+// Components returns the eight rational components of z as a slice, in the
+// order of Rats. The results alias z.
+func (z *Zorn) Components() []*big.Rat {
+	return rats(z.Rats())
+}
+
 // String returns the string representation of a Zorn value.
 //
 // If z corresponds to a + bi + cj + dk + er + fs + gt + hu, then the
@@ -39,7 +54,8 @@ func (z *Zorn) String() string {
 	v[0], v[1], v[2], v[3] = z.l.Rats()
 	v[4], v[5], v[6], v[7] = z.r.Rats()
 	a := make([]string, 17)
-	a[0] = leftBracket
+	br := brackets()
+	a[0] = br[0]
 	a[1] = fmt.Sprintf("%v", v[0].RatString())
 	i := 1
 	for j := 2; j < 16; j = j + 2 {
@@ -51,7 +67,7 @@ func (z *Zorn) String() string {
 		a[j+1] = symbZorn[i]
 		i++
 	}
-	a[16] = rightBracket
+	a[16] = br[1]
 	return strings.Join(a, "")
 }
 
@@ -63,6 +79,16 @@ func (z *Zorn) Equals(y *Zorn) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *Zorn) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Hamilton))
+}
+
+// IsPure returns true if the real part of z is zero.
+func (z *Zorn) IsPure() bool {
+	return z.Real().Sign() == 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *Zorn) Set(y *Zorn) *Zorn {
 	z.l.Set(&y.l)
@@ -146,12 +172,26 @@ func (z *Zorn) Sub(x, y *Zorn) *Zorn {
 // 		Mul(s, u) = -Mul(u, s) = -j
 // 		Mul(t, u) = -Mul(u, t) = +i
 // This binary operation is noncommutative and nonassociative.
-func (z *Zorn) Mul(x, y *Zorn) *Zorn {
-	a := new(Hamilton).Set(&x.l)
-	b := new(Hamilton).Set(&x.r)
-	c := new(Hamilton).Set(&y.l)
-	d := new(Hamilton).Set(&y.r)
-	temp := new(Hamilton)
+func (z *Zorn) Mul(x, y *Zorn) (product *Zorn) {
+	if h := currentHook(); h != nil {
+		x, y := new(Zorn).Set(x), new(Zorn).Set(y)
+		defer func() { traceMul(h, "Zorn.Mul", product != nil, z, x, y) }()
+	}
+	if x.IsReal() {
+		return z.Scal(y, new(big.Rat).Set(x.Real()))
+	}
+	if y.IsReal() {
+		return z.Scal(x, new(big.Rat).Set(y.Real()))
+	}
+	a, b, c, d := &x.l, &x.r, &y.l, &y.r
+	if z == x || z == y {
+		// z.l is written before z.r is computed
+		a, b = hamiltonArena.get().Set(a), hamiltonArena.get().Set(b)
+		c, d = hamiltonArena.get().Set(c), hamiltonArena.get().Set(d)
+		defer hamiltonArena.put(a, b, c, d)
+	}
+	temp := hamiltonArena.get()
+	defer hamiltonArena.put(temp)
 	z.l.Add(
 		z.l.Mul(a, c),
 		temp.Mul(temp.Conj(d), b),
@@ -189,10 +229,9 @@ func (z *Zorn) Associator(w, x, y *Zorn) *Zorn {
 //		a² + b² + c² + d² - e² - f² - g² - h²
 // This can be positive, negative, or zero.
 func (z *Zorn) Quad() *big.Rat {
-	return new(big.Rat).Sub(
-		z.l.Quad(),
-		z.r.Quad(),
-	)
+	q, r := z.l.Quad(), z.r.Quad()
+	defer putRat(r)
+	return q.Sub(q, r)
 }
 
 // IsZeroDivisor returns true if z is a zero divisor.
@@ -202,11 +241,16 @@ func (z *Zorn) IsZeroDivisor() bool {
 
 // Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
 // then Inv panics.
-func (z *Zorn) Inv(y *Zorn) *Zorn {
+func (z *Zorn) Inv(y *Zorn) (inverse *Zorn) {
+	if h := currentHook(); h != nil {
+		y := new(Zorn).Set(y)
+		defer func() { traceInv(h, "Zorn.Inv", inverse != nil, z, y) }()
+	}
 	if y.IsZeroDivisor() {
-		panic("inverse of zero divisor")
+		panic(divisorError("inverse of zero divisor"))
 	}
 	a := y.Quad()
+	defer putRat(a)
 	a.Inv(a)
 	return z.Scal(z.Conj(y), a)
 }
@@ -216,7 +260,7 @@ func (z *Zorn) Inv(y *Zorn) *Zorn {
 // Then it returns z. If y is a zero divisor, then QuoL panics.
 func (z *Zorn) QuoL(x, y *Zorn) *Zorn {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(z.Inv(y), x)
 }
@@ -226,26 +270,258 @@ func (z *Zorn) QuoL(x, y *Zorn) *Zorn {
 // Then it returns z. If y is a zero divisor, then QuoR panics.
 func (z *Zorn) QuoR(x, y *Zorn) *Zorn {
 	if y.IsZeroDivisor() {
-		panic("denominator is zero divisor")
+		panic(divisorError("denominator is zero divisor"))
 	}
 	return z.Mul(x, z.Inv(y))
 }
 
+// LeftMul returns the linear operator that sends y to Mul(z, y), acting on
+// the rational components of y.
+func (z *Zorn) LeftMul() *Operator {
+	a := new(Zorn).Set(z)
+	return &Operator{8, func(v []*big.Rat) []*big.Rat {
+		y := NewZorn(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+		return rats(y.Mul(a, y).Rats())
+	}}
+}
+
+// RightMul returns the linear operator that sends y to Mul(y, z), acting on
+// the rational components of y.
+func (z *Zorn) RightMul() *Operator {
+	a := new(Zorn).Set(z)
+	return &Operator{8, func(v []*big.Rat) []*big.Rat {
+		y := NewZorn(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+		return rats(y.Mul(y, a).Rats())
+	}}
+}
+
+// MinPoly returns the minimal polynomial of z. If z is real, then the
+// minimal polynomial is
+// 		t - Real(z)
+// Otherwise, it is
+// 		t² - 2Real(z)t + Quad(z)
+func (z *Zorn) MinPoly() Laurent {
+	re := new(big.Rat).Set(z.Real())
+	pure := new(Zorn).Set(z)
+	pure.Real().SetInt64(0)
+	if pure.Equals(new(Zorn)) {
+		return Laurent{0: re.Neg(re), 1: big.NewRat(1, 1)}
+	}
+	return Laurent{
+		0: z.Quad(),
+		1: re.Mul(re, big.NewRat(-2, 1)),
+		2: big.NewRat(1, 1),
+	}
+}
+
+// EvaluateMinPoly returns true if z satisfies its minimal polynomial.
+func (z *Zorn) EvaluateMinPoly() bool {
+	return satisfies(z, z.MinPoly())
+}
+
+// PowViaMinPoly sets z equal to y raised to the power n, and returns z. High
+// powers are reduced with the minimal polynomial of y, so only O(log n)
+// rational multiplications are needed.
+func (z *Zorn) PowViaMinPoly(y *Zorn, n uint64) *Zorn {
+	p, q := quadPow(y.MinPoly(), n)
+	z.Scal(y, p)
+	z.Real().Add(z.Real(), q)
+	return z
+}
+
+// WriteTo writes a canonical encoding of z to w, and returns the number of
+// bytes written. Equal values produce equal encodings, so any hash.Hash can be
+// used as w to hash z without building an intermediate byte slice.
+func (z *Zorn) WriteTo(w io.Writer) (int64, error) {
+	return writeRats(w, rats(z.Rats()))
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// the rational parameter t, and returns z. The curve is evaluated exactly with
+// the De Casteljau algorithm. If p is empty, then Bezier panics.
+func (z *Zorn) Bezier(t *big.Rat, p ...*Zorn) *Zorn {
+	if len(p) == 0 {
+		panic("no control points")
+	}
+	s := new(big.Rat).Sub(big.NewRat(1, 1), t)
+	b := make([]*Zorn, len(p))
+	for i := range p {
+		b[i] = new(Zorn).Set(p[i])
+	}
+	temp := new(Zorn)
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			b[i].Add(b[i].Scal(b[i], s), temp.Scal(b[i+1], t))
+		}
+	}
+	return z.Set(b[0])
+}
+
+// Triple returns the rational trilinear form of z, x, and y:
+// 		Real(Mul(Mul(z, x), Conj(y)))
+// Restricted to pure values, this is the associative 3-form, and it is
+// alternating.
+func (z *Zorn) Triple(x, y *Zorn) *big.Rat {
+	p := new(Zorn).Mul(z, x)
+	p.Mul(p, new(Zorn).Conj(y))
+	return new(big.Rat).Set(p.Real())
+}
+
+// AssociatorQuad returns the quadrance of the associator of z, x, and y.
+func (z *Zorn) AssociatorQuad(x, y *Zorn) *big.Rat {
+	return new(Zorn).Associator(z, x, y).Quad()
+}
+
+// Quadruple returns the rational 4-form of z, w, x, and y:
+// 		Real(Mul(z, Conj(Associator(w, x, y)))) / 2
+// Restricted to pure values, this is the coassociative 4-form, and it is
+// alternating.
+func (z *Zorn) Quadruple(w, x, y *Zorn) *big.Rat {
+	a := new(Zorn).Associator(w, x, y)
+	p := new(Zorn).Mul(z, a.Conj(a))
+	return new(big.Rat).Quo(p.Real(), big.NewRat(2, 1))
+}
+
+// Round sets z equal to y with each component rounded to a multiple of 1/den
+// in the direction given by mode, and returns z. Directed modes round every
+// component the same way, so interval bounds stay rigorous. If den is not
+// positive, then Round panics.
+func (z *Zorn) Round(y *Zorn, den *big.Int, mode big.RoundingMode) *Zorn {
+	z.Set(y)
+	for _, c := range rats(z.Rats()) {
+		roundRat(c, c, den, mode)
+	}
+	return z
+}
+
+// Act returns the left action of z on the pair (a, b) of Hamilton values,
+// which is identified with the Zorn value a + br and multiplied on the left by
+// z. Like Cayley, Zorn is not associative, so Act is not a representation:
+// acting by Mul(x, y) differs in general from acting by y and then by x. Since
+// Zorn is alternative, acting by x twice is still acting by Mul(x, x).
+func (z *Zorn) Act(a, b *Hamilton) (*Hamilton, *Hamilton) {
+	v := new(Zorn)
+	v.l.Set(a)
+	v.r.Set(b)
+	v.Mul(z, v)
+	return &v.l, &v.r
+}
+
+// A ZornMatrix is the vector-matrix form of a Zorn value,
+// 		⎡ a v ⎤
+// 		⎣ w b ⎦
+// with rational scalars a and b and rational 3-vectors v and w. Products are
+// given by Zorn's rule
+// 		⎡ aa' + v·w'         av' + b'v - w×w' ⎤
+// 		⎣ a'w + bw' + v×v'   bb' + w·v'       ⎦
+type ZornMatrix struct {
+	A, B *big.Rat
+	V, W [3]*big.Rat
+}
+
+// NewZornMatrix returns a pointer to the zero ZornMatrix.
+func NewZornMatrix() *ZornMatrix {
+	m := &ZornMatrix{A: new(big.Rat), B: new(big.Rat)}
+	for i := 0; i < 3; i++ {
+		m.V[i], m.W[i] = new(big.Rat), new(big.Rat)
+	}
+	return m
+}
+
+// Equals returns true if y and m are equal.
+func (m *ZornMatrix) Equals(y *ZornMatrix) bool {
+	if m.A.Cmp(y.A) != 0 || m.B.Cmp(y.B) != 0 {
+		return false
+	}
+	for i := 0; i < 3; i++ {
+		if m.V[i].Cmp(y.V[i]) != 0 || m.W[i].Cmp(y.W[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Det returns the determinant ab - v·w of m, which is the quadrance of the
+// corresponding Zorn value.
+func (m *ZornMatrix) Det() *big.Rat {
+	det := new(big.Rat).Mul(m.A, m.B)
+	return det.Sub(det, dot3(m.V, m.W))
+}
+
+// dot3 returns the dot product of the 3-vectors u and v.
+func dot3(u, v [3]*big.Rat) *big.Rat {
+	d := new(big.Rat)
+	temp := new(big.Rat)
+	for i := range u {
+		d.Add(d, temp.Mul(u[i], v[i]))
+	}
+	return d
+}
+
+// cross3 returns the cross product of the 3-vectors u and v.
+func cross3(u, v [3]*big.Rat) [3]*big.Rat {
+	var w [3]*big.Rat
+	temp := new(big.Rat)
+	for i := range w {
+		j, k := (i+1)%3, (i+2)%3
+		w[i] = new(big.Rat).Mul(u[j], v[k])
+		w[i].Sub(w[i], temp.Mul(u[k], v[j]))
+	}
+	return w
+}
+
+// Mul sets m equal to the Zorn product of x and y, and returns m.
+func (m *ZornMatrix) Mul(x, y *ZornMatrix) *ZornMatrix {
+	p := NewZornMatrix()
+	p.A.Add(p.A.Mul(x.A, y.A), dot3(x.V, y.W))
+	p.B.Add(p.B.Mul(x.B, y.B), dot3(x.W, y.V))
+	ww, vv := cross3(x.W, y.W), cross3(x.V, y.V)
+	temp := new(big.Rat)
+	for i := 0; i < 3; i++ {
+		p.V[i].Add(p.V[i].Mul(x.A, y.V[i]), temp.Mul(y.B, x.V[i]))
+		p.V[i].Sub(p.V[i], ww[i])
+		p.W[i].Add(p.W[i].Mul(y.A, x.W[i]), temp.Mul(x.B, y.W[i]))
+		p.W[i].Add(p.W[i], vv[i])
+	}
+	*m = *p
+	return m
+}
+
+// ZornMatrix returns the vector-matrix form of z. If
+// 		z = a + bi + cj + dk + er + fs + gt + hu
+// then the scalars are a+e and a-e, and with p = (b, c, d) and q = (f, g, h),
+// the vectors are v = q-p and w = q+p. This identification sends Mul to the
+// Mul of ZornMatrix.
+func (z *Zorn) ZornMatrix() *ZornMatrix {
+	c := z.Components()
+	m := NewZornMatrix()
+	m.A.Add(c[0], c[4])
+	m.B.Sub(c[0], c[4])
+	for i := 0; i < 3; i++ {
+		m.V[i].Sub(c[5+i], c[1+i])
+		m.W[i].Add(c[5+i], c[1+i])
+	}
+	return m
+}
+
+// SetZornMatrix sets z equal to the Zorn value with vector-matrix form m, and
+// returns z. It is the inverse of ZornMatrix.
+func (z *Zorn) SetZornMatrix(m *ZornMatrix) *Zorn {
+	half := big.NewRat(1, 2)
+	v := make([]*big.Rat, 8)
+	v[0] = new(big.Rat).Add(m.A, m.B)
+	v[4] = new(big.Rat).Sub(m.A, m.B)
+	for i := 0; i < 3; i++ {
+		v[1+i] = new(big.Rat).Sub(m.W[i], m.V[i])
+		v[5+i] = new(big.Rat).Add(m.W[i], m.V[i])
+	}
+	for _, x := range v {
+		x.Mul(x, half)
+	}
+	return z.Set(NewZorn(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7]))
+}
+
 // Generate returns a random Zorn value for quick.Check testing.
 func (z *Zorn) Generate(rand *rand.Rand, size int) reflect.Value {
-	randomZorn := &Zorn{
-		*NewHamilton(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-		*NewHamilton(
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-			big.NewRat(rand.Int63(), rand.Int63()),
-		),
-	}
-	return reflect.ValueOf(randomZorn)
+	return reflect.ValueOf(new(Zorn).Random(rand, generateOptions))
 }
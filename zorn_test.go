@@ -366,3 +366,108 @@ func TestZornComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Scalar operands
+
+func TestZornMulReal(t *testing.T) {
+	f := func(x, y *Zorn) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(big.Rat).Set(y.Real())
+		s := new(Zorn)
+		s.Real().Set(a)
+		if !s.IsReal() || x.IsReal() {
+			return false
+		}
+		l, r := new(Zorn), new(Zorn)
+		l.Mul(s, x)
+		r.Mul(x, s)
+		// an independent product: s x = (s + x)x - x x, with no real factor
+		xx := new(Zorn).Mul(x, x)
+		sx := new(Zorn).Add(s, x)
+		return l.Equals(new(Zorn).Sub(new(Zorn).Mul(sx, x), xx)) &&
+			r.Equals(new(Zorn).Sub(new(Zorn).Mul(x, sx), xx))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestZornIsPure(t *testing.T) {
+	f := func(x *Zorn) bool {
+		// t.Logf("x = %v", x)
+		p := new(Zorn).Set(x)
+		p.Real().SetInt64(0)
+		return p.IsPure() && !x.IsPure()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Trilinear forms
+
+func TestZornTripleAlternating(t *testing.T) {
+	f := func(x, y, z *Zorn) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		x.Real().SetInt64(0)
+		y.Real().SetInt64(0)
+		z.Real().SetInt64(0)
+		a := x.Triple(y, z)
+		b := new(big.Rat).Neg(y.Triple(x, z))
+		c := new(big.Rat).Neg(x.Triple(z, y))
+		return a.Cmp(b) == 0 && a.Cmp(c) == 0 && x.Triple(x, y).Sign() == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestZornQuadrupleAlternating(t *testing.T) {
+	f := func(w, x, y, z *Zorn) bool {
+		// t.Logf("w = %v, x = %v, y = %v, z = %v", w, x, y, z)
+		w.Real().SetInt64(0)
+		x.Real().SetInt64(0)
+		y.Real().SetInt64(0)
+		z.Real().SetInt64(0)
+		a := w.Quadruple(x, y, z)
+		b := new(big.Rat).Neg(x.Quadruple(w, y, z))
+		c := new(big.Rat).Neg(w.Quadruple(x, z, y))
+		return a.Cmp(b) == 0 && a.Cmp(c) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestZornAssociatorQuad(t *testing.T) {
+	f := func(x, y *Zorn) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return x.AssociatorQuad(x, y).Sign() == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestZornMatrixMul(t *testing.T) {
+	f := func(x, y *Zorn) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(Zorn).Mul(x, y).ZornMatrix()
+		r := NewZornMatrix().Mul(x.ZornMatrix(), y.ZornMatrix())
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestZornMatrixRoundTrip(t *testing.T) {
+	f := func(x *Zorn) bool {
+		// t.Logf("x = %v", x)
+		m := x.ZornMatrix()
+		return new(Zorn).SetZornMatrix(m).Equals(x) && m.Det().Cmp(x.Quad()) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}